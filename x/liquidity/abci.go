@@ -12,9 +12,13 @@ import (
 
 // In the Begin blocker of the liquidity module,
 // Reinitialize batch messages that were not executed in the previous batch and delete batch messages that were executed or ready to delete.
+// Then distribute the rewards of the reward plans to the staked pool coins, and return the pool coins of the bonds
+// whose unbonding has completed.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	k.DeleteAndInitPoolBatches(ctx)
+	k.DistributeRewards(ctx)
+	k.CompleteUnbonds(ctx)
 }
 
 // In case of deposit, withdraw, and swap msgs, unlike other normal tx msgs,
@@ -459,6 +459,21 @@ func (s *IntegrationTestSuite) TestNewSwapWithinBatchCmd() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"valid transaction with the swap fee rate of the pool",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				fmt.Sprintf("%d", liquiditytypes.DefaultSwapTypeID),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(10_000))).String(),
+				denomY,
+				fmt.Sprintf("%.2f", 1.0),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -9,6 +9,24 @@ import (
 const (
 	FlagPoolCoinDenom = "pool-coin-denom"
 	FlagReserveAcc    = "reserve-acc"
+	FlagWeights       = "weights"
+	FlagAmplification = "amplification"
+	FlagOrderLifespan = "order-lifespan"
+
+	FlagMinDemandCoinAmount = "min-demand-coin-amount"
+	FlagDemandCoinAmount    = "demand-coin-amount"
+
+	FlagWithdrawCoinDenom = "withdraw-coin-denom"
+
+	FlagPairDenoms = "pair-denoms"
+	FlagDenom      = "denom"
+	FlagTypeID     = "type-id"
+
+	FlagPoolID = "pool-id"
+
+	FlagMinDuration = "min-duration"
+
+	FlagMsgType = "msg-type"
 )
 
 func flagSetPool() *flag.FlagSet {
@@ -16,6 +34,84 @@ func flagSetPool() *flag.FlagSet {
 
 	fs.String(FlagPoolCoinDenom, "", "The denomination of the pool coin")
 	fs.String(FlagReserveAcc, "", "The Bech32 address of the reserve account")
+	fs.StringSlice(FlagPairDenoms, nil, "The two reserve coin denoms of the pool in any order")
+	fs.Uint32(FlagTypeID, 0, "The pool type id of the pool with the pair denoms, required only when several pools have the pair")
+
+	return fs
+}
+
+func flagSetPools() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagDenom, "", "The reserve coin denom to filter the pools")
+	fs.Uint32(FlagTypeID, 0, "The pool type id to filter the pools")
+
+	return fs
+}
+
+func flagSetCreatePool() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.UintSlice(FlagWeights, nil, "The weights of the deposit coins in alphabetical denom order, only for the weighted pool type")
+	fs.Uint32(FlagAmplification, 0, "The amplification of the StableSwap curve, only for the stable pool type, the StableSwapAmplification param by default")
+
+	return fs
+}
+
+func flagSetSwap() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint32(FlagOrderLifespan, 0, "The lifespan of the swap order in blocks, the order expires at the end of the current batch by default")
+	fs.String(FlagMinDemandCoinAmount, "0", "The minimum amount of demand coin to receive after the swap fee, the matched order receiving less is refunded")
+	fs.String(FlagDemandCoinAmount, "0", "The exact amount of demand coin to receive after the swap fee, only for the exact-output swap type 2")
+
+	return fs
+}
+
+func flagSetWithdraw() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagWithdrawCoinDenom, "", "The reserve coin denom in which the whole pool coin is withdrawn, only for the weighted pool type")
+
+	return fs
+}
+
+func flagSetTimeWeightedAveragePrice() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.StringSlice(FlagPairDenoms, nil, "The two reserve coin denoms of the pair, required for the pool with more than two reserve coins")
+
+	return fs
+}
+
+func flagSetRewardPlans() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagPoolID, 0, "The pool id to filter the reward plans")
+
+	return fs
+}
+
+func flagSetBondedPoolCoins() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Duration(FlagMinDuration, 0, "The minimum bond duration of the bonds to count")
+
+	return fs
+}
+
+func flagSetCircuitBreakers() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagPoolID, 0, "The pool id to filter the circuit breakers, including those of all pools")
+
+	return fs
+}
+
+func flagSetSetCircuitBreaker() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMsgType, "", "The type of the messages to halt, all types if empty")
 
 	return fs
 }
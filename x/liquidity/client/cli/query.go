@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
@@ -38,6 +39,25 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryPoolBatchWithdrawMsg(),
 		GetCmdQueryPoolBatchSwapMsgs(),
 		GetCmdQueryPoolBatchSwapMsg(),
+		GetCmdQueryLiquidityPoolPositions(),
+		GetCmdQuerySimulateSwap(),
+		GetCmdQueryEstimateDeposit(),
+		GetCmdQueryEstimateWithdraw(),
+		GetCmdQueryPoolBatchResults(),
+		GetCmdQueryTimeWeightedAveragePrice(),
+		GetCmdQuerySwapMsgsByRequester(),
+		GetCmdQueryDepositMsgsByDepositor(),
+		GetCmdQueryWithdrawMsgsByWithdrawer(),
+		GetCmdQueryLiquidityPositions(),
+		GetCmdQueryRewardPlans(),
+		GetCmdQueryRewardPlan(),
+		GetCmdQueryRewardAccumulator(),
+		GetCmdQueryStakes(),
+		GetCmdQueryBonds(),
+		GetCmdQueryBondedPoolCoins(),
+		GetCmdQueryCollectedProtocolFees(),
+		GetCmdQueryPoolFeeRate(),
+		GetCmdQueryCircuitBreakers(),
 	)
 
 	return liquidityQueryCmd
@@ -97,6 +117,10 @@ $ %[1]s query %[2]s pool --pool-coin-denom=[denom]
 
 Example (with reserve acc):
 $ %[1]s query %[2]s pool --reserve-acc=[address]
+
+Example (with reserve coin denoms):
+$ %[1]s query %[2]s pool --pair-denoms=uatom,uusd
+$ %[1]s query %[2]s pool --pair-denoms=uatom,uusd --type-id=4
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -135,6 +159,22 @@ $ %[1]s query %[2]s pool --reserve-acc=[address]
 				}
 			}
 
+			pairDenoms, _ := cmd.Flags().GetStringSlice(FlagPairDenoms)
+			if !foundArg && len(pairDenoms) > 0 {
+				if len(pairDenoms) != 2 {
+					return fmt.Errorf("%s must be the two reserve coin denoms of the pool", FlagPairDenoms)
+				}
+				typeID, _ := cmd.Flags().GetUint32(FlagTypeID)
+				foundArg = true
+				res, err = queryClient.LiquidityPoolByDenoms(
+					context.Background(),
+					&types.QueryLiquidityPoolByDenomsRequest{DenomA: pairDenoms[0], DenomB: pairDenoms[1], TypeId: typeID},
+				)
+				if err != nil {
+					return err
+				}
+			}
+
 			if !foundArg && len(args) > 0 {
 				poolID, err := strconv.ParseUint(args[0], 10, 64)
 				if err != nil {
@@ -154,7 +194,7 @@ $ %[1]s query %[2]s pool --reserve-acc=[address]
 			}
 
 			if !foundArg {
-				return fmt.Errorf("provide the pool-id argument or --%s or --%s flag", FlagPoolCoinDenom, FlagReserveAcc)
+				return fmt.Errorf("provide the pool-id argument or --%s or --%s or --%s flag", FlagPoolCoinDenom, FlagReserveAcc, FlagPairDenoms)
 			}
 
 			return clientCtx.PrintProto(res)
@@ -173,8 +213,11 @@ func GetCmdQueryLiquidityPools() *cobra.Command {
 		Short: "Query for all liquidity pools",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query details about all liquidity pools on a network.
+The pools can be filtered by a reserve coin denom and by the pool type id.
+
 Example:
-$ %s query %s pools
+$ %[1]s query %[2]s pools
+$ %[1]s query %[2]s pools --denom=uatom --type-id=1
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -191,9 +234,16 @@ $ %s query %s pools
 				return err
 			}
 
+			denom, _ := cmd.Flags().GetString(FlagDenom)
+			typeID, _ := cmd.Flags().GetUint32(FlagTypeID)
+
 			res, err := queryClient.LiquidityPools(
 				context.Background(),
-				&types.QueryLiquidityPoolsRequest{Pagination: pageReq},
+				&types.QueryLiquidityPoolsRequest{
+					Pagination: pageReq,
+					TypeId:     typeID,
+					Denom:      denom,
+				},
 			)
 			if err != nil {
 				return err
@@ -203,6 +253,7 @@ $ %s query %s pools
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetPools())
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -574,3 +625,1008 @@ $ %s query %s swap 1 20
 
 	return cmd
 }
+
+// GetCmdQueryLiquidityPoolPositions implements the positions query command.
+func GetCmdQueryLiquidityPoolPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all positions of the concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all positions of the concentrated liquidity pool for the specified pool-id
+
+Example:
+$ %s query %s positions 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer pool-id", args[0])
+			}
+
+			res, err := queryClient.LiquidityPoolPositions(
+				context.Background(),
+				&types.QueryLiquidityPoolPositionsRequest{
+					PoolId:     poolID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySimulateSwap implements the simulate swap query command.
+func GetCmdQuerySimulateSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-swap [pool-id] [swap-type] [offer-coin] [demand-coin-denom] [order-price]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Query the expected outcome of a swap order in the current batch of the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the expected swap price, price direction, transacted amount and fees of a swap order matched with the current reserves and the swap orders in the current batch of the liquidity pool.
+Nothing is written to the state, and the outcome can change with the swap orders appended to the batch before it is executed.
+
+Example:
+$ %s query %s simulate-swap 1 1 50000000uusd uatom 0.019
+
+With the swap-type 2 (exact-output swap), the --demand-coin-amount flag sets the exact amount of demand coin to receive.
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer pool-id", args[0])
+			}
+
+			swapTypeID, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("swap-type %s not a valid uint, input a valid unsigned 32-bit integer for swap-type", args[1])
+			}
+
+			demandCoinAmt, err := cmd.Flags().GetString(FlagDemandCoinAmount)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateSwap(
+				context.Background(),
+				&types.QuerySimulateSwapRequest{
+					PoolId:           poolID,
+					SwapTypeId:       uint32(swapTypeID),
+					OfferCoin:        args[2],
+					DemandCoinDenom:  args[3],
+					OrderPrice:       args[4],
+					DemandCoinAmount: demandCoinAmt,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDemandCoinAmount, "0", "The exact amount of demand coin to receive after the swap fee, only for the exact-output swap type 2")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEstimateDeposit implements the estimate deposit query command.
+func GetCmdQueryEstimateDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-deposit [pool-id] [deposit-coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the expected outcome of depositing coins to the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accepted coins, the refunded coins and the pool coin minted for depositing coins to the liquidity pool at the current reserves.
+The deposit coins over the reserve coin ratio of the pool are refunded. Nothing is written to the state, and the outcome can change with the reserves before the batch is executed.
+
+Example:
+$ %s query %s estimate-deposit 1 100000000uatom,5000000000uusd
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer pool-id", args[0])
+			}
+
+			res, err := queryClient.EstimateDeposit(
+				context.Background(),
+				&types.QueryEstimateDepositRequest{
+					PoolId:       poolID,
+					DepositCoins: args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEstimateWithdraw implements the estimate withdraw query command.
+func GetCmdQueryEstimateWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-withdraw [pool-id] [pool-coin-amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the expected outcome of withdrawing pool coin from the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reserve coins paid out and the withdraw fee coins for withdrawing pool coin from the liquidity pool at the current reserves.
+Nothing is written to the state, and the outcome can change with the reserves before the batch is executed.
+
+Example:
+$ %s query %s estimate-withdraw 1 10000
+
+The whole pool coin is withdrawn in the reserve coin of the --withdraw-coin-denom flag from a weighted pool.
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer pool-id", args[0])
+			}
+
+			withdrawCoinDenom, err := cmd.Flags().GetString(FlagWithdrawCoinDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateWithdraw(
+				context.Background(),
+				&types.QueryEstimateWithdrawRequest{
+					PoolId:            poolID,
+					PoolCoinAmount:    args[1],
+					WithdrawCoinDenom: withdrawCoinDenom,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetWithdraw())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPoolBatchResults implements the batch results query command.
+func GetCmdQueryPoolBatchResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-results [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the results of the latest executed batches of the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the results of the latest executed batches of the liquidity pool for the specified pool-id.
+The clearing price, the volumes and the fees of each pair of reserve coins, and the reserve coins after the execution
+are kept for the number of batches set by the batch_result_retention param.
+
+Example:
+$ %s query %s batch-results 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer pool-id", args[0])
+			}
+
+			res, err := queryClient.PoolBatchResults(
+				context.Background(),
+				&types.QueryPoolBatchResultsRequest{
+					PoolId:     poolID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTimeWeightedAveragePrice implements the time-weighted average price query command.
+func GetCmdQueryTimeWeightedAveragePrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pool-id] [start-time] [end-time]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Query the time-weighted average price of the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time-weighted average price of a pair of reserve coins of the liquidity pool between the start time
+and the end time in RFC3339 format. The end time defaults to the latest block time. The price is the exchange ratio of
+X/Y where the denoms of the pair are sorted alphabetically, and the window must be covered by the price accumulators
+kept for the number of batches set by the price_accumulator_retention param.
+
+Example:
+$ %s query %s twap 1 2022-01-01T00:00:00Z 2022-01-01T01:00:00Z
+$ %s query %s twap 2 2022-01-01T00:00:00Z --%s=denomA,denomB
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, FlagPairDenoms,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer pool-id", args[0])
+			}
+
+			req := &types.QueryTimeWeightedAveragePriceRequest{
+				PoolId:    poolID,
+				StartTime: args[1],
+			}
+			if len(args) > 2 {
+				req.EndTime = args[2]
+			}
+
+			pairDenoms, err := cmd.Flags().GetStringSlice(FlagPairDenoms)
+			if err != nil {
+				return err
+			}
+			if len(pairDenoms) > 0 {
+				if len(pairDenoms) != 2 {
+					return fmt.Errorf("%s must be the two reserve coin denoms of the pair", FlagPairDenoms)
+				}
+				req.DenomX, req.DenomY = pairDenoms[0], pairDenoms[1]
+			}
+
+			res, err := queryClient.TimeWeightedAveragePrice(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetTimeWeightedAveragePrice())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySwapMsgsByRequester implements the swap messages by swap requester query command.
+func GetCmdQuerySwapMsgsByRequester() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swaps-by-requester [requester-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all swap messages of the swap requester in the batches of all liquidity pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all swap messages of the swap requester in the current batches of all liquidity pools,
+ordered by the pool id and the msg index.
+
+If batch messages are normally processed from the endblock,
+the resulting state is applied and the messages are removed in the beginning of next block.
+
+Example:
+$ %s query %s swaps-by-requester cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("requester-address %s is not a valid bech32 address: %w", args[0], err)
+			}
+
+			res, err := queryClient.SwapMsgsByRequester(
+				context.Background(),
+				&types.QuerySwapMsgsByRequesterRequest{
+					SwapRequesterAddress: args[0],
+					Pagination:           pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "swaps-by-requester")
+
+	return cmd
+}
+
+// GetCmdQueryDepositMsgsByDepositor implements the deposit messages by depositor query command.
+func GetCmdQueryDepositMsgsByDepositor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits-by-depositor [depositor-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all deposit messages of the depositor in the batches of all liquidity pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all deposit messages of the depositor in the current batches of all liquidity pools,
+ordered by the pool id and the msg index.
+
+If batch messages are normally processed from the endblock,
+the resulting state is applied and the messages are removed in the beginning of next block.
+
+Example:
+$ %s query %s deposits-by-depositor cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("depositor-address %s is not a valid bech32 address: %w", args[0], err)
+			}
+
+			res, err := queryClient.DepositMsgsByDepositor(
+				context.Background(),
+				&types.QueryDepositMsgsByDepositorRequest{
+					DepositorAddress: args[0],
+					Pagination:       pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deposits-by-depositor")
+
+	return cmd
+}
+
+// GetCmdQueryWithdrawMsgsByWithdrawer implements the withdraw messages by withdrawer query command.
+func GetCmdQueryWithdrawMsgsByWithdrawer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraws-by-withdrawer [withdrawer-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all withdraw messages of the withdrawer in the batches of all liquidity pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all withdraw messages of the withdrawer in the current batches of all liquidity pools,
+ordered by the pool id and the msg index.
+
+If batch messages are normally processed from the endblock,
+the resulting state is applied and the messages are removed in the beginning of next block.
+
+Example:
+$ %s query %s withdraws-by-withdrawer cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("withdrawer-address %s is not a valid bech32 address: %w", args[0], err)
+			}
+
+			res, err := queryClient.WithdrawMsgsByWithdrawer(
+				context.Background(),
+				&types.QueryWithdrawMsgsByWithdrawerRequest{
+					WithdrawerAddress: args[0],
+					Pagination:        pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "withdraws-by-withdrawer")
+
+	return cmd
+}
+
+// GetCmdQueryLiquidityPositions implements the liquidity positions query command.
+func GetCmdQueryLiquidityPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity-positions [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pool coins of all liquidity pools held by the address, valued at the current reserves",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pool coins of all liquidity pools held by the address and escrowed in its pending withdraw messages,
+ordered by the pool id.
+
+Each position includes the share of the held and escrowed pool coins in the pool coin total supply,
+and the reserve coins withdrawable for them at the current reserves after the withdraw fee.
+
+Example:
+$ %s query %s liquidity-positions cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("address %s is not a valid bech32 address: %w", args[0], err)
+			}
+
+			res, err := queryClient.LiquidityPositions(
+				context.Background(),
+				&types.QueryLiquidityPositionsRequest{Address: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRewardPlans implements the reward plans query command.
+func GetCmdQueryRewardPlans() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-plans",
+		Args:  cobra.NoArgs,
+		Short: "Query all reward plans",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all reward plans that have not ended, optionally filtered by the pool id.
+
+Example:
+$ %[1]s query %[2]s reward-plans
+$ %[1]s query %[2]s reward-plans --pool-id 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			poolID, _ := cmd.Flags().GetUint64(FlagPoolID)
+
+			res, err := queryClient.RewardPlans(
+				context.Background(),
+				&types.QueryRewardPlansRequest{
+					PoolId:     poolID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetRewardPlans())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reward-plans")
+
+	return cmd
+}
+
+// GetCmdQueryRewardPlan implements the reward plan query command.
+func GetCmdQueryRewardPlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-plan [plan-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a reward plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of a reward plan that has not ended.
+
+Example:
+$ %s query %s reward-plan 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			planID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("plan-id %s not a valid uint, input a valid unsigned 64-bit integer for plan-id", args[0])
+			}
+
+			res, err := queryClient.RewardPlan(
+				context.Background(),
+				&types.QueryRewardPlanRequest{PlanId: planID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRewardAccumulator implements the reward accumulator query command.
+func GetCmdQueryRewardAccumulator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-accumulator [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the total staked pool coin and the accumulated reward per share of the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total staked pool coin and the accumulated reward per share of the liquidity pool.
+
+Example:
+$ %s query %s reward-accumulator 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 64-bit integer for pool-id", args[0])
+			}
+
+			res, err := queryClient.RewardAccumulator(
+				context.Background(),
+				&types.QueryRewardAccumulatorRequest{PoolId: poolID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStakes implements the stakes query command.
+func GetCmdQueryStakes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stakes [staker-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all staked pool coins of the staker with their pending rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all staked pool coins of the staker with their pending rewards, ordered by the pool id.
+
+Example:
+$ %s query %s stakes cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("staker-address %s is not a valid bech32 address: %w", args[0], err)
+			}
+
+			res, err := queryClient.Stakes(
+				context.Background(),
+				&types.QueryStakesRequest{
+					StakerAddress: args[0],
+					Pagination:    pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stakes")
+
+	return cmd
+}
+
+// GetCmdQueryBonds implements the bonds query command.
+func GetCmdQueryBonds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bonds [owner-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all bonds of the owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all bonds of the owner, ordered by the bond id.
+
+Example:
+$ %s query %s bonds cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("owner-address %s is not a valid bech32 address: %w", args[0], err)
+			}
+
+			res, err := queryClient.Bonds(
+				context.Background(),
+				&types.QueryBondsRequest{
+					OwnerAddress: args[0],
+					Pagination:   pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bonds")
+
+	return cmd
+}
+
+// GetCmdQueryBondedPoolCoins implements the bonded pool coins query command.
+func GetCmdQueryBondedPoolCoins() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bonded-pool-coins [owner-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pool coins bonded by the owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pool coins of the bonds of the owner not unbonding.
+
+Only the bonds whose bond duration is at least the --min-duration flag are counted.
+
+Example:
+$ %s query %s bonded-pool-coins cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v --min-duration=168h
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("owner-address %s is not a valid bech32 address: %w", args[0], err)
+			}
+
+			minDuration, _ := cmd.Flags().GetDuration(FlagMinDuration)
+
+			res, err := queryClient.BondedPoolCoins(
+				context.Background(),
+				&types.QueryBondedPoolCoinsRequest{
+					OwnerAddress: args[0],
+					MinDuration:  minDuration,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetBondedPoolCoins())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCollectedProtocolFees implements the protocol-fees query command.
+func GetCmdQueryCollectedProtocolFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-fees [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the protocol fees collected from the fees of the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total protocol fees collected from the swap fees and the withdraw fees of the liquidity pool and sent to the community pool.
+
+Example:
+$ %s query %s protocol-fees 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 64-bit integer for pool-id", args[0])
+			}
+
+			res, err := queryClient.CollectedProtocolFees(
+				context.Background(),
+				&types.QueryCollectedProtocolFeesRequest{PoolId: poolID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPoolFeeRate implements the pool-fee-rate query command.
+func GetCmdQueryPoolFeeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-fee-rate [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the swap fee rate and the withdraw fee rate applied to the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the swap fee rate and the withdraw fee rate applied to the liquidity pool.
+
+The fee rates set for the pool by governance are applied within the bounds of the min-pool-fee-rate and max-pool-fee-rate
+parameters, and the pools without them use the swap-fee-rate and withdraw-fee-rate parameters.
+
+Example:
+$ %s query %s pool-fee-rate 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 64-bit integer for pool-id", args[0])
+			}
+
+			res, err := queryClient.PoolFeeRate(
+				context.Background(),
+				&types.QueryPoolFeeRateRequest{PoolId: poolID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCircuitBreakers implements the query enabled circuit breakers command.
+func GetCmdQueryCircuitBreakers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breakers",
+		Args:  cobra.NoArgs,
+		Short: "Query all enabled circuit breakers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all enabled circuit breakers, optionally filtered by the pool id.
+
+The circuit breakers of pool id 0 apply to all pools, and those with an empty message type apply to all the message types
+which circuit breakers can halt. Filtering by the pool id returns the circuit breakers of all pools as well.
+
+Example:
+$ %[1]s query %[2]s circuit-breakers
+$ %[1]s query %[2]s circuit-breakers --pool-id 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			poolID, _ := cmd.Flags().GetUint64(FlagPoolID)
+
+			res, err := queryClient.CircuitBreakers(
+				context.Background(),
+				&types.QueryCircuitBreakersRequest{
+					PoolId:     poolID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetCircuitBreakers())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "circuit-breakers")
+
+	return cmd
+}
@@ -4,15 +4,20 @@ package cli
 // client is excluded from test coverage in the poc phase milestone 1 and will be included in milestone 2 with completeness
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/tendermint/liquidity/x/liquidity/types"
@@ -33,6 +38,17 @@ func GetTxCmd() *cobra.Command {
 		NewDepositWithinBatchCmd(),
 		NewWithdrawWithinBatchCmd(),
 		NewSwapWithinBatchCmd(),
+		NewDepositToRangeCmd(),
+		NewWithdrawFromRangeCmd(),
+		NewCancelSwapCmd(),
+		NewSwapRouteCmd(),
+		NewCreateRewardPlanCmd(),
+		NewStakeCmd(),
+		NewUnstakeCmd(),
+		NewClaimRewardsCmd(),
+		NewBondCmd(),
+		NewBeginUnbondCmd(),
+		NewSetCircuitBreakerCmd(),
 	)
 
 	return liquidityTxCmd
@@ -48,13 +64,32 @@ func NewCreatePoolCmd() *cobra.Command {
 			fmt.Sprintf(`Create liquidity pool and deposit coins.
 
 Example:
-$ %s tx %s create-pool 1 1000000000uatom,50000000000uusd --from mykey
+$ %[1]s tx %[2]s create-pool 1 1000000000uatom,50000000000uusd --from mykey
 
 This example creates a liquidity pool of pool-type 1 (two coins) and deposits 1000000000uatom and 50000000000uusd.
 New liquidity pools can be created only for coin combinations that do not already exist in the network.
 
-[pool-type]: The id of the liquidity pool-type. The only supported pool type is 1
-[deposit-coins]: The amount of coins to deposit to the liquidity pool. The number of deposit coins must be 2 in pool type 1.
+$ %[1]s tx %[2]s create-pool 2 1000000000uusdc,1000000000uusdt,1000000000uust --from mykey
+
+This example creates a multi-asset liquidity pool of pool-type 2 (three to eight coins) with three kinds of stablecoins.
+
+$ %[1]s tx %[2]s create-pool 3 8000000000uatom,20000000000uusd --weights 80,20 --from mykey
+
+This example creates a weighted liquidity pool of pool-type 3 (two to eight coins) with the weights 80 uatom and 20 uusd.
+The weights are given in alphabetical denom order and must sum up to 100.
+
+$ %[1]s tx %[2]s create-pool 4 1000000000uusdc,1000000000uusdt --from mykey
+
+This example creates a stable liquidity pool of pool-type 4 (two coins) with two kinds of pegged stablecoins.
+The amplification of the StableSwap curve can be given with --amplification, the StableSwapAmplification param is used otherwise.
+
+$ %[1]s tx %[2]s create-pool 5 1000000000uatom,50000000000uusd --from mykey
+
+This example creates a concentrated liquidity pool of pool-type 5 (two coins). No pool coin is minted, the deposit coins
+are provided as a full range position of the pool creator, and the liquidity is provided to price ranges with deposit-range.
+
+[pool-type]: The id of the liquidity pool-type. The supported pool types are 1, 2, 3, 4 and 5
+[deposit-coins]: The amount of coins to deposit to the liquidity pool. The number of deposit coins must be 2 in pool type 1, 3 to 8 in pool type 2, 2 to 8 in pool type 3 and 2 in pool types 4 and 5.
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -83,15 +118,34 @@ New liquidity pools can be created only for coin combinations that do not alread
 				return err
 			}
 
-			if poolTypeID != 1 {
+			var poolType *types.PoolType
+			for i := range types.DefaultPoolTypes {
+				if types.DefaultPoolTypes[i].Id == uint32(poolTypeID) {
+					poolType = &types.DefaultPoolTypes[i]
+				}
+			}
+			if poolType == nil {
 				return types.ErrPoolTypeNotExists
 			}
 
-			if depositCoins.Len() != 2 {
-				return fmt.Errorf("the number of deposit coins must be two in pool-type 1")
+			if n := uint32(depositCoins.Len()); n < poolType.MinReserveCoinNum || n > poolType.MaxReserveCoinNum {
+				return fmt.Errorf("the number of deposit coins must be between %d and %d in pool-type %d",
+					poolType.MinReserveCoinNum, poolType.MaxReserveCoinNum, poolTypeID)
+			}
+
+			weights, err := cmd.Flags().GetUintSlice(FlagWeights)
+			if err != nil {
+				return err
 			}
 
 			msg := types.NewMsgCreatePool(poolCreator, uint32(poolTypeID), depositCoins)
+			for _, weight := range weights {
+				msg.ReserveCoinWeights = append(msg.ReserveCoinWeights, uint32(weight))
+			}
+			msg.Amplification, err = cmd.Flags().GetUint32(FlagAmplification)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -100,6 +154,7 @@ New liquidity pools can be created only for coin combinations that do not alread
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetCreatePool())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -121,7 +176,9 @@ Example:
 $ %s tx %s deposit 1 100000000uatom,5000000000uusd --from mykey
 
 This example request deposits 100000000uatom and 5000000000uusd to pool-id 1.
-Deposits must be the same coin denoms as the reserve coins.
+Deposits must be the same coin denoms as the reserve coins, including every reserve coin of a multi-asset pool.
+Any subset of the reserve coins can be deposited to a weighted pool, where the part of the deposit which is not
+proportional to the reserves is charged the swap fee.
 
 [pool-id]: The pool id of the liquidity pool
 [deposit-coins]: The amount of coins to deposit to the liquidity pool
@@ -153,10 +210,6 @@ Deposits must be the same coin denoms as the reserve coins.
 				return err
 			}
 
-			if depositCoins.Len() != 2 {
-				return fmt.Errorf("the number of deposit coins must be two in the pool-type 1")
-			}
-
 			msg := types.NewMsgDepositWithinBatch(depositor, poolID, depositCoins)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -184,11 +237,16 @@ This swap request is not processed immediately since it is accumulated in the li
 All requests in a batch are treated equally and executed at the same swap price.
 
 Example:
-$ %s tx %s withdraw 1 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295 --from mykey
+$ %[1]s tx %[2]s withdraw 1 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295 --from mykey
 
 This example request withdraws 10000 pool coin from the specified liquidity pool.
 The appropriate pool coin must be requested from the specified pool.
 
+$ %[1]s tx %[2]s withdraw 2 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295 --withdraw-coin-denom=uatom --from mykey
+
+This example request withdraws 10000 pool coin from the weighted pool-id 2 in uatom only, where the part of the
+payout over the share of the uatom weight is charged the swap fee.
+
 [pool-id]: The pool id of the liquidity pool
 [pool-coin]: The amount of pool coin to withdraw from the liquidity pool
 `,
@@ -220,6 +278,10 @@ The appropriate pool coin must be requested from the specified pool.
 			}
 
 			msg := types.NewMsgWithdrawWithinBatch(withdrawer, poolID, poolCoin)
+			msg.WithdrawCoinDenom, err = cmd.Flags().GetString(FlagWithdrawCoinDenom)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -228,6 +290,7 @@ The appropriate pool coin must be requested from the specified pool.
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetWithdraw())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -237,7 +300,7 @@ The appropriate pool coin must be requested from the specified pool.
 func NewSwapWithinBatchCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "swap [pool-id] [swap-type] [offer-coin] [demand-coin-denom] [order-price] [swap-fee-rate]",
-		Args:  cobra.ExactArgs(6),
+		Args:  cobra.RangeArgs(5, 6),
 		Short: "Swap offer coin with demand coin from the liquidity pool with the given order price",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Swap offer coin with demand coin from the liquidity pool with the given order price.
@@ -259,15 +322,28 @@ A sufficient balance of half of the swap-fee-rate of the offer coin is required
 The order price is the exchange ratio of X/Y, where X is the amount of the first coin and Y is the amount of the second coin when their denoms are sorted alphabetically.
 Increasing order price reduces the possibility for your request to be processed and results in buying uatom at a lower price than the pool price.
 
-For explicit calculations, The swap fee rate must be the value that set as liquidity parameter in the current network.
-The only supported swap-type is 1. For the detailed swap algorithm, see https://github.com/tendermint/liquidity
+For explicit calculations, The swap fee rate must be the swap fee rate applied to the pool in the current network, which is
+the fee rate set for the pool by governance or the liquidity parameter otherwise. If the swap-fee-rate is omitted, the swap fee
+rate of the pool is queried from the network.
+The supported swap-types are 1 and 2. For the detailed swap algorithm, see https://github.com/tendermint/liquidity
+
+The swap order expires at the end of the current batch by default. With the --order-lifespan flag, the order not fully matched
+is carried forward to the following batches until the end of the batch at or after the given number of blocks, which can not
+exceed the max order lifespan parameter.
+
+With the --min-demand-coin-amount flag, the matched order is refunded instead when the demand coin received after the swap fee
+is less than the given amount.
+
+With the swap-type 2 (exact-output swap), the --demand-coin-amount flag sets the exact amount of demand coin to receive after
+the swap fee and the offer coin is the maximum amount to offer. Only the offer coin needed for the demand coin amount at the swap
+price is transacted, and the unused offer coin is refunded with its offer coin fee.
 
 [pool-id]: The pool id of the liquidity pool 
-[swap-type]: The swap type of the swap message. The only supported swap type is 1 (instant swap).
+[swap-type]: The swap type of the swap message, 1 (instant swap) or 2 (exact-output swap).
 [offer-coin]: The amount of offer coin to swap 
 [demand-coin-denom]: The denomination of the coin to exchange with offer coin 
 [order-price]: The limit order price for the swap order. The price is the exchange ratio of X/Y where X is the amount of the first coin and Y is the amount of the second coin when their denoms are sorted alphabetically 
-[swap-fee-rate]: The swap fee rate to pay for swap that is proportional to swap amount. The swap fee rate must be the value that set as liquidity parameter in the current network.
+[swap-fee-rate]: Optional, the swap fee rate to pay for swap that is proportional to swap amount. The swap fee rate must be the swap fee rate applied to the pool in the current network.
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -291,7 +367,7 @@ The only supported swap-type is 1. For the detailed swap algorithm, see https://
 				return fmt.Errorf("swap-type %s not a valid uint, input a valid unsigned 32-bit integer for swap-type", args[2])
 			}
 
-			if swapTypeID != 1 {
+			if swapTypeID != uint64(types.DefaultSwapTypeID) && swapTypeID != uint64(types.ExactOutputSwapTypeID) {
 				return types.ErrSwapTypeNotExists
 			}
 
@@ -316,12 +392,351 @@ The only supported swap-type is 1. For the detailed swap algorithm, see https://
 				return err
 			}
 
-			swapFeeRate, err := sdk.NewDecFromStr(args[5])
+			swapFeeRate, err := getSwapFeeRate(clientCtx, args, 5, poolID)
+			if err != nil {
+				return err
+			}
+
+			orderLifespan, err := cmd.Flags().GetUint32(FlagOrderLifespan)
+			if err != nil {
+				return err
+			}
+
+			minDemandCoinAmtStr, err := cmd.Flags().GetString(FlagMinDemandCoinAmount)
+			if err != nil {
+				return err
+			}
+			minDemandCoinAmt, ok := sdk.NewIntFromString(minDemandCoinAmtStr)
+			if !ok {
+				return fmt.Errorf("min-demand-coin-amount %s not a valid integer", minDemandCoinAmtStr)
+			}
+
+			demandCoinAmtStr, err := cmd.Flags().GetString(FlagDemandCoinAmount)
 			if err != nil {
 				return err
 			}
+			demandCoinAmt, ok := sdk.NewIntFromString(demandCoinAmtStr)
+			if !ok {
+				return fmt.Errorf("demand-coin-amount %s not a valid integer", demandCoinAmtStr)
+			}
 
 			msg := types.NewMsgSwapWithinBatch(swapRequester, poolID, uint32(swapTypeID), offerCoin, args[3], orderPrice, swapFeeRate)
+			msg.OrderLifespan = orderLifespan
+			msg.MinDemandCoinAmount = minDemandCoinAmt
+			msg.DemandCoinAmount = demandCoinAmt
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetSwap())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Deposit coins to a price range of the specified concentrated liquidity pool.
+func NewDepositToRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-range [pool-id] [lower-price] [upper-price] [deposit-coins]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Deposit coins to a price range of the specified concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit coins to a price range of the specified concentrated liquidity pool.
+
+This deposit request is processed immediately, creating a position with the liquidity the deposit coins provide
+within the price range at the current pool price. Only the deposit coins needed for the liquidity are accepted.
+The liquidity of the position is used by the swaps only while the pool price is within the price range.
+
+Example:
+$ %s tx %s deposit-range 1 0.9 1.1 100000000stake,100000000token --from mykey
+
+This example request deposits at most 100000000stake and 100000000token to the price range from 0.9 to 1.1 of pool 1.
+The price is the exchange ratio of X/Y, where X and Y are the reserve coins of the pool sorted by denom.
+
+[pool-id]: The pool id of the concentrated liquidity pool
+[lower-price]: The lower bound of the price range
+[upper-price]: The upper bound of the price range
+[deposit-coins]: The amount of coins to deposit to the price range
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			depositor := clientCtx.GetFromAddress()
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			lowerPrice, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			upperPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositToRange(depositor, poolID, lowerPrice, upperPrice, depositCoins)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Withdraw the specified position from the concentrated liquidity pool.
+func NewWithdrawFromRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-range [position-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw the specified position from the concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the specified position from the concentrated liquidity pool.
+
+This withdraw request is processed immediately, paying out the reserve coins of the position at the current pool price
+and its share of the swap fees accrued in the pool, and deleting the position.
+
+Example:
+$ %s tx %s withdraw-range 1 --from mykey
+
+This example request withdraws the position 1 owned by mykey.
+
+[position-id]: The id of the position to withdraw
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			owner := clientCtx.GetFromAddress()
+
+			positionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("position-id %s not a valid uint, input a valid unsigned 64-bit integer for position-id", args[0])
+			}
+
+			msg := types.NewMsgWithdrawFromRange(owner, positionID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Cancel a pending swap order of the specified liquidity pool batch.
+func NewCancelSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-swap [pool-id] [msg-index]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a pending swap order of the liquidity pool batch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a pending swap order of the liquidity pool batch.
+
+The canceled swap order is not matched anymore, and its remaining offer coin and unused reserved offer coin fee are
+refunded at the end of the batch. Only the swap requester of the swap order can cancel it.
+
+Example:
+$ %s tx %s cancel-swap 1 3 --from mykey
+
+This example request cancels the swap order with the msg index 3 in the batch of the liquidity pool 1.
+
+[pool-id]: The pool id of the liquidity pool
+[msg-index]: The msg index of the swap order in the pool batch
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			swapRequester := clientCtx.GetFromAddress()
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 64-bit integer for pool-id", args[0])
+			}
+
+			msgIndex, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("msg-index %s not a valid uint, input a valid unsigned 64-bit integer for msg-index", args[1])
+			}
+
+			msg := types.NewMsgCancelSwap(swapRequester, poolID, msgIndex)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Swap offer coin with demand coin through the ordered list of liquidity pools.
+func NewSwapRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-route [pool-ids] [offer-coin] [demand-coin-denom] [min-demand-coin-amount] [swap-fee-rate]",
+		Args:  cobra.RangeArgs(4, 5),
+		Short: "Swap offer coin with demand coin through the ordered list of liquidity pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap offer coin with demand coin through the ordered list of liquidity pools.
+
+The offer coin is swapped in the batch of the first pool, and the coin exchanged by each hop is swapped in the batch of the next pool,
+within the same end blocker or in the following batch. The demand coin of each hop is the reserve coin the pool shares with the next pool.
+The last hop is not executed when it receives less than the minimum demand coin amount, and the coins of a failed hop are refunded.
+
+Example:
+$ %s tx %s swap-route 1,2 50000000uusd uosmo 9500000 0.003 --from mykey
+
+For this example, imagine that pool 1 has uatom and uusd reserve coins, and pool 2 has uatom and uosmo reserve coins.
+This example request swaps 50000000uusd for uatom in pool 1, and the uatom exchanged for at least 9500000uosmo in pool 2.
+A sufficient balance of half of the swap-fee-rate of the offer coin is required to reserve the offer coin fee of the first hop.
+
+[pool-ids]: The comma separated ids of the liquidity pools to swap through in order
+[offer-coin]: The amount of offer coin to swap
+[demand-coin-denom]: The denomination of the coin to exchange with offer coin in the last pool
+[min-demand-coin-amount]: The minimum amount of demand coin to receive from the last pool
+[swap-fee-rate]: Optional, the swap fee rate to pay for swap that is proportional to swap amount. The swap fee rate must be the swap fee rate applied to the first pool in the current network, which is queried from the network if omitted.
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			swapRequester := clientCtx.GetFromAddress()
+
+			var poolIDs []uint64
+			for _, arg := range strings.Split(args[0], ",") {
+				poolID, err := strconv.ParseUint(strings.TrimSpace(arg), 10, 64)
+				if err != nil {
+					return fmt.Errorf("pool-id %s not a valid uint, input valid unsigned integers for pool-ids", arg)
+				}
+				poolIDs = append(poolIDs, poolID)
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			err = sdk.ValidateDenom(args[2])
+			if err != nil {
+				return err
+			}
+
+			minDemandCoinAmt, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("min-demand-coin-amount %s not a valid integer", args[3])
+			}
+
+			swapFeeRate, err := getSwapFeeRate(clientCtx, args, 4, poolIDs[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapRoute(swapRequester, poolIDs, offerCoin, args[2], minDemandCoinAmt, swapFeeRate)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Create a reward plan streaming the reward coins to the staked pool coins of the liquidity pool.
+func NewCreateRewardPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-reward-plan [pool-id] [reward-coins] [duration]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Create a reward plan for the staked pool coins of the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a reward plan for the staked pool coins of the liquidity pool.
+
+The reward coins are streamed evenly over the duration in blocks from the next block, and the rewards of each block are
+distributed to the staked pool coins of the liquidity pool in proportion to their amount. The reward coins not
+distributed, such as the rewards of the blocks without staked pool coin, are refunded to the funder when the plan ends.
+The reward plan creation fee is collected in the community pool.
+
+Example:
+$ %s tx %s create-reward-plan 1 100000000uatom 100000 --from mykey
+
+This example request creates a reward plan streaming 100000000uatom over 100000 blocks to the stakers of the pool coin
+of the liquidity pool 1.
+
+[pool-id]: The pool id of the liquidity pool
+[reward-coins]: The reward coins of the reward plan
+[duration]: The duration of the reward plan in blocks
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			funder := clientCtx.GetFromAddress()
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 64-bit integer for pool-id", args[0])
+			}
+
+			rewardCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			duration, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("duration %s not a valid uint, input a valid unsigned 64-bit integer for duration", args[2])
+			}
+
+			msg := types.NewMsgCreateRewardPlan(funder, poolID, rewardCoins, duration)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -334,3 +749,482 @@ The only supported swap-type is 1. For the detailed swap algorithm, see https://
 
 	return cmd
 }
+
+// Stake pool coin to accrue the rewards of the reward plans of the liquidity pool.
+func NewStakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stake [pool-coin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Stake pool coin to accrue the rewards of the reward plans of the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Stake pool coin to accrue the rewards of the reward plans of the liquidity pool.
+
+The rewards accrued by the existing stake of the pool coin are claimed when staking more pool coin.
+
+Example:
+$ %s tx %s stake 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295 --from mykey
+
+This example request stakes 10000 pool coin of the liquidity pool minting the pool coin.
+
+[pool-coin]: The amount of pool coin to stake
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			staker := clientCtx.GetFromAddress()
+
+			poolCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStake(staker, poolCoin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Unstake staked pool coin of the liquidity pool.
+func NewUnstakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unstake [pool-coin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Unstake staked pool coin of the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unstake staked pool coin of the liquidity pool.
+
+The rewards accrued by the stake of the pool coin are claimed when unstaking.
+
+Example:
+$ %s tx %s unstake 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295 --from mykey
+
+This example request unstakes 10000 staked pool coin of the liquidity pool minting the pool coin.
+
+[pool-coin]: The amount of staked pool coin to unstake
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			staker := clientCtx.GetFromAddress()
+
+			poolCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnstake(staker, poolCoin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Claim the rewards accrued by the staked pool coin of the liquidity pool.
+func NewClaimRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Claim the rewards accrued by the staked pool coin of the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the rewards accrued by the staked pool coin of the liquidity pool.
+
+Example:
+$ %s tx %s claim-rewards 1 --from mykey
+
+This example request claims the rewards accrued by the staked pool coin of the liquidity pool 1.
+
+[pool-id]: The pool id of the liquidity pool
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			staker := clientCtx.GetFromAddress()
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 64-bit integer for pool-id", args[0])
+			}
+
+			msg := types.NewMsgClaimRewards(staker, poolID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Bond pool coin of the liquidity pool for a bond duration.
+func NewBondCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bond [pool-coin] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Bond pool coin of the liquidity pool for a bond duration",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Bond pool coin of the liquidity pool for a bond duration.
+
+The bond duration must be one of the bond durations of the params. The bonded pool coin is returned when the bond
+duration has passed since the unbonding of the bond begins.
+
+Example:
+$ %s tx %s bond 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295 168h --from mykey
+
+This example request bonds 10000 pool coin of the liquidity pool minting the pool coin for 7 days.
+
+[pool-coin]: The amount of pool coin to bond
+[duration]: The bond duration, such as 24h, 168h or 336h
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			owner := clientCtx.GetFromAddress()
+
+			poolCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("duration %s not a valid duration, input a valid duration such as 168h: %w", args[1], err)
+			}
+
+			msg := types.NewMsgBond(owner, poolCoin, duration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Begin the unbonding of a bond.
+func NewBeginUnbondCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "begin-unbond [bond-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Begin the unbonding of a bond",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Begin the unbonding of a bond.
+
+The unbonding can begin at any time, and the bonded pool coin is returned when the bond duration of the bond has
+passed since.
+
+Example:
+$ %s tx %s begin-unbond 1 --from mykey
+
+This example request begins the unbonding of the bond 1.
+
+[bond-id]: The id of the bond
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			owner := clientCtx.GetFromAddress()
+
+			bondID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("bond-id %s not a valid uint, input a valid unsigned 64-bit integer for bond-id", args[0])
+			}
+
+			msg := types.NewMsgBeginUnbond(owner, bondID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Enable or disable a circuit breaker as an emergency admin.
+func NewSetCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-circuit-breaker [pool-id] [enabled]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Enable or disable a circuit breaker as an emergency admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable a circuit breaker halting a type of messages of a liquidity pool.
+
+Only the emergency admins of the circuit-breaker-admins parameter can set the circuit breakers. Pool id 0 applies to all
+pools, and omitting the --msg-type flag applies to all the message types which circuit breakers can halt: %s.
+
+Example:
+$ %s tx %s set-circuit-breaker 1 true --msg-type swap_within_batch --from mykey
+
+This example request halts the swaps of pool 1 while the other messages and pools keep running.
+
+[pool-id]: The id of the pool, 0 for all pools
+[enabled]: Whether to enable the circuit breaker, halting the messages
+`,
+				strings.Join(types.CircuitBreakerMsgTypes, ", "), version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			admin := clientCtx.GetFromAddress()
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 64-bit integer for pool-id", args[0])
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("enabled %s not a valid bool, input true or false for enabled", args[1])
+			}
+
+			msgType, _ := cmd.Flags().GetString(FlagMsgType)
+
+			msg := types.NewMsgSetCircuitBreaker(admin, poolID, msgType, enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetSetCircuitBreaker())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSubmitPoolFeeRatesProposalCmd implements the command to submit a pool fee rates proposal.
+func NewSubmitPoolFeeRatesProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-fee-rates [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the fee rates of liquidity pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to set the swap fee rates and the withdraw fee rates of liquidity pools along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+The fee rates override the swap-fee-rate and withdraw-fee-rate parameters for the pools, and must be within the bounds of
+the min-pool-fee-rate and max-pool-fee-rate parameters when the proposal passes.
+
+Example:
+$ %s tx gov submit-proposal pool-fee-rates <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Pool Fee Rates",
+  "description": "Lower the swap fee of the stable pair pool",
+  "pool_fee_rates": [
+    {
+      "pool_id": "1",
+      "swap_fee_rate": "0.0005",
+      "withdraw_fee_rate": "0"
+    }
+  ],
+  "deposit": "10000000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParsePoolFeeRatesProposalWithDeposit(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewPoolFeeRatesProposal(proposal.Title, proposal.Description, proposal.PoolFeeRates)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// ParsePoolFeeRatesProposalWithDeposit reads and parses a PoolFeeRatesProposalWithDeposit from a JSON file.
+func ParsePoolFeeRatesProposalWithDeposit(cdc codec.JSONCodec, proposalFile string) (types.PoolFeeRatesProposalWithDeposit, error) {
+	proposal := types.PoolFeeRatesProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// NewSubmitCircuitBreakerProposalCmd implements the command to submit a circuit breaker proposal.
+func NewSubmitCircuitBreakerProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to enable or disable circuit breakers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to enable or disable circuit breakers of liquidity pools along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Each circuit breaker halts a type of messages of a pool, of all pools if the pool id is 0, and all the message types which
+circuit breakers can halt if the message type is empty.
+
+Example:
+$ %s tx gov submit-proposal circuit-breaker <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Halt Swaps",
+  "description": "Halt the swaps of pool 1 during the incident",
+  "circuit_breakers": [
+    {
+      "pool_id": "1",
+      "msg_type": "swap_within_batch",
+      "enabled": true
+    }
+  ],
+  "deposit": "10000000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseCircuitBreakerProposalWithDeposit(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCircuitBreakerProposal(proposal.Title, proposal.Description, proposal.CircuitBreakers)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// ParseCircuitBreakerProposalWithDeposit reads and parses a CircuitBreakerProposalWithDeposit from a JSON file.
+func ParseCircuitBreakerProposalWithDeposit(cdc codec.JSONCodec, proposalFile string) (types.CircuitBreakerProposalWithDeposit, error) {
+	proposal := types.CircuitBreakerProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// getSwapFeeRate returns the swap fee rate given as the argument at the index, or the swap fee rate applied to the pool
+// queried from the network if the argument is omitted.
+func getSwapFeeRate(clientCtx client.Context, args []string, index int, poolID uint64) (sdk.Dec, error) {
+	if len(args) > index {
+		return sdk.NewDecFromStr(args[index])
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.PoolFeeRate(context.Background(), &types.QueryPoolFeeRateRequest{PoolId: poolID})
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("failed to query the swap fee rate of pool %d: %w", poolID, err)
+	}
+	return res.PoolFeeRate.SwapFeeRate, nil
+}
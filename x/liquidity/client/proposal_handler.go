@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/tendermint/liquidity/x/liquidity/client/cli"
+	"github.com/tendermint/liquidity/x/liquidity/client/rest"
+)
+
+// PoolFeeRatesProposalHandler is the pool fee rates proposal handler, and CircuitBreakerProposalHandler is the circuit
+// breaker proposal handler.
+var (
+	PoolFeeRatesProposalHandler   = govclient.NewProposalHandler(cli.NewSubmitPoolFeeRatesProposalCmd, rest.ProposalRESTHandler)
+	CircuitBreakerProposalHandler = govclient.NewProposalHandler(cli.NewSubmitCircuitBreakerProposalCmd, rest.CircuitBreakerProposalRESTHandler)
+)
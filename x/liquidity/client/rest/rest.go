@@ -0,0 +1,108 @@
+package rest
+
+// DONTCOVER
+// client is excluded from test coverage in the poc phase milestone 1 and will be included in milestone 2 with completeness
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// PoolFeeRatesProposalReq defines a pool fee rates proposal request body.
+type PoolFeeRatesProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title        string              `json:"title" yaml:"title"`
+	Description  string              `json:"description" yaml:"description"`
+	PoolFeeRates []types.PoolFeeRate `json:"pool_fee_rates" yaml:"pool_fee_rates"`
+	Proposer     sdk.AccAddress      `json:"proposer" yaml:"proposer"`
+	Deposit      sdk.Coins           `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the pool fee rates REST handler with a given sub-route.
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pool_fee_rates",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PoolFeeRatesProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewPoolFeeRatesProposal(req.Title, req.Description, req.PoolFeeRates)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// CircuitBreakerProposalReq defines a circuit breaker proposal request body.
+type CircuitBreakerProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title           string                 `json:"title" yaml:"title"`
+	Description     string                 `json:"description" yaml:"description"`
+	CircuitBreakers []types.CircuitBreaker `json:"circuit_breakers" yaml:"circuit_breakers"`
+	Proposer        sdk.AccAddress         `json:"proposer" yaml:"proposer"`
+	Deposit         sdk.Coins              `json:"deposit" yaml:"deposit"`
+}
+
+// CircuitBreakerProposalRESTHandler returns a ProposalRESTHandler that exposes the circuit breaker REST handler with a
+// given sub-route.
+func CircuitBreakerProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "circuit_breaker",
+		Handler:  postCircuitBreakerProposalHandlerFn(clientCtx),
+	}
+}
+
+func postCircuitBreakerProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CircuitBreakerProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCircuitBreakerProposal(req.Title, req.Description, req.CircuitBreakers)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -36,18 +36,22 @@ func (k Keeper) DeleteAndInitPoolBatches(ctx sdk.Context) {
 			height := ctx.BlockHeight()
 
 			// In the case of remaining swap msg states, those are either fractionally matched
-			// or has not yet been expired.
+			// or has not yet been expired. The expired ones are refunded and deleted.
 			swapMsgs := k.GetAllRemainingPoolBatchSwapMsgStates(ctx, poolBatch)
 			if len(swapMsgs) > 0 {
+				var expiredSwapMsgs []*types.SwapMsgState
 				for _, msg := range swapMsgs {
 					if height > msg.OrderExpiryHeight {
-						msg.ToBeDeleted = true
+						expiredSwapMsgs = append(expiredSwapMsgs, msg)
 					} else {
 						msg.Executed = false
 						msg.Succeeded = false
 					}
 				}
 				k.SetPoolBatchSwapMsgStatesByPointer(ctx, poolBatch.PoolId, swapMsgs)
+				if err := k.RefundAndDeleteSwaps(ctx, poolBatch.PoolId, expiredSwapMsgs); err != nil {
+					panic(err)
+				}
 			}
 
 			// Delete all batch msg states that are ready to be deleted.
@@ -83,12 +87,14 @@ func (k Keeper) ExecutePoolBatches(ctx sdk.Context) {
 	params := k.GetParams(ctx)
 	logger := k.Logger(ctx)
 
+	var routeHops []types.SwapRouteHop
 	k.IterateAllPoolBatches(ctx, func(poolBatch types.PoolBatch) bool {
 		if !poolBatch.Executed && ctx.BlockHeight()%int64(params.UnitBatchHeight) == 0 {
-			executedMsgCount, err := k.SwapExecution(ctx, poolBatch)
+			executedMsgCount, swapResults, batchRouteHops, err := k.SwapExecution(ctx, poolBatch)
 			if err != nil {
 				panic(err)
 			}
+			routeHops = append(routeHops, batchRouteHops...)
 
 			k.IterateAllPoolBatchDepositMsgStates(ctx, poolBatch, func(batchMsg types.DepositMsgState) bool {
 				if batchMsg.Executed || batchMsg.ToBeDeleted || batchMsg.Succeeded {
@@ -132,10 +138,56 @@ func (k Keeper) ExecutePoolBatches(ctx sdk.Context) {
 			if executedMsgCount > 0 {
 				poolBatch.Executed = true
 				k.SetPoolBatch(ctx, poolBatch)
+				k.RecordPoolBatchResult(ctx, poolBatch, swapResults, params.BatchResultRetention)
+				k.UpdatePriceAccumulator(ctx, poolBatch, params.PriceAccumulatorRetention)
+				if params.DynamicSwapFeeEnabled {
+					k.UpdateDynamicSwapFee(ctx, poolBatch, params)
+				}
+				if params.CircuitBreakerPriceMoveThreshold.IsPositive() {
+					k.TripPriceMoveCircuitBreaker(ctx, poolBatch, params)
+				}
 			}
 		}
 		return false
 	})
+
+	// the next hops of the swap routes are appended once every batch is executed, so that no batch is written while the
+	// batches are iterated, and are executed by the following batches of their pools
+	for _, hop := range routeHops {
+		if err := k.SwapRouteNextHop(ctx, hop.SwapMsgState, hop.ExchangedCoin); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RecordPoolBatchResult stores the result of the executed batch with the reserve coins of the pool after the execution,
+// and prunes the results of the pool older than the latest batches within the retention.
+func (k Keeper) RecordPoolBatchResult(ctx sdk.Context, poolBatch types.PoolBatch, swapResults []types.PairSwapResult, retention uint32) {
+	if retention > 0 {
+		pool, found := k.GetPool(ctx, poolBatch.PoolId)
+		if !found {
+			return
+		}
+		k.SetPoolBatchResult(ctx, types.PoolBatchResult{
+			PoolId:       poolBatch.PoolId,
+			BatchIndex:   poolBatch.Index,
+			Height:       ctx.BlockHeight(),
+			SwapResults:  swapResults,
+			ReserveCoins: k.GetReserveCoins(ctx, pool),
+		})
+	}
+
+	var prunedBatchIndexes []uint64
+	k.IteratePoolBatchResults(ctx, poolBatch.PoolId, func(result types.PoolBatchResult) bool {
+		if result.BatchIndex+uint64(retention) > poolBatch.Index {
+			return true
+		}
+		prunedBatchIndexes = append(prunedBatchIndexes, result.BatchIndex)
+		return false
+	})
+	for _, batchIndex := range prunedBatchIndexes {
+		k.DeletePoolBatchResult(ctx, poolBatch.PoolId, batchIndex)
+	}
 }
 
 // HoldEscrow sends coins to the module account for an escrow.
@@ -234,6 +286,23 @@ func (k Keeper) WithdrawWithinBatch(ctx sdk.Context, msg *types.MsgWithdrawWithi
 	return batchPoolMsg, nil
 }
 
+// CancelSwap marks the pending swap order of the swap requester to be deleted, so it is not matched anymore. The
+// remaining offer coin and the unused reserved offer coin fee are refunded by the next batch execution.
+func (k Keeper) CancelSwap(ctx sdk.Context, msg *types.MsgCancelSwap) (*types.SwapMsgState, error) {
+	sms, found := k.GetPoolBatchSwapMsgState(ctx, msg.PoolId, msg.MsgIndex)
+	if !found || sms.Executed || sms.ToBeDeleted {
+		return nil, types.ErrSwapMsgStateNotExists
+	}
+	if sms.Msg.SwapRequesterAddress != msg.SwapRequesterAddress {
+		return nil, types.ErrNotSwapRequester
+	}
+
+	sms.ToBeDeleted = true
+	k.SetPoolBatchSwapMsgState(ctx, msg.PoolId, sms)
+
+	return &sms, nil
+}
+
 // In order to deal with the batch at the same time, the coins of msgs are deposited in escrow.
 func (k Keeper) SwapWithinBatch(ctx sdk.Context, msg *types.MsgSwapWithinBatch, orderExpirySpanHeight int64) (*types.SwapMsgState, error) {
 	pool, found := k.GetPool(ctx, msg.PoolId)
@@ -246,6 +315,8 @@ func (k Keeper) SwapWithinBatch(ctx sdk.Context, msg *types.MsgSwapWithinBatch,
 	if err := k.ValidateMsgSwapWithinBatch(ctx, *msg, pool); err != nil {
 		return nil, err
 	}
+	// the min demand coin amount limits the order price, so the order is matched only at the swap prices meeting it
+	msg.OrderPrice = types.MinDemandOrderPrice(*msg)
 	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
 	if !found {
 		return nil, types.ErrPoolBatchNotExists
@@ -255,14 +326,17 @@ func (k Keeper) SwapWithinBatch(ctx sdk.Context, msg *types.MsgSwapWithinBatch,
 		poolBatch.BeginHeight = ctx.BlockHeight()
 	}
 
-	currentHeight := ctx.BlockHeight()
-
-	if orderExpirySpanHeight == 0 {
-		params := k.GetParams(ctx)
-		u := int64(params.UnitBatchHeight)
-		orderExpirySpanHeight = (u - currentHeight%u) % u
+	params := k.GetParams(ctx)
+	if orderExpirySpanHeight < 0 || orderExpirySpanHeight > int64(params.MaxOrderLifespan) {
+		return nil, types.ErrExceededMaxOrderLifespan
 	}
 
+	// the order expires at the end of the batch at or after its lifespan, so it is refunded by the batch execution
+	currentHeight := ctx.BlockHeight()
+	u := int64(params.UnitBatchHeight)
+	lifespanHeight := currentHeight + orderExpirySpanHeight
+	orderExpirySpanHeight += (u - lifespanHeight%u) % u
+
 	batchPoolMsg := types.SwapMsgState{
 		MsgHeight:            currentHeight,
 		MsgIndex:             poolBatch.SwapMsgIndex,
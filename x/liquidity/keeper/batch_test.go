@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strconv"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -1242,3 +1243,444 @@ func TestSwapAutoOrderExpiryHeight(t *testing.T) {
 	states = simapp.LiquidityKeeper.GetAllPoolBatchSwapMsgStates(ctx, batch)
 	require.Len(t, states, 0)
 }
+
+func TestSwapOrderLifespan(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(1)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.UnitBatchHeight = 2
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	offerCoins := sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)))
+	buyer := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+	restingBuyer := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the order lifespan can not exceed the max order lifespan
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		buyer, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("0.95"), params.SwapFeeRate),
+		int64(params.MaxOrderLifespan)+1)
+	require.ErrorIs(t, err, types.ErrExceededMaxOrderLifespan)
+
+	// the order expires at the end of the batch at or after its lifespan
+	sms, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		buyer, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("0.95"), params.SwapFeeRate), 4)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), sms.OrderExpiryHeight)
+	sms, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		restingBuyer, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("0.5"), params.SwapFeeRate), 4)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), sms.OrderExpiryHeight)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the unmatched orders are carried forward to the next batch without refund
+	ctx = ctx.WithBlockHeight(2)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, buyer).IsZero())
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, restingBuyer).IsZero())
+
+	ctx = ctx.WithBlockHeight(3)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	batch, found := simapp.LiquidityKeeper.GetPoolBatch(ctx, pool.Id)
+	require.True(t, found)
+	states := simapp.LiquidityKeeper.GetAllPoolBatchSwapMsgStates(ctx, batch)
+	require.Len(t, states, 2)
+	for _, state := range states {
+		require.False(t, state.Executed)
+		require.False(t, state.ToBeDeleted)
+	}
+
+	// the carried forward order is matched with the order of the later batch
+	sellCoin := sdk.NewInt64Coin(DenomY, 100000)
+	seller := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(sellCoin.Add(types.GetOfferCoinFee(sellCoin, params.SwapFeeRate))))
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		seller, pool.Id, types.DefaultSwapTypeID, sellCoin, DenomX, sdk.MustNewDecFromStr("0.9"), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	ctx = ctx.WithBlockHeight(4)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, buyer, DenomY).Amount.IsPositive())
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, restingBuyer).IsZero())
+
+	// the unmatched order is refunded at its expiry height
+	ctx = ctx.WithBlockHeight(5)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, restingBuyer).IsZero())
+
+	ctx = ctx.WithBlockHeight(6)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, offerCoins, simapp.BankKeeper.GetAllBalances(ctx, restingBuyer))
+
+	ctx = ctx.WithBlockHeight(7)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	require.Len(t, simapp.LiquidityKeeper.GetAllPoolBatchSwapMsgStates(ctx, batch), 0)
+}
+
+func TestSwapFeeRateRaiseKeepsReservedFee(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(1)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.UnitBatchHeight = 2
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	buyer := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		buyer, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate), 4)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the swap fee rate is raised by governance while the order is pending
+	ctx = ctx.WithBlockHeight(2)
+	params.SwapFeeRate = sdk.NewDecWithPrec(1, 2)
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the order is executed with the offer coin fee reserved at submission instead of being refunded
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, buyer, DenomX).Amount.IsZero())
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, buyer, DenomY).Amount.IsPositive())
+}
+
+func TestCancelSwap(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(1)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	offerCoins := sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)))
+	swapRequester := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+	other := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins())
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	sms, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		swapRequester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("0.5"), params.SwapFeeRate), 10)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	ctx = ctx.WithBlockHeight(2)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// only the swap requester cancels the pending swap order
+	_, err = simapp.LiquidityKeeper.CancelSwap(ctx, types.NewMsgCancelSwap(other, pool.Id, sms.MsgIndex))
+	require.ErrorIs(t, err, types.ErrNotSwapRequester)
+	_, err = simapp.LiquidityKeeper.CancelSwap(ctx, types.NewMsgCancelSwap(swapRequester, pool.Id, sms.MsgIndex+1))
+	require.ErrorIs(t, err, types.ErrSwapMsgStateNotExists)
+
+	canceled, err := simapp.LiquidityKeeper.CancelSwap(ctx, types.NewMsgCancelSwap(swapRequester, pool.Id, sms.MsgIndex))
+	require.NoError(t, err)
+	require.True(t, canceled.ToBeDeleted)
+	_, err = simapp.LiquidityKeeper.CancelSwap(ctx, types.NewMsgCancelSwap(swapRequester, pool.Id, sms.MsgIndex))
+	require.ErrorIs(t, err, types.ErrSwapMsgStateNotExists)
+
+	// the canceled swap order is refunded at the end of the batch
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, swapRequester).IsZero())
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, offerCoins, simapp.BankKeeper.GetAllBalances(ctx, swapRequester))
+
+	ctx = ctx.WithBlockHeight(3)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, found := simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, pool.Id, sms.MsgIndex)
+	require.False(t, found)
+}
+
+func TestSwapRoute(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin(DenomY, 1000000), sdk.NewInt64Coin(DenomA, 1000000))
+	creator := app.AddRandomTestAddr(simapp, ctx, depositCoins.Add(params.PoolCreationFee...))
+	pool2, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.DefaultPoolTypeID, depositCoins))
+	require.NoError(t, err)
+
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	offerCoins := sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)))
+
+	// the pools of the route must share the demand coin of each hop
+	_, err = simapp.LiquidityKeeper.SwapRoute(ctx, types.NewMsgSwapRoute(
+		app.AddRandomTestAddr(simapp, ctx, offerCoins), []uint64{pool.Id, pool2.Id}, offerCoin, DenomB, sdk.ZeroInt(), params.SwapFeeRate))
+	require.ErrorIs(t, err, types.ErrBadSwapRoute)
+	_, err = simapp.LiquidityKeeper.SwapRoute(ctx, types.NewMsgSwapRoute(
+		app.AddRandomTestAddr(simapp, ctx, offerCoins), []uint64{pool2.Id, pool.Id}, offerCoin, DenomA, sdk.ZeroInt(), params.SwapFeeRate))
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+
+	ctx = ctx.WithBlockHeight(1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	swapRequester := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+	sms, err := simapp.LiquidityKeeper.SwapRoute(ctx, types.NewMsgSwapRoute(
+		swapRequester, []uint64{pool.Id, pool2.Id}, offerCoin, DenomA, sdk.NewInt(9000), params.SwapFeeRate))
+	require.NoError(t, err)
+	require.Equal(t, DenomY, sms.Msg.DemandCoinDenom)
+	require.Equal(t, []uint64{pool2.Id}, sms.Route.PoolIds)
+	// the route is refunded by the last hop receiving less than the minimum demand coin amount
+	refundedRequester := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+	_, err = simapp.LiquidityKeeper.SwapRoute(ctx, types.NewMsgSwapRoute(
+		refundedRequester, []uint64{pool.Id, pool2.Id}, offerCoin, DenomA, sdk.NewInt(9900), params.SwapFeeRate))
+	require.NoError(t, err)
+
+	// the next hops are appended once every batch is executed, and are executed by the following batch of the next pool
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, swapRequester).IsZero())
+	states := simapp.LiquidityKeeper.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, types.PoolBatch{PoolId: pool2.Id})
+	require.Len(t, states, 2)
+	for _, state := range states {
+		require.Equal(t, int64(2), state.OrderExpiryHeight)
+		require.Equal(t, DenomY, state.Msg.OfferCoin.Denom)
+	}
+
+	ctx = ctx.WithBlockHeight(2)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	balances := simapp.BankKeeper.GetAllBalances(ctx, swapRequester)
+	require.True(t, balances.AmountOf(DenomA).GTE(sdk.NewInt(9000)))
+	require.True(t, balances.AmountOf(DenomX).IsZero())
+	// the last hop is refunded in its offer coin, the demand coin of the first hop, not in the offer coin of the route
+	refunded := simapp.BankKeeper.GetAllBalances(ctx, refundedRequester)
+	require.True(t, refunded.AmountOf(DenomA).IsZero())
+	require.True(t, refunded.AmountOf(DenomX).IsZero())
+	require.True(t, refunded.AmountOf(DenomY).IsPositive())
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, simapp.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	// the next hop to a pool with a lower id is executed by the following batch as well
+	ctx = ctx.WithBlockHeight(3)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	offerCoin = sdk.NewInt64Coin(DenomA, 10000)
+	offerCoins = sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)))
+	reverseRequester := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+	_, err = simapp.LiquidityKeeper.SwapRoute(ctx, types.NewMsgSwapRoute(
+		reverseRequester, []uint64{pool2.Id, pool.Id}, offerCoin, DenomX, sdk.ZeroInt(), params.SwapFeeRate))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, reverseRequester).AmountOf(DenomX).IsZero())
+	states = simapp.LiquidityKeeper.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, types.PoolBatch{PoolId: pool.Id})
+	require.Len(t, states, 1)
+	require.Equal(t, int64(4), states[0].OrderExpiryHeight)
+
+	ctx = ctx.WithBlockHeight(4)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, reverseRequester).AmountOf(DenomX).IsPositive())
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, simapp.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
+
+func TestSwapMinDemandCoinAmount(t *testing.T) {
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	// executes a batch of the given swap orders of the offer coin with the min demand coin amounts, and returns the
+	// swap requesters and the swap price of the batch
+	executeBatch := func(minDemandCoinAmts ...int64) (*app.LiquidityApp, sdk.Context, types.Pool, []sdk.AccAddress, []*types.SwapMsgState, sdk.Dec) {
+		simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+		require.NoError(t, err)
+		ctx = ctx.WithBlockHeight(1)
+		params := simapp.LiquidityKeeper.GetParams(ctx)
+		offerCoins := sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)))
+
+		liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+		var requesters []sdk.AccAddress
+		var msgs []*types.SwapMsgState
+		for _, minDemandCoinAmt := range minDemandCoinAmts {
+			requester := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+			msg := types.NewMsgSwapWithinBatch(requester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate)
+			msg.MinDemandCoinAmount = sdk.NewInt(minDemandCoinAmt)
+			sms, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, 0)
+			require.NoError(t, err)
+			requesters = append(requesters, requester)
+			msgs = append(msgs, sms)
+		}
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+		results := simapp.LiquidityKeeper.GetPoolBatchResults(ctx, pool.Id)
+		require.Len(t, results, 1)
+		require.Len(t, results[0].SwapResults, 1)
+		return simapp, ctx, pool, requesters, msgs, results[0].SwapResults[0].SwapPrice
+	}
+
+	simapp, ctx, pool, requesters, msgs, swapPrice := executeBatch(9000, 9900)
+	offerCoins := sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, simapp.LiquidityKeeper.GetParams(ctx).SwapFeeRate)))
+
+	// the min demand coin amount limits the order price, so the order not meeting it is not matched at all and does
+	// not move the swap price of the batch
+	_, _, _, _, _, expectedSwapPrice := executeBatch(9000)
+	require.Equal(t, expectedSwapPrice, swapPrice)
+	require.True(t, msgs[1].Msg.OrderPrice.LT(swapPrice))
+
+	// the matched order receives at least its minimum, and the other one is refunded with its offer coin fee
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, requesters[0]).AmountOf(DenomY).GTE(sdk.NewInt(9000)))
+	require.Equal(t, offerCoins, simapp.BankKeeper.GetAllBalances(ctx, requesters[1]))
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, simapp.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+	reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	require.Equal(t, sdk.NewInt(1000000).Add(offerCoins.AmountOf(DenomX)), reserveCoins.AmountOf(DenomX))
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSwapTransacted {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		if attrs[types.AttributeValueMsgIndex] == strconv.FormatUint(msgs[1].MsgIndex, 10) {
+			require.Equal(t, types.Failure, attrs[types.AttributeValueSuccess])
+			require.Equal(t, msgs[1].Msg.OrderPrice.String(), attrs[types.AttributeValueOrderPrice])
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestSwapExactOutput(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(1)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	offerCoins := sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)))
+	swapRequester := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the max offer coin can not be exchanged with the demand coin amount at the order price
+	msg := types.NewMsgSwapWithinBatch(swapRequester, pool.Id, types.ExactOutputSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate)
+	msg.DemandCoinAmount = sdk.NewInt(10000)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, 0)
+	require.ErrorIs(t, err, types.ErrBadDemandCoinAmount)
+
+	msg.DemandCoinAmount = sdk.NewInt(5000)
+	sms, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, 0)
+	require.NoError(t, err)
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the exact demand coin amount is received, and the unused offer coin is refunded with its offer coin fee
+	balances := simapp.BankKeeper.GetAllBalances(ctx, swapRequester)
+	require.Equal(t, sdk.NewInt(5000), balances.AmountOf(DenomY))
+	require.True(t, balances.AmountOf(DenomX).GT(sdk.NewInt(4000)))
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, simapp.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	// the order is sized before matching, so the batch is matched at the swap price of the offer coin as transacted
+	results := simapp.LiquidityKeeper.GetPoolBatchResults(ctx, pool.Id)
+	require.Len(t, results, 1)
+	offerAmt, offerCoinFeeAmt := types.ExactOutputOfferAmount(sms, results[0].SwapResults[0].SwapPrice)
+	require.Equal(t, offerAmt, results[0].SwapResults[0].XToYVolume)
+	require.Equal(t, offerCoins.AmountOf(DenomX).Sub(offerAmt).Sub(offerCoinFeeAmt), balances.AmountOf(DenomX))
+
+	reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	require.Equal(t, sdk.NewInt(1000000).Add(offerCoins.AmountOf(DenomX)).Sub(balances.AmountOf(DenomX)), reserveCoins.AmountOf(DenomX))
+	require.Equal(t, sdk.NewInt(1000000-5000), reserveCoins.AmountOf(DenomY))
+
+	ctx = ctx.WithBlockHeight(2)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	require.Empty(t, simapp.LiquidityKeeper.GetAllPoolBatchSwapMsgStatesAsPointer(ctx, types.PoolBatch{PoolId: pool.Id}))
+}
+
+func TestSwapExactOutputRejected(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(1)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	offerCoins := sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)))
+	swapRequester := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+	exactOutputRequester := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx,
+		types.NewMsgSwapWithinBatch(swapRequester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	// the exact-output order can not receive its demand coin amount at the swap price moved by the other order
+	msg := types.NewMsgSwapWithinBatch(exactOutputRequester, pool.Id, types.ExactOutputSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.001"), params.SwapFeeRate)
+	msg.DemandCoinAmount = sdk.NewInt(9900)
+	sms, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, 0)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the rejected order is refunded before matching, so only the other order is transacted
+	require.Equal(t, offerCoins, simapp.BankKeeper.GetAllBalances(ctx, exactOutputRequester))
+	results := simapp.LiquidityKeeper.GetPoolBatchResults(ctx, pool.Id)
+	require.Len(t, results, 1)
+	require.Equal(t, offerCoin.Amount, results[0].SwapResults[0].XToYVolume)
+	require.True(t, results[0].SwapResults[0].SwapPrice.GT(msg.OrderPrice))
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, simapp.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSwapTransacted {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		if attrs[types.AttributeValueMsgIndex] == strconv.FormatUint(sms.MsgIndex, 10) {
+			require.Equal(t, types.Failure, attrs[types.AttributeValueSuccess])
+			require.Equal(t, types.FailureReasonExactOutputNotFillable, attrs[types.AttributeValueFailureReason])
+			require.Equal(t, msg.DemandCoinAmount.String(), attrs[types.AttributeValueDemandCoinAmount])
+			require.NotContains(t, attrs, types.AttributeValueMinDemandCoinAmount)
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestPoolBatchResults(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.BatchResultRetention = 2
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+
+	for height := int64(1); height <= 3; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+		offerCoinX := sdk.NewInt64Coin(DenomX, 10000)
+		offerCoinY := sdk.NewInt64Coin(DenomY, 5000)
+		requesterX := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoinX.Add(types.GetOfferCoinFee(offerCoinX, params.SwapFeeRate))))
+		requesterY := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoinY.Add(types.GetOfferCoinFee(offerCoinY, params.SwapFeeRate))))
+		_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx,
+			types.NewMsgSwapWithinBatch(requesterX, pool.Id, types.DefaultSwapTypeID, offerCoinX, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate), 0)
+		require.NoError(t, err)
+		_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx,
+			types.NewMsgSwapWithinBatch(requesterY, pool.Id, types.DefaultSwapTypeID, offerCoinY, DenomX, sdk.MustNewDecFromStr("0.9"), params.SwapFeeRate), 0)
+		require.NoError(t, err)
+
+		liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	}
+
+	// only the results of the latest batches within the retention are kept
+	results := simapp.LiquidityKeeper.GetPoolBatchResults(ctx, pool.Id)
+	require.Len(t, results, 2)
+	require.Equal(t, uint64(2), results[0].BatchIndex)
+	require.Equal(t, uint64(3), results[1].BatchIndex)
+
+	result := results[1]
+	require.Equal(t, pool.Id, result.PoolId)
+	require.Equal(t, int64(3), result.Height)
+	require.Equal(t, simapp.LiquidityKeeper.GetReserveCoins(ctx, pool), result.ReserveCoins)
+	require.Len(t, result.SwapResults, 1)
+	swapResult := result.SwapResults[0]
+	require.Equal(t, DenomX, swapResult.DenomX)
+	require.Equal(t, DenomY, swapResult.DenomY)
+	require.True(t, swapResult.SwapPrice.IsPositive())
+	require.Equal(t, sdk.NewInt(10000), swapResult.XToYVolume)
+	require.Equal(t, sdk.NewInt(5000), swapResult.YToXVolume)
+	require.True(t, swapResult.FeeCoins.AmountOf(DenomX).IsPositive())
+	require.True(t, swapResult.FeeCoins.AmountOf(DenomY).IsPositive())
+}
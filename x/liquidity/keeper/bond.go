@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// Bond locks the pool coin of the owner for the bond duration, which must be one of the bond durations of the params.
+// The pool coin is escrowed in the bonds escrow account until the bond is unbonded.
+func (k Keeper) Bond(ctx sdk.Context, msg *types.MsgBond) (types.Bond, error) {
+	params := k.GetParams(ctx)
+	if !params.IsBondDuration(msg.Duration) {
+		return types.Bond{}, sdkerrors.Wrapf(types.ErrBadBondDuration, "%s is not one of the bond durations %v", msg.Duration, params.BondDurations)
+	}
+	if _, err := k.getPoolByPoolCoinDenom(ctx, msg.PoolCoin.Denom); err != nil {
+		return types.Bond{}, err
+	}
+
+	owner := msg.GetOwner()
+	balance := k.bankKeeper.GetBalance(ctx, owner, msg.PoolCoin.Denom)
+	if balance.IsLT(msg.PoolCoin) {
+		return types.Bond{}, sdkerrors.Wrapf(
+			types.ErrInsufficientBalance, "%s is smaller than %s", balance, msg.PoolCoin)
+	}
+	if err := k.bankKeeper.SendCoins(ctx, owner, types.BondsEscrowAcc, sdk.NewCoins(msg.PoolCoin)); err != nil {
+		return types.Bond{}, err
+	}
+
+	bond := types.Bond{
+		Id:           k.GetNextBondIDWithUpdate(ctx),
+		OwnerAddress: msg.OwnerAddress,
+		PoolCoin:     msg.PoolCoin,
+		Duration:     msg.Duration,
+	}
+	k.SetBond(ctx, bond)
+
+	return bond, nil
+}
+
+// BeginUnbond begins the unbonding of the bond at any time, queueing the bond to return its pool coin to the owner when
+// the bond duration has passed from the current block time.
+func (k Keeper) BeginUnbond(ctx sdk.Context, msg *types.MsgBeginUnbond) (types.Bond, error) {
+	bond, found := k.GetBond(ctx, msg.BondId)
+	if !found {
+		return types.Bond{}, types.ErrBondNotExists
+	}
+	if bond.OwnerAddress != msg.OwnerAddress {
+		return types.Bond{}, types.ErrNotBondOwner
+	}
+	if bond.IsUnbonding() {
+		return types.Bond{}, sdkerrors.Wrapf(types.ErrBondUnbonding, "bond %d is unbonded at %s", bond.Id, bond.UnbondTime)
+	}
+
+	bond.UnbondTime = ctx.BlockTime().Add(bond.Duration)
+	k.SetBond(ctx, bond)
+
+	return bond, nil
+}
+
+// CompleteUnbonds returns the pool coins of the unbonding bonds whose unbond time has come to their owners, and
+// deletes the bonds.
+func (k Keeper) CompleteUnbonds(ctx sdk.Context) {
+	var bonds []types.Bond
+	k.IterateMatureUnbondingBonds(ctx, ctx.BlockTime(), func(bond types.Bond) bool {
+		bonds = append(bonds, bond)
+		return false
+	})
+
+	for _, bond := range bonds {
+		if err := k.bankKeeper.SendCoins(ctx, types.BondsEscrowAcc, bond.GetOwner(), sdk.NewCoins(bond.PoolCoin)); err != nil {
+			panic(err)
+		}
+		k.DeleteBond(ctx, bond)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnbondCompleted,
+				sdk.NewAttribute(types.AttributeValueBondId, strconv.FormatUint(bond.Id, 10)),
+				sdk.NewAttribute(types.AttributeValueOwner, bond.OwnerAddress),
+				sdk.NewAttribute(types.AttributeValuePoolCoinDenom, bond.PoolCoin.Denom),
+				sdk.NewAttribute(types.AttributeValuePoolCoinAmount, bond.PoolCoin.Amount.String()),
+			))
+	}
+}
+
+// GetBondedPoolCoins returns the pool coins of the bonds of the owner that are not unbonding and whose bond duration is
+// at least the minimum duration. Other modules can use it to weight the pool coins by the commitment of the owner.
+func (k Keeper) GetBondedPoolCoins(ctx sdk.Context, owner sdk.AccAddress, minDuration time.Duration) sdk.Coins {
+	poolCoins := sdk.NewCoins()
+	k.IterateBondsByOwner(ctx, owner, func(bond types.Bond) bool {
+		if !bond.IsUnbonding() && bond.Duration >= minDuration {
+			poolCoins = poolCoins.Add(bond.PoolCoin)
+		}
+		return false
+	})
+	return poolCoins
+}
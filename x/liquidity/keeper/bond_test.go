@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/app"
+	"github.com/tendermint/liquidity/x/liquidity"
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestBond(t *testing.T) {
+	simapp, ctx, pool, owner, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	invariant := keeper.AllInvariants(k)
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	day := 24 * time.Hour
+
+	_, err = k.Bond(ctx, types.NewMsgBond(owner, sdk.NewInt64Coin(pool.PoolCoinDenom, 1000), 2*day))
+	require.ErrorIs(t, err, types.ErrBadBondDuration)
+	_, err = k.Bond(ctx, types.NewMsgBond(owner, sdk.NewInt64Coin(DenomX, 1000), day))
+	require.ErrorIs(t, err, types.ErrBadPoolCoinDenom)
+	_, err = k.Bond(ctx, types.NewMsgBond(owner, sdk.NewInt64Coin(pool.PoolCoinDenom, 1000001), day))
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+
+	bond1, err := k.Bond(ctx, types.NewMsgBond(owner, sdk.NewInt64Coin(pool.PoolCoinDenom, 300000), day))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), bond1.Id)
+	require.False(t, bond1.IsUnbonding())
+	bond2, err := k.Bond(ctx, types.NewMsgBond(owner, sdk.NewInt64Coin(pool.PoolCoinDenom, 200000), 7*day))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), bond2.Id)
+
+	require.Equal(t, sdk.NewInt64Coin(pool.PoolCoinDenom, 500000), simapp.BankKeeper.GetBalance(ctx, owner, pool.PoolCoinDenom))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 500000)), simapp.BankKeeper.GetAllBalances(ctx, types.BondsEscrowAcc))
+	require.Equal(t, []types.Bond{bond1, bond2}, k.GetBondsByOwner(ctx, owner))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 500000)), k.GetBondedPoolCoins(ctx, owner, 0))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 200000)), k.GetBondedPoolCoins(ctx, owner, 7*day))
+	require.True(t, k.GetBondedPoolCoins(ctx, owner, 14*day).IsZero())
+
+	other := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins())
+	_, err = k.BeginUnbond(ctx, types.NewMsgBeginUnbond(other, bond2.Id))
+	require.ErrorIs(t, err, types.ErrNotBondOwner)
+	_, err = k.BeginUnbond(ctx, types.NewMsgBeginUnbond(owner, 3))
+	require.ErrorIs(t, err, types.ErrBondNotExists)
+
+	// the unbonding can begin before the bond duration has passed since the bond was bonded
+	ctx = ctx.WithBlockTime(now.Add(day))
+	bond2, err = k.BeginUnbond(ctx, types.NewMsgBeginUnbond(owner, bond2.Id))
+	require.NoError(t, err)
+	require.Equal(t, now.Add(8*day), bond2.UnbondTime)
+	_, err = k.BeginUnbond(ctx, types.NewMsgBeginUnbond(owner, bond2.Id))
+	require.ErrorIs(t, err, types.ErrBondUnbonding)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 300000)), k.GetBondedPoolCoins(ctx, owner, 0))
+	require.True(t, k.GetBondedPoolCoins(ctx, owner, 7*day).IsZero())
+
+	record, found := k.GetPoolRecord(ctx, pool)
+	require.True(t, found)
+	require.Equal(t, []types.Bond{bond1, bond2}, record.Bonds)
+	require.NoError(t, k.ValidatePoolRecord(ctx, record))
+
+	// the pool coin of the unbonding bond is returned once the bond duration has passed since the unbonding began
+	ctx = ctx.WithBlockTime(now.Add(8*day - time.Second))
+	liquidity.BeginBlocker(ctx, k)
+	_, found = k.GetBond(ctx, bond2.Id)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(now.Add(8 * day))
+	liquidity.BeginBlocker(ctx, k)
+	_, found = k.GetBond(ctx, bond2.Id)
+	require.False(t, found)
+	require.Equal(t, []types.Bond{bond1}, k.GetBondsByOwner(ctx, owner))
+	require.Equal(t, sdk.NewInt64Coin(pool.PoolCoinDenom, 700000), simapp.BankKeeper.GetBalance(ctx, owner, pool.PoolCoinDenom))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 300000)), simapp.BankKeeper.GetAllBalances(ctx, types.BondsEscrowAcc))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// the bond of a shorter duration is unbonded earlier
+	_, err = k.BeginUnbond(ctx, types.NewMsgBeginUnbond(owner, bond1.Id))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(now.Add(9 * day))
+	liquidity.BeginBlocker(ctx, k)
+	require.Empty(t, k.GetBondsByOwner(ctx, owner))
+	require.Equal(t, sdk.NewInt64Coin(pool.PoolCoinDenom, 1000000), simapp.BankKeeper.GetBalance(ctx, owner, pool.PoolCoinDenom))
+	require.Equal(t, uint64(3), k.GetNextBondID(ctx))
+}
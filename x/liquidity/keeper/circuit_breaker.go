@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// IsCircuitBreakerEnabled returns whether the messages of the type are halted for the pool, by the circuit breaker
+// param, or by an enabled circuit breaker of the pool or of all pools, for the message type or for all message types.
+// The circuit breaker param halts all pools and message types regardless of the registry, and is lifted only by
+// governance setting it back to false.
+func (k Keeper) IsCircuitBreakerEnabled(ctx sdk.Context, poolID uint64, msgType string) bool {
+	if k.GetCircuitBreakerEnabled(ctx) {
+		return true
+	}
+	poolIDs := []uint64{0}
+	if poolID != 0 {
+		poolIDs = append(poolIDs, poolID)
+	}
+	for _, id := range poolIDs {
+		for _, t := range []string{"", msgType} {
+			if _, found := k.GetCircuitBreaker(ctx, id, t); found {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateCircuitBreaker returns ErrCircuitBreakerEnabled if the messages of the type are halted for the pool.
+func (k Keeper) ValidateCircuitBreaker(ctx sdk.Context, poolID uint64, msgType string) error {
+	if k.IsCircuitBreakerEnabled(ctx, poolID, msgType) {
+		return sdkerrors.Wrapf(types.ErrCircuitBreakerEnabled, "%s messages of pool %d are halted", msgType, poolID)
+	}
+	return nil
+}
+
+// ValidatePoolCoinCircuitBreaker returns ErrCircuitBreakerEnabled if the messages of the type are halted for the pool
+// of the pool coin denom, or for all pools if no pool has the pool coin denom.
+func (k Keeper) ValidatePoolCoinCircuitBreaker(ctx sdk.Context, poolCoinDenom string, msgType string) error {
+	var poolID uint64
+	if pool, err := k.getPoolByPoolCoinDenom(ctx, poolCoinDenom); err == nil {
+		poolID = pool.Id
+	}
+	return k.ValidateCircuitBreaker(ctx, poolID, msgType)
+}
+
+// ApplyCircuitBreaker enables the circuit breaker, or deletes it when disabled. The circuit breakers of a pool must
+// apply to an existing pool.
+func (k Keeper) ApplyCircuitBreaker(ctx sdk.Context, circuitBreaker types.CircuitBreaker) error {
+	if err := circuitBreaker.Validate(); err != nil {
+		return err
+	}
+	if circuitBreaker.PoolId != 0 {
+		if _, found := k.GetPool(ctx, circuitBreaker.PoolId); !found {
+			return sdkerrors.Wrapf(types.ErrPoolNotExists, "pool %d", circuitBreaker.PoolId)
+		}
+	}
+
+	if circuitBreaker.Enabled {
+		k.SetCircuitBreaker(ctx, circuitBreaker)
+	} else {
+		k.DeleteCircuitBreaker(ctx, circuitBreaker.PoolId, circuitBreaker.MsgType)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCircuitBreaker,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(circuitBreaker.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgType, circuitBreaker.MsgType),
+			sdk.NewAttribute(types.AttributeValueEnabled, strconv.FormatBool(circuitBreaker.Enabled)),
+		),
+	)
+	return nil
+}
+
+// SetCircuitBreakerByAdmin applies the circuit breaker of MsgSetCircuitBreaker submitted by one of the emergency
+// admins of the circuit breaker admins param.
+func (k Keeper) SetCircuitBreakerByAdmin(ctx sdk.Context, msg *types.MsgSetCircuitBreaker) error {
+	isAdmin := false
+	for _, admin := range k.GetParams(ctx).CircuitBreakerAdmins {
+		if admin == msg.AdminAddress {
+			isAdmin = true
+			break
+		}
+	}
+	if !isAdmin {
+		return sdkerrors.Wrapf(types.ErrNotCircuitBreakerAdmin, "%s", msg.AdminAddress)
+	}
+	return k.ApplyCircuitBreaker(ctx, msg.GetCircuitBreaker())
+}
+
+// TripPriceMoveCircuitBreaker halts the swaps of the pool when the clearing price of the executed batch moves beyond
+// the circuit breaker price move threshold from the clearing prices of the previous batch results within the lookback,
+// which is limited to the previous batch results kept within the batch result retention. The circuit breakers stay enabled until they are disabled by governance or the circuit breaker admins. It returns
+// whether any circuit breaker is enabled.
+func (k Keeper) TripPriceMoveCircuitBreaker(ctx sdk.Context, poolBatch types.PoolBatch, params types.Params) bool {
+	results := k.GetPoolBatchResults(ctx, poolBatch.PoolId)
+	if len(results) < 2 || results[len(results)-1].BatchIndex != poolBatch.Index {
+		return false
+	}
+	latest, previous := results[len(results)-1], results[:len(results)-1]
+	// the lookback is not checked against the retention on the parameter changes, so it is clamped here
+	lookback := int(params.CircuitBreakerPriceMoveLookback)
+	if retained := int(params.BatchResultRetention) - 1; lookback > retained {
+		lookback = retained
+	}
+	if len(previous) > lookback {
+		previous = previous[len(previous)-lookback:]
+	}
+	priceMove := types.PriceMove(latest, previous)
+	if priceMove.LTE(params.CircuitBreakerPriceMoveThreshold) {
+		return false
+	}
+
+	tripped := false
+	for _, msgType := range types.PriceMoveCircuitBreakerMsgTypes {
+		if _, found := k.GetCircuitBreaker(ctx, poolBatch.PoolId, msgType); !found {
+			k.SetCircuitBreaker(ctx, types.NewCircuitBreaker(poolBatch.PoolId, msgType, true))
+			tripped = true
+		}
+	}
+	if !tripped {
+		return false
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCircuitBreakerTripped,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(poolBatch.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValuePriceMove, priceMove.String()),
+		),
+	)
+	return true
+}
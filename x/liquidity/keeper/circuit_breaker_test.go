@@ -0,0 +1,299 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/app"
+	"github.com/tendermint/liquidity/x/liquidity"
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestCircuitBreakerRegistry(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	otherPool, err := createPool(simapp, ctx, sdk.NewInt(1000000), sdk.NewInt(1000000), DenomX, "denomz")
+	require.NoError(t, err)
+	handler := liquidity.NewHandler(k)
+	liquidity.BeginBlocker(ctx, k)
+
+	swap := func(poolID uint64, demandCoinDenom string) error {
+		offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+		requester := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+		_, err := handler(ctx, types.NewMsgSwapWithinBatch(
+			requester, poolID, types.DefaultSwapTypeID, offerCoin, demandCoinDenom, sdk.NewDecWithPrec(11, 1), params.SwapFeeRate))
+		return err
+	}
+	deposit := func(poolID uint64, denomY string) error {
+		depositCoins := sdk.NewCoins(sdk.NewInt64Coin(DenomX, 10000), sdk.NewInt64Coin(denomY, 10000))
+		depositor := app.AddRandomTestAddr(simapp, ctx, depositCoins)
+		_, err := handler(ctx, types.NewMsgDepositWithinBatch(depositor, poolID, depositCoins))
+		return err
+	}
+
+	// halting the swaps of a pool leaves the deposits and the other pools running
+	require.NoError(t, k.ApplyCircuitBreaker(ctx, types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapWithinBatch, true)))
+	require.True(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapWithinBatch))
+	require.ErrorIs(t, swap(pool.Id, DenomY), types.ErrCircuitBreakerEnabled)
+	require.NoError(t, deposit(pool.Id, DenomY))
+	require.NoError(t, swap(otherPool.Id, "denomz"))
+
+	// a circuit breaker without a message type halts all messages of the pool
+	require.NoError(t, k.ApplyCircuitBreaker(ctx, types.NewCircuitBreaker(otherPool.Id, "", true)))
+	require.ErrorIs(t, swap(otherPool.Id, "denomz"), types.ErrCircuitBreakerEnabled)
+	require.ErrorIs(t, deposit(otherPool.Id, "denomz"), types.ErrCircuitBreakerEnabled)
+
+	// a circuit breaker of pool 0 halts the messages of the type for all pools
+	require.NoError(t, k.ApplyCircuitBreaker(ctx, types.NewCircuitBreaker(0, types.TypeMsgDepositWithinBatch, true)))
+	require.ErrorIs(t, deposit(pool.Id, DenomY), types.ErrCircuitBreakerEnabled)
+
+	// disabled circuit breakers are deleted
+	for _, cb := range []types.CircuitBreaker{
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapWithinBatch, false),
+		types.NewCircuitBreaker(otherPool.Id, "", false),
+		types.NewCircuitBreaker(0, types.TypeMsgDepositWithinBatch, false),
+	} {
+		require.NoError(t, k.ApplyCircuitBreaker(ctx, cb))
+	}
+	require.Empty(t, k.GetAllCircuitBreakers(ctx))
+	require.NoError(t, swap(pool.Id, DenomY))
+	require.NoError(t, deposit(otherPool.Id, "denomz"))
+
+	// the circuit breaker param still halts all pools and message types, and is not lifted by disabling the circuit
+	// breakers
+	params.CircuitBreakerEnabled = true
+	k.SetParams(ctx, params)
+	require.True(t, k.IsCircuitBreakerEnabled(ctx, otherPool.Id, types.TypeMsgStake))
+	require.ErrorIs(t, swap(otherPool.Id, "denomz"), types.ErrCircuitBreakerEnabled)
+	require.NoError(t, k.ApplyCircuitBreaker(ctx, types.NewCircuitBreaker(0, "", false)))
+	require.ErrorIs(t, swap(otherPool.Id, "denomz"), types.ErrCircuitBreakerEnabled)
+	params.CircuitBreakerEnabled = false
+	k.SetParams(ctx, params)
+	require.NoError(t, swap(otherPool.Id, "denomz"))
+
+	// the circuit breakers of a pool must apply to an existing pool
+	require.ErrorIs(t, k.ApplyCircuitBreaker(ctx, types.NewCircuitBreaker(10, "", true)), types.ErrPoolNotExists)
+	require.ErrorIs(t, k.ApplyCircuitBreaker(ctx, types.NewCircuitBreaker(pool.Id, types.TypeMsgWithdrawWithinBatch, true)), types.ErrBadCircuitBreaker)
+}
+
+func TestMigrateCircuitBreakerEnabled(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	params.CircuitBreakerEnabled = true
+	k.SetParams(ctx, params)
+
+	// the enabled circuit breaker param is moved to the circuit breaker of all pools and message types
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+	require.False(t, k.GetCircuitBreakerEnabled(ctx))
+	require.Equal(t, []types.CircuitBreaker{types.NewCircuitBreaker(0, "", true)}, k.GetAllCircuitBreakers(ctx))
+	require.True(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapWithinBatch))
+
+	// which is lifted by disabling the circuit breaker
+	require.NoError(t, k.ApplyCircuitBreaker(ctx, types.NewCircuitBreaker(0, "", false)))
+	require.False(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapWithinBatch))
+
+	// the disabled circuit breaker param adds no circuit breaker
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+	require.Empty(t, k.GetAllCircuitBreakers(ctx))
+}
+
+func TestCircuitBreakerHaltsSubmittedSwaps(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	pool2, err := createPool(simapp, ctx, sdk.NewInt(1000000), sdk.NewInt(1000000), DenomY, DenomA)
+	require.NoError(t, err)
+
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	offerCoins := sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)))
+	swapRequester := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+	routeRequester := app.AddRandomTestAddr(simapp, ctx, offerCoins)
+
+	ctx = ctx.WithBlockHeight(1)
+	liquidity.BeginBlocker(ctx, k)
+	_, err = k.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		swapRequester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.NewDecWithPrec(11, 1), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	_, err = k.SwapRoute(ctx, types.NewMsgSwapRoute(
+		routeRequester, []uint64{pool.Id, pool2.Id}, offerCoin, DenomA, sdk.ZeroInt(), params.SwapFeeRate))
+	require.NoError(t, err)
+
+	// the swaps are halted after the orders are submitted
+	require.NoError(t, k.ApplyCircuitBreaker(ctx, types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapWithinBatch, true)))
+	require.NoError(t, k.ApplyCircuitBreaker(ctx, types.NewCircuitBreaker(pool2.Id, types.TypeMsgSwapRoute, true)))
+	_, _, _, err = k.SimulateSwapWithinBatch(ctx, *types.NewMsgSwapWithinBatch(
+		swapRequester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.NewDecWithPrec(11, 1), params.SwapFeeRate))
+	require.ErrorIs(t, err, types.ErrCircuitBreakerEnabled)
+	liquidity.EndBlocker(ctx, k)
+
+	// the halted order is refunded instead of executed
+	require.Equal(t, offerCoins, simapp.BankKeeper.GetAllBalances(ctx, swapRequester))
+
+	// the first hop of the route is not halted, and its next hop to the halted pool is refunded in the intermediate denom
+	balances := simapp.BankKeeper.GetAllBalances(ctx, routeRequester)
+	require.True(t, balances.AmountOf(DenomX).IsZero())
+	require.True(t, balances.AmountOf(DenomY).IsPositive())
+	require.True(t, balances.AmountOf(DenomA).IsZero())
+	require.Empty(t, k.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, types.PoolBatch{PoolId: pool2.Id}))
+}
+
+func TestMsgSetCircuitBreaker(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	handler := liquidity.NewHandler(k)
+	addrs := app.AddTestAddrs(simapp, ctx, 2, sdk.NewCoins())
+
+	params := k.GetParams(ctx)
+	params.CircuitBreakerAdmins = []string{addrs[0].String()}
+	k.SetParams(ctx, params)
+
+	// only the circuit breaker admins may set the circuit breakers
+	_, err = handler(ctx, types.NewMsgSetCircuitBreaker(addrs[1], pool.Id, types.TypeMsgSwapWithinBatch, true))
+	require.ErrorIs(t, err, types.ErrNotCircuitBreakerAdmin)
+	require.False(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapWithinBatch))
+
+	_, err = handler(ctx, types.NewMsgSetCircuitBreaker(addrs[0], pool.Id, types.TypeMsgSwapWithinBatch, true))
+	require.NoError(t, err)
+	require.True(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapWithinBatch))
+	require.False(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgDepositWithinBatch))
+
+	_, err = handler(ctx, types.NewMsgSetCircuitBreaker(addrs[0], pool.Id, types.TypeMsgSwapWithinBatch, false))
+	require.NoError(t, err)
+	require.False(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapWithinBatch))
+}
+
+func TestHandleCircuitBreakerProposal(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+
+	// no circuit breaker is set if any of the pools does not exist
+	err = keeper.HandleCircuitBreakerProposal(ctx, k, types.NewCircuitBreakerProposal("title", "description", []types.CircuitBreaker{
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapWithinBatch, true),
+		types.NewCircuitBreaker(pool.Id+1, types.TypeMsgSwapWithinBatch, true),
+	}))
+	require.ErrorIs(t, err, types.ErrPoolNotExists)
+	require.Empty(t, k.GetAllCircuitBreakers(ctx))
+
+	// the proposal is routed to the handler by the liquidity router key
+	handler := liquidity.NewProposalHandler(k)
+	circuitBreakers := []types.CircuitBreaker{
+		types.NewCircuitBreaker(0, types.TypeMsgCreatePool, true),
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapWithinBatch, true),
+	}
+	require.NoError(t, handler(ctx, types.NewCircuitBreakerProposal("title", "description", circuitBreakers)))
+	require.Equal(t, circuitBreakers, k.GetAllCircuitBreakers(ctx))
+	require.True(t, k.IsCircuitBreakerEnabled(ctx, 0, types.TypeMsgCreatePool))
+
+	// the circuit breakers are exported and imported with the genesis state
+	genesis := k.ExportGenesis(ctx)
+	require.Equal(t, circuitBreakers, genesis.CircuitBreakers)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+	for _, cb := range circuitBreakers {
+		k.DeleteCircuitBreaker(ctx, cb.PoolId, cb.MsgType)
+	}
+	k.InitGenesis(ctx, *genesis)
+	require.Equal(t, circuitBreakers, k.GetAllCircuitBreakers(ctx))
+
+	require.NoError(t, handler(ctx, types.NewCircuitBreakerProposal("title", "description", []types.CircuitBreaker{
+		types.NewCircuitBreaker(0, types.TypeMsgCreatePool, false),
+	})))
+	require.Equal(t, circuitBreakers[1:], k.GetAllCircuitBreakers(ctx))
+}
+
+func TestTripPriceMoveCircuitBreaker(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	params.CircuitBreakerPriceMoveThreshold = sdk.NewDecWithPrec(5, 1)
+	params.CircuitBreakerPriceMoveLookback = 2
+	k.SetParams(ctx, params)
+
+	recordPrice := func(batchIndex uint64, price sdk.Dec) bool {
+		k.SetPoolBatchResult(ctx, types.PoolBatchResult{
+			PoolId:      pool.Id,
+			BatchIndex:  batchIndex,
+			SwapResults: []types.PairSwapResult{{DenomX: DenomX, DenomY: DenomY, SwapPrice: price}},
+		})
+		return k.TripPriceMoveCircuitBreaker(ctx, types.PoolBatch{PoolId: pool.Id, Index: batchIndex}, params)
+	}
+
+	// the price moves up to the threshold from the reference prices within the lookback are allowed
+	require.False(t, recordPrice(1, sdk.NewDec(1)))
+	require.False(t, recordPrice(2, sdk.NewDecWithPrec(12, 1)))
+	require.False(t, recordPrice(3, sdk.NewDecWithPrec(14, 1)))
+	require.False(t, recordPrice(4, sdk.NewDecWithPrec(16, 1)))
+	require.Empty(t, k.GetAllCircuitBreakers(ctx))
+
+	// the price move beyond the threshold halts the swaps of the pool
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.True(t, recordPrice(5, sdk.NewDecWithPrec(28, 1)))
+	require.Equal(t, []types.CircuitBreaker{
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapRoute, true),
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapWithinBatch, true),
+	}, k.GetAllCircuitBreakers(ctx))
+	require.True(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapRoute))
+	require.False(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgDepositWithinBatch))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeCircuitBreakerTripped, events[0].Type)
+	require.Equal(t, "price_move", string(events[0].Attributes[2].Key))
+	require.Equal(t, sdk.OneDec().String(), string(events[0].Attributes[2].Value))
+
+	// the halted swaps are not tripped again until the circuit breakers are disabled
+	require.False(t, recordPrice(6, sdk.NewDec(14)))
+	require.NoError(t, liquidity.NewProposalHandler(k)(ctx, types.NewCircuitBreakerProposal("title", "description", []types.CircuitBreaker{
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapWithinBatch, false),
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapRoute, false),
+	})))
+	require.False(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapWithinBatch))
+	require.True(t, recordPrice(7, sdk.NewDec(28)))
+
+	// the lookback over the batch result retention is limited to the retained previous batch results
+	require.NoError(t, liquidity.NewProposalHandler(k)(ctx, types.NewCircuitBreakerProposal("title", "description", []types.CircuitBreaker{
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapWithinBatch, false),
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapRoute, false),
+	})))
+	params.BatchResultRetention = 2
+	params.CircuitBreakerPriceMoveLookback = 3
+	require.False(t, recordPrice(8, sdk.NewDec(15)))
+	params.BatchResultRetention = types.DefaultBatchResultRetention
+	require.True(t, recordPrice(9, sdk.NewDec(7)))
+
+	// the clearing prices of the executed batches trip the circuit breaker
+	require.NoError(t, liquidity.NewProposalHandler(k)(ctx, types.NewCircuitBreakerProposal("title", "description", []types.CircuitBreaker{
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapWithinBatch, false),
+		types.NewCircuitBreaker(pool.Id, types.TypeMsgSwapRoute, false),
+	})))
+	for batchIndex := uint64(1); batchIndex <= 9; batchIndex++ {
+		k.DeletePoolBatchResult(ctx, pool.Id, batchIndex)
+	}
+	liquidity.BeginBlocker(ctx, k)
+	batch, found := k.GetPoolBatch(ctx, pool.Id)
+	require.True(t, found)
+	k.SetPoolBatchResult(ctx, types.PoolBatchResult{
+		PoolId:      pool.Id,
+		BatchIndex:  batch.Index - 1,
+		SwapResults: []types.PairSwapResult{{DenomX: DenomX, DenomY: DenomY, SwapPrice: sdk.NewDec(3)}},
+	})
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	requester := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+	_, err = k.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		requester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.NewDecWithPrec(9, 1), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, k)
+	results := k.GetPoolBatchResults(ctx, pool.Id)
+	require.Len(t, results, 2)
+	require.Equal(t, batch.Index, results[1].BatchIndex)
+	require.True(t, k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapWithinBatch))
+}
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// DepositToRange deposits the reserve coins to the price range of the concentrated liquidity pool, creating a position
+// with the liquidity the deposit coins provide at the current pool price. Only the deposit coins needed for the
+// liquidity are accepted from the depositor.
+func (k Keeper) DepositToRange(ctx sdk.Context, msg *types.MsgDepositToRange) (types.Position, sdk.Coins, error) {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.Position{}, nil, types.ErrPoolNotExists
+	}
+	if pool.TypeId != types.ConcentratedPoolTypeID {
+		return types.Position{}, nil, sdkerrors.Wrapf(types.ErrPoolTypeNotExists, "pool %d is not a concentrated liquidity pool", pool.Id)
+	}
+	if err := types.ValidatePriceRange(msg.LowerPrice, msg.UpperPrice); err != nil {
+		return types.Position{}, nil, err
+	}
+
+	depositCoins := msg.DepositCoins.Sort()
+	if depositCoins.Len() != len(pool.ReserveCoinDenoms) {
+		return types.Position{}, nil, types.ErrNumOfReserveCoin
+	}
+	for i, coin := range depositCoins {
+		if coin.Denom != pool.ReserveCoinDenoms[i] {
+			return types.Position{}, nil, types.ErrNotMatchedReserveCoin
+		}
+	}
+
+	params := k.GetParams(ctx)
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	if err := types.ValidateReserveCoinLimit(params.MaxReserveCoinAmount, reserveCoins.Add(depositCoins...)); err != nil {
+		return types.Position{}, nil, err
+	}
+
+	price := k.GetPoolPrice(ctx, pool.Id)
+	depositX, depositY := depositCoins[0].Amount, depositCoins[1].Amount
+	liquidity := types.LiquidityForAmounts(depositX.ToDec(), depositY.ToDec(), msg.LowerPrice, msg.UpperPrice, price)
+	if !liquidity.IsPositive() {
+		return types.Position{}, nil, types.ErrNoLiquidity
+	}
+
+	// the amounts of the liquidity are ceiled, so the reserves always cover the amounts of the positions
+	x, y := types.PositionAmounts(liquidity, msg.LowerPrice, msg.UpperPrice, price)
+	acceptedCoins := sdk.NewCoins(
+		sdk.NewCoin(depositCoins[0].Denom, sdk.MinInt(x.Ceil().TruncateInt(), depositX)),
+		sdk.NewCoin(depositCoins[1].Denom, sdk.MinInt(y.Ceil().TruncateInt(), depositY)),
+	)
+	if acceptedCoins.IsZero() {
+		return types.Position{}, nil, types.ErrNoLiquidity
+	}
+
+	depositor := msg.GetDepositor()
+	if err := k.bankKeeper.SendCoins(ctx, depositor, pool.GetReserveAccount(), acceptedCoins); err != nil {
+		return types.Position{}, nil, err
+	}
+
+	// the position accrues only the fees after the snapshot of the fee growth inside its price range
+	feeGrowthGlobal := k.GetFeeGrowthGlobal(ctx, pool.Id)
+	lowerTick := k.getPriceTick(ctx, pool.Id, msg.LowerPrice, price, feeGrowthGlobal)
+	upperTick := k.getPriceTick(ctx, pool.Id, msg.UpperPrice, price, feeGrowthGlobal)
+	k.SetPriceTick(ctx, lowerTick)
+	k.SetPriceTick(ctx, upperTick)
+
+	position := types.Position{
+		Id:                  k.GetNextPositionIDWithUpdate(ctx),
+		PoolId:              pool.Id,
+		OwnerAddress:        msg.DepositorAddress,
+		LowerPrice:          msg.LowerPrice,
+		UpperPrice:          msg.UpperPrice,
+		Liquidity:           liquidity,
+		FeeGrowthInsideLast: types.FeeGrowthInside(feeGrowthGlobal, lowerTick, upperTick, price),
+	}
+	k.SetPosition(ctx, position)
+
+	logger := k.Logger(ctx)
+	logger.Debug(
+		"deposit coins to the price range",
+		"msg", msg,
+		"pool", pool,
+		"position", position,
+		"acceptedCoins", acceptedCoins,
+		"poolPrice", price,
+	)
+
+	return position, acceptedCoins, nil
+}
+
+// WithdrawFromRange withdraws the position from the concentrated liquidity pool, paying out the reserve coins of the
+// position at the current pool price and deleting the position.
+func (k Keeper) WithdrawFromRange(ctx sdk.Context, msg *types.MsgWithdrawFromRange) (types.Position, sdk.Coins, error) {
+	position, found := k.GetPosition(ctx, msg.PositionId)
+	if !found {
+		return types.Position{}, nil, types.ErrPositionNotExists
+	}
+	if position.OwnerAddress != msg.OwnerAddress {
+		return types.Position{}, nil, types.ErrNotPositionOwner
+	}
+	pool, found := k.GetPool(ctx, position.PoolId)
+	if !found {
+		return types.Position{}, nil, types.ErrPoolNotExists
+	}
+
+	withdrawCoins := k.PositionPayout(ctx, pool, position)
+	k.DeletePosition(ctx, position)
+	k.deleteUnusedPriceTicks(ctx, position)
+
+	if !withdrawCoins.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetReserveAccount(), position.GetOwner(), withdrawCoins); err != nil {
+			return types.Position{}, nil, err
+		}
+	}
+
+	logger := k.Logger(ctx)
+	logger.Debug(
+		"withdraw coins from the price range",
+		"msg", msg,
+		"pool", pool,
+		"position", position,
+		"withdrawCoins", withdrawCoins,
+	)
+
+	return position, withdrawCoins, nil
+}
+
+// PositionPayout returns the reserve coins paid out for the position of the concentrated liquidity pool, which are the
+// amounts of the position at the current pool price and the swap fees accrued inside its price range since the
+// position was created. The last position of the pool takes all reserve coins.
+func (k Keeper) PositionPayout(ctx sdk.Context, pool types.Pool, position types.Position) sdk.Coins {
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	if len(k.GetPositionsByPool(ctx, pool.Id)) <= 1 {
+		return reserveCoins
+	}
+
+	price := k.GetPoolPrice(ctx, pool.Id)
+	x, y := types.PositionAmounts(position.Liquidity, position.LowerPrice, position.UpperPrice, price)
+	feeX, feeY := position.Fees(k.GetFeeGrowthInside(ctx, position, k.GetFeeGrowthGlobal(ctx, pool.Id), price))
+	payout := func(reserveCoin sdk.Coin, amt sdk.Dec) sdk.Coin {
+		return sdk.NewCoin(reserveCoin.Denom, sdk.MinInt(amt.TruncateInt(), reserveCoin.Amount))
+	}
+	return sdk.NewCoins(payout(reserveCoins[0], x.Add(feeX)), payout(reserveCoins[1], y.Add(feeY)))
+}
+
+// AccrueFeeGrowth accrues the swap fees of the batch to the fee growth of the concentrated liquidity pool as the pool
+// price moves to the given price, crossing the price ticks in between. The swap fees are the reserves over the amounts
+// and the unpaid fees of the positions, and are accrued per unit of the liquidity active at the given price.
+func (k Keeper) AccrueFeeGrowth(ctx sdk.Context, pool types.Pool, poolPrice sdk.Dec) {
+	prevPrice := k.GetPoolPrice(ctx, pool.Id)
+	feeGrowthGlobal := k.GetFeeGrowthGlobal(ctx, pool.Id)
+	k.IteratePriceTicksByPool(ctx, pool.Id, func(tick types.PriceTick) bool {
+		if prevPrice.GTE(tick.Price) != poolPrice.GTE(tick.Price) {
+			k.SetPriceTick(ctx, tick.Cross(feeGrowthGlobal))
+		}
+		return false
+	})
+
+	positions := k.GetPositionsByPool(ctx, pool.Id)
+	x, y := types.NewConcentratedCurve(poolPrice, positions).Amounts(poolPrice)
+	activeLiquidity := sdk.ZeroDec()
+	for _, position := range positions {
+		feeX, feeY := position.Fees(k.GetFeeGrowthInside(ctx, position, feeGrowthGlobal, poolPrice))
+		x, y = x.Add(feeX), y.Add(feeY)
+		if position.IsActive(poolPrice) {
+			activeLiquidity = activeLiquidity.Add(position.Liquidity)
+		}
+	}
+	// the fees are kept in the reserves until the pool price is within a price range
+	if !activeLiquidity.IsPositive() {
+		return
+	}
+
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	feeX := sdk.MaxDec(reserveCoins[0].Amount.ToDec().Sub(x), sdk.ZeroDec())
+	feeY := sdk.MaxDec(reserveCoins[1].Amount.ToDec().Sub(y), sdk.ZeroDec())
+	k.SetFeeGrowthGlobal(ctx, pool.Id, feeGrowthGlobal.Add(types.FeeGrowth{
+		X: feeX.QuoTruncate(activeLiquidity),
+		Y: feeY.QuoTruncate(activeLiquidity),
+	}))
+}
+
+// GetFeeGrowthInside returns the fee growth inside the price range of the position at the pool price.
+func (k Keeper) GetFeeGrowthInside(ctx sdk.Context, position types.Position, feeGrowthGlobal types.FeeGrowth, poolPrice sdk.Dec) types.FeeGrowth {
+	lowerTick := k.getPriceTick(ctx, position.PoolId, position.LowerPrice, poolPrice, feeGrowthGlobal)
+	upperTick := k.getPriceTick(ctx, position.PoolId, position.UpperPrice, poolPrice, feeGrowthGlobal)
+	return types.FeeGrowthInside(feeGrowthGlobal, lowerTick, upperTick, poolPrice)
+}
+
+// getPriceTick returns the price tick of the pool at the price, or a new one if not found.
+func (k Keeper) getPriceTick(ctx sdk.Context, poolID uint64, price, poolPrice sdk.Dec, feeGrowthGlobal types.FeeGrowth) types.PriceTick {
+	tick, found := k.GetPriceTick(ctx, poolID, price)
+	if !found {
+		return types.NewPriceTick(poolID, price, poolPrice, feeGrowthGlobal)
+	}
+	return tick
+}
+
+// deleteUnusedPriceTicks deletes the price ticks of the deleted position which no other position of the pool is
+// bounded by.
+func (k Keeper) deleteUnusedPriceTicks(ctx sdk.Context, deleted types.Position) {
+	lowerUsed, upperUsed := false, false
+	k.IteratePositionsByPool(ctx, deleted.PoolId, func(position types.Position) bool {
+		for _, price := range []sdk.Dec{position.LowerPrice, position.UpperPrice} {
+			lowerUsed = lowerUsed || price.Equal(deleted.LowerPrice)
+			upperUsed = upperUsed || price.Equal(deleted.UpperPrice)
+		}
+		return lowerUsed && upperUsed
+	})
+	if !lowerUsed {
+		k.DeletePriceTick(ctx, types.PriceTick{PoolId: deleted.PoolId, Price: deleted.LowerPrice})
+	}
+	if !upperUsed {
+		k.DeletePriceTick(ctx, types.PriceTick{PoolId: deleted.PoolId, Price: deleted.UpperPrice})
+	}
+}
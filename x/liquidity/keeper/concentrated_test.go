@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/app"
+	"github.com/tendermint/liquidity/x/liquidity"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestConcentratedPool(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	reserveCoins := sdk.NewCoins(sdk.NewCoin("denomx", sdk.NewInt(10_000_000)), sdk.NewCoin("denomy", sdk.NewInt(10_000_000)))
+	creator := app.AddRandomTestAddr(simapp, ctx, reserveCoins.Add(params.PoolCreationFee...))
+
+	// the deposit coins of the pool creator are provided as a full range position without the pool coin
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.ConcentratedPoolTypeID, reserveCoins))
+	require.NoError(t, err)
+	require.NoError(t, simapp.LiquidityKeeper.ValidatePool(ctx, &pool))
+	require.True(t, simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool).IsZero())
+	require.Equal(t, sdk.OneDec(), simapp.LiquidityKeeper.GetPoolPrice(ctx, pool.Id))
+	positions := simapp.LiquidityKeeper.GetPositionsByPool(ctx, pool.Id)
+	require.Len(t, positions, 1)
+	require.Equal(t, creator.String(), positions[0].OwnerAddress)
+	require.Equal(t, types.MinPositionPrice, positions[0].LowerPrice)
+	require.Equal(t, types.MaxPositionPrice, positions[0].UpperPrice)
+	require.False(t, simapp.LiquidityKeeper.IsDepletedPool(ctx, pool))
+
+	// the deposits and the withdrawals within batch are not supported
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(creator, pool.Id, reserveCoins))
+	require.ErrorIs(t, err, types.ErrPoolCoinNotSupported)
+
+	// only the deposit coins needed for the liquidity within the price range are accepted
+	depositCoins := sdk.NewCoins(sdk.NewCoin("denomx", sdk.NewInt(1_000_000)), sdk.NewCoin("denomy", sdk.NewInt(2_000_000)))
+	depositor := app.AddRandomTestAddr(simapp, ctx, depositCoins)
+	lowerPrice, upperPrice := sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("1.1")
+	position, acceptedCoins, err := simapp.LiquidityKeeper.DepositToRange(ctx, types.NewMsgDepositToRange(depositor, pool.Id, lowerPrice, upperPrice, depositCoins))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), position.Id)
+	require.Equal(t, depositCoins.AmountOf("denomx"), acceptedCoins.AmountOf("denomx"))
+	require.True(t, acceptedCoins.AmountOf("denomy").LT(depositCoins.AmountOf("denomy")))
+	require.Equal(t, depositCoins.Sub(acceptedCoins), simapp.BankKeeper.GetAllBalances(ctx, depositor))
+	require.True(t, position.Liquidity.GT(positions[0].Liquidity))
+
+	_, _, err = simapp.LiquidityKeeper.DepositToRange(ctx, types.NewMsgDepositToRange(depositor, pool.Id, sdk.OneDec(), sdk.NewDec(2),
+		sdk.NewCoins(sdk.NewCoin("denomx", sdk.NewInt(1_000_000)))))
+	require.ErrorIs(t, err, types.ErrNumOfReserveCoin)
+
+	// the swap is matched with the liquidity active at the swap price
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	offerCoin := sdk.NewCoin("denomx", sdk.NewInt(500_000))
+	swapRequester := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		swapRequester, pool.Id, types.DefaultSwapTypeID, offerCoin, "denomy", sdk.MustNewDecFromStr("1.05"), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	demandAmt := simapp.BankKeeper.GetBalance(ctx, swapRequester, "denomy").Amount
+	require.True(t, demandAmt.GT(sdk.NewInt(480_000)), demandAmt.String())
+	price := simapp.LiquidityKeeper.GetPoolPrice(ctx, pool.Id)
+	require.True(t, price.GT(sdk.OneDec()))
+	require.True(t, price.LTE(sdk.MustNewDecFromStr("1.05")))
+
+	// the reserves always cover the amounts of the positions at the pool price
+	positions = simapp.LiquidityKeeper.GetPositionsByPool(ctx, pool.Id)
+	x, y := types.NewConcentratedCurve(price, positions).Amounts(price)
+	reserveCoins = simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	require.True(t, x.LTE(reserveCoins.AmountOf("denomx").ToDec()))
+	require.True(t, y.LTE(reserveCoins.AmountOf("denomy").ToDec()))
+
+	// the positions and the pool price are exported and imported with the pool record
+	genState := simapp.LiquidityKeeper.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(*genState))
+	require.Len(t, genState.PoolRecords[0].Positions, 2)
+	require.Equal(t, price, genState.PoolRecords[0].PoolPrice)
+	require.True(t, genState.PoolRecords[0].FeeGrowthGlobal.X.IsPositive())
+	require.Len(t, genState.PoolRecords[0].PriceTicks, 4)
+	require.NoError(t, simapp.LiquidityKeeper.ValidateGenesis(ctx, *genState))
+
+	// only the owner withdraws the position
+	_, _, err = simapp.LiquidityKeeper.WithdrawFromRange(ctx, types.NewMsgWithdrawFromRange(creator, position.Id))
+	require.ErrorIs(t, err, types.ErrNotPositionOwner)
+	_, withdrawCoins, err := simapp.LiquidityKeeper.WithdrawFromRange(ctx, types.NewMsgWithdrawFromRange(depositor, position.Id))
+	require.NoError(t, err)
+	require.True(t, withdrawCoins.AmountOf("denomx").GT(acceptedCoins.AmountOf("denomx")))
+	require.True(t, withdrawCoins.AmountOf("denomy").LT(acceptedCoins.AmountOf("denomy")))
+	_, found := simapp.LiquidityKeeper.GetPosition(ctx, position.Id)
+	require.False(t, found)
+	require.Len(t, simapp.LiquidityKeeper.GetPriceTicksByPool(ctx, pool.Id), 2)
+
+	// the last position takes all reserve coins and the pool is depleted
+	reserveCoins = simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	_, withdrawCoins, err = simapp.LiquidityKeeper.WithdrawFromRange(ctx, types.NewMsgWithdrawFromRange(creator, positions[0].Id))
+	require.NoError(t, err)
+	require.Equal(t, reserveCoins, withdrawCoins)
+	require.True(t, simapp.LiquidityKeeper.GetReserveCoins(ctx, pool).IsZero())
+	require.True(t, simapp.LiquidityKeeper.IsDepletedPool(ctx, pool))
+}
+
+func TestConcentratedPoolFeeGrowth(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	reserveCoins := sdk.NewCoins(sdk.NewCoin("denomx", sdk.NewInt(10_000_000)), sdk.NewCoin("denomy", sdk.NewInt(10_000_000)))
+	creator := app.AddRandomTestAddr(simapp, ctx, reserveCoins.Add(params.PoolCreationFee...))
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.ConcentratedPoolTypeID, reserveCoins))
+	require.NoError(t, err)
+	creatorPosition := simapp.LiquidityKeeper.GetPositionsByPool(ctx, pool.Id)[0]
+
+	swap := func(offerCoin sdk.Coin, demandCoinDenom string, orderPrice sdk.Dec) {
+		liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+		swapRequester := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+		_, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+			swapRequester, pool.Id, types.DefaultSwapTypeID, offerCoin, demandCoinDenom, orderPrice, params.SwapFeeRate), 0)
+		require.NoError(t, err)
+		liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	}
+	fees := func(position types.Position) (sdk.Dec, sdk.Dec) {
+		price := simapp.LiquidityKeeper.GetPoolPrice(ctx, pool.Id)
+		feeGrowthGlobal := simapp.LiquidityKeeper.GetFeeGrowthGlobal(ctx, pool.Id)
+		return position.Fees(simapp.LiquidityKeeper.GetFeeGrowthInside(ctx, position, feeGrowthGlobal, price))
+	}
+
+	// the swap fees are accrued to the position active at the pool price
+	swap(sdk.NewCoin("denomx", sdk.NewInt(500_000)), "denomy", sdk.MustNewDecFromStr("1.05"))
+	creatorFeeX, _ := fees(creatorPosition)
+	require.True(t, creatorFeeX.IsPositive())
+
+	// a narrow position created after the swap takes none of the fees accrued before it
+	price := simapp.LiquidityKeeper.GetPoolPrice(ctx, pool.Id)
+	depositCoins := sdk.NewCoins(sdk.NewCoin("denomx", sdk.NewInt(5_000_000)), sdk.NewCoin("denomy", sdk.NewInt(5_000_000)))
+	depositor := app.AddRandomTestAddr(simapp, ctx, depositCoins)
+	position, acceptedCoins, err := simapp.LiquidityKeeper.DepositToRange(ctx, types.NewMsgDepositToRange(
+		depositor, pool.Id, price.Mul(sdk.MustNewDecFromStr("0.999")), price.Mul(sdk.MustNewDecFromStr("1.001")), depositCoins))
+	require.NoError(t, err)
+	require.True(t, position.Liquidity.GT(creatorPosition.Liquidity))
+	feeX, feeY := fees(position)
+	require.True(t, feeX.IsZero())
+	require.True(t, feeY.IsZero())
+
+	_, withdrawCoins, err := simapp.LiquidityKeeper.WithdrawFromRange(ctx, types.NewMsgWithdrawFromRange(depositor, position.Id))
+	require.NoError(t, err)
+	require.True(t, withdrawCoins.IsAllLTE(acceptedCoins), withdrawCoins.String())
+	creatorFeeXAfter, _ := fees(creatorPosition)
+	require.Equal(t, creatorFeeX, creatorFeeXAfter)
+
+	// a position created before the swap accrues the fees of the swap within its price range
+	position, _, err = simapp.LiquidityKeeper.DepositToRange(ctx, types.NewMsgDepositToRange(
+		depositor, pool.Id, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("1.1"), simapp.BankKeeper.GetAllBalances(ctx, depositor)))
+	require.NoError(t, err)
+	_, creatorFeeYBefore := fees(creatorPosition)
+	swap(sdk.NewCoin("denomy", sdk.NewInt(100_000)), "denomx", sdk.MustNewDecFromStr("0.95"))
+	_, feeY = fees(position)
+	_, creatorFeeY := fees(creatorPosition)
+	require.True(t, feeY.IsPositive())
+	require.True(t, feeY.GT(creatorFeeY.Sub(creatorFeeYBefore)))
+
+	// the fees of the positions are covered by the reserves over the amounts of the positions
+	price = simapp.LiquidityKeeper.GetPoolPrice(ctx, pool.Id)
+	x, y := types.NewConcentratedCurve(price, simapp.LiquidityKeeper.GetPositionsByPool(ctx, pool.Id)).Amounts(price)
+	reserveCoins = simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	require.True(t, x.Add(creatorFeeX).LTE(reserveCoins.AmountOf("denomx").ToDec()))
+	require.True(t, y.Add(feeY).Add(creatorFeeY).LTE(reserveCoins.AmountOf("denomy").ToDec()))
+}
@@ -17,6 +17,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, record := range genState.PoolRecords {
 		k.SetPoolRecord(ctx, record)
 	}
+
+	for _, circuitBreaker := range genState.CircuitBreakers {
+		k.SetCircuitBreaker(ctx, circuitBreaker)
+	}
 }
 
 // ExportGenesis returns the liquidity module's genesis state.
@@ -38,7 +42,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		poolRecords = []types.PoolRecord{}
 	}
 
-	return types.NewGenesisState(params, poolRecords)
+	circuitBreakers := k.GetAllCircuitBreakers(ctx)
+	if len(circuitBreakers) == 0 {
+		circuitBreakers = []types.CircuitBreaker{}
+	}
+
+	return types.NewGenesisState(params, poolRecords, circuitBreakers)
 }
 
 // ValidateGenesis validates the liquidity module's genesis state.
@@ -50,12 +59,14 @@ func (k Keeper) ValidateGenesis(ctx sdk.Context, genState types.GenesisState) er
 	cc, _ := ctx.CacheContext()
 	k.SetParams(cc, genState.Params)
 
+	poolIDs := make(map[uint64]bool)
 	for _, record := range genState.PoolRecords {
 		record = k.SetPoolRecord(cc, record)
 		if err := k.ValidatePoolRecord(cc, record); err != nil {
 			return err
 		}
+		poolIDs[record.Pool.Id] = true
 	}
 
-	return nil
+	return types.ValidateGenesisCircuitBreakers(genState.CircuitBreakers, poolIDs)
 }
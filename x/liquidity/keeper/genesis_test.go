@@ -50,7 +50,7 @@ func TestGenesisState(t *testing.T) {
 	params = simapp.LiquidityKeeper.GetParams(ctx)
 	params.SwapFeeRate = sdk.NewDec(-1)
 	negativeSwapFeeErrMsg := fmt.Sprintf("swap fee rate must not be negative: %s", params.SwapFeeRate)
-	genesisState := types.NewGenesisState(params, genesis.PoolRecords)
+	genesisState := types.NewGenesisState(params, genesis.PoolRecords, genesis.CircuitBreakers)
 	require.EqualError(t, types.ValidateGenesis(*genesisState), negativeSwapFeeErrMsg)
 
 	// define test denom X, Y for Liquidity Pool
@@ -79,16 +79,22 @@ func TestGenesisState(t *testing.T) {
 	require.Equal(t, sdk.NewInt(20000000), balanceXRefunded.Amount)
 	require.Equal(t, sdk.ZeroInt(), balanceYRefunded.Amount)
 
+	reserveCoinsAfterDeposit := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+
 	// next block
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
 
 	// validate pool records
 	newGenesis := simapp.LiquidityKeeper.ExportGenesis(ctx)
-	genesisState = types.NewGenesisState(paramsDefault, newGenesis.PoolRecords)
+	genesisState = types.NewGenesisState(paramsDefault, newGenesis.PoolRecords, newGenesis.CircuitBreakers)
 	require.NoError(t, types.ValidateGenesis(*genesisState))
+	require.Len(t, newGenesis.PoolRecords[0].BatchResults, 1)
+	require.Equal(t, reserveCoinsAfterDeposit, newGenesis.PoolRecords[0].BatchResults[0].ReserveCoins)
+	require.Len(t, newGenesis.PoolRecords[0].PriceAccumulators, 1)
+	require.Equal(t, uint64(1), newGenesis.PoolRecords[0].PriceAccumulators[0].BatchIndex)
 
-	pool.TypeId = 5
+	pool.TypeId = 6
 	simapp.LiquidityKeeper.SetPool(ctx, pool)
 	newGenesisBrokenPool := simapp.LiquidityKeeper.ExportGenesis(ctx)
 	require.NoError(t, types.ValidateGenesis(*newGenesisBrokenPool))
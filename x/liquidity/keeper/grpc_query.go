@@ -5,6 +5,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -75,6 +77,47 @@ func (k Querier) LiquidityPoolByReserveAcc(c context.Context, req *types.QueryLi
 	return k.MakeQueryLiquidityPoolResponse(pool)
 }
 
+// LiquidityPoolByDenoms queries a liquidity pool with the given pair of reserve coin denoms.
+func (k Querier) LiquidityPoolByDenoms(c context.Context, req *types.QueryLiquidityPoolByDenomsRequest) (*types.QueryLiquidityPoolResponse, error) {
+	if req == nil || req.DenomA == "" || req.DenomB == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if req.DenomA == req.DenomB {
+		return nil, status.Errorf(codes.InvalidArgument, "the reserve coin denoms must be different")
+	}
+	denomA, denomB := types.AlphabeticalDenomPair(req.DenomA, req.DenomB)
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pools []types.Pool
+	k.IteratePoolsByDenomPair(ctx, denomA, denomB, func(pool types.Pool) bool {
+		if req.TypeId == 0 || pool.TypeId == req.TypeId {
+			pools = append(pools, pool)
+		}
+		return false
+	})
+
+	switch len(pools) {
+	case 0:
+		return nil, status.Errorf(codes.NotFound, "the liquidity pool with the reserve coin denoms %s and %s doesn't exist", denomA, denomB)
+	case 1:
+		return k.MakeQueryLiquidityPoolResponse(pools[0])
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "%d liquidity pools have the reserve coin denoms %s and %s, type_id must be given", len(pools), denomA, denomB)
+	}
+}
+
+// LiquidityPoolsByDenom queries all liquidity pools having the given reserve coin denom.
+func (k Querier) LiquidityPoolsByDenom(c context.Context, req *types.QueryLiquidityPoolsByDenomRequest) (*types.QueryLiquidityPoolsResponse, error) {
+	if req == nil || req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return k.paginatePools(ctx, req.Denom, req.TypeId, req.Pagination)
+}
+
 // LiquidityPoolBatch queries a liquidity pool batch with the given pool id.
 func (k Querier) LiquidityPoolBatch(c context.Context, req *types.QueryLiquidityPoolBatchRequest) (*types.QueryLiquidityPoolBatchResponse, error) {
 	empty := &types.QueryLiquidityPoolBatchRequest{}
@@ -95,21 +138,50 @@ func (k Querier) LiquidityPoolBatch(c context.Context, req *types.QueryLiquidity
 }
 
 // Pools queries all liquidity pools currently existed with each liquidity pool with batch and metadata.
+// The pools can be filtered by the pool type id and by a reserve coin denom.
 func (k Querier) LiquidityPools(c context.Context, req *types.QueryLiquidityPoolsRequest) (*types.QueryLiquidityPoolsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	return k.paginatePools(ctx, req.Denom, req.TypeId, req.Pagination)
+}
+
+// paginatePools returns the page of the pools having the reserve coin denom, or all pools if the denom is empty,
+// of the pool type, or all types if the type id is 0.
+func (k Querier) paginatePools(ctx sdk.Context, denom string, typeID uint32, pageReq *query.PageRequest) (*types.QueryLiquidityPoolsResponse, error) {
 	store := ctx.KVStore(k.storeKey)
-	poolStore := prefix.NewStore(store, types.PoolKeyPrefix)
+
+	var poolStore prefix.Store
+	if denom == "" {
+		poolStore = prefix.NewStore(store, types.PoolKeyPrefix)
+	} else {
+		poolStore = prefix.NewStore(store, types.GetPoolsByDenomPrefix(denom))
+	}
 
 	var pools types.Pools
 
-	pageRes, err := query.Paginate(poolStore, req.Pagination, func(key []byte, value []byte) error {
-		pool, err := types.UnmarshalPool(k.cdc, value)
-		if err != nil {
-			return err
+	pageRes, err := query.FilteredPaginate(poolStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var pool types.Pool
+		if denom == "" {
+			var err error
+			pool, err = types.UnmarshalPool(k.cdc, value)
+			if err != nil {
+				return false, err
+			}
+		} else {
+			poolID := types.ParsePoolIDFromDenomIndexKey(key)
+			var found bool
+			pool, found = k.GetPool(ctx, poolID)
+			if !found {
+				return false, fmt.Errorf("pool %d indexed by denom %s not found", poolID, denom)
+			}
 		}
-		pools = append(pools, pool)
-		return nil
+		if typeID != 0 && pool.TypeId != typeID {
+			return false, nil
+		}
+		if accumulate {
+			pools = append(pools, pool)
+		}
+		return true, nil
 	})
 
 	if err != nil {
@@ -301,6 +373,676 @@ func (k Querier) PoolBatchWithdrawMsgs(c context.Context, req *types.QueryPoolBa
 	}, nil
 }
 
+// LiquidityPoolPositions queries all positions of the concentrated liquidity pool.
+func (k Querier) LiquidityPoolPositions(c context.Context, req *types.QueryLiquidityPoolPositionsRequest) (*types.QueryLiquidityPoolPositionsResponse, error) {
+	empty := &types.QueryLiquidityPoolPositionsRequest{}
+	if req == nil || *req == *empty {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	positionStore := prefix.NewStore(store, types.GetPositionsByPoolPrefix(req.PoolId))
+
+	var positions []types.Position
+
+	pageRes, err := query.Paginate(positionStore, req.Pagination, func(key []byte, _ []byte) error {
+		position, found := k.GetPosition(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return types.ErrPositionNotExists
+		}
+
+		positions = append(positions, position)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLiquidityPoolPositionsResponse{
+		Positions:  positions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SimulateSwap queries the expected outcome of the swap order matched with the swap orders in the current batch of the pool.
+func (k Querier) SimulateSwap(c context.Context, req *types.QuerySimulateSwapRequest) (*types.QuerySimulateSwapResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	offerCoin, err := sdk.ParseCoinNormalized(req.OfferCoin)
+	if err != nil || !offerCoin.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid offer coin %s", req.OfferCoin)
+	}
+	if err := sdk.ValidateDenom(req.DemandCoinDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid demand coin denom %s", req.DemandCoinDenom)
+	}
+	orderPrice, err := sdk.NewDecFromStr(req.OrderPrice)
+	if err != nil || !orderPrice.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order price %s", req.OrderPrice)
+	}
+	demandCoinAmt := sdk.ZeroInt()
+	if req.DemandCoinAmount != "" {
+		var ok bool
+		if demandCoinAmt, ok = sdk.NewIntFromString(req.DemandCoinAmount); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid demand coin amount %s", req.DemandCoinAmount)
+		}
+	}
+	switch req.SwapTypeId {
+	case types.DefaultSwapTypeID:
+	case types.ExactOutputSwapTypeID:
+		if !demandCoinAmt.IsPositive() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid demand coin amount %s", req.DemandCoinAmount)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "swap type %d doesn't exist", req.SwapTypeId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPool(ctx, req.PoolId); !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	result, match, matched, err := k.SimulateSwapWithinBatch(ctx, types.MsgSwapWithinBatch{
+		PoolId:           req.PoolId,
+		SwapTypeId:       req.SwapTypeId,
+		OfferCoin:        offerCoin,
+		DemandCoinDenom:  req.DemandCoinDenom,
+		OrderPrice:       orderPrice,
+		DemandCoinAmount: demandCoinAmt,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &types.QuerySimulateSwapResponse{
+		SwapPrice:           result.SwapPrice,
+		PriceDirection:      result.PriceDirection.String(),
+		Matched:             matched,
+		TransactedCoin:      sdk.NewCoin(offerCoin.Denom, sdk.ZeroInt()),
+		OfferCoinFee:        sdk.NewCoin(offerCoin.Denom, sdk.ZeroInt()),
+		ExchangedDemandCoin: sdk.NewCoin(req.DemandCoinDenom, sdk.ZeroInt()),
+		ExchangedCoinFee:    sdk.NewCoin(req.DemandCoinDenom, sdk.ZeroInt()),
+	}
+	if res.SwapPrice.IsNil() {
+		res.SwapPrice = sdk.ZeroDec()
+	}
+	if matched {
+		receiveAmt := match.ExchangedDemandCoinAmt.Sub(match.ExchangedCoinFeeAmt).TruncateInt()
+		if req.SwapTypeId == types.ExactOutputSwapTypeID && receiveAmt.GTE(demandCoinAmt) {
+			receiveAmt = demandCoinAmt
+		}
+		res.TransactedCoin.Amount = match.TransactedCoinAmt.TruncateInt()
+		res.OfferCoinFee.Amount = match.OfferCoinFeeAmt.TruncateInt()
+		res.ExchangedDemandCoin.Amount = receiveAmt
+		res.ExchangedCoinFee.Amount = match.ExchangedCoinFeeAmt.TruncateInt()
+	}
+
+	return res, nil
+}
+
+// EstimateDeposit queries the expected outcome of depositing the coins to the liquidity pool at the current reserves.
+func (k Querier) EstimateDeposit(c context.Context, req *types.QueryEstimateDepositRequest) (*types.QueryEstimateDepositResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	depositCoins, err := sdk.ParseCoinsNormalized(req.DepositCoins)
+	if err != nil || depositCoins.IsZero() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deposit coins %s", req.DepositCoins)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPool(ctx, req.PoolId); !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	acceptedCoins, refundedCoins, poolCoin, err := k.EstimateDepositWithinBatch(ctx, req.PoolId, depositCoins)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEstimateDepositResponse{
+		AcceptedCoins: acceptedCoins,
+		RefundedCoins: refundedCoins,
+		PoolCoin:      poolCoin,
+	}, nil
+}
+
+// EstimateWithdraw queries the expected outcome of withdrawing the pool coin from the liquidity pool at the current reserves.
+func (k Querier) EstimateWithdraw(c context.Context, req *types.QueryEstimateWithdrawRequest) (*types.QueryEstimateWithdrawResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	poolCoinAmt, ok := sdk.NewIntFromString(req.PoolCoinAmount)
+	if !ok || !poolCoinAmt.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pool coin amount %s", req.PoolCoinAmount)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, poolCoinAmt)
+	withdrawCoins, withdrawFeeCoins, err := k.EstimateWithdrawWithinBatch(ctx, req.PoolId, poolCoin, req.WithdrawCoinDenom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEstimateWithdrawResponse{
+		PoolCoin:         poolCoin,
+		WithdrawCoins:    withdrawCoins,
+		WithdrawFeeCoins: withdrawFeeCoins,
+	}, nil
+}
+
+// PoolBatchResults queries the results of the latest executed batches of the liquidity pool kept in the store.
+func (k Querier) PoolBatchResults(c context.Context, req *types.QueryPoolBatchResultsRequest) (*types.QueryPoolBatchResultsResponse, error) {
+	empty := &types.QueryPoolBatchResultsRequest{}
+	if req == nil || *req == *empty {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	resultStore := prefix.NewStore(store, types.GetPoolBatchResultsPrefix(req.PoolId))
+
+	var results []types.PoolBatchResult
+
+	pageRes, err := query.Paginate(resultStore, req.Pagination, func(_ []byte, value []byte) error {
+		result, err := types.UnmarshalPoolBatchResult(k.cdc, value)
+		if err != nil {
+			return err
+		}
+
+		results = append(results, result)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolBatchResultsResponse{
+		BatchResults: results,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// TimeWeightedAveragePrice queries the time-weighted average price of a pair of reserve coins of the liquidity pool.
+func (k Querier) TimeWeightedAveragePrice(c context.Context, req *types.QueryTimeWeightedAveragePriceRequest) (*types.QueryTimeWeightedAveragePriceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	startTime, err := time.Parse(time.RFC3339, req.StartTime)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start time %s", req.StartTime)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	endTime := ctx.BlockTime()
+	if req.EndTime != "" {
+		endTime, err = time.Parse(time.RFC3339, req.EndTime)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end time %s", req.EndTime)
+		}
+	}
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	denomX, denomY := req.DenomX, req.DenomY
+	if denomX == "" && denomY == "" {
+		if len(pool.ReserveCoinDenoms) != 2 {
+			return nil, status.Errorf(codes.InvalidArgument, "denoms of the pair must be given for the pool with %d reserve coins", len(pool.ReserveCoinDenoms))
+		}
+		denomX, denomY = pool.ReserveCoinDenoms[0], pool.ReserveCoinDenoms[1]
+	}
+	denomX, denomY = types.AlphabeticalDenomPair(denomX, denomY)
+
+	price, err := k.GetTimeWeightedAveragePrice(ctx, pool, denomX, denomY, startTime, endTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTimeWeightedAveragePriceResponse{
+		DenomX: denomX,
+		DenomY: denomY,
+		Price:  price,
+	}, nil
+}
+
+// SwapMsgsByRequester queries all swap messages of the swap requester in the latest batches of all liquidity pools.
+func (k Querier) SwapMsgsByRequester(c context.Context, req *types.QuerySwapMsgsByRequesterRequest) (*types.QuerySwapMsgsByRequesterResponse, error) {
+	if req == nil || req.SwapRequesterAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	requester, err := sdk.AccAddressFromBech32(req.SwapRequesterAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid swap requester address %s: %v", req.SwapRequesterAddress, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetSwapMsgStatesByRequesterPrefix(requester))
+
+	var msgs []types.SwapMsgState
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		poolID, msgIndex := types.ParsePoolMsgIndexFromAddressIndexKey(key)
+		msg, found := k.GetPoolBatchSwapMsgState(ctx, poolID, msgIndex)
+		if !found {
+			return fmt.Errorf("swap msg state %d of pool %d not found", msgIndex, poolID)
+		}
+
+		msgs = append(msgs, msg)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySwapMsgsByRequesterResponse{
+		Swaps:      msgs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DepositMsgsByDepositor queries all deposit messages of the depositor in the latest batches of all liquidity pools.
+func (k Querier) DepositMsgsByDepositor(c context.Context, req *types.QueryDepositMsgsByDepositorRequest) (*types.QueryDepositMsgsByDepositorResponse, error) {
+	if req == nil || req.DepositorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	depositor, err := sdk.AccAddressFromBech32(req.DepositorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid depositor address %s: %v", req.DepositorAddress, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetDepositMsgStatesByDepositorPrefix(depositor))
+
+	var msgs []types.DepositMsgState
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		poolID, msgIndex := types.ParsePoolMsgIndexFromAddressIndexKey(key)
+		msg, found := k.GetPoolBatchDepositMsgState(ctx, poolID, msgIndex)
+		if !found {
+			return fmt.Errorf("deposit msg state %d of pool %d not found", msgIndex, poolID)
+		}
+
+		msgs = append(msgs, msg)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositMsgsByDepositorResponse{
+		Deposits:   msgs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// WithdrawMsgsByWithdrawer queries all withdraw messages of the withdrawer in the latest batches of all liquidity pools.
+func (k Querier) WithdrawMsgsByWithdrawer(c context.Context, req *types.QueryWithdrawMsgsByWithdrawerRequest) (*types.QueryWithdrawMsgsByWithdrawerResponse, error) {
+	if req == nil || req.WithdrawerAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid withdrawer address %s: %v", req.WithdrawerAddress, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetWithdrawMsgStatesByWithdrawerPrefix(withdrawer))
+
+	var msgs []types.WithdrawMsgState
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		poolID, msgIndex := types.ParsePoolMsgIndexFromAddressIndexKey(key)
+		msg, found := k.GetPoolBatchWithdrawMsgState(ctx, poolID, msgIndex)
+		if !found {
+			return fmt.Errorf("withdraw msg state %d of pool %d not found", msgIndex, poolID)
+		}
+
+		msgs = append(msgs, msg)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawMsgsByWithdrawerResponse{
+		Withdraws:  msgs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// LiquidityPositions queries the pool coins held by the address and escrowed in its pending withdraw messages, valued at the current reserves.
+func (k Querier) LiquidityPositions(c context.Context, req *types.QueryLiquidityPositionsRequest) (*types.QueryLiquidityPositionsResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", req.Address, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	positions, err := k.GetLiquidityPositions(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLiquidityPositionsResponse{
+		Positions: positions,
+	}, nil
+}
+
+// RewardPlans queries all reward plans, or the reward plans of the pool if the pool id is given.
+func (k Querier) RewardPlans(c context.Context, req *types.QueryRewardPlansRequest) (*types.QueryRewardPlansResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+
+	var plans []types.RewardPlan
+	var pageRes *query.PageResponse
+	var err error
+	if req.PoolId == 0 {
+		planStore := prefix.NewStore(store, types.RewardPlanKeyPrefix)
+		pageRes, err = query.Paginate(planStore, req.Pagination, func(_ []byte, value []byte) error {
+			plan, err := types.UnmarshalRewardPlan(k.cdc, value)
+			if err != nil {
+				return err
+			}
+			plans = append(plans, plan)
+			return nil
+		})
+	} else {
+		planStore := prefix.NewStore(store, types.GetRewardPlansByPoolPrefix(req.PoolId))
+		pageRes, err = query.Paginate(planStore, req.Pagination, func(key []byte, _ []byte) error {
+			plan, found := k.GetRewardPlan(ctx, sdk.BigEndianToUint64(key))
+			if !found {
+				return types.ErrRewardPlanNotExists
+			}
+			plans = append(plans, plan)
+			return nil
+		})
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardPlansResponse{
+		Plans:      plans,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RewardPlan queries a specific reward plan.
+func (k Querier) RewardPlan(c context.Context, req *types.QueryRewardPlanRequest) (*types.QueryRewardPlanResponse, error) {
+	empty := &types.QueryRewardPlanRequest{}
+	if req == nil || *req == *empty {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	plan, found := k.GetRewardPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "reward plan %d doesn't exist", req.PlanId)
+	}
+
+	return &types.QueryRewardPlanResponse{
+		Plan: plan,
+	}, nil
+}
+
+// RewardAccumulator queries the reward accumulator of the pool.
+func (k Querier) RewardAccumulator(c context.Context, req *types.QueryRewardAccumulatorRequest) (*types.QueryRewardAccumulatorResponse, error) {
+	empty := &types.QueryRewardAccumulatorRequest{}
+	if req == nil || *req == *empty {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	return &types.QueryRewardAccumulatorResponse{
+		Accumulator: k.GetRewardAccumulator(ctx, req.PoolId),
+	}, nil
+}
+
+// Stakes queries all stakes of the staker with their pending rewards.
+func (k Querier) Stakes(c context.Context, req *types.QueryStakesRequest) (*types.QueryStakesResponse, error) {
+	if req == nil || req.StakerAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	staker, err := sdk.AccAddressFromBech32(req.StakerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staker address %s: %v", req.StakerAddress, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	stakeStore := prefix.NewStore(store, types.GetStakesByStakerPrefix(staker))
+
+	var stakes []types.StakeWithRewards
+
+	pageRes, err := query.Paginate(stakeStore, req.Pagination, func(key []byte, _ []byte) error {
+		stake, found := k.GetStake(ctx, types.ParsePoolIDFromStakeByStakerIndexKey(key), staker)
+		if !found {
+			return types.ErrStakeNotExists
+		}
+
+		stakes = append(stakes, types.StakeWithRewards{
+			Stake:   stake,
+			Rewards: k.GetPendingRewards(ctx, stake),
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStakesResponse{
+		Stakes:     stakes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Bonds queries the bonds of the owner.
+func (k Querier) Bonds(c context.Context, req *types.QueryBondsRequest) (*types.QueryBondsResponse, error) {
+	if req == nil || req.OwnerAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.OwnerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s: %v", req.OwnerAddress, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	bondStore := prefix.NewStore(store, types.GetBondsByOwnerPrefix(owner))
+
+	var bonds []types.Bond
+
+	pageRes, err := query.Paginate(bondStore, req.Pagination, func(key []byte, _ []byte) error {
+		bond, found := k.GetBond(ctx, types.ParseBondIDFromIndexKey(key))
+		if !found {
+			return types.ErrBondNotExists
+		}
+
+		bonds = append(bonds, bond)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBondsResponse{
+		Bonds:      bonds,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BondedPoolCoins queries the pool coins bonded by the owner for at least the minimum duration.
+func (k Querier) BondedPoolCoins(c context.Context, req *types.QueryBondedPoolCoinsRequest) (*types.QueryBondedPoolCoinsResponse, error) {
+	if req == nil || req.OwnerAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.OwnerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s: %v", req.OwnerAddress, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBondedPoolCoinsResponse{
+		PoolCoins: k.GetBondedPoolCoins(ctx, owner, req.MinDuration),
+	}, nil
+}
+
+// CollectedProtocolFees queries the protocol fees collected from the pool.
+func (k Querier) CollectedProtocolFees(c context.Context, req *types.QueryCollectedProtocolFeesRequest) (*types.QueryCollectedProtocolFeesResponse, error) {
+	empty := &types.QueryCollectedProtocolFeesRequest{}
+	if req == nil || *req == *empty {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	return &types.QueryCollectedProtocolFeesResponse{
+		CollectedProtocolFees: k.GetCollectedProtocolFees(ctx, req.PoolId),
+	}, nil
+}
+
+// PoolFeeRate queries the fee rates applied to the pool.
+func (k Querier) PoolFeeRate(c context.Context, req *types.QueryPoolFeeRateRequest) (*types.QueryPoolFeeRateResponse, error) {
+	empty := &types.QueryPoolFeeRateRequest{}
+	if req == nil || *req == *empty {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	dynamicSwapFee, _ := k.GetDynamicSwapFee(ctx, req.PoolId)
+	return &types.QueryPoolFeeRateResponse{
+		PoolFeeRate:    k.GetEffectivePoolFeeRate(ctx, req.PoolId),
+		DynamicSwapFee: dynamicSwapFee,
+	}, nil
+}
+
+// CircuitBreakers queries all enabled circuit breakers, of the pool and all pools if the pool id is given.
+func (k Querier) CircuitBreakers(c context.Context, req *types.QueryCircuitBreakersRequest) (*types.QueryCircuitBreakersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+
+	var circuitBreakers []types.CircuitBreaker
+	var pageRes *query.PageResponse
+	var err error
+	if req.PoolId == 0 {
+		circuitBreakerStore := prefix.NewStore(store, types.CircuitBreakerKeyPrefix)
+		pageRes, err = query.Paginate(circuitBreakerStore, req.Pagination, func(_ []byte, value []byte) error {
+			circuitBreaker, err := types.UnmarshalCircuitBreaker(k.cdc, value)
+			if err != nil {
+				return err
+			}
+			circuitBreakers = append(circuitBreakers, circuitBreaker)
+			return nil
+		})
+	} else {
+		// the circuit breakers of all pools apply to the pool as well, and precede those of the pool
+		circuitBreakerStore := prefix.NewStore(store, types.CircuitBreakerKeyPrefix)
+		pageRes, err = query.FilteredPaginate(circuitBreakerStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			circuitBreaker, err := types.UnmarshalCircuitBreaker(k.cdc, value)
+			if err != nil {
+				return false, err
+			}
+			if circuitBreaker.PoolId != 0 && circuitBreaker.PoolId != req.PoolId {
+				return false, nil
+			}
+			if accumulate {
+				circuitBreakers = append(circuitBreakers, circuitBreaker)
+			}
+			return true, nil
+		})
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCircuitBreakersResponse{
+		CircuitBreakers: circuitBreakers,
+		Pagination:      pageRes,
+	}, nil
+}
+
 // Params queries params of liquidity module.
 func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
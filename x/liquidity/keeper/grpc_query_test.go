@@ -3,9 +3,14 @@ package keeper_test
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/tendermint/liquidity/app"
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
 	"github.com/tendermint/liquidity/x/liquidity/types"
 )
 
@@ -191,6 +196,39 @@ func (suite *KeeperTestSuite) TestGRPCQueryLiquidityPools() {
 			2,
 			false,
 		},
+		{
+			"valid request with type id",
+			func() {
+				req = &types.QueryLiquidityPoolsRequest{
+					TypeId:     types.DefaultPoolTypeID,
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			true,
+			2,
+			false,
+		},
+		{
+			"valid request with denom",
+			func() {
+				req = &types.QueryLiquidityPoolsRequest{
+					Denom:      DenomA,
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			true,
+			1,
+			false,
+		},
+		{
+			"no pools matched",
+			func() {
+				req = &types.QueryLiquidityPoolsRequest{
+					TypeId:     types.StableSwapPoolTypeID,
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			false,
+			0,
+			false,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
@@ -200,7 +238,11 @@ func (suite *KeeperTestSuite) TestGRPCQueryLiquidityPools() {
 				suite.NoError(err)
 				suite.NotNil(resp)
 				suite.Equal(tc.numPools, len(resp.Pools))
-				suite.Equal(uint64(len(pools)), resp.Pagination.Total)
+				if req.Denom == "" && req.TypeId == 0 {
+					suite.Equal(uint64(len(pools)), resp.Pagination.Total)
+				} else {
+					suite.Equal(uint64(tc.numPools), resp.Pagination.Total)
+				}
 
 				if tc.hasNext {
 					suite.NotNil(resp.Pagination.NextKey)
@@ -214,6 +256,161 @@ func (suite *KeeperTestSuite) TestGRPCQueryLiquidityPools() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCLiquidityPoolByDenoms() {
+	simapp, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	pool, found := simapp.LiquidityKeeper.GetPool(ctx, suite.pools[0].Id)
+	suite.True(found)
+
+	var req *types.QueryLiquidityPoolByDenomsRequest
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryLiquidityPoolByDenomsRequest{}
+			},
+			false,
+		},
+		{
+			"same denoms",
+			func() {
+				req = &types.QueryLiquidityPoolByDenomsRequest{DenomA: DenomX, DenomB: DenomX}
+			},
+			false,
+		},
+		{
+			"pool not found",
+			func() {
+				req = &types.QueryLiquidityPoolByDenomsRequest{DenomA: DenomX, DenomB: DenomA}
+			},
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryLiquidityPoolByDenomsRequest{DenomA: DenomX, DenomB: DenomY}
+			},
+			true,
+		},
+		{
+			"valid request with reversed denoms",
+			func() {
+				req = &types.QueryLiquidityPoolByDenomsRequest{DenomA: DenomY, DenomB: DenomX, TypeId: types.DefaultPoolTypeID}
+			},
+			true,
+		},
+		{
+			"pool type not found",
+			func() {
+				req = &types.QueryLiquidityPoolByDenomsRequest{DenomA: DenomX, DenomB: DenomY, TypeId: types.StableSwapPoolTypeID}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			res, err := queryClient.LiquidityPoolByDenoms(context.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				suite.Equal(pool, res.Pool)
+			} else {
+				suite.Error(err)
+				suite.Nil(res)
+			}
+		})
+	}
+
+	// the type id must be given when several pools have the denoms
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	creator := app.AddRandomTestAddr(simapp, ctx, coins.Add(params.PoolCreationFee...))
+	stablePool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.StableSwapPoolTypeID, coins))
+	suite.Require().NoError(err)
+
+	_, err = queryClient.LiquidityPoolByDenoms(context.Background(), &types.QueryLiquidityPoolByDenomsRequest{DenomA: DenomX, DenomB: DenomY})
+	suite.Error(err)
+	res, err := queryClient.LiquidityPoolByDenoms(context.Background(),
+		&types.QueryLiquidityPoolByDenomsRequest{DenomA: DenomX, DenomB: DenomY, TypeId: types.StableSwapPoolTypeID})
+	suite.Require().NoError(err)
+	suite.Equal(stablePool, res.Pool)
+}
+
+func (suite *KeeperTestSuite) TestGRPCLiquidityPoolsByDenom() {
+	queryClient := suite.queryClient
+
+	var req *types.QueryLiquidityPoolsByDenomRequest
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		poolIDs  []uint64
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryLiquidityPoolsByDenomRequest{}
+			},
+			false,
+			nil,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryLiquidityPoolsByDenomRequest{Denom: DenomX}
+			},
+			true,
+			[]uint64{suite.pools[0].Id},
+		},
+		{
+			"valid request with type id",
+			func() {
+				req = &types.QueryLiquidityPoolsByDenomRequest{Denom: DenomA, TypeId: types.DefaultPoolTypeID}
+			},
+			true,
+			[]uint64{suite.pools[1].Id},
+		},
+		{
+			"pools not found",
+			func() {
+				req = &types.QueryLiquidityPoolsByDenomRequest{Denom: DenomX, TypeId: types.StableSwapPoolTypeID}
+			},
+			false,
+			nil,
+		},
+		{
+			"pool coin denom is not indexed",
+			func() {
+				req = &types.QueryLiquidityPoolsByDenomRequest{Denom: suite.pools[0].PoolCoinDenom}
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			res, err := queryClient.LiquidityPoolsByDenom(context.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				var poolIDs []uint64
+				for _, pool := range res.Pools {
+					poolIDs = append(poolIDs, pool.Id)
+				}
+				suite.Equal(tc.poolIDs, poolIDs)
+			} else {
+				suite.Error(err)
+				suite.Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCLiquidityPoolBatch() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 	batch, found := app.LiquidityKeeper.GetPoolBatch(ctx, suite.pools[0].Id)
@@ -486,3 +683,789 @@ func (suite *KeeperTestSuite) TestGRPCQueryBatchSwapMsgs() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCSimulateSwap() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	pool := suite.pools[0]
+	offerCoin := sdk.NewInt64Coin(pool.ReserveCoinDenoms[0], 10000)
+
+	var req *types.QuerySimulateSwapRequest
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QuerySimulateSwapRequest{}
+			},
+			false,
+		},
+		{
+			"invalid swap type",
+			func() {
+				req = &types.QuerySimulateSwapRequest{
+					PoolId:          pool.Id,
+					SwapTypeId:      3,
+					OfferCoin:       offerCoin.String(),
+					DemandCoinDenom: pool.ReserveCoinDenoms[1],
+					OrderPrice:      "1.1",
+				}
+			},
+			false,
+		},
+		{
+			"pool not found",
+			func() {
+				req = &types.QuerySimulateSwapRequest{
+					PoolId:          uint64(len(suite.pools) + 1),
+					SwapTypeId:      types.DefaultSwapTypeID,
+					OfferCoin:       offerCoin.String(),
+					DemandCoinDenom: pool.ReserveCoinDenoms[1],
+					OrderPrice:      "1.1",
+				}
+			},
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QuerySimulateSwapRequest{
+					PoolId:          pool.Id,
+					SwapTypeId:      types.DefaultSwapTypeID,
+					OfferCoin:       offerCoin.String(),
+					DemandCoinDenom: pool.ReserveCoinDenoms[1],
+					OrderPrice:      "2.5",
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			res, err := queryClient.SimulateSwap(context.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				suite.True(res.Matched)
+				suite.True(res.SwapPrice.IsPositive())
+				suite.Equal(offerCoin, res.TransactedCoin)
+				suite.True(res.ExchangedDemandCoin.IsPositive())
+				suite.Equal(types.GetOfferCoinFee(offerCoin, app.LiquidityKeeper.GetParams(ctx).SwapFeeRate), res.OfferCoinFee)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCEstimateDeposit() {
+	queryClient := suite.queryClient
+	pool := suite.pools[0]
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin(pool.ReserveCoinDenoms[0], 1000000), sdk.NewInt64Coin(pool.ReserveCoinDenoms[1], 1000000))
+
+	var req *types.QueryEstimateDepositRequest
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryEstimateDepositRequest{}
+			},
+			false,
+		},
+		{
+			"pool not found",
+			func() {
+				req = &types.QueryEstimateDepositRequest{PoolId: uint64(len(suite.pools) + 1), DepositCoins: depositCoins.String()}
+			},
+			false,
+		},
+		{
+			"not matched reserve coins",
+			func() {
+				req = &types.QueryEstimateDepositRequest{PoolId: pool.Id, DepositCoins: depositCoins[:1].String()}
+			},
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryEstimateDepositRequest{PoolId: pool.Id, DepositCoins: depositCoins.String()}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			res, err := queryClient.EstimateDeposit(context.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				suite.Equal(depositCoins, res.AcceptedCoins.Add(res.RefundedCoins...))
+				suite.Equal(pool.PoolCoinDenom, res.PoolCoin.Denom)
+				suite.True(res.PoolCoin.IsPositive())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCEstimateWithdraw() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	pool := suite.pools[0]
+	poolCoinTotalSupply := app.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool)
+
+	var req *types.QueryEstimateWithdrawRequest
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryEstimateWithdrawRequest{}
+			},
+			false,
+		},
+		{
+			"pool not found",
+			func() {
+				req = &types.QueryEstimateWithdrawRequest{PoolId: uint64(len(suite.pools) + 1), PoolCoinAmount: "1000"}
+			},
+			false,
+		},
+		{
+			"exceeded pool coin total supply",
+			func() {
+				req = &types.QueryEstimateWithdrawRequest{PoolId: pool.Id, PoolCoinAmount: poolCoinTotalSupply.AddRaw(1).String()}
+			},
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryEstimateWithdrawRequest{PoolId: pool.Id, PoolCoinAmount: poolCoinTotalSupply.QuoRaw(10).String()}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			res, err := queryClient.EstimateWithdraw(context.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				suite.Equal(sdk.NewCoin(pool.PoolCoinDenom, poolCoinTotalSupply.QuoRaw(10)), res.PoolCoin)
+				suite.Len(res.WithdrawCoins, len(pool.ReserveCoinDenoms))
+				suite.True(res.WithdrawCoins.IsAllPositive())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCPoolBatchResults() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	pool := suite.pools[0]
+	for batchIndex := uint64(1); batchIndex <= 3; batchIndex++ {
+		app.LiquidityKeeper.SetPoolBatchResult(ctx, types.PoolBatchResult{
+			PoolId:       pool.Id,
+			BatchIndex:   batchIndex,
+			Height:       int64(batchIndex),
+			ReserveCoins: app.LiquidityKeeper.GetReserveCoins(ctx, pool),
+		})
+	}
+
+	var req *types.QueryPoolBatchResultsRequest
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expPass    bool
+		numResults int
+		hasNext    bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryPoolBatchResultsRequest{}
+			},
+			false,
+			0,
+			false,
+		},
+		{
+			"pool not found",
+			func() {
+				req = &types.QueryPoolBatchResultsRequest{PoolId: uint64(len(suite.pools) + 1)}
+			},
+			false,
+			0,
+			false,
+		},
+		{
+			"returns all the pool batch results",
+			func() {
+				req = &types.QueryPoolBatchResultsRequest{PoolId: pool.Id}
+			},
+			true,
+			3,
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryPoolBatchResultsRequest{
+					PoolId:     pool.Id,
+					Pagination: &query.PageRequest{Limit: 2, CountTotal: true}}
+			},
+			true,
+			2,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			resp, err := queryClient.PoolBatchResults(context.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				suite.Equal(tc.numResults, len(resp.BatchResults))
+				suite.Equal(uint64(3), resp.Pagination.Total)
+				for i, result := range resp.BatchResults {
+					suite.Equal(uint64(i+1), result.BatchIndex)
+				}
+
+				if tc.hasNext {
+					suite.NotNil(resp.Pagination.NextKey)
+				} else {
+					suite.Nil(resp.Pagination.NextKey)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCTimeWeightedAveragePrice() {
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	app, ctx := suite.app, suite.ctx.WithBlockTime(blockTime)
+	pool := suite.pools[0]
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.Querier{Keeper: app.LiquidityKeeper})
+	queryClient := types.NewQueryClient(queryHelper)
+	for i, price := range []sdk.Dec{sdk.NewDec(1), sdk.NewDec(2)} {
+		app.LiquidityKeeper.SetPriceAccumulator(ctx, types.PriceAccumulator{
+			PoolId:     pool.Id,
+			BatchIndex: uint64(i + 1),
+			Height:     int64(i + 1),
+			Time:       blockTime.Add(time.Duration(i-2) * 10 * time.Second),
+			PairAccumulators: []types.PairPriceAccumulator{{
+				DenomX:          pool.ReserveCoinDenoms[0],
+				DenomY:          pool.ReserveCoinDenoms[1],
+				LastPrice:       price,
+				CumulativePrice: sdk.NewDec(int64(i * 10)),
+			}},
+		})
+	}
+	formatTime := func(offset time.Duration) string {
+		return blockTime.Add(offset).Format(time.RFC3339)
+	}
+
+	var req *types.QueryTimeWeightedAveragePriceRequest
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		expPrice sdk.Dec
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryTimeWeightedAveragePriceRequest{}
+			},
+			false,
+			sdk.Dec{},
+		},
+		{
+			"pool not found",
+			func() {
+				req = &types.QueryTimeWeightedAveragePriceRequest{PoolId: uint64(len(suite.pools) + 1), StartTime: formatTime(-20 * time.Second)}
+			},
+			false,
+			sdk.Dec{},
+		},
+		{
+			"invalid end time",
+			func() {
+				req = &types.QueryTimeWeightedAveragePriceRequest{PoolId: pool.Id, StartTime: formatTime(-20 * time.Second), EndTime: "invalid"}
+			},
+			false,
+			sdk.Dec{},
+		},
+		{
+			"window not covered",
+			func() {
+				req = &types.QueryTimeWeightedAveragePriceRequest{PoolId: pool.Id, StartTime: formatTime(-30 * time.Second)}
+			},
+			false,
+			sdk.Dec{},
+		},
+		{
+			"not matched reserve coin",
+			func() {
+				req = &types.QueryTimeWeightedAveragePriceRequest{
+					PoolId: pool.Id, DenomX: pool.ReserveCoinDenoms[0], DenomY: "denomZ", StartTime: formatTime(-20 * time.Second)}
+			},
+			false,
+			sdk.Dec{},
+		},
+		{
+			"defaults to the block time and the reserve coins",
+			func() {
+				req = &types.QueryTimeWeightedAveragePriceRequest{PoolId: pool.Id, StartTime: formatTime(-20 * time.Second)}
+			},
+			true,
+			sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryTimeWeightedAveragePriceRequest{
+					PoolId:    pool.Id,
+					DenomX:    pool.ReserveCoinDenoms[1],
+					DenomY:    pool.ReserveCoinDenoms[0],
+					StartTime: formatTime(-15 * time.Second),
+					EndTime:   formatTime(-5 * time.Second),
+				}
+			},
+			true,
+			sdk.MustNewDecFromStr("1.5"),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			resp, err := queryClient.TimeWeightedAveragePrice(context.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				suite.Equal(pool.ReserveCoinDenoms[0], resp.DenomX)
+				suite.Equal(pool.ReserveCoinDenoms[1], resp.DenomY)
+				suite.Equal(tc.expPrice, resp.Price)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCSwapMsgsByRequester() {
+	queryClient := suite.queryClient
+	requester := suite.addrs[1]
+
+	var req *types.QuerySwapMsgsByRequesterRequest
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		numMsgs  int
+		hasNext  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QuerySwapMsgsByRequesterRequest{}
+			},
+			false,
+			0,
+			false,
+		},
+		{
+			"invalid address",
+			func() {
+				req = &types.QuerySwapMsgsByRequesterRequest{SwapRequesterAddress: "invalid"}
+			},
+			false,
+			0,
+			false,
+		},
+		{
+			"no swap messages",
+			func() {
+				req = &types.QuerySwapMsgsByRequesterRequest{SwapRequesterAddress: suite.addrs[3].String()}
+			},
+			true,
+			0,
+			false,
+		},
+		{
+			"returns all the swap messages of the requester",
+			func() {
+				req = &types.QuerySwapMsgsByRequesterRequest{SwapRequesterAddress: requester.String()}
+			},
+			true,
+			3,
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QuerySwapMsgsByRequesterRequest{
+					SwapRequesterAddress: requester.String(),
+					Pagination:           &query.PageRequest{Limit: 2, CountTotal: true}}
+			},
+			true,
+			2,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			resp, err := queryClient.SwapMsgsByRequester(context.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				suite.Equal(tc.numMsgs, len(resp.Swaps))
+				for _, swap := range resp.Swaps {
+					suite.Equal(req.SwapRequesterAddress, swap.Msg.SwapRequesterAddress)
+				}
+
+				if tc.hasNext {
+					suite.NotNil(resp.Pagination.NextKey)
+				} else {
+					suite.Nil(resp.Pagination.NextKey)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCDepositMsgsByDepositor() {
+	queryClient := suite.queryClient
+	for _, depositor := range suite.addrs[1:3] {
+		resp, err := queryClient.DepositMsgsByDepositor(context.Background(),
+			&types.QueryDepositMsgsByDepositorRequest{DepositorAddress: depositor.String()})
+		suite.Require().NoError(err)
+		suite.Require().Len(resp.Deposits, 2)
+		for _, deposit := range resp.Deposits {
+			suite.Equal(depositor.String(), deposit.Msg.DepositorAddress)
+		}
+	}
+
+	_, err := queryClient.DepositMsgsByDepositor(context.Background(), &types.QueryDepositMsgsByDepositorRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.DepositMsgsByDepositor(context.Background(),
+		&types.QueryDepositMsgsByDepositorRequest{DepositorAddress: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCWithdrawMsgsByWithdrawer() {
+	queryClient := suite.queryClient
+	for _, withdrawer := range suite.addrs[1:3] {
+		resp, err := queryClient.WithdrawMsgsByWithdrawer(context.Background(),
+			&types.QueryWithdrawMsgsByWithdrawerRequest{WithdrawerAddress: withdrawer.String()})
+		suite.Require().NoError(err)
+		suite.Require().Len(resp.Withdraws, 2)
+		for _, withdraw := range resp.Withdraws {
+			suite.Equal(withdrawer.String(), withdraw.Msg.WithdrawerAddress)
+		}
+	}
+
+	_, err := queryClient.WithdrawMsgsByWithdrawer(context.Background(), &types.QueryWithdrawMsgsByWithdrawerRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.WithdrawMsgsByWithdrawer(context.Background(),
+		&types.QueryWithdrawMsgsByWithdrawerRequest{WithdrawerAddress: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCLiquidityPositions() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	positions, err := app.LiquidityKeeper.GetLiquidityPositions(ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(positions)
+
+	var req *types.QueryLiquidityPositionsRequest
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expPass      bool
+		numPositions int
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryLiquidityPositionsRequest{}
+			},
+			false,
+			0,
+		},
+		{
+			"invalid address",
+			func() {
+				req = &types.QueryLiquidityPositionsRequest{Address: "invalid"}
+			},
+			false,
+			0,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryLiquidityPositionsRequest{Address: suite.addrs[0].String()}
+			},
+			true,
+			len(positions),
+		},
+		{
+			"no positions",
+			func() {
+				req = &types.QueryLiquidityPositionsRequest{Address: suite.addrs[len(suite.addrs)-1].String()}
+			},
+			true,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			res, err := queryClient.LiquidityPositions(context.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				suite.Len(res.Positions, tc.numPositions)
+				for i, position := range res.Positions {
+					suite.Equal(positions[i].String(), position.String())
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCRewardPlans() {
+	simapp, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	rewardCoins := sdk.NewCoins(sdk.NewInt64Coin(DenomA, 1000000))
+	funder := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins())
+	var plans []types.RewardPlan
+	for _, poolID := range []uint64{suite.pools[0].Id, suite.pools[1].Id, suite.pools[0].Id} {
+		app.SaveAccount(simapp, ctx, funder, rewardCoins.Add(params.RewardPlanCreationFee...))
+		plan, err := simapp.LiquidityKeeper.CreateRewardPlan(ctx, types.NewMsgCreateRewardPlan(funder, poolID, rewardCoins, 100))
+		suite.Require().NoError(err)
+		plans = append(plans, plan)
+	}
+
+	res, err := queryClient.RewardPlans(context.Background(), &types.QueryRewardPlansRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(plans, res.Plans)
+
+	res, err = queryClient.RewardPlans(context.Background(), &types.QueryRewardPlansRequest{PoolId: suite.pools[0].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RewardPlan{plans[0], plans[2]}, res.Plans)
+
+	res, err = queryClient.RewardPlans(context.Background(),
+		&types.QueryRewardPlansRequest{PoolId: suite.pools[0].Id, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RewardPlan{plans[0]}, res.Plans)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	planRes, err := queryClient.RewardPlan(context.Background(), &types.QueryRewardPlanRequest{PlanId: plans[1].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(plans[1], planRes.Plan)
+
+	_, err = queryClient.RewardPlan(context.Background(), &types.QueryRewardPlanRequest{PlanId: 4})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCStakes() {
+	simapp, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	staker := suite.addrs[0]
+	poolCoin := simapp.BankKeeper.GetBalance(ctx, staker, suite.pools[0].PoolCoinDenom)
+	suite.Require().True(poolCoin.IsPositive())
+	stake, _, err := simapp.LiquidityKeeper.Stake(ctx, types.NewMsgStake(staker, poolCoin))
+	suite.Require().NoError(err)
+
+	// distribute rewards to the stake
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(DenomA, 1000000))
+	accumulator, _ := simapp.LiquidityKeeper.GetRewardAccumulator(ctx, suite.pools[0].Id).Distribute(rewards)
+	simapp.LiquidityKeeper.SetRewardAccumulator(ctx, accumulator)
+	pendingRewards := simapp.LiquidityKeeper.GetPendingRewards(ctx, stake)
+	suite.Require().False(pendingRewards.IsZero())
+
+	accRes, err := queryClient.RewardAccumulator(context.Background(), &types.QueryRewardAccumulatorRequest{PoolId: suite.pools[0].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolCoin.Amount.String(), accRes.Accumulator.TotalStaked.String())
+	suite.Require().Equal(accumulator.RewardPerShare, accRes.Accumulator.RewardPerShare)
+
+	accRes, err = queryClient.RewardAccumulator(context.Background(), &types.QueryRewardAccumulatorRequest{PoolId: suite.pools[1].Id})
+	suite.Require().NoError(err)
+	suite.Require().True(accRes.Accumulator.TotalStaked.IsZero())
+
+	_, err = queryClient.RewardAccumulator(context.Background(), &types.QueryRewardAccumulatorRequest{PoolId: 3})
+	suite.Require().Error(err)
+
+	res, err := queryClient.Stakes(context.Background(), &types.QueryStakesRequest{StakerAddress: staker.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stakes, 1)
+	suite.Require().Equal(stake.String(), res.Stakes[0].Stake.String())
+	suite.Require().Equal(pendingRewards, res.Stakes[0].Rewards)
+
+	res, err = queryClient.Stakes(context.Background(), &types.QueryStakesRequest{StakerAddress: suite.addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Stakes)
+
+	_, err = queryClient.Stakes(context.Background(), &types.QueryStakesRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.Stakes(context.Background(), &types.QueryStakesRequest{StakerAddress: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCBonds() {
+	simapp, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	owner := suite.addrs[0]
+	poolCoin := simapp.BankKeeper.GetBalance(ctx, owner, suite.pools[0].PoolCoinDenom)
+	halfPoolCoin := sdk.NewCoin(poolCoin.Denom, poolCoin.Amount.QuoRaw(2))
+	var bonds []types.Bond
+	for _, duration := range []time.Duration{24 * time.Hour, 14 * 24 * time.Hour} {
+		bond, err := simapp.LiquidityKeeper.Bond(ctx, types.NewMsgBond(owner, halfPoolCoin, duration))
+		suite.Require().NoError(err)
+		bonds = append(bonds, bond)
+	}
+
+	res, err := queryClient.Bonds(context.Background(), &types.QueryBondsRequest{OwnerAddress: owner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(bonds, res.Bonds)
+
+	res, err = queryClient.Bonds(context.Background(),
+		&types.QueryBondsRequest{OwnerAddress: owner.String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(bonds[:1], res.Bonds)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = queryClient.Bonds(context.Background(), &types.QueryBondsRequest{OwnerAddress: suite.addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Bonds)
+
+	_, err = queryClient.Bonds(context.Background(), &types.QueryBondsRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.Bonds(context.Background(), &types.QueryBondsRequest{OwnerAddress: "invalid"})
+	suite.Require().Error(err)
+
+	coinsRes, err := queryClient.BondedPoolCoins(context.Background(), &types.QueryBondedPoolCoinsRequest{OwnerAddress: owner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(halfPoolCoin.Add(halfPoolCoin)), coinsRes.PoolCoins)
+
+	coinsRes, err = queryClient.BondedPoolCoins(context.Background(),
+		&types.QueryBondedPoolCoinsRequest{OwnerAddress: owner.String(), MinDuration: 7 * 24 * time.Hour})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(halfPoolCoin), coinsRes.PoolCoins)
+
+	_, err = queryClient.BondedPoolCoins(context.Background(), &types.QueryBondedPoolCoinsRequest{OwnerAddress: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCCollectedProtocolFees() {
+	simapp, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	fees := types.CollectedProtocolFees{
+		PoolId:       suite.pools[0].Id,
+		SwapFeeCoins: sdk.NewCoins(sdk.NewInt64Coin(DenomX, 30), sdk.NewInt64Coin(DenomY, 20)),
+	}
+	simapp.LiquidityKeeper.SetCollectedProtocolFees(ctx, fees)
+
+	res, err := queryClient.CollectedProtocolFees(context.Background(), &types.QueryCollectedProtocolFeesRequest{PoolId: suite.pools[0].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(fees.SwapFeeCoins, res.CollectedProtocolFees.SwapFeeCoins)
+	suite.Require().True(res.CollectedProtocolFees.WithdrawFeeCoins.IsZero())
+
+	res, err = queryClient.CollectedProtocolFees(context.Background(), &types.QueryCollectedProtocolFeesRequest{PoolId: suite.pools[1].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.pools[1].Id, res.CollectedProtocolFees.PoolId)
+	suite.Require().True(res.CollectedProtocolFees.SwapFeeCoins.IsZero())
+
+	_, err = queryClient.CollectedProtocolFees(context.Background(), &types.QueryCollectedProtocolFeesRequest{PoolId: 3})
+	suite.Require().Error(err)
+	_, err = queryClient.CollectedProtocolFees(context.Background(), &types.QueryCollectedProtocolFeesRequest{})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCPoolFeeRate() {
+	simapp, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	feeRate := types.NewPoolFeeRate(suite.pools[0].Id, sdk.NewDecWithPrec(5, 4), sdk.NewDecWithPrec(1, 3))
+	simapp.LiquidityKeeper.SetPoolFeeRate(ctx, feeRate)
+
+	res, err := queryClient.PoolFeeRate(context.Background(), &types.QueryPoolFeeRateRequest{PoolId: suite.pools[0].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(feeRate, res.PoolFeeRate)
+
+	res, err = queryClient.PoolFeeRate(context.Background(), &types.QueryPoolFeeRateRequest{PoolId: suite.pools[1].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewPoolFeeRate(suite.pools[1].Id, params.SwapFeeRate, params.WithdrawFeeRate), res.PoolFeeRate)
+
+	// the dynamic swap fee raises the swap fee rate of the pool while enabled
+	params.DynamicSwapFeeEnabled = true
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	dynamicSwapFee := types.DynamicSwapFee{
+		PoolId:      suite.pools[0].Id,
+		BatchIndex:  1,
+		Volatility:  sdk.NewDecWithPrec(2, 2),
+		SwapFeeRate: sdk.NewDecWithPrec(2, 3),
+	}
+	simapp.LiquidityKeeper.SetDynamicSwapFee(ctx, dynamicSwapFee)
+	res, err = queryClient.PoolFeeRate(context.Background(), &types.QueryPoolFeeRateRequest{PoolId: suite.pools[0].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewPoolFeeRate(suite.pools[0].Id, dynamicSwapFee.SwapFeeRate, feeRate.WithdrawFeeRate), res.PoolFeeRate)
+	suite.Require().Equal(dynamicSwapFee, res.DynamicSwapFee)
+
+	_, err = queryClient.PoolFeeRate(context.Background(), &types.QueryPoolFeeRateRequest{PoolId: 3})
+	suite.Require().Error(err)
+	_, err = queryClient.PoolFeeRate(context.Background(), &types.QueryPoolFeeRateRequest{})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCCircuitBreakers() {
+	simapp, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	circuitBreakers := []types.CircuitBreaker{
+		types.NewCircuitBreaker(0, types.TypeMsgCreatePool, true),
+		types.NewCircuitBreaker(suite.pools[0].Id, types.TypeMsgSwapWithinBatch, true),
+		types.NewCircuitBreaker(suite.pools[1].Id, "", true),
+	}
+	for _, cb := range circuitBreakers {
+		suite.Require().NoError(simapp.LiquidityKeeper.ApplyCircuitBreaker(ctx, cb))
+	}
+
+	res, err := queryClient.CircuitBreakers(context.Background(), &types.QueryCircuitBreakersRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(circuitBreakers, res.CircuitBreakers)
+
+	// the circuit breakers of all pools apply to the pool as well
+	res, err = queryClient.CircuitBreakers(context.Background(), &types.QueryCircuitBreakersRequest{PoolId: suite.pools[1].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.CircuitBreaker{circuitBreakers[0], circuitBreakers[2]}, res.CircuitBreakers)
+
+	res, err = queryClient.CircuitBreakers(context.Background(), &types.QueryCircuitBreakersRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(circuitBreakers[:2], res.CircuitBreakers)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.CircuitBreakers(context.Background(), &types.QueryCircuitBreakersRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(circuitBreakers[2:], res.CircuitBreakers)
+}
@@ -12,13 +12,30 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "escrow-amount",
 		LiquidityPoolsEscrowAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "rewards-escrow-amount",
+		RewardsEscrowAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "stakes-escrow-amount",
+		StakesEscrowAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bonds-escrow-amount",
+		BondsEscrowAmountInvariant(k))
 }
 
 // AllInvariants runs all invariants of the liquidity module.
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		res, stop := LiquidityPoolsEscrowAmountInvariant(k)(ctx)
-		return res, stop
+		if stop {
+			return res, stop
+		}
+		res, stop = RewardsEscrowAmountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = StakesEscrowAmountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return BondsEscrowAmountInvariant(k)(ctx)
 	}
 }
 
@@ -52,6 +69,75 @@ func LiquidityPoolsEscrowAmountInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// RewardsEscrowAmountInvariant checks that the rewards escrow account holds the reward coins of the reward plans not
+// distributed yet, the pending rewards of the stakes, and the whole truncated rewards of the reward plans and the pools.
+func RewardsEscrowAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		remainingCoins := sdk.NewCoins()
+		truncatedRewards := sdk.DecCoins{}
+		for _, plan := range k.GetAllRewardPlans(ctx) {
+			remainingCoins = remainingCoins.Add(plan.RemainingCoins()...)
+			truncatedRewards = truncatedRewards.Add(plan.TruncatedRewards...)
+		}
+		for _, pool := range k.GetAllPools(ctx) {
+			accumulator := k.GetRewardAccumulator(ctx, pool.Id)
+			truncatedRewards = truncatedRewards.Add(accumulator.TruncatedRewards...)
+			k.IterateStakesByPool(ctx, pool.Id, func(stake types.Stake) bool {
+				remainingCoins = remainingCoins.Add(stake.PendingRewards(accumulator)...)
+				return false
+			})
+		}
+
+		truncatedCoins, _ := truncatedRewards.TruncateDecimal()
+		remainingCoins = remainingCoins.Add(truncatedCoins...)
+
+		escrowAmt := k.bankKeeper.GetAllBalances(ctx, types.RewardsEscrowAcc)
+
+		broken := !escrowAmt.IsAllGTE(remainingCoins)
+
+		return sdk.FormatInvariant(types.ModuleName, "rewards escrow amount invariant broken",
+			"rewards escrow amount LT remaining reward amount"), broken
+	}
+}
+
+// StakesEscrowAmountInvariant checks that the stakes escrow account holds the staked pool coins.
+func StakesEscrowAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		stakedCoins := sdk.NewCoins()
+		for _, pool := range k.GetAllPools(ctx) {
+			k.IterateStakesByPool(ctx, pool.Id, func(stake types.Stake) bool {
+				stakedCoins = stakedCoins.Add(sdk.NewCoin(pool.PoolCoinDenom, stake.Amount))
+				return false
+			})
+		}
+
+		escrowAmt := k.bankKeeper.GetAllBalances(ctx, types.StakesEscrowAcc)
+
+		broken := !escrowAmt.IsAllGTE(stakedCoins)
+
+		return sdk.FormatInvariant(types.ModuleName, "stakes escrow amount invariant broken",
+			"stakes escrow amount LT staked amount"), broken
+	}
+}
+
+// BondsEscrowAmountInvariant checks that the bonds escrow account holds the pool coins of the bonds.
+func BondsEscrowAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		bondedCoins := sdk.NewCoins()
+		k.IterateAllBonds(ctx, func(bond types.Bond) bool {
+			bondedCoins = bondedCoins.Add(bond.PoolCoin)
+			return false
+		})
+
+		escrowAmt := k.bankKeeper.GetAllBalances(ctx, types.BondsEscrowAcc)
+
+		broken := !escrowAmt.IsAllGTE(bondedCoins)
+
+		return sdk.FormatInvariant(types.ModuleName, "bonds escrow amount invariant broken",
+			"bonds escrow amount LT bonded amount"), broken
+	}
+}
+
 // These invariants cannot be registered via RegisterInvariants since the module uses per-block batch execution.
 // We should approach adding these invariant checks inside actual logics of deposit / withdraw / swap.
 
@@ -222,6 +308,137 @@ func ImmutablePoolPriceAfterWithdrawInvariant(reserveCoinA, reserveCoinB, withdr
 	}
 }
 
+// MultiAssetDepositInvariant checks the after deposit amounts and the minting amount of pool coins of the pools with
+// more than two reserve coins, where the accepted deposit coins are proportional to every reserve coin.
+func MultiAssetDepositInvariant(lastReserveCoins, depositCoins, refundedCoins, afterReserveCoins sdk.Coins, poolCoinTotalSupply, mintPoolCoin sdk.Int) {
+	poolCoinRatio := mintPoolCoin.ToDec().QuoInt(poolCoinTotalSupply)
+	for _, lastReserveCoin := range lastReserveCoins {
+		denom := lastReserveCoin.Denom
+		acceptedAmt := depositCoins.AmountOf(denom).Sub(refundedCoins.AmountOf(denom))
+
+		// AfterDepositReserveCoin = LastReserveCoin + AfterRefundedDepositCoin
+		if !afterReserveCoins.AmountOf(denom).Equal(lastReserveCoin.Amount.Add(acceptedAmt)) {
+			panic("invariant check fails due to incorrect deposit amounts")
+		}
+
+		// NewPoolCoinAmount / LastPoolCoinSupply == AfterRefundedDepositCoin / LastReserveCoin
+		if acceptedAmt.GTE(coinAmountThreshold) && lastReserveCoin.Amount.GTE(coinAmountThreshold) &&
+			mintPoolCoin.GTE(coinAmountThreshold) && poolCoinTotalSupply.GTE(coinAmountThreshold) &&
+			errorRate(acceptedAmt.ToDec().QuoInt(lastReserveCoin.Amount), poolCoinRatio).GT(errorRateThreshold) {
+			panic("invariant check fails due to incorrect ratio of pool coins")
+		}
+	}
+}
+
+// MultiAssetWithdrawInvariant checks the after withdraw amounts and the withdraw coin amounts of the pools with more
+// than two reserve coins, where every reserve coin is paid out in proportion to the burned pool coin amount.
+func MultiAssetWithdrawInvariant(reserveCoins, withdrawCoins, withdrawFeeCoins, afterReserveCoins sdk.Coins,
+	burnedPoolCoin, lastPoolCoinSupply, afterPoolCoinSupply sdk.Int, withdrawFeeRate sdk.Dec) {
+	// AfterWithdrawPoolCoinSupply = LastPoolCoinSupply - BurnedPoolCoinAmount
+	if !afterPoolCoinSupply.Equal(lastPoolCoinSupply.Sub(burnedPoolCoin)) {
+		panic("invariant check fails due to incorrect total supply")
+	}
+
+	burningPoolCoinRatio := burnedPoolCoin.ToDec().Quo(lastPoolCoinSupply.ToDec())
+	for _, reserveCoin := range reserveCoins {
+		denom := reserveCoin.Denom
+		withdrawAmt := withdrawCoins.AmountOf(denom)
+
+		// AfterWithdrawReserveCoin = LastReserveCoin - WithdrawCoin
+		if !afterReserveCoins.AmountOf(denom).Equal(reserveCoin.Amount.Sub(withdrawAmt)) {
+			panic("invariant check fails due to incorrect withdraw coin amount")
+		}
+		if burnedPoolCoin.Equal(lastPoolCoinSupply) {
+			continue
+		}
+
+		// BurnedPoolCoinAmount / LastPoolCoinSupply >= (WithdrawCoin+WithdrawFeeCoin) / LastReserveCoin
+		if withdrawAmt.Add(withdrawFeeCoins.AmountOf(denom)).ToDec().Quo(reserveCoin.Amount.ToDec()).GT(burningPoolCoinRatio) {
+			panic("invariant check fails due to incorrect ratio of burning pool coins")
+		}
+		idealWithdrawAmt := reserveCoin.Amount.ToDec().Mul(burningPoolCoinRatio).Mul(sdk.OneDec().Sub(withdrawFeeRate))
+		if idealWithdrawAmt.Sub(withdrawAmt.ToDec()).Abs().GTE(sdk.OneDec()) {
+			panic(fmt.Sprintf("withdraw coin amount %v differs too much from %v", withdrawAmt, idealWithdrawAmt))
+		}
+	}
+}
+
+// WeightedDepositInvariant checks the after deposit amounts and the minted pool coin amount of the weighted pools,
+// where the minted pool coin ratio must not exceed the growth of the weighted invariant Π(B^W).
+func WeightedDepositInvariant(pool types.Pool, lastReserveCoins, depositCoins, refundedCoins, afterReserveCoins sdk.Coins,
+	poolCoinTotalSupply, mintPoolCoin sdk.Int) {
+	for _, lastReserveCoin := range lastReserveCoins {
+		denom := lastReserveCoin.Denom
+		acceptedAmt := depositCoins.AmountOf(denom).Sub(refundedCoins.AmountOf(denom))
+
+		// AfterDepositReserveCoin = LastReserveCoin + AfterRefundedDepositCoin
+		if !afterReserveCoins.AmountOf(denom).Equal(lastReserveCoin.Amount.Add(acceptedAmt)) {
+			panic("invariant check fails due to incorrect deposit amounts")
+		}
+	}
+
+	// NewPoolCoinAmount / LastPoolCoinSupply <= Π((AfterReserveCoin / LastReserveCoin)^W) - 1
+	poolCoinRatio := mintPoolCoin.ToDec().QuoInt(poolCoinTotalSupply)
+	invariantRatio := weightedInvariantRatio(pool, lastReserveCoins, afterReserveCoins).Sub(sdk.OneDec())
+	if mintPoolCoin.GTE(coinAmountThreshold) && poolCoinTotalSupply.GTE(coinAmountThreshold) &&
+		poolCoinRatio.GT(invariantRatio) && errorRate(invariantRatio, poolCoinRatio).GT(errorRateThreshold) {
+		panic("invariant check fails due to incorrect ratio of pool coins")
+	}
+}
+
+// WeightedWithdrawInvariant checks the after withdraw amounts of the weighted pools, where the weighted invariant
+// Π(B^W) must not decrease more than the pool coin supply.
+func WeightedWithdrawInvariant(pool types.Pool, reserveCoins, withdrawCoins, afterReserveCoins sdk.Coins,
+	burnedPoolCoin, lastPoolCoinSupply, afterPoolCoinSupply sdk.Int) {
+	// AfterWithdrawPoolCoinSupply = LastPoolCoinSupply - BurnedPoolCoinAmount
+	if !afterPoolCoinSupply.Equal(lastPoolCoinSupply.Sub(burnedPoolCoin)) {
+		panic("invariant check fails due to incorrect total supply")
+	}
+	for _, reserveCoin := range reserveCoins {
+		// AfterWithdrawReserveCoin = LastReserveCoin - WithdrawCoin
+		if !afterReserveCoins.AmountOf(reserveCoin.Denom).Equal(reserveCoin.Amount.Sub(withdrawCoins.AmountOf(reserveCoin.Denom))) {
+			panic("invariant check fails due to incorrect withdraw coin amount")
+		}
+	}
+	if afterPoolCoinSupply.IsZero() {
+		return
+	}
+
+	// Π((AfterReserveCoin / LastReserveCoin)^W) >= AfterWithdrawPoolCoinSupply / LastPoolCoinSupply
+	supplyRatio := afterPoolCoinSupply.ToDec().QuoInt(lastPoolCoinSupply)
+	invariantRatio := weightedInvariantRatio(pool, reserveCoins, afterReserveCoins)
+	if invariantRatio.LT(supplyRatio) && errorRate(supplyRatio, invariantRatio).GT(errorRateThreshold) {
+		panic("invariant check fails due to incorrect ratio of burning pool coins")
+	}
+}
+
+// weightedInvariantRatio returns the ratio Π((AfterReserveCoin / LastReserveCoin)^W) of the weighted invariants.
+func weightedInvariantRatio(pool types.Pool, lastReserveCoins, afterReserveCoins sdk.Coins) sdk.Dec {
+	ratio := sdk.OneDec()
+	for i, denom := range pool.ReserveCoinDenoms {
+		power, err := types.WeightedPower(afterReserveCoins.AmountOf(denom).ToDec().QuoInt(lastReserveCoins.AmountOf(denom)),
+			pool.ReserveCoinWeights[i], types.TotalReserveCoinWeight)
+		if err != nil {
+			panic(err)
+		}
+		ratio = ratio.Mul(power)
+	}
+	return ratio
+}
+
+// MultiAssetSwapInvariant checks that the swap orders of a pair of reserve coins of the pools with more than two
+// reserve coins do not change the other reserve coins of the pool.
+func MultiAssetSwapInvariant(denomX, denomY string, lastReserveCoins, afterReserveCoins sdk.Coins) {
+	for _, lastReserveCoin := range lastReserveCoins {
+		if lastReserveCoin.Denom == denomX || lastReserveCoin.Denom == denomY {
+			continue
+		}
+		if !afterReserveCoins.AmountOf(lastReserveCoin.Denom).Equal(lastReserveCoin.Amount) {
+			panic("invariant check fails due to changed reserve coin out of the swap pair")
+		}
+	}
+}
+
 // SwapMatchingInvariants checks swap matching results of both X to Y and Y to X cases.
 func SwapMatchingInvariants(xToY, yToX []*types.SwapMsgState, matchResultXtoY, matchResultYtoX []types.MatchResult) {
 	beforeMatchingXtoYLen := len(xToY)
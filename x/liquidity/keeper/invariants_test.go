@@ -77,6 +77,57 @@ func TestMintingPoolCoinsInvariant(t *testing.T) {
 	}
 }
 
+func TestMultiAssetDepositInvariant(t *testing.T) {
+	reserveCoins := sdk.NewCoins(sdk.NewInt64Coin("denoma", 100000), sdk.NewInt64Coin("denomb", 200000), sdk.NewInt64Coin("denomc", 300000))
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin("denoma", 10000), sdk.NewInt64Coin("denomb", 20000), sdk.NewInt64Coin("denomc", 40000))
+	refundedCoins := sdk.NewCoins(sdk.NewInt64Coin("denomc", 10000))
+	afterReserveCoins := sdk.NewCoins(sdk.NewInt64Coin("denoma", 110000), sdk.NewInt64Coin("denomb", 220000), sdk.NewInt64Coin("denomc", 330000))
+
+	require.NotPanics(t, func() {
+		keeper.MultiAssetDepositInvariant(reserveCoins, depositCoins, refundedCoins, afterReserveCoins, sdk.NewInt(10000), sdk.NewInt(1000))
+	})
+	// the deposit coin over the proportional amount is not refunded
+	require.Panics(t, func() {
+		keeper.MultiAssetDepositInvariant(reserveCoins, depositCoins, sdk.NewCoins(), afterReserveCoins.Add(sdk.NewInt64Coin("denomc", 10000)), sdk.NewInt(10000), sdk.NewInt(1000))
+	})
+	// too many pool coins are minted
+	require.Panics(t, func() {
+		keeper.MultiAssetDepositInvariant(reserveCoins, depositCoins, refundedCoins, afterReserveCoins, sdk.NewInt(10000), sdk.NewInt(2000))
+	})
+}
+
+func TestMultiAssetWithdrawInvariant(t *testing.T) {
+	reserveCoins := sdk.NewCoins(sdk.NewInt64Coin("denoma", 100000), sdk.NewInt64Coin("denomb", 200000), sdk.NewInt64Coin("denomc", 300000))
+	withdrawCoins := sdk.NewCoins(sdk.NewInt64Coin("denoma", 10000), sdk.NewInt64Coin("denomb", 20000), sdk.NewInt64Coin("denomc", 30000))
+	afterReserveCoins := sdk.NewCoins(sdk.NewInt64Coin("denoma", 90000), sdk.NewInt64Coin("denomb", 180000), sdk.NewInt64Coin("denomc", 270000))
+
+	require.NotPanics(t, func() {
+		keeper.MultiAssetWithdrawInvariant(reserveCoins, withdrawCoins, sdk.NewCoins(), afterReserveCoins, sdk.NewInt(1000), sdk.NewInt(10000), sdk.NewInt(9000), sdk.ZeroDec())
+	})
+	// too many reserve coins are paid out for the burned pool coins
+	require.Panics(t, func() {
+		keeper.MultiAssetWithdrawInvariant(reserveCoins, withdrawCoins.Add(sdk.NewInt64Coin("denomc", 1000)), sdk.NewCoins(),
+			afterReserveCoins.Sub(sdk.NewCoins(sdk.NewInt64Coin("denomc", 1000))), sdk.NewInt(1000), sdk.NewInt(10000), sdk.NewInt(9000), sdk.ZeroDec())
+	})
+	// the pool coin supply is not reduced by the burned pool coins
+	require.Panics(t, func() {
+		keeper.MultiAssetWithdrawInvariant(reserveCoins, withdrawCoins, sdk.NewCoins(), afterReserveCoins, sdk.NewInt(1000), sdk.NewInt(10000), sdk.NewInt(10000), sdk.ZeroDec())
+	})
+}
+
+func TestMultiAssetSwapInvariant(t *testing.T) {
+	reserveCoins := sdk.NewCoins(sdk.NewInt64Coin("denoma", 100000), sdk.NewInt64Coin("denomb", 200000), sdk.NewInt64Coin("denomc", 300000))
+
+	require.NotPanics(t, func() {
+		keeper.MultiAssetSwapInvariant("denoma", "denomb", reserveCoins,
+			sdk.NewCoins(sdk.NewInt64Coin("denoma", 110000), sdk.NewInt64Coin("denomb", 181819), sdk.NewInt64Coin("denomc", 300000)))
+	})
+	require.Panics(t, func() {
+		keeper.MultiAssetSwapInvariant("denoma", "denomb", reserveCoins,
+			sdk.NewCoins(sdk.NewInt64Coin("denoma", 110000), sdk.NewInt64Coin("denomb", 181819), sdk.NewInt64Coin("denomc", 299999)))
+	})
+}
+
 func TestLiquidityPoolsEscrowAmountInvariant(t *testing.T) {
 	simapp, ctx := app.CreateTestInput()
 
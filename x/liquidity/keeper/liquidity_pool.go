@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,22 +36,41 @@ func (k Keeper) ValidateMsgCreatePool(ctx sdk.Context, msg *types.MsgCreatePool)
 		reserveCoinDenoms[i] = msg.DepositCoins.GetDenomByIndex(i)
 	}
 
-	denomA, denomB := types.AlphabeticalDenomPair(reserveCoinDenoms[0], reserveCoinDenoms[1])
-	if denomA != msg.DepositCoins[0].Denom || denomB != msg.DepositCoins[1].Denom {
-		return types.ErrBadOrderingReserveCoin
+	for i := 1; i < len(reserveCoinDenoms); i++ {
+		if reserveCoinDenoms[i-1] == reserveCoinDenoms[i] {
+			return types.ErrEqualDenom
+		}
+		if reserveCoinDenoms[i-1] > reserveCoinDenoms[i] {
+			return types.ErrBadOrderingReserveCoin
+		}
 	}
 
-	if denomA == denomB {
-		return types.ErrEqualDenom
+	poolCurve, found := types.GetPoolCurve(msg.PoolTypeId)
+	if !found {
+		return types.ErrPoolTypeNotExists
+	}
+	pool := types.Pool{
+		TypeId:             msg.PoolTypeId,
+		ReserveCoinDenoms:  reserveCoinDenoms,
+		ReserveCoinWeights: msg.ReserveCoinWeights,
+		Amplification:      msg.Amplification,
+	}
+	poolCurve.InitPool(&pool, params)
+	if err := poolCurve.ValidatePool(pool); err != nil {
+		return err
 	}
 
 	if err := types.ValidateReserveCoinLimit(params.MaxReserveCoinAmount, msg.DepositCoins); err != nil {
 		return err
 	}
 
+	if err := poolCurve.ValidateInitialDeposit(pool, msg.DepositCoins); err != nil {
+		return err
+	}
+
 	poolName := types.PoolName(reserveCoinDenoms, msg.PoolTypeId)
 	reserveAcc := types.GetPoolReserveAcc(poolName, false)
-	_, found := k.GetPoolByReserveAccIndex(ctx, reserveAcc)
+	_, found = k.GetPoolByReserveAccIndex(ctx, reserveAcc)
 	if found {
 		return types.ErrPoolAlreadyExists
 	}
@@ -95,8 +115,11 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 
 	params := k.GetParams(ctx)
 
-	denom1, denom2 := types.AlphabeticalDenomPair(msg.DepositCoins[0].Denom, msg.DepositCoins[1].Denom)
-	reserveCoinDenoms := []string{denom1, denom2}
+	reserveCoinDenoms := make([]string, msg.DepositCoins.Len())
+	for i, coin := range msg.DepositCoins {
+		reserveCoinDenoms[i] = coin.Denom
+	}
+	reserveCoinDenoms = types.SortDenoms(reserveCoinDenoms)
 
 	poolName := types.PoolName(reserveCoinDenoms, msg.PoolTypeId)
 
@@ -106,7 +129,14 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 		ReserveCoinDenoms:     reserveCoinDenoms,
 		ReserveAccountAddress: types.GetPoolReserveAcc(poolName, false).String(),
 		PoolCoinDenom:         types.GetPoolCoinDenom(poolName),
+		ReserveCoinWeights:    msg.ReserveCoinWeights,
+		Amplification:         msg.Amplification,
 	}
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return types.Pool{}, types.ErrPoolTypeNotExists
+	}
+	poolCurve.InitPool(&pool, params)
 
 	poolCreator := msg.GetPoolCreator()
 
@@ -135,8 +165,11 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 		}
 	}
 
-	if _, err := k.MintAndSendPoolCoin(ctx, pool, poolCreator, poolCreator, msg.DepositCoins); err != nil {
-		return types.Pool{}, err
+	// the pool type without the pool coin provides the deposit coins on the pool state initialization below
+	if poolCurve.HasPoolCoin() {
+		if _, err := k.MintAndSendPoolCoin(ctx, pool, poolCreator, poolCreator, msg.DepositCoins); err != nil {
+			return types.Pool{}, err
+		}
 	}
 
 	// pool creation fees are collected in community pool
@@ -150,6 +183,10 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 
 	k.SetPoolBatch(ctx, batch)
 
+	if err := poolCurve.InitPoolState(ctx, k, pool, poolCreator, msg.DepositCoins); err != nil {
+		return types.Pool{}, err
+	}
+
 	reserveCoins := k.GetReserveCoins(ctx, pool)
 	lastReserveRatio := sdk.NewDecFromInt(reserveCoins[0].Amount).Quo(sdk.NewDecFromInt(reserveCoins[1].Amount))
 	logger := k.Logger(ctx)
@@ -192,6 +229,9 @@ func (k Keeper) ExecuteDeposit(ctx sdk.Context, msg types.DepositMsgState, batch
 
 	// reinitialize pool if the pool is depleted
 	if k.IsDepletedPool(ctx, pool) {
+		if msg.Msg.DepositCoins.Len() != len(pool.ReserveCoinDenoms) {
+			return types.ErrNumOfReserveCoin
+		}
 		for _, depositCoin := range msg.Msg.DepositCoins {
 			if depositCoin.Amount.Add(reserveCoins.AmountOf(depositCoin.Denom)).LT(params.MinInitDepositAmount) {
 				return types.ErrLessThanMinInitDeposit
@@ -239,33 +279,21 @@ func (k Keeper) ExecuteDeposit(ctx sdk.Context, msg types.DepositMsgState, batch
 
 	reserveCoins.Sort()
 
-	lastReserveCoinA := reserveCoins[0]
-	lastReserveCoinB := reserveCoins[1]
-
-	depositCoinA := depositCoins[0]
-	depositCoinB := depositCoins[1]
-
-	poolCoinTotalSupply := k.GetPoolCoinTotalSupply(ctx, pool).ToDec()
-	if err := types.CheckOverflowWithDec(poolCoinTotalSupply, depositCoinA.Amount.ToDec()); err != nil {
-		return err
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return types.ErrPoolTypeNotExists
 	}
-	if err := types.CheckOverflowWithDec(poolCoinTotalSupply, depositCoinB.Amount.ToDec()); err != nil {
+
+	// the minting amount and the accepted coins are calculated by the pool curve of the pool type
+	poolCoinTotalSupply := k.GetPoolCoinTotalSupply(ctx, pool)
+	poolCoinMintAmt, acceptedCoins, err := poolCurve.DepositMint(pool, params, reserveCoins, poolCoinTotalSupply, depositCoins,
+		k.GetEffectivePoolFeeRate(ctx, pool.Id))
+	if err != nil {
 		return err
 	}
-	poolCoinMintAmt := sdk.MinDec(
-		poolCoinTotalSupply.MulTruncate(depositCoinA.Amount.ToDec()).QuoTruncate(lastReserveCoinA.Amount.ToDec()),
-		poolCoinTotalSupply.MulTruncate(depositCoinB.Amount.ToDec()).QuoTruncate(lastReserveCoinB.Amount.ToDec()),
-	)
-	mintRate := poolCoinMintAmt.TruncateDec().QuoTruncate(poolCoinTotalSupply)
-	acceptedCoins := sdk.NewCoins(
-		sdk.NewCoin(depositCoins[0].Denom, lastReserveCoinA.Amount.ToDec().Mul(mintRate).TruncateInt()),
-		sdk.NewCoin(depositCoins[1].Denom, lastReserveCoinB.Amount.ToDec().Mul(mintRate).TruncateInt()),
-	)
 	refundedCoins := depositCoins.Sub(acceptedCoins)
-	refundedCoinA := sdk.NewCoin(depositCoinA.Denom, refundedCoins.AmountOf(depositCoinA.Denom))
-	refundedCoinB := sdk.NewCoin(depositCoinB.Denom, refundedCoins.AmountOf(depositCoinB.Denom))
 
-	mintPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, poolCoinMintAmt.TruncateInt())
+	mintPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, poolCoinMintAmt)
 	mintPoolCoins := sdk.NewCoins(mintPoolCoin)
 
 	if mintPoolCoins.IsZero() || acceptedCoins.IsZero() {
@@ -302,12 +330,20 @@ func (k Keeper) ExecuteDeposit(ctx sdk.Context, msg types.DepositMsgState, batch
 	msg.ToBeDeleted = true
 	k.SetPoolBatchDepositMsgState(ctx, msg.Msg.PoolId, msg)
 
-	if BatchLogicInvariantCheckFlag {
+	if BatchLogicInvariantCheckFlag && len(pool.ReserveCoinWeights) > 0 {
+		WeightedDepositInvariant(pool, reserveCoins, depositCoins, refundedCoins, k.GetReserveCoins(ctx, pool), poolCoinTotalSupply, mintPoolCoin.Amount)
+	} else if BatchLogicInvariantCheckFlag && len(reserveCoins) > 2 {
+		MultiAssetDepositInvariant(reserveCoins, depositCoins, refundedCoins, k.GetReserveCoins(ctx, pool), poolCoinTotalSupply, mintPoolCoin.Amount)
+	} else if BatchLogicInvariantCheckFlag {
+		lastReserveCoinA, lastReserveCoinB := reserveCoins[0], reserveCoins[1]
+		depositCoinA, depositCoinB := depositCoins[0], depositCoins[1]
+		refundedCoinA := sdk.NewCoin(depositCoinA.Denom, refundedCoins.AmountOf(depositCoinA.Denom))
+		refundedCoinB := sdk.NewCoin(depositCoinB.Denom, refundedCoins.AmountOf(depositCoinB.Denom))
 		afterReserveCoins := k.GetReserveCoins(ctx, pool)
 		afterReserveCoinA := afterReserveCoins[0].Amount
 		afterReserveCoinB := afterReserveCoins[1].Amount
 
-		MintingPoolCoinsInvariant(poolCoinTotalSupply.TruncateInt(), mintPoolCoin.Amount, depositCoinA.Amount, depositCoinB.Amount,
+		MintingPoolCoinsInvariant(poolCoinTotalSupply, mintPoolCoin.Amount, depositCoinA.Amount, depositCoinB.Amount,
 			lastReserveCoinA.Amount, lastReserveCoinB.Amount, refundedCoinA.Amount, refundedCoinB.Amount)
 		DepositInvariant(lastReserveCoinA.Amount, lastReserveCoinB.Amount, depositCoinA.Amount, depositCoinB.Amount,
 			afterReserveCoinA, afterReserveCoinB, refundedCoinA.Amount, refundedCoinB.Amount)
@@ -373,29 +409,18 @@ func (k Keeper) ExecuteWithdrawal(ctx sdk.Context, msg types.WithdrawMsgState, b
 	reserveAcc := pool.GetReserveAccount()
 	withdrawer := msg.Msg.GetWithdrawer()
 
-	params := k.GetParams(ctx)
-	withdrawProportion := sdk.OneDec().Sub(params.WithdrawFeeRate)
-	withdrawCoins := sdk.NewCoins()
-	withdrawFeeCoins := sdk.NewCoins()
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return types.ErrPoolTypeNotExists
+	}
 
-	// Case for withdrawing all reserve coins
-	if msg.Msg.PoolCoin.Amount.Equal(poolCoinTotalSupply) {
-		withdrawCoins = reserveCoins
-	} else {
-		// Calculate withdraw amount of respective reserve coin considering fees and pool coin's totally supply
-		for _, reserveCoin := range reserveCoins {
-			if err := types.CheckOverflow(reserveCoin.Amount, msg.Msg.PoolCoin.Amount); err != nil {
-				return err
-			}
-			if err := types.CheckOverflow(reserveCoin.Amount.Mul(msg.Msg.PoolCoin.Amount).ToDec().TruncateInt(), poolCoinTotalSupply); err != nil {
-				return err
-			}
-			// WithdrawAmount = ReserveAmount * PoolCoinAmount * WithdrawFeeProportion / TotalSupply
-			withdrawAmtWithFee := reserveCoin.Amount.Mul(msg.Msg.PoolCoin.Amount).ToDec().TruncateInt().Quo(poolCoinTotalSupply)
-			withdrawAmt := reserveCoin.Amount.Mul(msg.Msg.PoolCoin.Amount).ToDec().MulTruncate(withdrawProportion).TruncateInt().Quo(poolCoinTotalSupply)
-			withdrawCoins = append(withdrawCoins, sdk.NewCoin(reserveCoin.Denom, withdrawAmt))
-			withdrawFeeCoins = append(withdrawFeeCoins, sdk.NewCoin(reserveCoin.Denom, withdrawAmtWithFee.Sub(withdrawAmt)))
-		}
+	// the withdraw amount of the reserve coins is calculated by the pool curve of the pool type
+	feeRate := k.GetEffectivePoolFeeRate(ctx, pool.Id)
+	withdrawFeeRate := feeRate.WithdrawFeeRate
+	withdrawCoins, withdrawFeeCoins, err := poolCurve.WithdrawPayout(pool, k.GetParams(ctx), reserveCoins, poolCoinTotalSupply,
+		msg.Msg.PoolCoin.Amount, msg.Msg.WithdrawCoinDenom, feeRate)
+	if err != nil {
+		return err
 	}
 
 	if withdrawCoins.IsValid() {
@@ -419,7 +444,13 @@ func (k Keeper) ExecuteWithdrawal(ctx sdk.Context, msg types.WithdrawMsgState, b
 	msg.ToBeDeleted = true
 	k.SetPoolBatchWithdrawMsgState(ctx, msg.Msg.PoolId, msg)
 
-	if BatchLogicInvariantCheckFlag {
+	if BatchLogicInvariantCheckFlag && len(pool.ReserveCoinWeights) > 0 {
+		WeightedWithdrawInvariant(pool, reserveCoins, withdrawCoins, k.GetReserveCoins(ctx, pool),
+			poolCoins[0].Amount, poolCoinTotalSupply, k.GetPoolCoinTotalSupply(ctx, pool))
+	} else if BatchLogicInvariantCheckFlag && len(reserveCoins) > 2 {
+		MultiAssetWithdrawInvariant(reserveCoins, withdrawCoins, withdrawFeeCoins, k.GetReserveCoins(ctx, pool),
+			poolCoins[0].Amount, poolCoinTotalSupply, k.GetPoolCoinTotalSupply(ctx, pool), withdrawFeeRate)
+	} else if BatchLogicInvariantCheckFlag {
 		afterPoolCoinTotalSupply := k.GetPoolCoinTotalSupply(ctx, pool)
 		afterReserveCoins := k.GetReserveCoins(ctx, pool)
 		afterReserveCoinA := sdk.ZeroInt()
@@ -439,10 +470,15 @@ func (k Keeper) ExecuteWithdrawal(ctx sdk.Context, msg types.WithdrawMsgState, b
 		BurningPoolCoinsInvariant(burnedPoolCoin, withdrawCoinA, withdrawCoinB, reserveCoinA, reserveCoinB, lastPoolCoinTotalSupply, withdrawFeeCoins)
 		WithdrawReserveCoinsInvariant(withdrawCoinA, withdrawCoinB, reserveCoinA, reserveCoinB,
 			afterReserveCoinA, afterReserveCoinB, afterPoolTotalSupply, lastPoolCoinTotalSupply, burnedPoolCoin)
-		WithdrawAmountInvariant(withdrawCoinA, withdrawCoinB, reserveCoinA, reserveCoinB, burnedPoolCoin, lastPoolCoinTotalSupply, params.WithdrawFeeRate)
+		WithdrawAmountInvariant(withdrawCoinA, withdrawCoinB, reserveCoinA, reserveCoinB, burnedPoolCoin, lastPoolCoinTotalSupply, withdrawFeeRate)
 		ImmutablePoolPriceAfterWithdrawInvariant(reserveCoinA, reserveCoinB, withdrawCoinA, withdrawCoinB, afterReserveCoinA, afterReserveCoinB)
 	}
 
+	// the protocol fee is charged on the withdraw fee coins left in the reserve account after the invariant checks
+	if _, err := k.CollectProtocolFee(ctx, pool, withdrawFeeCoins, types.FeeTypeWithdraw); err != nil {
+		return err
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeWithdrawFromPool,
@@ -481,6 +517,152 @@ func (k Keeper) ExecuteWithdrawal(ctx sdk.Context, msg types.WithdrawMsgState, b
 	return nil
 }
 
+// EstimateDepositWithinBatch returns the accepted deposit coins, the refunded deposit coins and the pool coin minted
+// for depositing the coins to the pool at the current reserves, calculated as ExecuteDeposit does without writing to
+// the store.
+func (k Keeper) EstimateDepositWithinBatch(ctx sdk.Context, poolID uint64, depositCoins sdk.Coins) (acceptedCoins, refundedCoins sdk.Coins, poolCoin sdk.Coin, err error) {
+	if err := k.ValidateMsgDepositWithinBatch(ctx, types.MsgDepositWithinBatch{PoolId: poolID, DepositCoins: depositCoins}); err != nil {
+		return nil, nil, sdk.Coin{}, err
+	}
+	pool, _ := k.GetPool(ctx, poolID)
+	depositCoins = depositCoins.Sort()
+	params := k.GetParams(ctx)
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+
+	// the depleted pool is reinitialized with all deposit coins
+	if k.IsDepletedPool(ctx, pool) {
+		if depositCoins.Len() != len(pool.ReserveCoinDenoms) {
+			return nil, nil, sdk.Coin{}, types.ErrNumOfReserveCoin
+		}
+		for _, depositCoin := range depositCoins {
+			if depositCoin.Amount.Add(reserveCoins.AmountOf(depositCoin.Denom)).LT(params.MinInitDepositAmount) {
+				return nil, nil, sdk.Coin{}, types.ErrLessThanMinInitDeposit
+			}
+		}
+		return depositCoins, sdk.NewCoins(), sdk.NewCoin(pool.PoolCoinDenom, params.InitPoolCoinMintAmount), nil
+	}
+
+	reserveCoins.Sort()
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return nil, nil, sdk.Coin{}, types.ErrPoolTypeNotExists
+	}
+	poolCoinMintAmt, acceptedCoins, err := poolCurve.DepositMint(pool, params, reserveCoins, k.GetPoolCoinTotalSupply(ctx, pool),
+		depositCoins, k.GetEffectivePoolFeeRate(ctx, pool.Id))
+	if err != nil {
+		return nil, nil, sdk.Coin{}, err
+	}
+	if !poolCoinMintAmt.IsPositive() || acceptedCoins.IsZero() {
+		return nil, nil, sdk.Coin{}, sdkerrors.Wrap(types.ErrBadDepositCoinsAmount, "pool coin truncated, no accepted coin")
+	}
+	return acceptedCoins, depositCoins.Sub(acceptedCoins), sdk.NewCoin(pool.PoolCoinDenom, poolCoinMintAmt), nil
+}
+
+// EstimateWithdrawWithinBatch returns the reserve coins paid out and the withdraw fee coins for withdrawing the pool
+// coin from the pool at the current reserves, in the withdraw coin denom if given, calculated as ExecuteWithdrawal does
+// without writing to the store.
+func (k Keeper) EstimateWithdrawWithinBatch(ctx sdk.Context, poolID uint64, poolCoin sdk.Coin, withdrawCoinDenom string) (withdrawCoins, withdrawFeeCoins sdk.Coins, err error) {
+	if !poolCoin.IsPositive() {
+		return nil, nil, types.ErrBadPoolCoinAmount
+	}
+	if err := k.ValidateMsgWithdrawWithinBatch(ctx, types.MsgWithdrawWithinBatch{PoolId: poolID, PoolCoin: poolCoin, WithdrawCoinDenom: withdrawCoinDenom}); err != nil {
+		return nil, nil, err
+	}
+	pool, _ := k.GetPool(ctx, poolID)
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	reserveCoins.Sort()
+
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return nil, nil, types.ErrPoolTypeNotExists
+	}
+	withdrawCoins, withdrawFeeCoins, err = poolCurve.WithdrawPayout(pool, k.GetParams(ctx), reserveCoins, k.GetPoolCoinTotalSupply(ctx, pool),
+		poolCoin.Amount, withdrawCoinDenom, k.GetEffectivePoolFeeRate(ctx, pool.Id))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !withdrawCoins.IsValid() {
+		return nil, nil, types.ErrBadPoolCoinAmount
+	}
+	return withdrawCoins, withdrawFeeCoins, nil
+}
+
+// GetLiquidityPositions returns the liquidity positions of the pool coins held by the account and escrowed in its
+// pending withdraw messages in ascending order of the pool id, valued at the current reserves as ExecuteWithdrawal does.
+func (k Keeper) GetLiquidityPositions(ctx sdk.Context, addr sdk.AccAddress) ([]types.LiquidityPosition, error) {
+	positions := map[uint64]*types.LiquidityPosition{}
+	pools := map[uint64]types.Pool{}
+	var poolIDs []uint64
+	getPosition := func(pool types.Pool) *types.LiquidityPosition {
+		position, ok := positions[pool.Id]
+		if !ok {
+			position = &types.LiquidityPosition{
+				PoolId:           pool.Id,
+				PoolCoin:         sdk.NewCoin(pool.PoolCoinDenom, sdk.ZeroInt()),
+				EscrowedPoolCoin: sdk.NewCoin(pool.PoolCoinDenom, sdk.ZeroInt()),
+			}
+			positions[pool.Id] = position
+			pools[pool.Id] = pool
+			poolIDs = append(poolIDs, pool.Id)
+		}
+		return position
+	}
+
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, addr) {
+		reserveAcc, err := types.GetReserveAcc(coin.Denom, false)
+		if err != nil {
+			continue
+		}
+		pool, found := k.GetPoolByReserveAccIndex(ctx, reserveAcc)
+		if !found || pool.PoolCoinDenom != coin.Denom {
+			continue
+		}
+		position := getPosition(pool)
+		position.PoolCoin = coin
+	}
+
+	k.IterateWithdrawMsgStatesByWithdrawer(ctx, addr, func(state types.WithdrawMsgState) bool {
+		if state.Executed {
+			return false
+		}
+		pool, found := k.GetPool(ctx, state.Msg.PoolId)
+		if !found {
+			return false
+		}
+		position := getPosition(pool)
+		position.EscrowedPoolCoin = position.EscrowedPoolCoin.Add(state.Msg.PoolCoin)
+		return false
+	})
+
+	sort.Slice(poolIDs, func(i, j int) bool { return poolIDs[i] < poolIDs[j] })
+
+	result := make([]types.LiquidityPosition, 0, len(poolIDs))
+	for _, poolID := range poolIDs {
+		pool, position := pools[poolID], positions[poolID]
+		position.PoolCoinTotalSupply = k.GetPoolCoinTotalSupply(ctx, pool)
+		poolCoinAmt := position.PoolCoin.Amount.Add(position.EscrowedPoolCoin.Amount)
+		if !position.PoolCoinTotalSupply.IsPositive() || poolCoinAmt.GT(position.PoolCoinTotalSupply) {
+			return nil, sdkerrors.Wrapf(types.ErrBadPoolCoinAmount, "pool coin amount %s of pool %d exceeds the total supply", poolCoinAmt, poolID)
+		}
+		position.Share = poolCoinAmt.ToDec().Quo(position.PoolCoinTotalSupply.ToDec())
+
+		poolCurve, found := types.GetPoolCurve(pool.TypeId)
+		if !found {
+			return nil, types.ErrPoolTypeNotExists
+		}
+		reserveCoins := k.GetReserveCoins(ctx, pool)
+		reserveCoins.Sort()
+		withdrawCoins, withdrawFeeCoins, err := poolCurve.WithdrawPayout(pool, k.GetParams(ctx), reserveCoins, position.PoolCoinTotalSupply,
+			poolCoinAmt, "", k.GetEffectivePoolFeeRate(ctx, pool.Id))
+		if err != nil {
+			return nil, err
+		}
+		position.WithdrawCoins, position.WithdrawFeeCoins = withdrawCoins, withdrawFeeCoins
+		result = append(result, *position)
+	}
+	return result, nil
+}
+
 // GetPoolCoinTotalSupply returns total supply of pool coin of the pool in form of sdk.Int
 func (k Keeper) GetPoolCoinTotalSupply(ctx sdk.Context, pool types.Pool) sdk.Int {
 	return k.bankKeeper.GetSupply(ctx, pool.PoolCoinDenom).Amount
@@ -488,10 +670,11 @@ func (k Keeper) GetPoolCoinTotalSupply(ctx sdk.Context, pool types.Pool) sdk.Int
 
 // IsDepletedPool returns true if the pool is depleted.
 func (k Keeper) IsDepletedPool(ctx sdk.Context, pool types.Pool) bool {
-	reserveCoins := k.GetReserveCoins(ctx, pool)
-	return !k.GetPoolCoinTotalSupply(ctx, pool).IsPositive() ||
-		reserveCoins.AmountOf(pool.ReserveCoinDenoms[0]).IsZero() ||
-		reserveCoins.AmountOf(pool.ReserveCoinDenoms[1]).IsZero()
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return true
+	}
+	return poolCurve.IsDepleted(ctx, k, pool, k.GetReserveCoins(ctx, pool), k.GetPoolCoinTotalSupply(ctx, pool))
 }
 
 // GetPoolCoinTotal returns total supply of pool coin of the pool in form of sdk.Coin
@@ -524,13 +707,28 @@ func (k Keeper) GetPoolRecord(ctx sdk.Context, pool types.Pool) (types.PoolRecor
 	if !found {
 		return types.PoolRecord{}, false
 	}
+	poolFeeRate, _ := k.GetPoolFeeRate(ctx, pool.Id)
+	dynamicSwapFee, _ := k.GetDynamicSwapFee(ctx, pool.Id)
 	return types.PoolRecord{
-		Pool:              pool,
-		PoolMetadata:      k.GetPoolMetaData(ctx, pool),
-		PoolBatch:         batch,
-		DepositMsgStates:  k.GetAllPoolBatchDepositMsgs(ctx, batch),
-		WithdrawMsgStates: k.GetAllPoolBatchWithdrawMsgStates(ctx, batch),
-		SwapMsgStates:     k.GetAllPoolBatchSwapMsgStates(ctx, batch),
+		Pool:                  pool,
+		PoolMetadata:          k.GetPoolMetaData(ctx, pool),
+		PoolBatch:             batch,
+		DepositMsgStates:      k.GetAllPoolBatchDepositMsgs(ctx, batch),
+		WithdrawMsgStates:     k.GetAllPoolBatchWithdrawMsgStates(ctx, batch),
+		SwapMsgStates:         k.GetAllPoolBatchSwapMsgStates(ctx, batch),
+		Positions:             k.GetPositionsByPool(ctx, pool.Id),
+		PoolPrice:             k.GetPoolPrice(ctx, pool.Id),
+		FeeGrowthGlobal:       k.GetFeeGrowthGlobal(ctx, pool.Id),
+		PriceTicks:            k.GetPriceTicksByPool(ctx, pool.Id),
+		BatchResults:          k.GetPoolBatchResults(ctx, pool.Id),
+		PriceAccumulators:     k.GetPriceAccumulators(ctx, pool.Id),
+		RewardPlans:           k.GetRewardPlansByPool(ctx, pool.Id),
+		RewardAccumulator:     k.GetRewardAccumulator(ctx, pool.Id),
+		Stakes:                k.GetStakesByPool(ctx, pool.Id),
+		Bonds:                 k.GetBondsByPoolCoinDenom(ctx, pool.PoolCoinDenom),
+		CollectedProtocolFees: k.GetCollectedProtocolFees(ctx, pool.Id),
+		PoolFeeRate:           poolFeeRate,
+		DynamicSwapFee:        dynamicSwapFee,
 	}, true
 }
 
@@ -544,6 +742,54 @@ func (k Keeper) SetPoolRecord(ctx sdk.Context, record types.PoolRecord) types.Po
 	k.SetPoolBatchDepositMsgStates(ctx, record.Pool.Id, record.DepositMsgStates)
 	k.SetPoolBatchWithdrawMsgStates(ctx, record.Pool.Id, record.WithdrawMsgStates)
 	k.SetPoolBatchSwapMsgStates(ctx, record.Pool.Id, record.SwapMsgStates)
+	for _, position := range record.Positions {
+		k.SetPosition(ctx, position)
+		if position.Id >= k.GetNextPositionID(ctx) {
+			k.SetNextPositionID(ctx, position.Id+1)
+		}
+	}
+	if !record.PoolPrice.IsNil() && record.PoolPrice.IsPositive() {
+		k.SetPoolPrice(ctx, record.Pool.Id, record.PoolPrice)
+	}
+	if record.Pool.TypeId == types.ConcentratedPoolTypeID {
+		k.SetFeeGrowthGlobal(ctx, record.Pool.Id, types.ZeroFeeGrowth().Add(record.FeeGrowthGlobal))
+	}
+	for _, tick := range record.PriceTicks {
+		k.SetPriceTick(ctx, tick)
+	}
+	for _, result := range record.BatchResults {
+		k.SetPoolBatchResult(ctx, result)
+	}
+	for _, accumulator := range record.PriceAccumulators {
+		k.SetPriceAccumulator(ctx, accumulator)
+	}
+	for _, plan := range record.RewardPlans {
+		k.SetRewardPlan(ctx, plan)
+		if plan.Id >= k.GetNextRewardPlanID(ctx) {
+			k.SetNextRewardPlanID(ctx, plan.Id+1)
+		}
+	}
+	if record.RewardAccumulator.PoolId != 0 {
+		k.SetRewardAccumulator(ctx, record.RewardAccumulator)
+	}
+	for _, stake := range record.Stakes {
+		k.SetStake(ctx, stake)
+	}
+	for _, bond := range record.Bonds {
+		k.SetBond(ctx, bond)
+		if bond.Id >= k.GetNextBondID(ctx) {
+			k.SetNextBondID(ctx, bond.Id+1)
+		}
+	}
+	if record.CollectedProtocolFees.PoolId != 0 {
+		k.SetCollectedProtocolFees(ctx, record.CollectedProtocolFees)
+	}
+	if record.PoolFeeRate.PoolId != 0 {
+		k.SetPoolFeeRate(ctx, record.PoolFeeRate)
+	}
+	if record.DynamicSwapFee.PoolId != 0 {
+		k.SetDynamicSwapFee(ctx, record.DynamicSwapFee)
+	}
 	return record
 }
 
@@ -602,16 +848,19 @@ func (k Keeper) RefundWithdrawal(ctx sdk.Context, batchMsg types.WithdrawMsgStat
 	return nil
 }
 
-// TransactAndRefundSwapLiquidityPool transacts, refunds, expires, sends coins with escrow, update state by TransactAndRefundSwapLiquidityPool
+// TransactAndRefundSwapLiquidityPool transacts, refunds, expires, sends coins with escrow, update state by TransactAndRefundSwapLiquidityPool.
+// The matched orders refunded for receiving less than their minimum demand coin amount are removed from matchResultMap,
+// and the matched exact-output orders are replaced by the match results sized to their demand coin amounts. It returns
+// the next hops of the transacted hops of swap routes, to be appended by SwapRouteNextHop.
 func (k Keeper) TransactAndRefundSwapLiquidityPool(ctx sdk.Context, swapMsgStates []*types.SwapMsgState,
-	matchResultMap map[uint64]types.MatchResult, pool types.Pool, batchResult types.BatchResult) error {
+	matchResultMap map[uint64]types.MatchResult, pool types.Pool, batchResult types.BatchResult) ([]types.SwapRouteHop, error) {
 	var inputs []banktypes.Input
 	var outputs []banktypes.Output
 	batchEscrowAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
 	poolReserveAcc := pool.GetReserveAccount()
 	batch, found := k.GetPoolBatch(ctx, pool.Id)
 	if !found {
-		return types.ErrPoolBatchNotExists
+		return nil, types.ErrPoolBatchNotExists
 	}
 	sendCoin := func(from, to sdk.AccAddress, coin sdk.Coin) {
 		coins := sdk.NewCoins(coin)
@@ -620,19 +869,23 @@ func (k Keeper) TransactAndRefundSwapLiquidityPool(ctx sdk.Context, swapMsgState
 			outputs = append(outputs, banktypes.NewOutput(to, coins))
 		}
 	}
+	// the swap fees collected in the reserve account, charged the protocol fee after the transactions
+	feeCoins := sdk.NewCoins()
+	// the demand coins exchanged by the hops of swap routes are held in escrow for their next hops
+	var routeHops []types.SwapRouteHop
 	for _, sms := range swapMsgStates {
 		if pool.Id != sms.Msg.PoolId {
-			return fmt.Errorf("broken msg pool consistency")
+			return nil, fmt.Errorf("broken msg pool consistency")
 		}
 		if !sms.Executed && sms.Succeeded {
-			return fmt.Errorf("can't refund not executed with succeed msg")
+			return nil, fmt.Errorf("can't refund not executed with succeed msg")
 		}
 		if sms.RemainingOfferCoin.IsNegative() {
-			return fmt.Errorf("negative RemainingOfferCoin")
+			return nil, fmt.Errorf("negative RemainingOfferCoin")
 		} else if sms.RemainingOfferCoin.IsPositive() &&
 			((!sms.ToBeDeleted && sms.OrderExpiryHeight <= ctx.BlockHeight()) ||
 				(sms.ToBeDeleted && sms.OrderExpiryHeight != ctx.BlockHeight())) {
-			return fmt.Errorf("consistency of OrderExpiryHeight and ToBeDeleted flag is broken")
+			return nil, fmt.Errorf("consistency of OrderExpiryHeight and ToBeDeleted flag is broken")
 		}
 
 		if match, ok := matchResultMap[sms.MsgIndex]; ok {
@@ -640,11 +893,31 @@ func (k Keeper) TransactAndRefundSwapLiquidityPool(ctx sdk.Context, swapMsgState
 			receiveAmt := match.ExchangedDemandCoinAmt.Sub(match.ExchangedCoinFeeAmt).TruncateInt()
 			offerCoinFeeAmt := match.OfferCoinFeeAmt.TruncateInt()
 
+			// the exact-output order is sized to its demand coin amount before matching, and the demand coin exchanged
+			// beyond the amount by the rounding of the sized offer coin is kept by the pool
+			exactOutput := sms.Msg.SwapTypeId == types.ExactOutputSwapTypeID
+			if exactOutput {
+				receiveAmt = sms.Msg.DemandCoinAmount
+				sms.ToBeDeleted = true
+			}
+
+			receiver := sms.Msg.GetSwapRequester()
+			if sms.Route != nil && len(sms.Route.PoolIds) > 0 {
+				receiver = batchEscrowAcc
+				routeHops = append(routeHops, types.SwapRouteHop{
+					SwapMsgState:  sms,
+					ExchangedCoin: sdk.NewCoin(sms.Msg.DemandCoinDenom, receiveAmt),
+				})
+			}
+
 			sendCoin(batchEscrowAcc, poolReserveAcc, sdk.NewCoin(sms.Msg.OfferCoin.Denom, transactedAmt))
-			sendCoin(poolReserveAcc, sms.Msg.GetSwapRequester(), sdk.NewCoin(sms.Msg.DemandCoinDenom, receiveAmt))
+			sendCoin(poolReserveAcc, receiver, sdk.NewCoin(sms.Msg.DemandCoinDenom, receiveAmt))
 			sendCoin(batchEscrowAcc, poolReserveAcc, sdk.NewCoin(sms.Msg.OfferCoin.Denom, offerCoinFeeAmt))
+			feeCoins = feeCoins.
+				Add(sdk.NewCoin(sms.Msg.OfferCoin.Denom, offerCoinFeeAmt)).
+				Add(sdk.NewCoin(sms.Msg.DemandCoinDenom, match.ExchangedCoinFeeAmt.TruncateInt()))
 
-			if sms.RemainingOfferCoin.Add(sms.ReservedOfferCoinFee).IsPositive() && sms.OrderExpiryHeight == ctx.BlockHeight() {
+			if sms.RemainingOfferCoin.Add(sms.ReservedOfferCoinFee).IsPositive() && (sms.OrderExpiryHeight == ctx.BlockHeight() || exactOutput) {
 				sendCoin(batchEscrowAcc, sms.Msg.GetSwapRequester(), sms.RemainingOfferCoin.Add(sms.ReservedOfferCoinFee))
 			}
 
@@ -676,6 +949,9 @@ func (k Keeper) TransactAndRefundSwapLiquidityPool(ctx sdk.Context, swapMsgState
 					sdk.NewAttribute(types.AttributeValueOrderExpiryHeight, strconv.FormatInt(sms.OrderExpiryHeight, 10)),
 					sdk.NewAttribute(types.AttributeValueSuccess, types.Success),
 				))
+		} else if sms.OrderExpiryHeight > ctx.BlockHeight() {
+			// Not matched, carried forward to the next batch within the order lifespan
+			sms.Succeeded = false
 		} else {
 			// Not matched, remaining
 			sendCoin(batchEscrowAcc, sms.Msg.GetSwapRequester(), sms.RemainingOfferCoin.Add(sms.ReservedOfferCoinFee))
@@ -705,10 +981,13 @@ func (k Keeper) TransactAndRefundSwapLiquidityPool(ctx sdk.Context, swapMsgState
 		}
 	}
 	if err := k.bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
-		return err
+		return nil, err
+	}
+	if _, err := k.CollectProtocolFee(ctx, pool, feeCoins, types.FeeTypeSwap); err != nil {
+		return nil, err
 	}
 	k.SetPoolBatchSwapMsgStatesByPointer(ctx, pool.Id, swapMsgStates)
-	return nil
+	return routeHops, nil
 }
 
 func (k Keeper) RefundSwaps(ctx sdk.Context, pool types.Pool, swapMsgStates []*types.SwapMsgState) error {
@@ -735,6 +1014,55 @@ func (k Keeper) RefundSwaps(ctx sdk.Context, pool types.Pool, swapMsgStates []*t
 	return nil
 }
 
+// RefundAndDeleteSwaps refunds the remaining offer coins and the reserved offer coin fees of the swap orders that are
+// not executable anymore, such as the expired, the canceled, or the orders carried forward to a depleted pool, and
+// marks them to be deleted.
+func (k Keeper) RefundAndDeleteSwaps(ctx sdk.Context, poolID uint64, swapMsgStates []*types.SwapMsgState) error {
+	var inputs []banktypes.Input
+	var outputs []banktypes.Output
+	batchEscrowAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	for _, sms := range swapMsgStates {
+		coins := sdk.NewCoins(sms.RemainingOfferCoin.Add(sms.ReservedOfferCoinFee))
+		if !coins.Empty() && coins.IsValid() {
+			inputs = append(inputs, banktypes.NewInput(batchEscrowAcc, coins))
+			outputs = append(outputs, banktypes.NewOutput(sms.Msg.GetSwapRequester(), coins))
+		}
+		sms.Succeeded = false
+		sms.ToBeDeleted = true
+	}
+	if err := k.bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+	k.SetPoolBatchSwapMsgStatesByPointer(ctx, poolID, swapMsgStates)
+	return nil
+}
+
+// RefundCanceledSwaps refunds the remaining offer coins and the unused reserved offer coin fees of the swap orders
+// canceled by their swap requesters since the last batch, and returns the number of the canceled orders.
+func (k Keeper) RefundCanceledSwaps(ctx sdk.Context, poolBatch types.PoolBatch) (uint64, error) {
+	swapMsgStates := k.GetAllCanceledPoolBatchSwapMsgStates(ctx, poolBatch)
+	for _, sms := range swapMsgStates {
+		sms.Executed = true
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapCanceled,
+				sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(poolBatch.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+				sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(sms.MsgIndex, 10)),
+				sdk.NewAttribute(types.AttributeValueSwapRequester, sms.Msg.GetSwapRequester().String()),
+				sdk.NewAttribute(types.AttributeValueOfferCoinDenom, sms.Msg.OfferCoin.Denom),
+				sdk.NewAttribute(types.AttributeValueRemainingOfferCoinAmount, sms.RemainingOfferCoin.Amount.String()),
+				sdk.NewAttribute(types.AttributeValueExchangedOfferCoinAmount, sms.ExchangedOfferCoin.Amount.String()),
+				sdk.NewAttribute(types.AttributeValueReservedOfferCoinFeeAmount, sms.ReservedOfferCoinFee.Amount.String()),
+				sdk.NewAttribute(types.AttributeValueOrderExpiryHeight, strconv.FormatInt(sms.OrderExpiryHeight, 10)),
+			))
+	}
+	if err := k.RefundAndDeleteSwaps(ctx, poolBatch.PoolId, swapMsgStates); err != nil {
+		return 0, err
+	}
+	return uint64(len(swapMsgStates)), nil
+}
+
 // ValidateMsgDepositWithinBatch validates MsgDepositWithinBatch
 func (k Keeper) ValidateMsgDepositWithinBatch(ctx sdk.Context, msg types.MsgDepositWithinBatch) error {
 	pool, found := k.GetPool(ctx, msg.PoolId)
@@ -742,21 +1070,17 @@ func (k Keeper) ValidateMsgDepositWithinBatch(ctx sdk.Context, msg types.MsgDepo
 		return types.ErrPoolNotExists
 	}
 
-	if msg.DepositCoins.Len() != len(pool.ReserveCoinDenoms) {
-		return types.ErrNumOfReserveCoin
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return types.ErrPoolTypeNotExists
 	}
-
-	params := k.GetParams(ctx)
-	reserveCoins := k.GetReserveCoins(ctx, pool)
-	if err := types.ValidateReserveCoinLimit(params.MaxReserveCoinAmount, reserveCoins.Add(msg.DepositCoins...)); err != nil {
+	if err := poolCurve.ValidateDepositCoins(pool, msg.DepositCoins); err != nil {
 		return err
 	}
 
-	denomA, denomB := types.AlphabeticalDenomPair(msg.DepositCoins[0].Denom, msg.DepositCoins[1].Denom)
-	if denomA != pool.ReserveCoinDenoms[0] || denomB != pool.ReserveCoinDenoms[1] {
-		return types.ErrNotMatchedReserveCoin
-	}
-	return nil
+	params := k.GetParams(ctx)
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	return types.ValidateReserveCoinLimit(params.MaxReserveCoinAmount, reserveCoins.Add(msg.DepositCoins...))
 }
 
 // ValidateMsgWithdrawWithinBatch validates MsgWithdrawWithinBatch
@@ -766,6 +1090,14 @@ func (k Keeper) ValidateMsgWithdrawWithinBatch(ctx sdk.Context, msg types.MsgWit
 		return types.ErrPoolNotExists
 	}
 
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return types.ErrPoolTypeNotExists
+	}
+	if err := poolCurve.ValidateWithdrawCoinDenom(pool, msg.WithdrawCoinDenom); err != nil {
+		return err
+	}
+
 	if msg.PoolCoin.Denom != pool.PoolCoinDenom {
 		return types.ErrBadPoolCoinDenom
 	}
@@ -781,36 +1113,82 @@ func (k Keeper) ValidateMsgWithdrawWithinBatch(ctx sdk.Context, msg types.MsgWit
 	return nil
 }
 
-// ValidateMsgSwapWithinBatch validates MsgSwapWithinBatch.
+// ValidateMsgSwapWithinBatch validates MsgSwapWithinBatch when the order is submitted. The order price is bound to the
+// price band and the offer coin fee to the swap fee rate only here, so an accepted order is kept through its lifespan
+// even if the pool price or the swap fee rate changes before the order is executed.
 func (k Keeper) ValidateMsgSwapWithinBatch(ctx sdk.Context, msg types.MsgSwapWithinBatch, pool types.Pool) error {
-	denomA, denomB := types.AlphabeticalDenomPair(msg.OfferCoin.Denom, msg.DemandCoinDenom)
-	if denomA != pool.ReserveCoinDenoms[0] || denomB != pool.ReserveCoinDenoms[1] {
+	if err := k.ValidateSwapExecution(ctx, msg, pool); err != nil {
+		return err
+	}
+
+	// the order price can not deviate from the pool price beyond the max order price deviation when the order is
+	// submitted, so that absurd order prices can not swing the price direction of the batch. The pending orders left
+	// beyond the band by the later pool price are clamped to the band when matched instead.
+	if params := k.GetParams(ctx); params.MaxOrderPriceDeviation.IsPositive() {
+		denomX, denomY := types.AlphabeticalDenomPair(msg.OfferCoin.Denom, msg.DemandCoinDenom)
+		poolPrice := k.GetPairPrice(ctx, pool, denomX, denomY)
+		if poolPrice.IsPositive() && !types.IsWithinPriceBand(msg.OrderPrice, poolPrice, params.MaxOrderPriceDeviation) {
+			lower, upper := types.PriceBand(poolPrice, params.MaxOrderPriceDeviation)
+			return sdkerrors.Wrapf(types.ErrOrderPriceOutOfBand, "order price %s is not between %s and %s", msg.OrderPrice, lower, upper)
+		}
+	}
+
+	if msg.OfferCoinFee.Denom != msg.OfferCoin.Denom {
+		return types.ErrBadOfferCoinFee
+	}
+
+	// while the dynamic swap fee is enabled, the offer coin fee may be reserved at any rate up to the max swap fee rate
+	// so that the orders stay valid while the swap fee rate changes between batches
+	minOfferCoinFee := types.GetOfferCoinFee(msg.OfferCoin, k.GetSwapFeeRate(ctx, pool.Id))
+	maxOfferCoinFee := types.GetOfferCoinFee(msg.OfferCoin, k.GetMaxSwapFeeRate(ctx, pool.Id))
+	if msg.OfferCoinFee.IsLT(minOfferCoinFee) || maxOfferCoinFee.IsLT(msg.OfferCoinFee) {
+		return types.ErrBadOfferCoinFee
+	}
+
+	if msg.SwapTypeId == types.ExactOutputSwapTypeID {
+		// the offer coin without the offer coin fee should be enough for the demand coin amount at the order price
+		offerAmt := msg.OfferCoin.Amount.Sub(msg.OfferCoinFee.Amount).ToDec()
+		denomX, _ := types.AlphabeticalDenomPair(msg.OfferCoin.Denom, msg.DemandCoinDenom)
+		if msg.OfferCoin.Denom == denomX {
+			offerAmt = offerAmt.Quo(msg.OrderPrice)
+		} else {
+			offerAmt = offerAmt.Mul(msg.OrderPrice)
+		}
+		if offerAmt.LT(msg.DemandCoinAmount.ToDec()) {
+			return types.ErrBadDemandCoinAmount
+		}
+	}
+
+	return nil
+}
+
+// ValidateSwapExecution validates the swap order against the current state of the pool, both when the order is
+// submitted and again before every batch execution while the order is pending.
+func (k Keeper) ValidateSwapExecution(ctx sdk.Context, msg types.MsgSwapWithinBatch, pool types.Pool) error {
+	if msg.OfferCoin.Denom == msg.DemandCoinDenom ||
+		!pool.HasReserveCoinDenom(msg.OfferCoin.Denom) || !pool.HasReserveCoinDenom(msg.DemandCoinDenom) {
 		return types.ErrNotMatchedReserveCoin
 	}
 
 	params := k.GetParams(ctx)
 
 	// can not exceed max order ratio  of reserve coins that can be ordered at a order
-	reserveCoinAmt := k.GetReserveCoins(ctx, pool).AmountOf(msg.OfferCoin.Denom)
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return types.ErrPoolTypeNotExists
+	}
+	reserveCoinAmt := poolCurve.MaxOrderReserve(pool, k.GetReserveCoins(ctx, pool), msg.OfferCoin.Denom, msg.DemandCoinDenom, msg.OrderPrice)
 
 	// Decimal Error, Multiply the Int coin amount by the Decimal Rate and erase the decimal point to order a lower value
-	maximumOrderableAmt := reserveCoinAmt.ToDec().MulTruncate(params.MaxOrderAmountRatio).TruncateInt()
+	maximumOrderableAmt := reserveCoinAmt.MulTruncate(params.MaxOrderAmountRatio).TruncateInt()
 	if msg.OfferCoin.Amount.GT(maximumOrderableAmt) {
 		return types.ErrExceededMaxOrderable
 	}
 
-	if msg.OfferCoinFee.Denom != msg.OfferCoin.Denom {
-		return types.ErrBadOfferCoinFee
-	}
-
 	if err := types.CheckOverflowWithDec(msg.OfferCoin.Amount.ToDec(), msg.OrderPrice); err != nil {
 		return err
 	}
 
-	if !msg.OfferCoinFee.Equal(types.GetOfferCoinFee(msg.OfferCoin, params.SwapFeeRate)) {
-		return types.ErrBadOfferCoinFee
-	}
-
 	return nil
 }
 
@@ -847,9 +1225,18 @@ func (k Keeper) ValidatePool(ctx sdk.Context, pool *types.Pool) error {
 		}
 	}
 
-	denomA, denomB := types.AlphabeticalDenomPair(pool.ReserveCoinDenoms[0], pool.ReserveCoinDenoms[1])
-	if denomA != pool.ReserveCoinDenoms[0] || denomB != pool.ReserveCoinDenoms[1] {
-		return types.ErrBadOrderingReserveCoin
+	for i := 1; i < len(pool.ReserveCoinDenoms); i++ {
+		if pool.ReserveCoinDenoms[i-1] >= pool.ReserveCoinDenoms[i] {
+			return types.ErrBadOrderingReserveCoin
+		}
+	}
+
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return types.ErrPoolTypeNotExists
+	}
+	if err := poolCurve.ValidatePool(*pool); err != nil {
+		return err
 	}
 
 	poolName := types.PoolName(pool.ReserveCoinDenoms, pool.TypeId)
@@ -858,7 +1245,7 @@ func (k Keeper) ValidatePool(ctx sdk.Context, pool *types.Pool) error {
 		return types.ErrBadPoolCoinDenom
 	}
 
-	_, found := k.GetPoolBatch(ctx, pool.Id)
+	_, found = k.GetPoolBatch(ctx, pool.Id)
 	if !found {
 		return types.ErrPoolBatchNotExists
 	}
@@ -900,7 +1287,22 @@ func (k Keeper) ValidatePoolRecord(ctx sdk.Context, record types.PoolRecord) err
 		return types.ErrBadBatchMsgIndex
 	}
 
-	return nil
+	if err := record.ValidatePositions(); err != nil {
+		return err
+	}
+	if err := record.ValidateRewards(); err != nil {
+		return err
+	}
+	if err := record.ValidateBonds(); err != nil {
+		return err
+	}
+	if err := record.ValidateCollectedProtocolFees(); err != nil {
+		return err
+	}
+	if err := record.ValidatePoolFeeRate(); err != nil {
+		return err
+	}
+	return record.ValidateDynamicSwapFee()
 }
 
 // IsPoolCoinDenom returns true if the denom is a valid pool coin denom.
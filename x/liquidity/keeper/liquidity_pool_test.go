@@ -56,10 +56,14 @@ func TestCreatePool(t *testing.T) {
 	_, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
 	require.NoError(t, err)
 
-	invalidMsg := types.NewMsgCreatePool(addrs[0], 2, depositBalance)
+	invalidMsg := types.NewMsgCreatePool(addrs[0], uint32(len(params.PoolTypes)+1), depositBalance)
 	_, err = simapp.LiquidityKeeper.CreatePool(ctx, invalidMsg)
 	require.ErrorIs(t, err, types.ErrPoolTypeNotExists)
 
+	invalidMsg = types.NewMsgCreatePool(addrs[0], types.MultiAssetPoolTypeID, depositBalance)
+	_, err = simapp.LiquidityKeeper.CreatePool(ctx, invalidMsg)
+	require.ErrorIs(t, err, types.ErrNumOfReserveCoin)
+
 	pools := simapp.LiquidityKeeper.GetAllPools(ctx)
 	require.Equal(t, 1, len(pools))
 	require.Equal(t, uint64(1), pools[0].Id)
@@ -1321,3 +1325,238 @@ func TestExecuteBigDeposit(t *testing.T) {
 	require.Equal(t, sdk.NewInt(-4), balanceAfter.AmountOf(denomA).SubRaw(hugeInt))
 	require.Equal(t, sdk.NewInt(-4), balanceAfter.AmountOf(denomB).SubRaw(hugeInt))
 }
+
+func TestMultiAssetPool(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	reserveCoins := sdk.NewCoins(
+		sdk.NewCoin("uusdc", sdk.NewInt(1000*1000000)),
+		sdk.NewCoin("uusdt", sdk.NewInt(2000*1000000)),
+		sdk.NewCoin("uust", sdk.NewInt(4000*1000000)),
+	)
+	creator := app.AddRandomTestAddr(simapp, ctx, reserveCoins.Add(params.PoolCreationFee...))
+
+	_, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.DefaultPoolTypeID, reserveCoins))
+	require.ErrorIs(t, err, types.ErrNumOfReserveCoin)
+
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.MultiAssetPoolTypeID, reserveCoins))
+	require.NoError(t, err)
+	require.Equal(t, []string{"uusdc", "uusdt", "uust"}, pool.ReserveCoinDenoms)
+	require.Equal(t, reserveCoins, simapp.LiquidityKeeper.GetReserveCoins(ctx, pool))
+	require.NoError(t, simapp.LiquidityKeeper.ValidatePool(ctx, &pool))
+
+	// deposits must contain every reserve coin of the pool
+	depositor := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(
+		sdk.NewCoin("uusdc", sdk.NewInt(100*1000000)),
+		sdk.NewCoin("uusdt", sdk.NewInt(100*1000000)),
+		sdk.NewCoin("uust", sdk.NewInt(400*1000000)),
+	))
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(depositor, pool.Id, sdk.NewCoins(
+		sdk.NewCoin("uusdc", sdk.NewInt(100*1000000)),
+		sdk.NewCoin("uusdt", sdk.NewInt(100*1000000)),
+	)))
+	require.ErrorIs(t, err, types.ErrNumOfReserveCoin)
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(depositor, pool.Id, sdk.NewCoins(
+		sdk.NewCoin("uusdc", sdk.NewInt(100*1000000)),
+		sdk.NewCoin("uusdt", sdk.NewInt(100*1000000)),
+		sdk.NewCoin("uust", sdk.NewInt(400*1000000)),
+	)))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the deposit is bounded by uusdt, which has the smallest deposit ratio of 5%
+	poolCoin := simapp.BankKeeper.GetBalance(ctx, depositor, pool.PoolCoinDenom)
+	require.Equal(t, params.InitPoolCoinMintAmount.QuoRaw(20), poolCoin.Amount)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewCoin("uusdc", sdk.NewInt(50*1000000)),
+		sdk.NewCoin("uusdt", sdk.NewInt(0)),
+		sdk.NewCoin("uust", sdk.NewInt(200*1000000)),
+	), simapp.BankKeeper.GetAllBalances(ctx, depositor).Sub(sdk.NewCoins(poolCoin)))
+
+	// withdrawal pays out every reserve coin proportionally
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(depositor, pool.Id, poolCoin))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewCoin("uusdc", sdk.NewInt(100*1000000)),
+		sdk.NewCoin("uusdt", sdk.NewInt(100*1000000)),
+		sdk.NewCoin("uust", sdk.NewInt(400*1000000)),
+	), simapp.BankKeeper.GetAllBalances(ctx, depositor))
+	require.Equal(t, reserveCoins, simapp.LiquidityKeeper.GetReserveCoins(ctx, pool))
+}
+
+func TestEstimateDepositAndWithdrawWithinBatch(t *testing.T) {
+	simapp, ctx, pool, creatorAddr, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 2000000))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the deposit coins over the reserve coin ratio are refunded
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin(DenomX, 10000), sdk.NewInt64Coin(DenomY, 30000))
+	acceptedCoins, refundedCoins, poolCoin, err := simapp.LiquidityKeeper.EstimateDepositWithinBatch(ctx, pool.Id, depositCoins)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomX, 10000), sdk.NewInt64Coin(DenomY, 20000)), acceptedCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomY, 10000)), refundedCoins)
+	require.True(t, poolCoin.IsPositive())
+
+	_, _, _, err = simapp.LiquidityKeeper.EstimateDepositWithinBatch(ctx, pool.Id, sdk.NewCoins(sdk.NewInt64Coin(DenomX, 10000)))
+	require.ErrorIs(t, err, types.ErrNumOfReserveCoin)
+
+	// the estimated deposit is the one executed by the batch
+	depositor := app.AddRandomTestAddr(simapp, ctx, depositCoins)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(depositor, pool.Id, depositCoins))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, refundedCoins.Add(poolCoin), simapp.BankKeeper.GetAllBalances(ctx, depositor))
+
+	ctx = ctx.WithBlockHeight(2)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.WithdrawFeeRate = sdk.NewDecWithPrec(3, 3)
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+
+	withdrawPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, simapp.BankKeeper.GetBalance(ctx, creatorAddr, pool.PoolCoinDenom).Amount.QuoRaw(2))
+	withdrawCoins, withdrawFeeCoins, err := simapp.LiquidityKeeper.EstimateWithdrawWithinBatch(ctx, pool.Id, withdrawPoolCoin, "")
+	require.NoError(t, err)
+	require.True(t, withdrawFeeCoins.IsAllPositive())
+
+	_, _, err = simapp.LiquidityKeeper.EstimateWithdrawWithinBatch(ctx, pool.Id, sdk.NewCoin(pool.PoolCoinDenom, simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool).AddRaw(1)), "")
+	require.ErrorIs(t, err, types.ErrBadPoolCoinAmount)
+
+	// the estimated withdrawal is the one executed by the batch
+	balances := simapp.BankKeeper.GetAllBalances(ctx, creatorAddr)
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(creatorAddr, pool.Id, withdrawPoolCoin))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, balances.Sub(sdk.NewCoins(withdrawPoolCoin)).Add(withdrawCoins...), simapp.BankKeeper.GetAllBalances(ctx, creatorAddr))
+}
+
+func TestWeightedPoolSingleSidedDepositAndWithdraw(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	reserveCoins := sdk.NewCoins(sdk.NewInt64Coin(DenomX, 800_000_000), sdk.NewInt64Coin(DenomY, 200_000_000))
+	creator := app.AddRandomTestAddr(simapp, ctx, reserveCoins.Add(params.PoolCreationFee...))
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreateWeightedPool(creator, reserveCoins, []uint32{80, 20}))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// a single reserve coin is deposited to the weighted pool and accepted in full
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin(DenomX, 1_000_000))
+	acceptedCoins, refundedCoins, poolCoin, err := simapp.LiquidityKeeper.EstimateDepositWithinBatch(ctx, pool.Id, depositCoins)
+	require.NoError(t, err)
+	require.Equal(t, depositCoins, acceptedCoins)
+	require.True(t, refundedCoins.IsZero())
+
+	// the part over the weighted ratio is charged the swap fee, so it mints less than the balanced deposit
+	_, _, balancedPoolCoin, err := simapp.LiquidityKeeper.EstimateDepositWithinBatch(ctx, pool.Id,
+		sdk.NewCoins(sdk.NewInt64Coin(DenomX, 800_000), sdk.NewInt64Coin(DenomY, 200_000)))
+	require.NoError(t, err)
+	require.True(t, poolCoin.Amount.LT(balancedPoolCoin.Amount))
+
+	depositor := app.AddRandomTestAddr(simapp, ctx, depositCoins)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(depositor, pool.Id, depositCoins))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, sdk.NewCoins(poolCoin), simapp.BankKeeper.GetAllBalances(ctx, depositor))
+	require.Equal(t, reserveCoins.Add(depositCoins...), simapp.LiquidityKeeper.GetReserveCoins(ctx, pool))
+
+	ctx = ctx.WithBlockHeight(2)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the proportional withdrawal pays out every reserve coin, and the single-coin withdrawal only the withdraw coin
+	withdrawCoins, _, err := simapp.LiquidityKeeper.EstimateWithdrawWithinBatch(ctx, pool.Id, poolCoin, DenomY)
+	require.NoError(t, err)
+	require.Len(t, withdrawCoins, 1)
+	require.True(t, withdrawCoins.AmountOf(DenomY).IsPositive())
+	_, _, err = simapp.LiquidityKeeper.EstimateWithdrawWithinBatch(ctx, pool.Id, poolCoin, "denomz")
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+
+	msg := types.NewMsgWithdrawWithinBatch(depositor, pool.Id, poolCoin)
+	msg.WithdrawCoinDenom = DenomY
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, msg)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, withdrawCoins, simapp.BankKeeper.GetAllBalances(ctx, depositor))
+
+	// the round trip through the pool costs the swap fees, so it pays out less than the deposited value
+	lastPrice := types.WeightedPoolPrice(reserveCoins.AmountOf(DenomX).ToDec(), reserveCoins.AmountOf(DenomY).ToDec(), sdk.NewDec(80), sdk.NewDec(20))
+	require.True(t, withdrawCoins.AmountOf(DenomY).ToDec().Mul(lastPrice).LT(depositCoins.AmountOf(DenomX).ToDec()))
+	require.True(t, withdrawCoins.AmountOf(DenomY).GT(sdk.NewInt(990_000)), withdrawCoins.String())
+
+	// a coin out of the reserve coins can not be deposited
+	_, _, _, err = simapp.LiquidityKeeper.EstimateDepositWithinBatch(ctx, pool.Id, sdk.NewCoins(sdk.NewInt64Coin("denomz", 1000)))
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+}
+
+func TestGetLiquidityPositions(t *testing.T) {
+	simapp, ctx, pool, creatorAddr, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 2000000))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	params.WithdrawFeeRate = sdk.NewDecWithPrec(3, 3)
+	k.SetParams(ctx, params)
+
+	// the creator holds the whole pool coin supply, withdrawable without the withdraw fee
+	positions, err := k.GetLiquidityPositions(ctx, creatorAddr)
+	require.NoError(t, err)
+	require.Len(t, positions, 1)
+	require.Equal(t, pool.Id, positions[0].PoolId)
+	require.Equal(t, k.GetPoolCoinTotal(ctx, pool), positions[0].PoolCoin)
+	require.True(t, positions[0].EscrowedPoolCoin.IsZero())
+	require.Equal(t, sdk.OneDec(), positions[0].Share)
+	require.Equal(t, k.GetReserveCoins(ctx, pool), positions[0].WithdrawCoins)
+	require.True(t, positions[0].WithdrawFeeCoins.IsZero())
+
+	// the holder escrows a part of the pool coin in a pending withdraw message and the whole supply of another pool
+	reserveCoins := sdk.NewCoins(sdk.NewInt64Coin(DenomA, 1000000), sdk.NewInt64Coin(DenomB, 1000000))
+	holder := app.AddRandomTestAddr(simapp, ctx, reserveCoins.Add(params.PoolCreationFee...))
+	otherPool, err := k.CreatePool(ctx, types.NewMsgCreatePool(holder, types.DefaultPoolTypeID, reserveCoins))
+	require.NoError(t, err)
+	heldPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, k.GetPoolCoinTotalSupply(ctx, pool).QuoRaw(2))
+	require.NoError(t, simapp.BankKeeper.SendCoins(ctx, creatorAddr, holder, sdk.NewCoins(heldPoolCoin)))
+	withdrawCoins, withdrawFeeCoins, err := k.EstimateWithdrawWithinBatch(ctx, pool.Id, heldPoolCoin, "")
+	require.NoError(t, err)
+
+	escrowedPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, heldPoolCoin.Amount.QuoRaw(5))
+	_, err = k.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(holder, pool.Id, escrowedPoolCoin))
+	require.NoError(t, err)
+	otherPoolCoin := k.GetPoolCoinTotal(ctx, otherPool)
+	_, err = k.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(holder, otherPool.Id, otherPoolCoin))
+	require.NoError(t, err)
+
+	positions, err = k.GetLiquidityPositions(ctx, holder)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+	require.Equal(t, pool.Id, positions[0].PoolId)
+	require.Equal(t, heldPoolCoin.Sub(escrowedPoolCoin), positions[0].PoolCoin)
+	require.Equal(t, escrowedPoolCoin, positions[0].EscrowedPoolCoin)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), positions[0].Share)
+	require.Equal(t, withdrawCoins, positions[0].WithdrawCoins)
+	require.Equal(t, withdrawFeeCoins, positions[0].WithdrawFeeCoins)
+	require.Equal(t, otherPool.Id, positions[1].PoolId)
+	require.True(t, positions[1].PoolCoin.IsZero())
+	require.Equal(t, otherPoolCoin, positions[1].EscrowedPoolCoin)
+	require.Equal(t, sdk.OneDec(), positions[1].Share)
+	require.Equal(t, reserveCoins, positions[1].WithdrawCoins)
+
+	// the pool coins of the executed withdrawals are no longer escrowed
+	liquidity.EndBlocker(ctx, k)
+	positions, err = k.GetLiquidityPositions(ctx, holder)
+	require.NoError(t, err)
+	require.Len(t, positions, 1)
+	require.Equal(t, heldPoolCoin.Sub(escrowedPoolCoin), positions[0].PoolCoin)
+	require.True(t, positions[0].EscrowedPoolCoin.IsZero())
+
+	positions, err = k.GetLiquidityPositions(ctx, app.AddRandomTestAddr(simapp, ctx, reserveCoins))
+	require.NoError(t, err)
+	require.Empty(t, positions)
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v043 "github.com/tendermint/liquidity/x/liquidity/legacy/v043"
+	"github.com/tendermint/liquidity/x/liquidity/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +21,50 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// The params added since version 2 are set to their default values, the circuit breaker param is moved to the circuit
+// breaker registry, the pools are indexed by their reserve coin denoms, and the message states of the latest batches
+// are indexed by their requester addresses.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyPoolTypes, types.DefaultPoolTypes)
+	m.keeper.paramSpace.Set(ctx, types.KeyStableSwapAmplification, types.DefaultStableSwapAmplification)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxOrderLifespan, types.DefaultMaxOrderLifespan)
+	m.keeper.paramSpace.Set(ctx, types.KeyBatchResultRetention, types.DefaultBatchResultRetention)
+	m.keeper.paramSpace.Set(ctx, types.KeyPriceAccumulatorRetention, types.DefaultPriceAccumulatorRetention)
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardPlanCreationFee, types.DefaultRewardPlanCreationFee)
+	m.keeper.paramSpace.Set(ctx, types.KeyBondDurations, types.DefaultBondDurations)
+	m.keeper.paramSpace.Set(ctx, types.KeyProtocolFeeRate, types.DefaultProtocolFeeRate)
+	m.keeper.paramSpace.Set(ctx, types.KeyWithdrawProtocolFeeEnabled, types.DefaultWithdrawProtocolFeeEnabled)
+	m.keeper.paramSpace.Set(ctx, types.KeyMinPoolFeeRate, types.DefaultMinPoolFeeRate)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxPoolFeeRate, types.DefaultMaxPoolFeeRate)
+	m.keeper.paramSpace.Set(ctx, types.KeyDynamicSwapFeeEnabled, types.DefaultDynamicSwapFeeEnabled)
+	m.keeper.paramSpace.Set(ctx, types.KeyDynamicSwapFeeLookback, types.DefaultDynamicSwapFeeLookback)
+	m.keeper.paramSpace.Set(ctx, types.KeyDynamicSwapFeeSensitivity, types.DefaultDynamicSwapFeeSensitivity)
+	m.keeper.paramSpace.Set(ctx, types.KeyDynamicSwapFeeDecayRate, types.DefaultDynamicSwapFeeDecayRate)
+	m.keeper.paramSpace.Set(ctx, types.KeyCircuitBreakerAdmins, types.DefaultCircuitBreakerAdmins)
+	m.keeper.paramSpace.Set(ctx, types.KeyCircuitBreakerPriceMoveThreshold, types.DefaultCircuitBreakerPriceMoveThreshold)
+	m.keeper.paramSpace.Set(ctx, types.KeyCircuitBreakerPriceMoveLookback, types.DefaultCircuitBreakerPriceMoveLookback)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxOrderPriceDeviation, types.DefaultMaxOrderPriceDeviation)
+
+	// the circuit breaker param enabled before version 3 becomes the circuit breaker of all pools and message types, so
+	// that it can be disabled by the circuit breaker admins as the other circuit breakers
+	if m.keeper.GetCircuitBreakerEnabled(ctx) {
+		m.keeper.SetCircuitBreaker(ctx, types.NewCircuitBreaker(0, "", true))
+		m.keeper.paramSpace.Set(ctx, types.KeyCircuitBreakerEnabled, false)
+	}
+
+	for _, pool := range m.keeper.GetAllPools(ctx) {
+		m.keeper.SetPoolByDenomIndexes(ctx, pool)
+	}
+	for _, state := range m.keeper.GetAllDepositMsgStates(ctx) {
+		m.keeper.SetPoolBatchDepositMsgState(ctx, state.Msg.PoolId, state)
+	}
+	for _, state := range m.keeper.GetAllWithdrawMsgStates(ctx) {
+		m.keeper.SetPoolBatchWithdrawMsgState(ctx, state.Msg.PoolId, state)
+	}
+	for _, state := range m.keeper.GetAllSwapMsgStates(ctx) {
+		m.keeper.SetPoolBatchSwapMsgState(ctx, state.Msg.PoolId, state)
+	}
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -31,8 +33,8 @@ var _ types.MsgServer = msgServer{}
 func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetCircuitBreakerEnabled(ctx) {
-		return nil, types.ErrCircuitBreakerEnabled
+	if err := k.ValidateCircuitBreaker(ctx, 0, msg.Type()); err != nil {
+		return nil, err
 	}
 
 	pool, err := k.Keeper.CreatePool(ctx, msg)
@@ -63,8 +65,8 @@ func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 func (k msgServer) DepositWithinBatch(goCtx context.Context, msg *types.MsgDepositWithinBatch) (*types.MsgDepositWithinBatchResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetCircuitBreakerEnabled(ctx) {
-		return nil, types.ErrCircuitBreakerEnabled
+	if err := k.ValidateCircuitBreaker(ctx, msg.PoolId, msg.Type()); err != nil {
+		return nil, err
 	}
 
 	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
@@ -130,8 +132,8 @@ func (k msgServer) WithdrawWithinBatch(goCtx context.Context, msg *types.MsgWith
 func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwapWithinBatch) (*types.MsgSwapWithinBatchResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetCircuitBreakerEnabled(ctx) {
-		return nil, types.ErrCircuitBreakerEnabled
+	if err := k.ValidateCircuitBreaker(ctx, msg.PoolId, msg.Type()); err != nil {
+		return nil, err
 	}
 
 	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
@@ -139,7 +141,7 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwapWithinBatch) (*
 		return nil, types.ErrPoolBatchNotExists
 	}
 
-	batchMsg, err := k.Keeper.SwapWithinBatch(ctx, msg, types.CancelOrderLifeSpan)
+	batchMsg, err := k.Keeper.SwapWithinBatch(ctx, msg, int64(msg.OrderLifespan))
 	if err != nil {
 		return nil, err
 	}
@@ -165,3 +167,332 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwapWithinBatch) (*
 
 	return &types.MsgSwapWithinBatchResponse{}, nil
 }
+
+// Message server, handler for MsgDepositToRange
+func (k msgServer) DepositToRange(goCtx context.Context, msg *types.MsgDepositToRange) (*types.MsgDepositToRangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateCircuitBreaker(ctx, msg.PoolId, msg.Type()); err != nil {
+		return nil, err
+	}
+
+	position, acceptedCoins, err := k.Keeper.DepositToRange(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeDepositToRange,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(position.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValuePositionId, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeValueDepositor, msg.DepositorAddress),
+			sdk.NewAttribute(types.AttributeValueLowerPrice, position.LowerPrice.String()),
+			sdk.NewAttribute(types.AttributeValueUpperPrice, position.UpperPrice.String()),
+			sdk.NewAttribute(types.AttributeValueLiquidity, position.Liquidity.String()),
+			sdk.NewAttribute(types.AttributeValueAcceptedCoins, acceptedCoins.String()),
+			sdk.NewAttribute(types.AttributeValueRefundedCoins, msg.DepositCoins.Sub(acceptedCoins).String()),
+		),
+	})
+
+	return &types.MsgDepositToRangeResponse{PositionId: position.Id}, nil
+}
+
+// Message server, handler for MsgWithdrawFromRange
+func (k msgServer) WithdrawFromRange(goCtx context.Context, msg *types.MsgWithdrawFromRange) (*types.MsgWithdrawFromRangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	position, withdrawCoins, err := k.Keeper.WithdrawFromRange(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeWithdrawFromRange,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(position.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValuePositionId, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeValueOwner, msg.OwnerAddress),
+			sdk.NewAttribute(types.AttributeValueLiquidity, position.Liquidity.String()),
+			sdk.NewAttribute(types.AttributeValueWithdrawCoins, withdrawCoins.String()),
+		),
+	})
+
+	return &types.MsgWithdrawFromRangeResponse{}, nil
+}
+
+// Message server, handler for MsgCancelSwap
+func (k msgServer) CancelSwap(goCtx context.Context, msg *types.MsgCancelSwap) (*types.MsgCancelSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
+	if !found {
+		return nil, types.ErrPoolBatchNotExists
+	}
+
+	batchMsg, err := k.Keeper.CancelSwap(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelSwap,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(batchMsg.Msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueSwapRequester, msg.SwapRequesterAddress),
+		),
+	})
+
+	return &types.MsgCancelSwapResponse{}, nil
+}
+
+// Message server, handler for MsgSwapRoute
+func (k msgServer) SwapRoute(goCtx context.Context, msg *types.MsgSwapRoute) (*types.MsgSwapRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, poolID := range msg.PoolIds {
+		if err := k.ValidateCircuitBreaker(ctx, poolID, msg.Type()); err != nil {
+			return nil, err
+		}
+	}
+
+	batchMsg, err := k.Keeper.SwapRoute(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	poolBatch, found := k.GetPoolBatch(ctx, batchMsg.Msg.PoolId)
+	if !found {
+		return nil, types.ErrPoolBatchNotExists
+	}
+
+	poolIDs := make([]string, len(msg.PoolIds))
+	for i, poolID := range msg.PoolIds {
+		poolIDs[i] = strconv.FormatUint(poolID, 10)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeSwapRoute,
+			sdk.NewAttribute(types.AttributeValuePoolIds, strings.Join(poolIDs, ",")),
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(batchMsg.Msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueOfferCoinDenom, batchMsg.Msg.OfferCoin.Denom),
+			sdk.NewAttribute(types.AttributeValueOfferCoinAmount, batchMsg.Msg.OfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueOfferCoinFeeAmount, batchMsg.Msg.OfferCoinFee.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueDemandCoinDenom, msg.DemandCoinDenom),
+			sdk.NewAttribute(types.AttributeValueMinDemandCoinAmount, msg.MinDemandCoinAmount.String()),
+			sdk.NewAttribute(types.AttributeValueOrderPrice, batchMsg.Msg.OrderPrice.String()),
+		),
+	})
+
+	return &types.MsgSwapRouteResponse{}, nil
+}
+
+// Message server, handler for MsgCreateRewardPlan
+func (k msgServer) CreateRewardPlan(goCtx context.Context, msg *types.MsgCreateRewardPlan) (*types.MsgCreateRewardPlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateCircuitBreaker(ctx, msg.PoolId, msg.Type()); err != nil {
+		return nil, err
+	}
+
+	plan, err := k.Keeper.CreateRewardPlan(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateRewardPlan,
+			sdk.NewAttribute(types.AttributeValuePlanId, strconv.FormatUint(plan.Id, 10)),
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(plan.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeValueRewardCoins, plan.RewardCoins.String()),
+			sdk.NewAttribute(types.AttributeValueStartHeight, strconv.FormatInt(plan.StartHeight, 10)),
+			sdk.NewAttribute(types.AttributeValueEndHeight, strconv.FormatInt(plan.EndHeight, 10)),
+		),
+	})
+
+	return &types.MsgCreateRewardPlanResponse{PlanId: plan.Id}, nil
+}
+
+// Message server, handler for MsgStake
+func (k msgServer) Stake(goCtx context.Context, msg *types.MsgStake) (*types.MsgStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidatePoolCoinCircuitBreaker(ctx, msg.PoolCoin.Denom, msg.Type()); err != nil {
+		return nil, err
+	}
+
+	stake, rewards, err := k.Keeper.Stake(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeStake,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(stake.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueStaker, msg.StakerAddress),
+			sdk.NewAttribute(types.AttributeValuePoolCoinDenom, msg.PoolCoin.Denom),
+			sdk.NewAttribute(types.AttributeValuePoolCoinAmount, msg.PoolCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueRewards, rewards.String()),
+		),
+	})
+
+	return &types.MsgStakeResponse{}, nil
+}
+
+// Message server, handler for MsgUnstake
+func (k msgServer) Unstake(goCtx context.Context, msg *types.MsgUnstake) (*types.MsgUnstakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stake, rewards, err := k.Keeper.Unstake(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeUnstake,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(stake.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueStaker, msg.StakerAddress),
+			sdk.NewAttribute(types.AttributeValuePoolCoinDenom, msg.PoolCoin.Denom),
+			sdk.NewAttribute(types.AttributeValuePoolCoinAmount, msg.PoolCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueRewards, rewards.String()),
+		),
+	})
+
+	return &types.MsgUnstakeResponse{}, nil
+}
+
+// Message server, handler for MsgClaimRewards
+func (k msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rewards, err := k.Keeper.ClaimRewards(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeClaimRewards,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueStaker, msg.StakerAddress),
+			sdk.NewAttribute(types.AttributeValueRewards, rewards.String()),
+		),
+	})
+
+	return &types.MsgClaimRewardsResponse{Rewards: rewards}, nil
+}
+
+// Message server, handler for MsgBond
+func (k msgServer) Bond(goCtx context.Context, msg *types.MsgBond) (*types.MsgBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidatePoolCoinCircuitBreaker(ctx, msg.PoolCoin.Denom, msg.Type()); err != nil {
+		return nil, err
+	}
+
+	bond, err := k.Keeper.Bond(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeBond,
+			sdk.NewAttribute(types.AttributeValueBondId, strconv.FormatUint(bond.Id, 10)),
+			sdk.NewAttribute(types.AttributeValueOwner, msg.OwnerAddress),
+			sdk.NewAttribute(types.AttributeValuePoolCoinDenom, msg.PoolCoin.Denom),
+			sdk.NewAttribute(types.AttributeValuePoolCoinAmount, msg.PoolCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueDuration, bond.Duration.String()),
+		),
+	})
+
+	return &types.MsgBondResponse{BondId: bond.Id}, nil
+}
+
+// Message server, handler for MsgBeginUnbond
+func (k msgServer) BeginUnbond(goCtx context.Context, msg *types.MsgBeginUnbond) (*types.MsgBeginUnbondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bond, err := k.Keeper.BeginUnbond(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeBeginUnbond,
+			sdk.NewAttribute(types.AttributeValueBondId, strconv.FormatUint(bond.Id, 10)),
+			sdk.NewAttribute(types.AttributeValueOwner, msg.OwnerAddress),
+			sdk.NewAttribute(types.AttributeValueUnbondTime, bond.UnbondTime.Format(time.RFC3339Nano)),
+		),
+	})
+
+	return &types.MsgBeginUnbondResponse{UnbondTime: bond.UnbondTime}, nil
+}
+
+// Message server, handler for MsgSetCircuitBreaker
+func (k msgServer) SetCircuitBreaker(goCtx context.Context, msg *types.MsgSetCircuitBreaker) (*types.MsgSetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetCircuitBreakerByAdmin(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgSetCircuitBreakerResponse{}, nil
+}
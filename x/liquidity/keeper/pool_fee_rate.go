@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// GetEffectivePoolFeeRate returns the fee rates applied to the pool. While the dynamic swap fee is enabled, the swap
+// fee rate of the pool is its dynamic swap fee rate, not lower than the base swap fee rate and not higher than the
+// max swap fee rate.
+func (k Keeper) GetEffectivePoolFeeRate(ctx sdk.Context, poolID uint64) types.PoolFeeRate {
+	params := k.GetParams(ctx)
+	feeRate := k.getBasePoolFeeRate(ctx, params, poolID)
+	if !params.DynamicSwapFeeEnabled {
+		return feeRate
+	}
+	if fee, found := k.GetDynamicSwapFee(ctx, poolID); found {
+		feeRate.SwapFeeRate = sdk.MinDec(sdk.MaxDec(fee.SwapFeeRate, feeRate.SwapFeeRate), maxSwapFeeRate(params, feeRate))
+	}
+	return feeRate
+}
+
+// GetMaxSwapFeeRate returns the highest swap fee rate the pool can apply. It is the max pool fee rate while the dynamic
+// swap fee is enabled, or the base swap fee rate if higher, and otherwise the base swap fee rate.
+func (k Keeper) GetMaxSwapFeeRate(ctx sdk.Context, poolID uint64) sdk.Dec {
+	params := k.GetParams(ctx)
+	feeRate := k.getBasePoolFeeRate(ctx, params, poolID)
+	if !params.DynamicSwapFeeEnabled {
+		return feeRate.SwapFeeRate
+	}
+	return maxSwapFeeRate(params, feeRate)
+}
+
+// getBasePoolFeeRate returns the fee rates of the pool regardless of the dynamic swap fee. The fee rates set for the
+// pool by governance are clamped to the current bounds of the params, the upper bound taking precedence, and the pools
+// without them use the fee rate params.
+func (k Keeper) getBasePoolFeeRate(ctx sdk.Context, params types.Params, poolID uint64) types.PoolFeeRate {
+	feeRate, found := k.GetPoolFeeRate(ctx, poolID)
+	if !found {
+		return types.NewPoolFeeRate(poolID, params.SwapFeeRate, params.WithdrawFeeRate)
+	}
+	clamp := func(rate sdk.Dec) sdk.Dec {
+		return sdk.MinDec(sdk.MaxDec(rate, params.MinPoolFeeRate), params.MaxPoolFeeRate)
+	}
+	return types.NewPoolFeeRate(poolID, clamp(feeRate.SwapFeeRate), clamp(feeRate.WithdrawFeeRate))
+}
+
+// maxSwapFeeRate returns the highest dynamic swap fee rate for the base fee rates.
+func maxSwapFeeRate(params types.Params, base types.PoolFeeRate) sdk.Dec {
+	return sdk.MaxDec(base.SwapFeeRate, params.MaxPoolFeeRate)
+}
+
+// GetSwapFeeRate returns the swap fee rate applied to the pool.
+func (k Keeper) GetSwapFeeRate(ctx sdk.Context, poolID uint64) sdk.Dec {
+	return k.GetEffectivePoolFeeRate(ctx, poolID).SwapFeeRate
+}
+
+// GetWithdrawFeeRate returns the withdraw fee rate applied to the pool.
+func (k Keeper) GetWithdrawFeeRate(ctx sdk.Context, poolID uint64) sdk.Dec {
+	return k.GetEffectivePoolFeeRate(ctx, poolID).WithdrawFeeRate
+}
+
+// UpdateDynamicSwapFee updates the dynamic swap fee of the pool after its executed batch from the volatility of the
+// clearing prices in the latest batch results within the lookback, which is limited to the batch results kept within
+// the batch result retention. The prices of less than two batch results have no volatility. The swap fee rate rises
+// at once to the target rate of the volatility, and decays toward it by the decay rate at each executed batch otherwise.
+func (k Keeper) UpdateDynamicSwapFee(ctx sdk.Context, poolBatch types.PoolBatch, params types.Params) types.DynamicSwapFee {
+	results := k.GetPoolBatchResults(ctx, poolBatch.PoolId)
+	// the lookback is not checked against the retention on the parameter changes, so it is clamped here
+	lookback := int(params.DynamicSwapFeeLookback)
+	if retained := int(params.BatchResultRetention); lookback > retained {
+		lookback = retained
+	}
+	if len(results) > lookback {
+		results = results[len(results)-lookback:]
+	}
+	volatility := types.PriceVolatility(results)
+
+	base := k.getBasePoolFeeRate(ctx, params, poolBatch.PoolId)
+	current := base.SwapFeeRate
+	if fee, found := k.GetDynamicSwapFee(ctx, poolBatch.PoolId); found {
+		current = fee.SwapFeeRate
+	}
+	fee := types.DynamicSwapFee{
+		PoolId:     poolBatch.PoolId,
+		BatchIndex: poolBatch.Index,
+		Volatility: volatility,
+		SwapFeeRate: types.NextDynamicSwapFeeRate(current, base.SwapFeeRate, params.MaxPoolFeeRate, volatility,
+			params.DynamicSwapFeeSensitivity, params.DynamicSwapFeeDecayRate),
+	}
+	k.SetDynamicSwapFee(ctx, fee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDynamicSwapFeeUpdated,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(fee.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(fee.BatchIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueVolatility, fee.Volatility.String()),
+			sdk.NewAttribute(types.AttributeValueSwapFeeRate, fee.SwapFeeRate.String()),
+		),
+	)
+	return fee
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/app"
+	"github.com/tendermint/liquidity/x/liquidity"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestUpdateDynamicSwapFee(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	params.DynamicSwapFeeEnabled = true
+	params.DynamicSwapFeeLookback = 2
+	params.DynamicSwapFeeSensitivity = sdk.NewDec(10)
+	params.DynamicSwapFeeDecayRate = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+	require.Equal(t, params.SwapFeeRate, k.GetSwapFeeRate(ctx, pool.Id))
+
+	recordPrice := func(batchIndex uint64, price sdk.Dec) types.DynamicSwapFee {
+		k.SetPoolBatchResult(ctx, types.PoolBatchResult{
+			PoolId:      pool.Id,
+			BatchIndex:  batchIndex,
+			SwapResults: []types.PairSwapResult{{DenomX: DenomX, DenomY: DenomY, SwapPrice: price}},
+		})
+		return k.UpdateDynamicSwapFee(ctx, types.PoolBatch{PoolId: pool.Id, Index: batchIndex}, params)
+	}
+
+	// the swap fee rate rises at once with the volatility of the latest clearing prices
+	fee := recordPrice(1, sdk.NewDec(2))
+	require.Equal(t, sdk.ZeroDec(), fee.Volatility)
+	require.Equal(t, params.SwapFeeRate, fee.SwapFeeRate)
+	fee = recordPrice(2, sdk.NewDec(3))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), fee.Volatility)
+	require.Equal(t, sdk.NewDecWithPrec(18, 3), fee.SwapFeeRate)
+	require.Equal(t, fee.SwapFeeRate, k.GetSwapFeeRate(ctx, pool.Id))
+	stored, found := k.GetDynamicSwapFee(ctx, pool.Id)
+	require.True(t, found)
+	require.Equal(t, fee, stored)
+
+	// the swap orders may reserve the offer coin fee between the current and the max swap fee rate
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	requester := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.MaxPoolFeeRate))))
+	liquidity.BeginBlocker(ctx, k)
+	for _, tc := range []struct {
+		swapFeeRate sdk.Dec
+		expectedErr error
+	}{
+		{params.SwapFeeRate, types.ErrBadOfferCoinFee},
+		{sdk.NewDecWithPrec(2, 1), types.ErrBadOfferCoinFee},
+		{fee.SwapFeeRate, nil},
+		{params.MaxPoolFeeRate, nil},
+	} {
+		msg := types.NewMsgSwapWithinBatch(requester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.NewDecWithPrec(11, 1), tc.swapFeeRate)
+		require.ErrorIs(t, k.ValidateMsgSwapWithinBatch(ctx, *msg, pool), tc.expectedErr)
+	}
+
+	// the swap fee rate decays toward the base swap fee rate as the prices calm down
+	fee = recordPrice(3, sdk.NewDec(3))
+	require.Equal(t, sdk.ZeroDec(), fee.Volatility)
+	require.Equal(t, sdk.NewDecWithPrec(105, 4), fee.SwapFeeRate)
+	fee = recordPrice(4, sdk.NewDec(3))
+	require.Equal(t, sdk.NewDecWithPrec(675, 5), fee.SwapFeeRate)
+
+	// the stored swap fee rate is bounded by the base and the max swap fee rate, and ignored while disabled
+	params.MaxPoolFeeRate = sdk.NewDecWithPrec(5, 3)
+	k.SetParams(ctx, params)
+	require.Equal(t, sdk.NewDecWithPrec(5, 3), k.GetSwapFeeRate(ctx, pool.Id))
+	params.DynamicSwapFeeEnabled = false
+	k.SetParams(ctx, params)
+	require.Equal(t, params.SwapFeeRate, k.GetSwapFeeRate(ctx, pool.Id))
+	require.Equal(t, params.SwapFeeRate, k.GetMaxSwapFeeRate(ctx, pool.Id))
+
+	// the dynamic swap fee is exported with the pool
+	record, found := k.GetPoolRecord(ctx, pool)
+	require.True(t, found)
+	require.Equal(t, fee, record.DynamicSwapFee)
+}
+
+func TestDynamicSwapFeeLookbackOverRetention(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	params.DynamicSwapFeeEnabled = true
+	params.DynamicSwapFeeLookback = 3
+	k.SetParams(ctx, params)
+
+	recordPrice := func(batchIndex uint64, price sdk.Dec) types.DynamicSwapFee {
+		k.SetPoolBatchResult(ctx, types.PoolBatchResult{
+			PoolId:      pool.Id,
+			BatchIndex:  batchIndex,
+			SwapResults: []types.PairSwapResult{{DenomX: DenomX, DenomY: DenomY, SwapPrice: price}},
+		})
+		return k.UpdateDynamicSwapFee(ctx, types.PoolBatch{PoolId: pool.Id, Index: batchIndex}, params)
+	}
+	recordPrice(1, sdk.NewDec(2))
+	recordPrice(2, sdk.NewDec(3))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), recordPrice(3, sdk.NewDec(3)).Volatility)
+
+	// the lookback set over the batch result retention by a parameter change is limited to the retained batch results
+	params.BatchResultRetention = 2
+	require.Equal(t, sdk.ZeroDec(), recordPrice(4, sdk.NewDec(3)).Volatility)
+	params.BatchResultRetention = 3
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), recordPrice(5, sdk.NewDec(2)).Volatility)
+}
+
+func TestDynamicSwapFeeBoundedByReservedFee(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	ctx = ctx.WithBlockHeight(1)
+	params := k.GetParams(ctx)
+	params.UnitBatchHeight = 2
+	params.DynamicSwapFeeEnabled = true
+	k.SetParams(ctx, params)
+
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	baseFee := types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)
+	maxFee := types.GetOfferCoinFee(offerCoin, params.MaxPoolFeeRate)
+	baseBuyer := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(baseFee)))
+	maxBuyer := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(maxFee)))
+
+	liquidity.BeginBlocker(ctx, k)
+	_, err = k.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		baseBuyer, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate), 4)
+	require.NoError(t, err)
+	_, err = k.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		maxBuyer, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.MaxPoolFeeRate), 4)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, k)
+
+	// the dynamic swap fee rate rises between the bounds reserved by the orders before they are executed
+	ctx = ctx.WithBlockHeight(2)
+	swapFeeRate := sdk.NewDecWithPrec(5, 2)
+	k.SetDynamicSwapFee(ctx, types.DynamicSwapFee{PoolId: pool.Id, BatchIndex: 1, Volatility: sdk.ZeroDec(), SwapFeeRate: swapFeeRate})
+	liquidity.BeginBlocker(ctx, k)
+	liquidity.EndBlocker(ctx, k)
+
+	// the order reserving the base swap fee is executed with it instead of being refunded
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, baseBuyer, DenomX).Amount.IsZero())
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, baseBuyer, DenomY).Amount.IsPositive())
+
+	// the order reserving the max swap fee is charged the dynamic swap fee rate and the excess is released
+	excess := maxFee.Sub(types.GetOfferCoinFee(offerCoin, swapFeeRate))
+	require.Equal(t, excess, simapp.BankKeeper.GetBalance(ctx, maxBuyer, DenomX))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, maxBuyer, DenomY).Amount.IsPositive())
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// HandlePoolFeeRatesProposal is a handler for executing a passed pool fee rates proposal, which sets the fee rates of
+// the existing pools within the bounds of the params.
+func HandlePoolFeeRatesProposal(ctx sdk.Context, k Keeper, p *types.PoolFeeRatesProposal) error {
+	params := k.GetParams(ctx)
+	if params.MinPoolFeeRate.GT(params.MaxPoolFeeRate) {
+		return sdkerrors.Wrapf(types.ErrPoolFeeRateOutOfBounds, "min pool fee rate %s exceeds max pool fee rate %s",
+			params.MinPoolFeeRate, params.MaxPoolFeeRate)
+	}
+	for _, feeRate := range p.PoolFeeRates {
+		if _, found := k.GetPool(ctx, feeRate.PoolId); !found {
+			return sdkerrors.Wrapf(types.ErrPoolNotExists, "pool %d", feeRate.PoolId)
+		}
+		if !feeRate.IsWithinBounds(params) {
+			return sdkerrors.Wrapf(types.ErrPoolFeeRateOutOfBounds, "pool %d fee rates must be between %s and %s",
+				feeRate.PoolId, params.MinPoolFeeRate, params.MaxPoolFeeRate)
+		}
+	}
+
+	for _, feeRate := range p.PoolFeeRates {
+		k.SetPoolFeeRate(ctx, feeRate)
+		logger := k.Logger(ctx)
+		logger.Info("pool fee rates set", "pool_id", feeRate.PoolId, "swap_fee_rate", feeRate.SwapFeeRate, "withdraw_fee_rate", feeRate.WithdrawFeeRate)
+	}
+	return nil
+}
+
+// HandleCircuitBreakerProposal is a handler for executing a passed circuit breaker proposal, which enables or disables
+// the circuit breakers of all pools or of the existing pools.
+func HandleCircuitBreakerProposal(ctx sdk.Context, k Keeper, p *types.CircuitBreakerProposal) error {
+	for _, circuitBreaker := range p.CircuitBreakers {
+		if circuitBreaker.PoolId == 0 {
+			continue
+		}
+		if _, found := k.GetPool(ctx, circuitBreaker.PoolId); !found {
+			return sdkerrors.Wrapf(types.ErrPoolNotExists, "pool %d", circuitBreaker.PoolId)
+		}
+	}
+
+	for _, circuitBreaker := range p.CircuitBreakers {
+		if err := k.ApplyCircuitBreaker(ctx, circuitBreaker); err != nil {
+			return err
+		}
+		logger := k.Logger(ctx)
+		logger.Info("circuit breaker set", "pool_id", circuitBreaker.PoolId, "msg_type", circuitBreaker.MsgType, "enabled", circuitBreaker.Enabled)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/app"
+	"github.com/tendermint/liquidity/x/liquidity"
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestHandlePoolFeeRatesProposal(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	require.Equal(t, types.NewPoolFeeRate(pool.Id, params.SwapFeeRate, params.WithdrawFeeRate), k.GetEffectivePoolFeeRate(ctx, pool.Id))
+
+	// the fee rates must be set for existing pools within the bounds of the params
+	err = keeper.HandlePoolFeeRatesProposal(ctx, k, types.NewPoolFeeRatesProposal("title", "description", []types.PoolFeeRate{
+		types.NewPoolFeeRate(pool.Id+1, sdk.NewDecWithPrec(5, 4), sdk.ZeroDec()),
+	}))
+	require.ErrorIs(t, err, types.ErrPoolNotExists)
+	err = keeper.HandlePoolFeeRatesProposal(ctx, k, types.NewPoolFeeRatesProposal("title", "description", []types.PoolFeeRate{
+		types.NewPoolFeeRate(pool.Id, sdk.NewDecWithPrec(2, 1), sdk.ZeroDec()),
+	}))
+	require.ErrorIs(t, err, types.ErrPoolFeeRateOutOfBounds)
+	_, found := k.GetPoolFeeRate(ctx, pool.Id)
+	require.False(t, found)
+
+	// the fee rates can not be set while the bounds of the params are inverted
+	invertedParams := params
+	invertedParams.MinPoolFeeRate, invertedParams.MaxPoolFeeRate = sdk.NewDecWithPrec(2, 3), sdk.NewDecWithPrec(1, 3)
+	k.SetParams(ctx, invertedParams)
+	err = keeper.HandlePoolFeeRatesProposal(ctx, k, types.NewPoolFeeRatesProposal("title", "description", []types.PoolFeeRate{
+		types.NewPoolFeeRate(pool.Id, sdk.NewDecWithPrec(15, 4), sdk.ZeroDec()),
+	}))
+	require.ErrorIs(t, err, types.ErrPoolFeeRateOutOfBounds)
+	k.SetParams(ctx, params)
+
+	// the proposal is routed to the handler by the liquidity router key
+	handler := liquidity.NewProposalHandler(k)
+	feeRate := types.NewPoolFeeRate(pool.Id, sdk.NewDecWithPrec(5, 4), sdk.NewDecWithPrec(1, 3))
+	require.NoError(t, handler(ctx, types.NewPoolFeeRatesProposal("title", "description", []types.PoolFeeRate{feeRate})))
+	require.Equal(t, feeRate, k.GetEffectivePoolFeeRate(ctx, pool.Id))
+
+	// the swap orders must pay the offer coin fee of the swap fee rate of the pool
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	requester := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+	liquidity.BeginBlocker(ctx, k)
+	_, err = k.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		requester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.NewDecWithPrec(11, 1), params.SwapFeeRate), 0)
+	require.ErrorIs(t, err, types.ErrBadOfferCoinFee)
+	msg, err := k.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		requester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.NewDecWithPrec(11, 1), feeRate.SwapFeeRate), 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(DenomX, 3), msg.ReservedOfferCoinFee)
+	liquidity.EndBlocker(ctx, k)
+
+	// the fee rates of the pool are clamped to the bounds of the params
+	params.MaxPoolFeeRate = sdk.NewDecWithPrec(4, 4)
+	params.MinPoolFeeRate = sdk.NewDecWithPrec(2, 4)
+	k.SetParams(ctx, params)
+	require.Equal(t, sdk.NewDecWithPrec(4, 4), k.GetSwapFeeRate(ctx, pool.Id))
+	require.Equal(t, sdk.NewDecWithPrec(4, 4), k.GetWithdrawFeeRate(ctx, pool.Id))
+
+	// the fee rates of the pool are exported with the pool
+	record, found := k.GetPoolRecord(ctx, pool)
+	require.True(t, found)
+	require.Equal(t, feeRate, record.PoolFeeRate)
+	require.NoError(t, record.Validate())
+}
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	k := simapp.LiquidityKeeper
+	handler := liquidity.NewParamChangeProposalHandler(k, params.NewParamChangeProposalHandler(simapp.ParamsKeeper))
+
+	// the changes leaving the liquidity params inconsistent fail the proposal
+	cacheCtx, _ := ctx.CacheContext()
+	err := handler(cacheCtx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMinPoolFeeRate), `"0.200000000000000000"`),
+	}))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	err = handler(cacheCtx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyBatchResultRetention), `5`),
+	}))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the consistent changes pass
+	require.NoError(t, handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMinPoolFeeRate), `"0.050000000000000000"`),
+	})))
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), k.GetParams(ctx).MinPoolFeeRate)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// CollectProtocolFee sends the protocol fee rate share of the fee coins collected in the reserve account of the pool
+// to the community pool, and adds it to the protocol fees collected from the pool. The withdraw fee coins are charged
+// only if the protocol fee on the withdraw fees is enabled. The protocol fee is truncated, leaving the rest of the fee
+// coins to the pool, and returned.
+func (k Keeper) CollectProtocolFee(ctx sdk.Context, pool types.Pool, feeCoins sdk.Coins, feeType string) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if feeType == types.FeeTypeWithdraw && !params.WithdrawProtocolFeeEnabled {
+		return sdk.Coins{}, nil
+	}
+
+	protocolFee, _ := sdk.NewDecCoinsFromCoins(feeCoins...).MulDecTruncate(params.ProtocolFeeRate).TruncateDecimal()
+	if protocolFee.IsZero() {
+		return sdk.Coins{}, nil
+	}
+	if err := k.distrKeeper.FundCommunityPool(ctx, protocolFee, pool.GetReserveAccount()); err != nil {
+		return nil, err
+	}
+
+	fees := k.GetCollectedProtocolFees(ctx, pool.Id)
+	if feeType == types.FeeTypeWithdraw {
+		fees.WithdrawFeeCoins = fees.WithdrawFeeCoins.Add(protocolFee...)
+	} else {
+		fees.SwapFeeCoins = fees.SwapFeeCoins.Add(protocolFee...)
+	}
+	k.SetCollectedProtocolFees(ctx, fees)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolFeeCollected,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeValueFeeType, feeType),
+			sdk.NewAttribute(types.AttributeValueProtocolFeeCoins, protocolFee.String()),
+		))
+
+	return protocolFee, nil
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/app"
+	"github.com/tendermint/liquidity/x/liquidity"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestCollectProtocolFee(t *testing.T) {
+	simapp, ctx, pool, creator, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	params.ProtocolFeeRate = sdk.NewDecWithPrec(5, 1)
+	params.WithdrawFeeRate = sdk.NewDecWithPrec(1, 2)
+	k.SetParams(ctx, params)
+	require.Equal(t, types.CollectedProtocolFees{PoolId: pool.Id}, k.GetCollectedProtocolFees(ctx, pool.Id))
+
+	// half of the swap fees of both the offer coin and the demand coin is collected in the community pool
+	communityPool := simapp.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	requester := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+	liquidity.BeginBlocker(ctx, k)
+	_, err = k.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		requester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.NewDecWithPrec(11, 1), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, k)
+
+	fees := k.GetCollectedProtocolFees(ctx, pool.Id)
+	require.Equal(t, sdk.NewInt(7), fees.SwapFeeCoins.AmountOf(DenomX))
+	require.True(t, fees.SwapFeeCoins.AmountOf(DenomY).IsPositive())
+	require.True(t, fees.WithdrawFeeCoins.IsZero())
+	require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(fees.SwapFeeCoins...)...), simapp.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	require.Equal(t, sdk.NewInt(1000000+10000+15-7), reserveCoins.AmountOf(DenomX))
+
+	// the withdraw fees are not charged the protocol fee unless enabled
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, k)
+	_, err = k.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(creator, pool.Id, sdk.NewInt64Coin(pool.PoolCoinDenom, 10000)))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, k)
+	require.Equal(t, fees, k.GetCollectedProtocolFees(ctx, pool.Id))
+
+	params.WithdrawProtocolFeeEnabled = true
+	k.SetParams(ctx, params)
+	communityPool = simapp.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, k)
+	_, err = k.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(creator, pool.Id, sdk.NewInt64Coin(pool.PoolCoinDenom, 10000)))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, k)
+
+	withdrawFees := k.GetCollectedProtocolFees(ctx, pool.Id).WithdrawFeeCoins
+	require.True(t, withdrawFees.AmountOf(DenomX).IsPositive())
+	require.True(t, withdrawFees.AmountOf(DenomY).IsPositive())
+	require.Equal(t, fees.SwapFeeCoins, k.GetCollectedProtocolFees(ctx, pool.Id).SwapFeeCoins)
+	require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(withdrawFees...)...), simapp.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	// the collected protocol fees are exported with the pool
+	record, found := k.GetPoolRecord(ctx, pool)
+	require.True(t, found)
+	require.Equal(t, k.GetCollectedProtocolFees(ctx, pool.Id), record.CollectedProtocolFees)
+	require.NoError(t, record.Validate())
+}
@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"math"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// CreateRewardPlan creates a reward plan streaming the reward coins to the staked pool coins of the pool over the
+// duration in blocks from the next block. The reward coins are escrowed in the rewards escrow account, and the reward
+// plan creation fee is collected in the community pool.
+func (k Keeper) CreateRewardPlan(ctx sdk.Context, msg *types.MsgCreateRewardPlan) (types.RewardPlan, error) {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.RewardPlan{}, types.ErrPoolNotExists
+	}
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return types.RewardPlan{}, types.ErrPoolTypeNotExists
+	}
+	if !poolCurve.HasPoolCoin() {
+		return types.RewardPlan{}, sdkerrors.Wrapf(types.ErrPoolCoinNotSupported, "pool %d mints no pool coin", pool.Id)
+	}
+
+	startHeight := ctx.BlockHeight() + 1
+	if msg.Duration == 0 || msg.Duration > uint64(math.MaxInt64-startHeight) {
+		return types.RewardPlan{}, sdkerrors.Wrapf(types.ErrBadRewardPlan, "invalid duration %d", msg.Duration)
+	}
+
+	funder := msg.GetFunder()
+	params := k.GetParams(ctx)
+	for _, coin := range msg.RewardCoins {
+		balance := k.bankKeeper.GetBalance(ctx, funder, coin.Denom)
+		if balance.IsLT(coin) {
+			return types.RewardPlan{}, sdkerrors.Wrapf(
+				types.ErrInsufficientBalance, "%s is smaller than %s", balance, coin)
+		}
+	}
+	for _, coin := range params.RewardPlanCreationFee {
+		balance := k.bankKeeper.GetBalance(ctx, funder, coin.Denom)
+		neededCoin := sdk.NewCoin(coin.Denom, coin.Amount.Add(msg.RewardCoins.AmountOf(coin.Denom)))
+		if balance.IsLT(neededCoin) {
+			return types.RewardPlan{}, sdkerrors.Wrapf(
+				types.ErrInsufficientRewardPlanFee, "%s is smaller than %s", balance, neededCoin)
+		}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, funder, types.RewardsEscrowAcc, msg.RewardCoins); err != nil {
+		return types.RewardPlan{}, err
+	}
+	// reward plan creation fees are collected in community pool
+	if err := k.distrKeeper.FundCommunityPool(ctx, params.RewardPlanCreationFee, funder); err != nil {
+		return types.RewardPlan{}, err
+	}
+
+	plan := types.RewardPlan{
+		Id:            k.GetNextRewardPlanIDWithUpdate(ctx),
+		PoolId:        pool.Id,
+		FunderAddress: msg.FunderAddress,
+		RewardCoins:   msg.RewardCoins,
+		StartHeight:   startHeight,
+		EndHeight:     startHeight + int64(msg.Duration),
+	}
+	k.SetRewardPlan(ctx, plan)
+
+	logger := k.Logger(ctx)
+	logger.Debug(
+		"create reward plan",
+		"msg", msg,
+		"plan", plan,
+	)
+
+	return plan, nil
+}
+
+// Stake stakes the pool coins of the staker to accrue the rewards of the reward plans of the pool, paying out the
+// rewards pending for the existing stake of the staker.
+func (k Keeper) Stake(ctx sdk.Context, msg *types.MsgStake) (types.Stake, sdk.Coins, error) {
+	pool, err := k.getPoolByPoolCoinDenom(ctx, msg.PoolCoin.Denom)
+	if err != nil {
+		return types.Stake{}, nil, err
+	}
+
+	staker := msg.GetStaker()
+	balance := k.bankKeeper.GetBalance(ctx, staker, msg.PoolCoin.Denom)
+	if balance.IsLT(msg.PoolCoin) {
+		return types.Stake{}, nil, sdkerrors.Wrapf(
+			types.ErrInsufficientBalance, "%s is smaller than %s", balance, msg.PoolCoin)
+	}
+
+	accumulator := k.GetRewardAccumulator(ctx, pool.Id)
+	stake, found := k.GetStake(ctx, pool.Id, staker)
+	if !found {
+		stake = types.NewStake(accumulator, staker)
+	}
+	accumulator, stake, rewards, err := k.settleRewards(ctx, accumulator, stake)
+	if err != nil {
+		return types.Stake{}, nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, staker, types.StakesEscrowAcc, sdk.NewCoins(msg.PoolCoin)); err != nil {
+		return types.Stake{}, nil, err
+	}
+	stake.Amount = stake.Amount.Add(msg.PoolCoin.Amount)
+	accumulator.TotalStaked = accumulator.TotalStaked.Add(msg.PoolCoin.Amount)
+	k.SetStake(ctx, stake)
+	k.SetRewardAccumulator(ctx, accumulator)
+
+	return stake, rewards, nil
+}
+
+// Unstake unstakes the pool coins of the staker, paying out the rewards pending for the stake. The stake is deleted
+// once all of its pool coins are unstaked.
+func (k Keeper) Unstake(ctx sdk.Context, msg *types.MsgUnstake) (types.Stake, sdk.Coins, error) {
+	pool, err := k.getPoolByPoolCoinDenom(ctx, msg.PoolCoin.Denom)
+	if err != nil {
+		return types.Stake{}, nil, err
+	}
+
+	staker := msg.GetStaker()
+	stake, found := k.GetStake(ctx, pool.Id, staker)
+	if !found {
+		return types.Stake{}, nil, types.ErrStakeNotExists
+	}
+	if stake.Amount.LT(msg.PoolCoin.Amount) {
+		return types.Stake{}, nil, sdkerrors.Wrapf(
+			types.ErrInsufficientStake, "%s%s is smaller than %s", stake.Amount, pool.PoolCoinDenom, msg.PoolCoin)
+	}
+
+	accumulator := k.GetRewardAccumulator(ctx, pool.Id)
+	accumulator, stake, rewards, err := k.settleRewards(ctx, accumulator, stake)
+	if err != nil {
+		return types.Stake{}, nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.StakesEscrowAcc, staker, sdk.NewCoins(msg.PoolCoin)); err != nil {
+		return types.Stake{}, nil, err
+	}
+	stake.Amount = stake.Amount.Sub(msg.PoolCoin.Amount)
+	accumulator.TotalStaked = accumulator.TotalStaked.Sub(msg.PoolCoin.Amount)
+	if stake.Amount.IsZero() {
+		k.DeleteStake(ctx, stake)
+	} else {
+		k.SetStake(ctx, stake)
+	}
+	k.SetRewardAccumulator(ctx, accumulator)
+
+	return stake, rewards, nil
+}
+
+// ClaimRewards pays out the rewards pending for the stake of the staker in the pool.
+func (k Keeper) ClaimRewards(ctx sdk.Context, msg *types.MsgClaimRewards) (sdk.Coins, error) {
+	stake, found := k.GetStake(ctx, msg.PoolId, msg.GetStaker())
+	if !found {
+		return nil, types.ErrStakeNotExists
+	}
+
+	accumulator, stake, rewards, err := k.settleRewards(ctx, k.GetRewardAccumulator(ctx, msg.PoolId), stake)
+	if err != nil {
+		return nil, err
+	}
+	k.SetStake(ctx, stake)
+	k.SetRewardAccumulator(ctx, accumulator)
+
+	return rewards, nil
+}
+
+// GetPendingRewards returns the rewards pending for the stake, which would be paid out on the next claim.
+func (k Keeper) GetPendingRewards(ctx sdk.Context, stake types.Stake) sdk.Coins {
+	return stake.PendingRewards(k.GetRewardAccumulator(ctx, stake.PoolId))
+}
+
+// DistributeRewards distributes the emissions of the reward plans at the current height to the staked pool coins of
+// their pools. The emissions while no pool coin of the pool is staked are not distributed, and the reward coins not
+// distributed are refunded to the funder when the reward plan ends, with the whole rewards truncated from the
+// distributions of the plan.
+func (k Keeper) DistributeRewards(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	for _, plan := range k.GetAllRewardPlans(ctx) {
+		if height < plan.StartHeight {
+			continue
+		}
+
+		emission := plan.EmissionAt(height)
+		accumulator := k.GetRewardAccumulator(ctx, plan.PoolId)
+		if !emission.IsZero() && accumulator.TotalStaked.IsPositive() {
+			var truncated sdk.DecCoins
+			accumulator, truncated = accumulator.Distribute(emission)
+			k.SetRewardAccumulator(ctx, accumulator)
+			plan.DistributedCoins = plan.DistributedCoins.Add(emission...)
+			plan.TruncatedRewards = plan.TruncatedRewards.Add(truncated...)
+		}
+
+		if height < plan.EndHeight-1 {
+			k.SetRewardPlan(ctx, plan)
+			continue
+		}
+
+		accumulator, truncatedCoins := accumulator.TakeTruncatedRewards(plan)
+		k.SetRewardAccumulator(ctx, accumulator)
+		remainingCoins := plan.RemainingCoins().Add(truncatedCoins...)
+		if !remainingCoins.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, types.RewardsEscrowAcc, plan.GetFunder(), remainingCoins); err != nil {
+				panic(err)
+			}
+		}
+		k.DeleteRewardPlan(ctx, plan)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardPlanFinished,
+				sdk.NewAttribute(types.AttributeValuePlanId, strconv.FormatUint(plan.Id, 10)),
+				sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(plan.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeValueFunder, plan.FunderAddress),
+				sdk.NewAttribute(types.AttributeValueDistributedCoins, plan.DistributedCoins.String()),
+				sdk.NewAttribute(types.AttributeValueRefundedCoins, remainingCoins.String()),
+			))
+	}
+}
+
+// settleRewards pays out the rewards pending for the stake to the staker, and returns the accumulator with the
+// rewards truncated from the payout and the stake accruing rewards from the current reward per share.
+func (k Keeper) settleRewards(ctx sdk.Context, accumulator types.RewardAccumulator, stake types.Stake) (types.RewardAccumulator, types.Stake, sdk.Coins, error) {
+	accumulator, stake, rewards := accumulator.Settle(stake)
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.RewardsEscrowAcc, stake.GetStaker(), rewards); err != nil {
+			return types.RewardAccumulator{}, types.Stake{}, nil, err
+		}
+	}
+	return accumulator, stake, rewards, nil
+}
+
+// getPoolByPoolCoinDenom returns the pool minting the pool coin of the denom.
+func (k Keeper) getPoolByPoolCoinDenom(ctx sdk.Context, denom string) (types.Pool, error) {
+	reserveAcc, err := types.GetReserveAcc(denom, false)
+	if err != nil {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrBadPoolCoinDenom, "%s is not a pool coin denom", denom)
+	}
+	pool, found := k.GetPoolByReserveAccIndex(ctx, reserveAcc)
+	if !found || pool.PoolCoinDenom != denom {
+		return types.Pool{}, types.ErrPoolNotExists
+	}
+	return pool, nil
+}
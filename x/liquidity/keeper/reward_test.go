@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/app"
+	"github.com/tendermint/liquidity/x/liquidity"
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestRewardPlan(t *testing.T) {
+	simapp, ctx, pool, creator, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	invariant := keeper.AllInvariants(k)
+
+	// the creator holds 1000000 pool coins, half of which are sent to the other staker
+	staker := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins())
+	require.NoError(t, simapp.BankKeeper.SendCoins(ctx, creator, staker, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 500000))))
+
+	rewardCoins := sdk.NewCoins(sdk.NewInt64Coin(DenomA, 1000000))
+	funder := app.AddRandomTestAddr(simapp, ctx, rewardCoins.Add(params.RewardPlanCreationFee...))
+
+	_, err = k.CreateRewardPlan(ctx, types.NewMsgCreateRewardPlan(funder, 3, rewardCoins, 4))
+	require.ErrorIs(t, err, types.ErrPoolNotExists)
+	_, err = k.CreateRewardPlan(ctx, types.NewMsgCreateRewardPlan(staker, pool.Id, rewardCoins, 4))
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+	poorFunder := app.AddRandomTestAddr(simapp, ctx, rewardCoins)
+	_, err = k.CreateRewardPlan(ctx, types.NewMsgCreateRewardPlan(poorFunder, pool.Id, rewardCoins, 4))
+	require.ErrorIs(t, err, types.ErrInsufficientRewardPlanFee)
+
+	// the plan streams 250000 reward coins in each of 4 blocks from the next block
+	height := ctx.BlockHeight()
+	communityPool := simapp.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	plan, err := k.CreateRewardPlan(ctx, types.NewMsgCreateRewardPlan(funder, pool.Id, rewardCoins, 4))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), plan.Id)
+	require.Equal(t, height+1, plan.StartHeight)
+	require.Equal(t, height+5, plan.EndHeight)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, funder).IsZero())
+	require.Equal(t, rewardCoins, simapp.BankKeeper.GetAllBalances(ctx, types.RewardsEscrowAcc))
+	require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(params.RewardPlanCreationFee...)...), simapp.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	require.Equal(t, []types.RewardPlan{plan}, k.GetRewardPlansByPool(ctx, pool.Id))
+
+	_, _, err = k.Stake(ctx, types.NewMsgStake(creator, sdk.NewInt64Coin(DenomX, 1000)))
+	require.ErrorIs(t, err, types.ErrBadPoolCoinDenom)
+	_, _, err = k.Stake(ctx, types.NewMsgStake(creator, sdk.NewInt64Coin(pool.PoolCoinDenom, 500001)))
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+	_, _, err = k.Unstake(ctx, types.NewMsgUnstake(creator, sdk.NewInt64Coin(pool.PoolCoinDenom, 1)))
+	require.ErrorIs(t, err, types.ErrStakeNotExists)
+	_, err = k.ClaimRewards(ctx, types.NewMsgClaimRewards(creator, pool.Id))
+	require.ErrorIs(t, err, types.ErrStakeNotExists)
+
+	stake, rewards, err := k.Stake(ctx, types.NewMsgStake(creator, sdk.NewInt64Coin(pool.PoolCoinDenom, 500000)))
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+	require.Equal(t, sdk.NewInt(500000), stake.Amount)
+	require.Equal(t, sdk.NewInt(500000), k.GetRewardAccumulator(ctx, pool.Id).TotalStaked)
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, creator, pool.PoolCoinDenom).IsZero())
+
+	// the first block distributes all emission to the only stake
+	ctx = ctx.WithBlockHeight(height + 1)
+	liquidity.BeginBlocker(ctx, k)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), k.GetRewardAccumulator(ctx, pool.Id).RewardPerShare.AmountOf(DenomA))
+	stake, _ = k.GetStake(ctx, pool.Id, creator)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomA, 250000)), k.GetPendingRewards(ctx, stake))
+
+	_, _, err = k.Stake(ctx, types.NewMsgStake(staker, sdk.NewInt64Coin(pool.PoolCoinDenom, 500000)))
+	require.NoError(t, err)
+
+	// the second block distributes the emission to both stakes evenly
+	ctx = ctx.WithBlockHeight(height + 2)
+	liquidity.BeginBlocker(ctx, k)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+	rewards, err = k.ClaimRewards(ctx, types.NewMsgClaimRewards(creator, pool.Id))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomA, 375000)), rewards)
+	require.Equal(t, rewards, simapp.BankKeeper.GetAllBalances(ctx, creator))
+	rewards, err = k.ClaimRewards(ctx, types.NewMsgClaimRewards(creator, pool.Id))
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+
+	_, _, err = k.Unstake(ctx, types.NewMsgUnstake(staker, sdk.NewInt64Coin(pool.PoolCoinDenom, 500001)))
+	require.ErrorIs(t, err, types.ErrInsufficientStake)
+	_, rewards, err = k.Unstake(ctx, types.NewMsgUnstake(staker, sdk.NewInt64Coin(pool.PoolCoinDenom, 500000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomA, 125000)), rewards)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomA, 125000), sdk.NewInt64Coin(pool.PoolCoinDenom, 500000)),
+		simapp.BankKeeper.GetAllBalances(ctx, staker))
+	_, found := k.GetStake(ctx, pool.Id, staker)
+	require.False(t, found)
+	require.Empty(t, k.GetStakesByStaker(ctx, staker))
+
+	// the third block distributes all emission to the remaining stake
+	ctx = ctx.WithBlockHeight(height + 3)
+	liquidity.BeginBlocker(ctx, k)
+	_, rewards, err = k.Unstake(ctx, types.NewMsgUnstake(creator, sdk.NewInt64Coin(pool.PoolCoinDenom, 500000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomA, 250000)), rewards)
+	require.True(t, k.GetRewardAccumulator(ctx, pool.Id).TotalStaked.IsZero())
+	plan, found = k.GetRewardPlan(ctx, plan.Id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomA, 750000)), plan.DistributedCoins)
+
+	// the emission of the last block is not distributed without stakes, and is refunded to the funder with the plan ended
+	ctx = ctx.WithBlockHeight(height + 4)
+	liquidity.BeginBlocker(ctx, k)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+	_, found = k.GetRewardPlan(ctx, plan.Id)
+	require.False(t, found)
+	require.Empty(t, k.GetAllRewardPlans(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomA, 250000)), simapp.BankKeeper.GetAllBalances(ctx, funder))
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, types.RewardsEscrowAcc).IsZero())
+	require.Equal(t, uint64(2), k.GetNextRewardPlanID(ctx))
+}
+
+func TestRewardPlanTruncatedRewards(t *testing.T) {
+	simapp, ctx, pool, creator, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	invariant := keeper.AllInvariants(k)
+
+	// the reward coins are of the pool coin denom, and are escrowed apart from the staked pool coins
+	rewardCoins := sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 2000))
+	funder := app.AddRandomTestAddr(simapp, ctx, params.RewardPlanCreationFee)
+	stakerA := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins())
+	stakerB := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins())
+	require.NoError(t, simapp.BankKeeper.SendCoins(ctx, creator, funder, rewardCoins))
+	require.NoError(t, simapp.BankKeeper.SendCoins(ctx, creator, stakerA, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 1))))
+	require.NoError(t, simapp.BankKeeper.SendCoins(ctx, creator, stakerB, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 2))))
+
+	height := ctx.BlockHeight()
+	plan, err := k.CreateRewardPlan(ctx, types.NewMsgCreateRewardPlan(funder, pool.Id, rewardCoins, 2))
+	require.NoError(t, err)
+	_, _, err = k.Stake(ctx, types.NewMsgStake(stakerA, sdk.NewInt64Coin(pool.PoolCoinDenom, 1)))
+	require.NoError(t, err)
+	_, _, err = k.Stake(ctx, types.NewMsgStake(stakerB, sdk.NewInt64Coin(pool.PoolCoinDenom, 2)))
+	require.NoError(t, err)
+	require.Equal(t, rewardCoins, simapp.BankKeeper.GetAllBalances(ctx, types.RewardsEscrowAcc))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 3)), simapp.BankKeeper.GetAllBalances(ctx, types.StakesEscrowAcc))
+
+	// the emission of 1000 is distributed to the stakes of 1 and 2 pool coins, the rewards truncated from the
+	// distribution are tracked by the plan, and the rewards truncated from the payouts by the pool
+	ctx = ctx.WithBlockHeight(height + 1)
+	liquidity.BeginBlocker(ctx, k)
+	_, rewards, err := k.Unstake(ctx, types.NewMsgUnstake(stakerA, sdk.NewInt64Coin(pool.PoolCoinDenom, 1)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 333)), rewards)
+	_, rewards, err = k.Unstake(ctx, types.NewMsgUnstake(stakerB, sdk.NewInt64Coin(pool.PoolCoinDenom, 2)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 666)), rewards)
+	plan, found := k.GetRewardPlan(ctx, plan.Id)
+	require.True(t, found)
+	require.Equal(t, sdk.SmallestDec(), plan.TruncatedRewards.AmountOf(pool.PoolCoinDenom))
+	require.Equal(t, sdk.MustNewDecFromStr("0.999999999999999999"),
+		k.GetRewardAccumulator(ctx, pool.Id).TruncatedRewards.AmountOf(pool.PoolCoinDenom))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// the funder is refunded with the emission not distributed and the whole rewards truncated from the distributions
+	// of the plan, and the rewards truncated from the payouts are left in the escrow
+	ctx = ctx.WithBlockHeight(height + 2)
+	liquidity.BeginBlocker(ctx, k)
+	_, found = k.GetRewardPlan(ctx, plan.Id)
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 1000)), simapp.BankKeeper.GetAllBalances(ctx, funder))
+	require.Equal(t, sdk.OneDec(), k.GetRewardAccumulator(ctx, pool.Id).TruncatedRewards.AmountOf(pool.PoolCoinDenom))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 1)), simapp.BankKeeper.GetAllBalances(ctx, types.RewardsEscrowAcc))
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, types.StakesEscrowAcc).IsZero())
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestRewardPlanTruncatedRewardsPerPlan(t *testing.T) {
+	simapp, ctx, pool, creator, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	k := simapp.LiquidityKeeper
+	params := k.GetParams(ctx)
+	invariant := keeper.AllInvariants(k)
+
+	// two plans of the same reward coin denom are funded by different funders, and the emission of the second plan
+	// is distributed to the stakes without truncation
+	rewardCoinsA := sdk.NewCoins(sdk.NewInt64Coin(DenomA, 2000))
+	rewardCoinsB := sdk.NewCoins(sdk.NewInt64Coin(DenomA, 3000))
+	funderA := app.AddRandomTestAddr(simapp, ctx, rewardCoinsA.Add(params.RewardPlanCreationFee...))
+	funderB := app.AddRandomTestAddr(simapp, ctx, rewardCoinsB.Add(params.RewardPlanCreationFee...))
+	staker := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins())
+	require.NoError(t, simapp.BankKeeper.SendCoins(ctx, creator, staker, sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 3))))
+
+	height := ctx.BlockHeight()
+	planA, err := k.CreateRewardPlan(ctx, types.NewMsgCreateRewardPlan(funderA, pool.Id, rewardCoinsA, 2))
+	require.NoError(t, err)
+	planB, err := k.CreateRewardPlan(ctx, types.NewMsgCreateRewardPlan(funderB, pool.Id, rewardCoinsB, 1))
+	require.NoError(t, err)
+	_, _, err = k.Stake(ctx, types.NewMsgStake(staker, sdk.NewInt64Coin(pool.PoolCoinDenom, 3)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(height + 1)
+	liquidity.BeginBlocker(ctx, k)
+	planA, found := k.GetRewardPlan(ctx, planA.Id)
+	require.True(t, found)
+	require.Equal(t, sdk.SmallestDec(), planA.TruncatedRewards.AmountOf(DenomA))
+	_, found = k.GetRewardPlan(ctx, planB.Id)
+	require.False(t, found)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, funderB).IsZero())
+
+	// the payout truncates the rewards pending for the stake, which are not refunded to the funder of either plan
+	_, rewards, err := k.Unstake(ctx, types.NewMsgUnstake(staker, sdk.NewInt64Coin(pool.PoolCoinDenom, 3)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomA, 3999)), rewards)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	ctx = ctx.WithBlockHeight(height + 2)
+	liquidity.BeginBlocker(ctx, k)
+	require.Empty(t, k.GetAllRewardPlans(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomA, 1000)), simapp.BankKeeper.GetAllBalances(ctx, funderA))
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, funderB).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DenomA, 1)), simapp.BankKeeper.GetAllBalances(ctx, types.RewardsEscrowAcc))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestRewardPlanConcentratedPool(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	rewardCoins := sdk.NewCoins(sdk.NewInt64Coin(DenomA, 1000000))
+	creator := app.AddRandomTestAddr(simapp, ctx, depositCoins.Add(params.PoolCreationFee...).Add(rewardCoins...).Add(params.RewardPlanCreationFee...))
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.ConcentratedPoolTypeID, depositCoins))
+	require.NoError(t, err)
+
+	_, err = simapp.LiquidityKeeper.CreateRewardPlan(ctx, types.NewMsgCreateRewardPlan(creator, pool.Id, rewardCoins, 100))
+	require.ErrorIs(t, err, types.ErrPoolCoinNotSupported)
+}
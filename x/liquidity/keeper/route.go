@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// GetPairPrice returns the pool price of the pair of reserve coins of the pool, the price of denomY in denomX where
+// denomX and denomY are sorted alphabetically.
+func (k Keeper) GetPairPrice(ctx sdk.Context, pool types.Pool, denomX, denomY string) sdk.Dec {
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found || k.IsDepletedPool(ctx, pool) {
+		return sdk.ZeroDec()
+	}
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	curve, X, Y := poolCurve.CurrentSwapCurve(ctx, k, pool, k.GetParams(ctx), denomX, denomY, reserveCoins.AmountOf(denomX).ToDec(), reserveCoins.AmountOf(denomY).ToDec())
+	return curve.Price(X, Y)
+}
+
+// GetSwapRouteDenoms returns the denoms of the demand coins of each hop of the swap route through the pools. The demand
+// coin of a hop before the last is the reserve coin the pool shares with the next pool of the route.
+func (k Keeper) GetSwapRouteDenoms(ctx sdk.Context, poolIDs []uint64, offerCoinDenom, demandCoinDenom string) ([]string, error) {
+	pools := make([]types.Pool, len(poolIDs))
+	for i, poolID := range poolIDs {
+		pool, found := k.GetPool(ctx, poolID)
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrPoolNotExists, "pool %d of the swap route", poolID)
+		}
+		if k.IsDepletedPool(ctx, pool) {
+			return nil, sdkerrors.Wrapf(types.ErrDepletedPool, "pool %d of the swap route", poolID)
+		}
+		pools[i] = pool
+	}
+
+	denoms := make([]string, len(pools))
+	denom := offerCoinDenom
+	for i, pool := range pools {
+		if !pool.HasReserveCoinDenom(denom) {
+			return nil, sdkerrors.Wrapf(types.ErrNotMatchedReserveCoin, "%s is not a reserve coin of pool %d", denom, pool.Id)
+		}
+		var next []string
+		if i == len(pools)-1 {
+			if pool.HasReserveCoinDenom(demandCoinDenom) && demandCoinDenom != denom {
+				next = append(next, demandCoinDenom)
+			}
+		} else {
+			for _, reserveCoinDenom := range pool.ReserveCoinDenoms {
+				if reserveCoinDenom != denom && pools[i+1].HasReserveCoinDenom(reserveCoinDenom) {
+					next = append(next, reserveCoinDenom)
+				}
+			}
+		}
+		if len(next) != 1 {
+			return nil, sdkerrors.Wrapf(types.ErrBadSwapRoute, "no single demand coin denom from pool %d", pool.Id)
+		}
+		denom = next[0]
+		denoms[i] = denom
+	}
+	return denoms, nil
+}
+
+// GetSwapRouteOrderPrice returns the order price of a hop of the swap route offering the offer coin to the pool. The
+// order price is set beyond the pool price by SwapRouteOrderPriceSlippage, or by the max order price deviation param if
+// smaller, so that the order price stays within the price band.
+func (k Keeper) GetSwapRouteOrderPrice(ctx sdk.Context, pool types.Pool, offerCoin sdk.Coin, demandCoinDenom string) sdk.Dec {
+	denomX, denomY := types.AlphabeticalDenomPair(offerCoin.Denom, demandCoinDenom)
+	price := k.GetPairPrice(ctx, pool, denomX, denomY)
+	slippage := types.SwapRouteOrderPriceSlippage
+	if maxPriceDeviation := k.GetParams(ctx).MaxOrderPriceDeviation; maxPriceDeviation.IsPositive() {
+		slippage = sdk.MinDec(slippage, maxPriceDeviation)
+	}
+	if offerCoin.Denom == denomX {
+		return price.Mul(sdk.OneDec().Add(slippage))
+	}
+	return price.Mul(sdk.OneDec().Sub(slippage))
+}
+
+// SwapRoute appends the first hop of the swap route to the batch of the first pool of the route. The following hops
+// are appended by SwapRouteNextHop when the previous hop is transacted.
+func (k Keeper) SwapRoute(ctx sdk.Context, msg *types.MsgSwapRoute) (*types.SwapMsgState, error) {
+	denoms, err := k.GetSwapRouteDenoms(ctx, msg.PoolIds, msg.OfferCoin.Denom, msg.DemandCoinDenom)
+	if err != nil {
+		return nil, err
+	}
+	pool, _ := k.GetPool(ctx, msg.PoolIds[0])
+
+	swapMsg := &types.MsgSwapWithinBatch{
+		SwapRequesterAddress: msg.SwapRequesterAddress,
+		PoolId:               pool.Id,
+		SwapTypeId:           types.DefaultSwapTypeID,
+		OfferCoin:            msg.OfferCoin,
+		DemandCoinDenom:      denoms[0],
+		OfferCoinFee:         msg.OfferCoinFee,
+		OrderPrice:           k.GetSwapRouteOrderPrice(ctx, pool, msg.OfferCoin, denoms[0]),
+	}
+	if !swapMsg.OrderPrice.IsPositive() {
+		return nil, types.ErrBadOrderPrice
+	}
+	batchMsg, err := k.SwapWithinBatch(ctx, swapMsg, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	batchMsg.Route = &types.SwapRoute{
+		PoolIds:             msg.PoolIds[1:],
+		DemandCoinDenoms:    denoms[1:],
+		MinDemandCoinAmount: msg.MinDemandCoinAmount,
+	}
+	k.SetPoolBatchSwapMsgState(ctx, pool.Id, *batchMsg)
+	return batchMsg, nil
+}
+
+// SwapRouteNextHop appends the next hop of the swap route offering the demand coin exchanged by the transacted hop,
+// which is held in escrow. It is called once every batch of the end blocker is executed, so the next hop is executed
+// by the following batch of the next pool. The hops of the route are not atomic: if the next hop is not valid, the
+// exchanged coin of the intermediate denom is refunded to the swap requester instead of the original offer coin, as is
+// the offer coin of the last hop not matched at the order price limited by the minimum demand coin amount of the route.
+func (k Keeper) SwapRouteNextHop(ctx sdk.Context, sms *types.SwapMsgState, exchangedCoin sdk.Coin) error {
+	requester := sms.Msg.GetSwapRequester()
+	poolID := sms.Route.PoolIds[0]
+	nextRoute := &types.SwapRoute{
+		PoolIds:             sms.Route.PoolIds[1:],
+		DemandCoinDenoms:    sms.Route.DemandCoinDenoms[1:],
+		MinDemandCoinAmount: sms.Route.MinDemandCoinAmount,
+	}
+	minDemandCoinAmt := sdk.ZeroInt()
+	if len(nextRoute.PoolIds) == 0 {
+		minDemandCoinAmt = nextRoute.MinDemandCoinAmount
+	}
+
+	params := k.GetParams(ctx)
+	offerCoin, offerCoinFee := types.GetOfferCoinAndFee(exchangedCoin, k.GetSwapFeeRate(ctx, poolID))
+	msg := &types.MsgSwapWithinBatch{
+		SwapRequesterAddress: sms.Msg.SwapRequesterAddress,
+		PoolId:               poolID,
+		SwapTypeId:           types.DefaultSwapTypeID,
+		OfferCoin:            offerCoin,
+		DemandCoinDenom:      sms.Route.DemandCoinDenoms[0],
+		OfferCoinFee:         offerCoinFee,
+		MinDemandCoinAmount:  minDemandCoinAmt,
+	}
+
+	pool, found := k.GetPool(ctx, poolID)
+	poolBatch, batchFound := k.GetPoolBatch(ctx, poolID)
+	var err error = types.ErrPoolNotExists
+	if found && batchFound {
+		if k.IsDepletedPool(ctx, pool) {
+			err = types.ErrDepletedPool
+		} else if err = k.ValidateCircuitBreaker(ctx, poolID, types.TypeMsgSwapRoute); err == nil {
+			// the order price of the last hop is limited by the min demand coin amount of the route
+			msg.OrderPrice = k.GetSwapRouteOrderPrice(ctx, pool, offerCoin, msg.DemandCoinDenom)
+			msg.OrderPrice = types.MinDemandOrderPrice(*msg)
+			if err = msg.ValidateBasic(); err == nil {
+				err = k.ValidateMsgSwapWithinBatch(ctx, *msg, pool)
+			}
+		}
+	}
+	if err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapRouteRefunded,
+				sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(poolID, 10)),
+				sdk.NewAttribute(types.AttributeValueSwapRequester, requester.String()),
+				sdk.NewAttribute(types.AttributeValueOfferCoinDenom, exchangedCoin.Denom),
+				sdk.NewAttribute(types.AttributeValueRefundedCoinAmount, exchangedCoin.Amount.String()),
+			))
+		return k.ReleaseEscrow(ctx, requester, sdk.NewCoins(exchangedCoin))
+	}
+
+	// the decimal left over by the split of the offer coin fee is refunded
+	if remainder := exchangedCoin.Sub(offerCoin.Add(offerCoinFee)); remainder.IsPositive() {
+		if err := k.ReleaseEscrow(ctx, requester, sdk.NewCoins(remainder)); err != nil {
+			return err
+		}
+	}
+
+	// the next hop expires at the end of the following batch it is executed by
+	currentHeight := ctx.BlockHeight()
+	orderExpiryHeight := currentHeight + int64(params.UnitBatchHeight)
+	if poolBatch.BeginHeight == 0 {
+		poolBatch.BeginHeight = currentHeight
+	}
+	batchMsg := types.SwapMsgState{
+		MsgHeight:            currentHeight,
+		MsgIndex:             poolBatch.SwapMsgIndex,
+		OrderExpiryHeight:    orderExpiryHeight,
+		ExchangedOfferCoin:   sdk.NewCoin(offerCoin.Denom, sdk.ZeroInt()),
+		RemainingOfferCoin:   offerCoin,
+		ReservedOfferCoinFee: offerCoinFee,
+		Msg:                  msg,
+		Route:                nextRoute,
+	}
+	poolBatch.SwapMsgIndex++
+	k.SetPoolBatch(ctx, poolBatch)
+	k.SetPoolBatchSwapMsgState(ctx, poolID, batchMsg)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapWithinBatch,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(poolID, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueSwapRequester, requester.String()),
+			sdk.NewAttribute(types.AttributeValueSwapTypeId, strconv.FormatUint(uint64(msg.SwapTypeId), 10)),
+			sdk.NewAttribute(types.AttributeValueOfferCoinDenom, offerCoin.Denom),
+			sdk.NewAttribute(types.AttributeValueOfferCoinAmount, offerCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueOfferCoinFeeAmount, offerCoinFee.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueDemandCoinDenom, msg.DemandCoinDenom),
+			sdk.NewAttribute(types.AttributeValueOrderPrice, msg.OrderPrice.String()),
+		))
+	return nil
+}
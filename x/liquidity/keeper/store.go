@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -34,6 +37,7 @@ func (k Keeper) DeletePool(ctx sdk.Context, pool types.Pool) {
 	store := ctx.KVStore(k.storeKey)
 	Key := types.GetPoolKey(pool.Id)
 	store.Delete(Key)
+	k.DeletePoolByDenomIndexes(ctx, pool)
 }
 
 // IterateAllPools iterate through all of the liquidityPools
@@ -119,11 +123,81 @@ func (k Keeper) SetPoolByReserveAccIndex(ctx sdk.Context, pool types.Pool) {
 	store.Set(types.GetPoolByReserveAccIndexKey(pool.GetReserveAccount()), b)
 }
 
-// SetPoolAtomic sets pool with set global pool id index +1 and index by reserveAcc
+// SetPoolByDenomIndexes sets the indexes of the pool by each reserve coin denom and by each pair of reserve coin denoms
+func (k Keeper) SetPoolByDenomIndexes(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	for i, denomA := range pool.ReserveCoinDenoms {
+		store.Set(types.GetPoolByDenomIndexKey(denomA, pool.Id), []byte{})
+		for _, denomB := range pool.ReserveCoinDenoms[i+1:] {
+			store.Set(types.GetPoolByDenomPairIndexKey(denomA, denomB, pool.Id), []byte{})
+		}
+	}
+}
+
+// DeletePoolByDenomIndexes deletes the indexes of the pool by reserve coin denom and by pair of reserve coin denoms
+func (k Keeper) DeletePoolByDenomIndexes(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	for i, denomA := range pool.ReserveCoinDenoms {
+		store.Delete(types.GetPoolByDenomIndexKey(denomA, pool.Id))
+		for _, denomB := range pool.ReserveCoinDenoms[i+1:] {
+			store.Delete(types.GetPoolByDenomPairIndexKey(denomA, denomB, pool.Id))
+		}
+	}
+}
+
+// IteratePoolsByDenom iterates through the pools having the reserve coin denom in ascending order of the pool id
+func (k Keeper) IteratePoolsByDenom(ctx sdk.Context, denom string, cb func(pool types.Pool) (stop bool)) {
+	k.iteratePoolsByIndex(ctx, types.GetPoolsByDenomPrefix(denom), cb)
+}
+
+// GetPoolsByDenom returns all pools having the reserve coin denom
+func (k Keeper) GetPoolsByDenom(ctx sdk.Context, denom string) (pools []types.Pool) {
+	k.IteratePoolsByDenom(ctx, denom, func(pool types.Pool) bool {
+		pools = append(pools, pool)
+		return false
+	})
+	return pools
+}
+
+// IteratePoolsByDenomPair iterates through the pools having the pair of reserve coin denoms in ascending order of the
+// pool id, the denoms can be given in any order
+func (k Keeper) IteratePoolsByDenomPair(ctx sdk.Context, denomA, denomB string, cb func(pool types.Pool) (stop bool)) {
+	k.iteratePoolsByIndex(ctx, types.GetPoolsByDenomPairPrefix(denomA, denomB), cb)
+}
+
+// GetPoolsByDenomPair returns all pools having the pair of reserve coin denoms
+func (k Keeper) GetPoolsByDenomPair(ctx sdk.Context, denomA, denomB string) (pools []types.Pool) {
+	k.IteratePoolsByDenomPair(ctx, denomA, denomB, func(pool types.Pool) bool {
+		pools = append(pools, pool)
+		return false
+	})
+	return pools
+}
+
+func (k Keeper) iteratePoolsByIndex(ctx sdk.Context, prefix []byte, cb func(pool types.Pool) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		poolID := types.ParsePoolIDFromDenomIndexKey(iterator.Key())
+		pool, found := k.GetPool(ctx, poolID)
+		if !found {
+			panic(fmt.Sprintf("pool %d indexed by denom not found", poolID))
+		}
+		if cb(pool) {
+			break
+		}
+	}
+}
+
+// SetPoolAtomic sets pool with set global pool id index +1 and index by reserveAcc and reserve coin denoms
 func (k Keeper) SetPoolAtomic(ctx sdk.Context, pool types.Pool) types.Pool {
 	pool.Id = k.GetNextPoolIDWithUpdate(ctx)
 	k.SetPool(ctx, pool)
 	k.SetPoolByReserveAccIndex(ctx, pool)
+	k.SetPoolByDenomIndexes(ctx, pool)
 	return pool
 }
 
@@ -200,6 +274,7 @@ func (k Keeper) SetPoolBatchDepositMsgState(ctx sdk.Context, poolID uint64, stat
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDepositMsgState(k.cdc, state)
 	store.Set(types.GetPoolBatchDepositMsgStateIndexKey(poolID, state.MsgIndex), b)
+	store.Set(types.GetDepositMsgStateByDepositorIndexKey(state.Msg.GetDepositor(), poolID, state.MsgIndex), []byte{})
 }
 
 // SetPoolBatchDepositMsgStatesByPointer sets deposit batch msgs of the pool batch, with current state using pointers
@@ -211,6 +286,7 @@ func (k Keeper) SetPoolBatchDepositMsgStatesByPointer(ctx sdk.Context, poolID ui
 		}
 		b := types.MustMarshalDepositMsgState(k.cdc, *state)
 		store.Set(types.GetPoolBatchDepositMsgStateIndexKey(poolID, state.MsgIndex), b)
+		store.Set(types.GetDepositMsgStateByDepositorIndexKey(state.Msg.GetDepositor(), poolID, state.MsgIndex), []byte{})
 	}
 }
 
@@ -223,6 +299,7 @@ func (k Keeper) SetPoolBatchDepositMsgStates(ctx sdk.Context, poolID uint64, sta
 		}
 		b := types.MustMarshalDepositMsgState(k.cdc, state)
 		store.Set(types.GetPoolBatchDepositMsgStateIndexKey(poolID, state.MsgIndex), b)
+		store.Set(types.GetDepositMsgStateByDepositorIndexKey(state.Msg.GetDepositor(), poolID, state.MsgIndex), []byte{})
 	}
 }
 
@@ -308,6 +385,7 @@ func (k Keeper) DeleteAllReadyPoolBatchDepositMsgStates(ctx sdk.Context, poolBat
 		state := types.MustUnmarshalDepositMsgState(k.cdc, iterator.Value())
 		if state.ToBeDeleted {
 			store.Delete(iterator.Key())
+			store.Delete(types.GetDepositMsgStateByDepositorIndexKey(state.Msg.GetDepositor(), poolBatch.PoolId, state.MsgIndex))
 		}
 	}
 }
@@ -331,6 +409,7 @@ func (k Keeper) SetPoolBatchWithdrawMsgState(ctx sdk.Context, poolID uint64, sta
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalWithdrawMsgState(k.cdc, state)
 	store.Set(types.GetPoolBatchWithdrawMsgStateIndexKey(poolID, state.MsgIndex), b)
+	store.Set(types.GetWithdrawMsgStateByWithdrawerIndexKey(state.Msg.GetWithdrawer(), poolID, state.MsgIndex), []byte{})
 }
 
 // set withdraw batch msgs of the liquidity pool batch, with current state using pointers
@@ -342,6 +421,7 @@ func (k Keeper) SetPoolBatchWithdrawMsgStatesByPointer(ctx sdk.Context, poolID u
 		}
 		b := types.MustMarshalWithdrawMsgState(k.cdc, *state)
 		store.Set(types.GetPoolBatchWithdrawMsgStateIndexKey(poolID, state.MsgIndex), b)
+		store.Set(types.GetWithdrawMsgStateByWithdrawerIndexKey(state.Msg.GetWithdrawer(), poolID, state.MsgIndex), []byte{})
 	}
 }
 
@@ -354,6 +434,7 @@ func (k Keeper) SetPoolBatchWithdrawMsgStates(ctx sdk.Context, poolID uint64, st
 		}
 		b := types.MustMarshalWithdrawMsgState(k.cdc, state)
 		store.Set(types.GetPoolBatchWithdrawMsgStateIndexKey(poolID, state.MsgIndex), b)
+		store.Set(types.GetWithdrawMsgStateByWithdrawerIndexKey(state.Msg.GetWithdrawer(), poolID, state.MsgIndex), []byte{})
 	}
 }
 
@@ -438,6 +519,7 @@ func (k Keeper) DeleteAllReadyPoolBatchWithdrawMsgStates(ctx sdk.Context, poolBa
 		state := types.MustUnmarshalWithdrawMsgState(k.cdc, iterator.Value())
 		if state.ToBeDeleted {
 			store.Delete(iterator.Key())
+			store.Delete(types.GetWithdrawMsgStateByWithdrawerIndexKey(state.Msg.GetWithdrawer(), poolBatch.PoolId, state.MsgIndex))
 		}
 	}
 }
@@ -461,12 +543,16 @@ func (k Keeper) SetPoolBatchSwapMsgState(ctx sdk.Context, poolID uint64, state t
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalSwapMsgState(k.cdc, state)
 	store.Set(types.GetPoolBatchSwapMsgStateIndexKey(poolID, state.MsgIndex), b)
+	store.Set(types.GetSwapMsgStateByRequesterIndexKey(state.Msg.GetSwapRequester(), poolID, state.MsgIndex), []byte{})
 }
 
 // Delete swap batch msg of the liquidity pool batch, it used for test case
 func (k Keeper) DeletePoolBatchSwapMsgState(ctx sdk.Context, poolID uint64, msgIndex uint64) {
 	store := ctx.KVStore(k.storeKey)
 	batchKey := types.GetPoolBatchSwapMsgStateIndexKey(poolID, msgIndex)
+	if state, found := k.GetPoolBatchSwapMsgState(ctx, poolID, msgIndex); found {
+		store.Delete(types.GetSwapMsgStateByRequesterIndexKey(state.Msg.GetSwapRequester(), poolID, msgIndex))
+	}
 	store.Delete(batchKey)
 }
 
@@ -520,6 +606,7 @@ func (k Keeper) DeleteAllReadyPoolBatchSwapMsgStates(ctx sdk.Context, poolBatch
 		state := types.MustUnmarshalSwapMsgState(k.cdc, iterator.Value())
 		if state.ToBeDeleted {
 			store.Delete(iterator.Key())
+			store.Delete(types.GetSwapMsgStateByRequesterIndexKey(state.Msg.GetSwapRequester(), poolBatch.PoolId, state.MsgIndex))
 		}
 	}
 }
@@ -553,6 +640,17 @@ func (k Keeper) GetAllNotProcessedPoolBatchSwapMsgStates(ctx sdk.Context, poolBa
 	return states
 }
 
+// GetAllCanceledPoolBatchSwapMsgStates returns All only canceled swap msgs, not executed but toDelete BatchSwapMsgs indexed by the liquidityPoolBatch
+func (k Keeper) GetAllCanceledPoolBatchSwapMsgStates(ctx sdk.Context, poolBatch types.PoolBatch) (states []*types.SwapMsgState) {
+	k.IterateAllPoolBatchSwapMsgStates(ctx, poolBatch, func(state types.SwapMsgState) bool {
+		if !state.Executed && state.ToBeDeleted {
+			states = append(states, &state)
+		}
+		return false
+	})
+	return states
+}
+
 // GetAllRemainingPoolBatchSwapMsgStates returns All only remaining after endblock swap msgs, executed but not toDelete
 func (k Keeper) GetAllRemainingPoolBatchSwapMsgStates(ctx sdk.Context, poolBatch types.PoolBatch) (states []*types.SwapMsgState) {
 	k.IterateAllPoolBatchSwapMsgStates(ctx, poolBatch, func(state types.SwapMsgState) bool {
@@ -584,6 +682,7 @@ func (k Keeper) SetPoolBatchSwapMsgStatesByPointer(ctx sdk.Context, poolID uint6
 		}
 		b := types.MustMarshalSwapMsgState(k.cdc, *state)
 		store.Set(types.GetPoolBatchSwapMsgStateIndexKey(poolID, state.MsgIndex), b)
+		store.Set(types.GetSwapMsgStateByRequesterIndexKey(state.Msg.GetSwapRequester(), poolID, state.MsgIndex), []byte{})
 	}
 }
 
@@ -596,5 +695,801 @@ func (k Keeper) SetPoolBatchSwapMsgStates(ctx sdk.Context, poolID uint64, states
 		}
 		b := types.MustMarshalSwapMsgState(k.cdc, state)
 		store.Set(types.GetPoolBatchSwapMsgStateIndexKey(poolID, state.MsgIndex), b)
+		store.Set(types.GetSwapMsgStateByRequesterIndexKey(state.Msg.GetSwapRequester(), poolID, state.MsgIndex), []byte{})
 	}
 }
+
+// IterateDepositMsgStatesByDepositor iterates through the deposit message states of the depositor in the latest batches
+// of all pools in ascending order of the pool id and the msg index
+func (k Keeper) IterateDepositMsgStatesByDepositor(ctx sdk.Context, depositor sdk.AccAddress, cb func(state types.DepositMsgState) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDepositMsgStatesByDepositorPrefix(depositor))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		poolID, msgIndex := types.ParsePoolMsgIndexFromAddressIndexKey(iterator.Key())
+		state, found := k.GetPoolBatchDepositMsgState(ctx, poolID, msgIndex)
+		if !found {
+			panic(fmt.Sprintf("deposit msg state %d of pool %d indexed by depositor not found", msgIndex, poolID))
+		}
+		if cb(state) {
+			break
+		}
+	}
+}
+
+// GetDepositMsgStatesByDepositor returns all deposit message states of the depositor in the latest batches of all pools
+func (k Keeper) GetDepositMsgStatesByDepositor(ctx sdk.Context, depositor sdk.AccAddress) (states []types.DepositMsgState) {
+	k.IterateDepositMsgStatesByDepositor(ctx, depositor, func(state types.DepositMsgState) bool {
+		states = append(states, state)
+		return false
+	})
+	return states
+}
+
+// IterateWithdrawMsgStatesByWithdrawer iterates through the withdraw message states of the withdrawer in the latest batches
+// of all pools in ascending order of the pool id and the msg index
+func (k Keeper) IterateWithdrawMsgStatesByWithdrawer(ctx sdk.Context, withdrawer sdk.AccAddress, cb func(state types.WithdrawMsgState) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetWithdrawMsgStatesByWithdrawerPrefix(withdrawer))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		poolID, msgIndex := types.ParsePoolMsgIndexFromAddressIndexKey(iterator.Key())
+		state, found := k.GetPoolBatchWithdrawMsgState(ctx, poolID, msgIndex)
+		if !found {
+			panic(fmt.Sprintf("withdraw msg state %d of pool %d indexed by withdrawer not found", msgIndex, poolID))
+		}
+		if cb(state) {
+			break
+		}
+	}
+}
+
+// GetWithdrawMsgStatesByWithdrawer returns all withdraw message states of the withdrawer in the latest batches of all pools
+func (k Keeper) GetWithdrawMsgStatesByWithdrawer(ctx sdk.Context, withdrawer sdk.AccAddress) (states []types.WithdrawMsgState) {
+	k.IterateWithdrawMsgStatesByWithdrawer(ctx, withdrawer, func(state types.WithdrawMsgState) bool {
+		states = append(states, state)
+		return false
+	})
+	return states
+}
+
+// IterateSwapMsgStatesByRequester iterates through the swap message states of the swap requester in the latest batches
+// of all pools in ascending order of the pool id and the msg index
+func (k Keeper) IterateSwapMsgStatesByRequester(ctx sdk.Context, requester sdk.AccAddress, cb func(state types.SwapMsgState) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetSwapMsgStatesByRequesterPrefix(requester))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		poolID, msgIndex := types.ParsePoolMsgIndexFromAddressIndexKey(iterator.Key())
+		state, found := k.GetPoolBatchSwapMsgState(ctx, poolID, msgIndex)
+		if !found {
+			panic(fmt.Sprintf("swap msg state %d of pool %d indexed by swap requester not found", msgIndex, poolID))
+		}
+		if cb(state) {
+			break
+		}
+	}
+}
+
+// GetSwapMsgStatesByRequester returns all swap message states of the swap requester in the latest batches of all pools
+func (k Keeper) GetSwapMsgStatesByRequester(ctx sdk.Context, requester sdk.AccAddress) (states []types.SwapMsgState) {
+	k.IterateSwapMsgStatesByRequester(ctx, requester, func(state types.SwapMsgState) bool {
+		states = append(states, state)
+		return false
+	})
+	return states
+}
+
+// GetPosition reads from kvstore and returns a specific position of the concentrated liquidity pool
+func (k Keeper) GetPosition(ctx sdk.Context, positionID uint64) (position types.Position, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetPositionKey(positionID))
+	if value == nil {
+		return position, false
+	}
+	position = types.MustUnmarshalPosition(k.cdc, value)
+	return position, true
+}
+
+// SetPosition sets to kvstore a specific position with the index by pool id
+func (k Keeper) SetPosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalPosition(k.cdc, position)
+	store.Set(types.GetPositionKey(position.Id), b)
+	store.Set(types.GetPositionByPoolIndexKey(position.PoolId, position.Id), []byte{})
+}
+
+// DeletePosition deletes from kvstore a specific position with the index by pool id
+func (k Keeper) DeletePosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPositionKey(position.Id))
+	store.Delete(types.GetPositionByPoolIndexKey(position.PoolId, position.Id))
+}
+
+// IteratePositionsByPool iterates through all of the positions of the pool
+func (k Keeper) IteratePositionsByPool(ctx sdk.Context, poolID uint64, cb func(position types.Position) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPositionsByPoolPrefix(poolID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		positionID := sdk.BigEndianToUint64(iterator.Key()[9:])
+		position, found := k.GetPosition(ctx, positionID)
+		if !found {
+			panic(fmt.Sprintf("position %d indexed by pool %d not found", positionID, poolID))
+		}
+		if cb(position) {
+			break
+		}
+	}
+}
+
+// GetPositionsByPool returns all positions of the pool
+func (k Keeper) GetPositionsByPool(ctx sdk.Context, poolID uint64) (positions []types.Position) {
+	k.IteratePositionsByPool(ctx, poolID, func(position types.Position) bool {
+		positions = append(positions, position)
+		return false
+	})
+	return positions
+}
+
+// GetNextPositionIDWithUpdate returns and increments the global Position ID counter.
+func (k Keeper) GetNextPositionIDWithUpdate(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	positionID := k.GetNextPositionID(ctx)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: positionID + 1})
+	store.Set(types.GlobalPositionIDKey, bz)
+	return positionID
+}
+
+// GetNextPositionID returns next position id for new position, using index of latest position id
+func (k Keeper) GetNextPositionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalPositionIDKey)
+	if bz == nil {
+		// initialize the PositionID
+		return 1
+	}
+	val := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetNextPositionID sets next position id for new position, used when the positions are imported from genesis
+func (k Keeper) SetNextPositionID(ctx sdk.Context, positionID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: positionID})
+	store.Set(types.GlobalPositionIDKey, bz)
+}
+
+// GetPoolPrice returns the pool price of the concentrated liquidity pool, zero if not set
+func (k Keeper) GetPoolPrice(ctx sdk.Context, poolID uint64) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolPriceKey(poolID))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var price sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &price)
+	return price.Dec
+}
+
+// SetPoolPrice sets the pool price of the concentrated liquidity pool
+func (k Keeper) SetPoolPrice(ctx sdk.Context, poolID uint64, price sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: price})
+	store.Set(types.GetPoolPriceKey(poolID), bz)
+}
+
+// GetPriceTick returns the price tick of the concentrated liquidity pool at the price
+func (k Keeper) GetPriceTick(ctx sdk.Context, poolID uint64, price sdk.Dec) (tick types.PriceTick, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetPriceTickKey(poolID, price))
+	if value == nil {
+		return tick, false
+	}
+	k.cdc.MustUnmarshal(value, &tick)
+	return tick, true
+}
+
+// SetPriceTick sets the price tick of the concentrated liquidity pool
+func (k Keeper) SetPriceTick(ctx sdk.Context, tick types.PriceTick) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&tick)
+	store.Set(types.GetPriceTickKey(tick.PoolId, tick.Price), bz)
+}
+
+// DeletePriceTick deletes the price tick of the concentrated liquidity pool
+func (k Keeper) DeletePriceTick(ctx sdk.Context, tick types.PriceTick) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPriceTickKey(tick.PoolId, tick.Price))
+}
+
+// IteratePriceTicksByPool iterates through all of the price ticks of the pool in ascending order of the price
+func (k Keeper) IteratePriceTicksByPool(ctx sdk.Context, poolID uint64, cb func(tick types.PriceTick) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPriceTicksPrefix(poolID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tick types.PriceTick
+		k.cdc.MustUnmarshal(iterator.Value(), &tick)
+		if cb(tick) {
+			break
+		}
+	}
+}
+
+// GetPriceTicksByPool returns all price ticks of the pool in ascending order of the price
+func (k Keeper) GetPriceTicksByPool(ctx sdk.Context, poolID uint64) (ticks []types.PriceTick) {
+	k.IteratePriceTicksByPool(ctx, poolID, func(tick types.PriceTick) bool {
+		ticks = append(ticks, tick)
+		return false
+	})
+	return ticks
+}
+
+// GetFeeGrowthGlobal returns the global fee growth of the concentrated liquidity pool, zero if not set
+func (k Keeper) GetFeeGrowthGlobal(ctx sdk.Context, poolID uint64) types.FeeGrowth {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeGrowthGlobalKey(poolID))
+	if bz == nil {
+		return types.ZeroFeeGrowth()
+	}
+	var feeGrowth types.FeeGrowth
+	k.cdc.MustUnmarshal(bz, &feeGrowth)
+	return feeGrowth
+}
+
+// SetFeeGrowthGlobal sets the global fee growth of the concentrated liquidity pool
+func (k Keeper) SetFeeGrowthGlobal(ctx sdk.Context, poolID uint64, feeGrowth types.FeeGrowth) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&feeGrowth)
+	store.Set(types.GetFeeGrowthGlobalKey(poolID), bz)
+}
+
+// GetPoolBatchResult returns the result of the executed batch of the pool
+func (k Keeper) GetPoolBatchResult(ctx sdk.Context, poolID, batchIndex uint64) (result types.PoolBatchResult, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetPoolBatchResultKey(poolID, batchIndex))
+	if value == nil {
+		return result, false
+	}
+	result = types.MustUnmarshalPoolBatchResult(k.cdc, value)
+	return result, true
+}
+
+// SetPoolBatchResult sets to kvstore the result of the executed batch of the pool
+func (k Keeper) SetPoolBatchResult(ctx sdk.Context, result types.PoolBatchResult) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalPoolBatchResult(k.cdc, result)
+	store.Set(types.GetPoolBatchResultKey(result.PoolId, result.BatchIndex), b)
+}
+
+// DeletePoolBatchResult deletes from kvstore the result of the executed batch of the pool
+func (k Keeper) DeletePoolBatchResult(ctx sdk.Context, poolID, batchIndex uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolBatchResultKey(poolID, batchIndex))
+}
+
+// IteratePoolBatchResults iterates through the batch results of the pool in ascending order of the batch index
+func (k Keeper) IteratePoolBatchResults(ctx sdk.Context, poolID uint64, cb func(result types.PoolBatchResult) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolBatchResultsPrefix(poolID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		result := types.MustUnmarshalPoolBatchResult(k.cdc, iterator.Value())
+		if cb(result) {
+			break
+		}
+	}
+}
+
+// GetPoolBatchResults returns all batch results of the pool kept in the store
+func (k Keeper) GetPoolBatchResults(ctx sdk.Context, poolID uint64) (results []types.PoolBatchResult) {
+	k.IteratePoolBatchResults(ctx, poolID, func(result types.PoolBatchResult) bool {
+		results = append(results, result)
+		return false
+	})
+	return results
+}
+
+// SetPriceAccumulator sets to kvstore the price accumulator updated at the executed batch of the pool
+func (k Keeper) SetPriceAccumulator(ctx sdk.Context, accumulator types.PriceAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalPriceAccumulator(k.cdc, accumulator)
+	store.Set(types.GetPriceAccumulatorKey(accumulator.PoolId, accumulator.BatchIndex), b)
+}
+
+// DeletePriceAccumulator deletes from kvstore the price accumulator updated at the executed batch of the pool
+func (k Keeper) DeletePriceAccumulator(ctx sdk.Context, poolID, batchIndex uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPriceAccumulatorKey(poolID, batchIndex))
+}
+
+// IteratePriceAccumulators iterates through the price accumulators of the pool in ascending order of the batch index
+func (k Keeper) IteratePriceAccumulators(ctx sdk.Context, poolID uint64, cb func(accumulator types.PriceAccumulator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPriceAccumulatorsPrefix(poolID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		accumulator := types.MustUnmarshalPriceAccumulator(k.cdc, iterator.Value())
+		if cb(accumulator) {
+			break
+		}
+	}
+}
+
+// GetPriceAccumulators returns all price accumulators of the pool kept in the store
+func (k Keeper) GetPriceAccumulators(ctx sdk.Context, poolID uint64) (accumulators []types.PriceAccumulator) {
+	k.IteratePriceAccumulators(ctx, poolID, func(accumulator types.PriceAccumulator) bool {
+		accumulators = append(accumulators, accumulator)
+		return false
+	})
+	return accumulators
+}
+
+// GetLatestPriceAccumulatorAt returns the latest price accumulator of the pool updated at or before the given time
+func (k Keeper) GetLatestPriceAccumulatorAt(ctx sdk.Context, poolID uint64, t time.Time) (accumulator types.PriceAccumulator, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetPriceAccumulatorsPrefix(poolID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		accumulator = types.MustUnmarshalPriceAccumulator(k.cdc, iterator.Value())
+		if !accumulator.Time.After(t) {
+			return accumulator, true
+		}
+	}
+	return types.PriceAccumulator{}, false
+}
+
+// GetRewardPlan reads from kvstore and returns a specific reward plan
+func (k Keeper) GetRewardPlan(ctx sdk.Context, planID uint64) (plan types.RewardPlan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetRewardPlanKey(planID))
+	if value == nil {
+		return plan, false
+	}
+	plan = types.MustUnmarshalRewardPlan(k.cdc, value)
+	return plan, true
+}
+
+// SetRewardPlan sets to kvstore a specific reward plan with the index by pool id
+func (k Keeper) SetRewardPlan(ctx sdk.Context, plan types.RewardPlan) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalRewardPlan(k.cdc, plan)
+	store.Set(types.GetRewardPlanKey(plan.Id), b)
+	store.Set(types.GetRewardPlanByPoolIndexKey(plan.PoolId, plan.Id), []byte{})
+}
+
+// DeleteRewardPlan deletes from kvstore a specific reward plan with the index by pool id
+func (k Keeper) DeleteRewardPlan(ctx sdk.Context, plan types.RewardPlan) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRewardPlanKey(plan.Id))
+	store.Delete(types.GetRewardPlanByPoolIndexKey(plan.PoolId, plan.Id))
+}
+
+// IterateAllRewardPlans iterates through all of the reward plans in ascending order of the plan id
+func (k Keeper) IterateAllRewardPlans(ctx sdk.Context, cb func(plan types.RewardPlan) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.RewardPlanKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		plan := types.MustUnmarshalRewardPlan(k.cdc, iterator.Value())
+		if cb(plan) {
+			break
+		}
+	}
+}
+
+// GetAllRewardPlans returns all reward plans
+func (k Keeper) GetAllRewardPlans(ctx sdk.Context) (plans []types.RewardPlan) {
+	k.IterateAllRewardPlans(ctx, func(plan types.RewardPlan) bool {
+		plans = append(plans, plan)
+		return false
+	})
+	return plans
+}
+
+// IterateRewardPlansByPool iterates through all of the reward plans of the pool in ascending order of the plan id
+func (k Keeper) IterateRewardPlansByPool(ctx sdk.Context, poolID uint64, cb func(plan types.RewardPlan) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetRewardPlansByPoolPrefix(poolID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		planID := sdk.BigEndianToUint64(iterator.Key()[9:])
+		plan, found := k.GetRewardPlan(ctx, planID)
+		if !found {
+			panic(fmt.Sprintf("reward plan %d indexed by pool %d not found", planID, poolID))
+		}
+		if cb(plan) {
+			break
+		}
+	}
+}
+
+// GetRewardPlansByPool returns all reward plans of the pool
+func (k Keeper) GetRewardPlansByPool(ctx sdk.Context, poolID uint64) (plans []types.RewardPlan) {
+	k.IterateRewardPlansByPool(ctx, poolID, func(plan types.RewardPlan) bool {
+		plans = append(plans, plan)
+		return false
+	})
+	return plans
+}
+
+// GetNextRewardPlanIDWithUpdate returns and increments the global reward plan ID counter.
+func (k Keeper) GetNextRewardPlanIDWithUpdate(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	planID := k.GetNextRewardPlanID(ctx)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: planID + 1})
+	store.Set(types.GlobalRewardPlanIDKey, bz)
+	return planID
+}
+
+// GetNextRewardPlanID returns next reward plan id for new reward plan, using index of latest reward plan id
+func (k Keeper) GetNextRewardPlanID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalRewardPlanIDKey)
+	if bz == nil {
+		// initialize the RewardPlanID
+		return 1
+	}
+	val := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetNextRewardPlanID sets next reward plan id for new reward plan, used when the reward plans are imported from genesis
+func (k Keeper) SetNextRewardPlanID(ctx sdk.Context, planID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: planID})
+	store.Set(types.GlobalRewardPlanIDKey, bz)
+}
+
+// GetRewardAccumulator returns the reward accumulator of the pool, with no staked pool coin if not set
+func (k Keeper) GetRewardAccumulator(ctx sdk.Context, poolID uint64) types.RewardAccumulator {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetRewardAccumulatorKey(poolID))
+	if value == nil {
+		return types.NewRewardAccumulator(poolID)
+	}
+	return types.MustUnmarshalRewardAccumulator(k.cdc, value)
+}
+
+// SetRewardAccumulator sets to kvstore the reward accumulator of the pool
+func (k Keeper) SetRewardAccumulator(ctx sdk.Context, accumulator types.RewardAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalRewardAccumulator(k.cdc, accumulator)
+	store.Set(types.GetRewardAccumulatorKey(accumulator.PoolId), b)
+}
+
+// GetStake reads from kvstore and returns the stake of the staker in the pool
+func (k Keeper) GetStake(ctx sdk.Context, poolID uint64, staker sdk.AccAddress) (stake types.Stake, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetStakeKey(poolID, staker))
+	if value == nil {
+		return stake, false
+	}
+	stake = types.MustUnmarshalStake(k.cdc, value)
+	return stake, true
+}
+
+// SetStake sets to kvstore the stake of the staker in the pool with the index by staker
+func (k Keeper) SetStake(ctx sdk.Context, stake types.Stake) {
+	store := ctx.KVStore(k.storeKey)
+	staker := stake.GetStaker()
+	b := types.MustMarshalStake(k.cdc, stake)
+	store.Set(types.GetStakeKey(stake.PoolId, staker), b)
+	store.Set(types.GetStakeByStakerIndexKey(staker, stake.PoolId), []byte{})
+}
+
+// DeleteStake deletes from kvstore the stake of the staker in the pool with the index by staker
+func (k Keeper) DeleteStake(ctx sdk.Context, stake types.Stake) {
+	store := ctx.KVStore(k.storeKey)
+	staker := stake.GetStaker()
+	store.Delete(types.GetStakeKey(stake.PoolId, staker))
+	store.Delete(types.GetStakeByStakerIndexKey(staker, stake.PoolId))
+}
+
+// IterateStakesByPool iterates through all of the stakes of the pool
+func (k Keeper) IterateStakesByPool(ctx sdk.Context, poolID uint64, cb func(stake types.Stake) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetStakesByPoolPrefix(poolID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		stake := types.MustUnmarshalStake(k.cdc, iterator.Value())
+		if cb(stake) {
+			break
+		}
+	}
+}
+
+// GetStakesByPool returns all stakes of the pool
+func (k Keeper) GetStakesByPool(ctx sdk.Context, poolID uint64) (stakes []types.Stake) {
+	k.IterateStakesByPool(ctx, poolID, func(stake types.Stake) bool {
+		stakes = append(stakes, stake)
+		return false
+	})
+	return stakes
+}
+
+// IterateStakesByStaker iterates through the stakes of the staker in all pools in ascending order of the pool id
+func (k Keeper) IterateStakesByStaker(ctx sdk.Context, staker sdk.AccAddress, cb func(stake types.Stake) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetStakesByStakerPrefix(staker))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		poolID := types.ParsePoolIDFromStakeByStakerIndexKey(iterator.Key())
+		stake, found := k.GetStake(ctx, poolID, staker)
+		if !found {
+			panic(fmt.Sprintf("stake of pool %d indexed by staker %s not found", poolID, staker))
+		}
+		if cb(stake) {
+			break
+		}
+	}
+}
+
+// GetStakesByStaker returns all stakes of the staker in all pools
+func (k Keeper) GetStakesByStaker(ctx sdk.Context, staker sdk.AccAddress) (stakes []types.Stake) {
+	k.IterateStakesByStaker(ctx, staker, func(stake types.Stake) bool {
+		stakes = append(stakes, stake)
+		return false
+	})
+	return stakes
+}
+
+// GetBond returns a specific bond
+func (k Keeper) GetBond(ctx sdk.Context, bondID uint64) (bond types.Bond, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetBondKey(bondID))
+	if value == nil {
+		return bond, false
+	}
+	bond = types.MustUnmarshalBond(k.cdc, value)
+	return bond, true
+}
+
+// SetBond sets to kvstore a specific bond with the index by owner, and queues the bond by the unbond time if unbonding
+func (k Keeper) SetBond(ctx sdk.Context, bond types.Bond) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalBond(k.cdc, bond)
+	store.Set(types.GetBondKey(bond.Id), b)
+	store.Set(types.GetBondByOwnerIndexKey(bond.GetOwner(), bond.Id), []byte{})
+	if bond.IsUnbonding() {
+		store.Set(types.GetUnbondingQueueKey(bond.UnbondTime, bond.Id), []byte{})
+	}
+}
+
+// DeleteBond deletes from kvstore a specific bond with the index by owner and the entry of the unbonding queue
+func (k Keeper) DeleteBond(ctx sdk.Context, bond types.Bond) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBondKey(bond.Id))
+	store.Delete(types.GetBondByOwnerIndexKey(bond.GetOwner(), bond.Id))
+	if bond.IsUnbonding() {
+		store.Delete(types.GetUnbondingQueueKey(bond.UnbondTime, bond.Id))
+	}
+}
+
+// IterateAllBonds iterates through all of the bonds in ascending order of the bond id
+func (k Keeper) IterateAllBonds(ctx sdk.Context, cb func(bond types.Bond) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BondKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bond := types.MustUnmarshalBond(k.cdc, iterator.Value())
+		if cb(bond) {
+			break
+		}
+	}
+}
+
+// GetBondsByPoolCoinDenom returns all bonds locking the pool coin of the denom
+func (k Keeper) GetBondsByPoolCoinDenom(ctx sdk.Context, poolCoinDenom string) (bonds []types.Bond) {
+	k.IterateAllBonds(ctx, func(bond types.Bond) bool {
+		if bond.PoolCoin.Denom == poolCoinDenom {
+			bonds = append(bonds, bond)
+		}
+		return false
+	})
+	return bonds
+}
+
+// IterateBondsByOwner iterates through the bonds of the owner in ascending order of the bond id
+func (k Keeper) IterateBondsByOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(bond types.Bond) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetBondsByOwnerPrefix(owner))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bondID := types.ParseBondIDFromIndexKey(iterator.Key())
+		bond, found := k.GetBond(ctx, bondID)
+		if !found {
+			panic(fmt.Sprintf("bond %d indexed by owner %s not found", bondID, owner))
+		}
+		if cb(bond) {
+			break
+		}
+	}
+}
+
+// GetBondsByOwner returns all bonds of the owner
+func (k Keeper) GetBondsByOwner(ctx sdk.Context, owner sdk.AccAddress) (bonds []types.Bond) {
+	k.IterateBondsByOwner(ctx, owner, func(bond types.Bond) bool {
+		bonds = append(bonds, bond)
+		return false
+	})
+	return bonds
+}
+
+// IterateMatureUnbondingBonds iterates through the unbonding bonds whose unbond time is at or before the time, in
+// ascending order of the unbond time
+func (k Keeper) IterateMatureUnbondingBonds(ctx sdk.Context, t time.Time, cb func(bond types.Bond) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.UnbondingQueueKeyPrefix, sdk.PrefixEndBytes(types.GetUnbondingQueueTimePrefix(t)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bondID := types.ParseBondIDFromIndexKey(iterator.Key())
+		bond, found := k.GetBond(ctx, bondID)
+		if !found {
+			panic(fmt.Sprintf("unbonding bond %d not found", bondID))
+		}
+		if cb(bond) {
+			break
+		}
+	}
+}
+
+// GetNextBondIDWithUpdate returns and increments the global bond ID counter.
+func (k Keeper) GetNextBondIDWithUpdate(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bondID := k.GetNextBondID(ctx)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: bondID + 1})
+	store.Set(types.GlobalBondIDKey, bz)
+	return bondID
+}
+
+// GetNextBondID returns next bond id for new bond, using index of latest bond id
+func (k Keeper) GetNextBondID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalBondIDKey)
+	if bz == nil {
+		// initialize the BondID
+		return 1
+	}
+	val := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetNextBondID sets next bond id for new bond, used when the bonds are imported from genesis
+func (k Keeper) SetNextBondID(ctx sdk.Context, bondID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: bondID})
+	store.Set(types.GlobalBondIDKey, bz)
+}
+
+// GetCollectedProtocolFees returns the protocol fees collected from the pool, with no coin if not set
+func (k Keeper) GetCollectedProtocolFees(ctx sdk.Context, poolID uint64) types.CollectedProtocolFees {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetCollectedProtocolFeesKey(poolID))
+	if value == nil {
+		return types.CollectedProtocolFees{PoolId: poolID}
+	}
+	return types.MustUnmarshalCollectedProtocolFees(k.cdc, value)
+}
+
+// SetCollectedProtocolFees sets to kvstore the protocol fees collected from the pool
+func (k Keeper) SetCollectedProtocolFees(ctx sdk.Context, fees types.CollectedProtocolFees) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalCollectedProtocolFees(k.cdc, fees)
+	store.Set(types.GetCollectedProtocolFeesKey(fees.PoolId), b)
+}
+
+// GetPoolFeeRate returns the fee rates of the pool set by governance
+func (k Keeper) GetPoolFeeRate(ctx sdk.Context, poolID uint64) (feeRate types.PoolFeeRate, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetPoolFeeRateKey(poolID))
+	if value == nil {
+		return feeRate, false
+	}
+	return types.MustUnmarshalPoolFeeRate(k.cdc, value), true
+}
+
+// SetPoolFeeRate sets to kvstore the fee rates of the pool set by governance
+func (k Keeper) SetPoolFeeRate(ctx sdk.Context, feeRate types.PoolFeeRate) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalPoolFeeRate(k.cdc, feeRate)
+	store.Set(types.GetPoolFeeRateKey(feeRate.PoolId), b)
+}
+
+// GetDynamicSwapFee returns the dynamic swap fee of the pool updated at its latest executed batch
+func (k Keeper) GetDynamicSwapFee(ctx sdk.Context, poolID uint64) (fee types.DynamicSwapFee, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetDynamicSwapFeeKey(poolID))
+	if value == nil {
+		return fee, false
+	}
+	return types.MustUnmarshalDynamicSwapFee(k.cdc, value), true
+}
+
+// SetDynamicSwapFee sets to kvstore the dynamic swap fee of the pool
+func (k Keeper) SetDynamicSwapFee(ctx sdk.Context, fee types.DynamicSwapFee) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalDynamicSwapFee(k.cdc, fee)
+	store.Set(types.GetDynamicSwapFeeKey(fee.PoolId), b)
+}
+
+// GetCircuitBreaker returns the enabled circuit breaker of the pool and the message type
+func (k Keeper) GetCircuitBreaker(ctx sdk.Context, poolID uint64, msgType string) (circuitBreaker types.CircuitBreaker, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetCircuitBreakerKey(poolID, msgType))
+	if value == nil {
+		return circuitBreaker, false
+	}
+	return types.MustUnmarshalCircuitBreaker(k.cdc, value), true
+}
+
+// SetCircuitBreaker sets to kvstore the enabled circuit breaker
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, circuitBreaker types.CircuitBreaker) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalCircuitBreaker(k.cdc, circuitBreaker)
+	store.Set(types.GetCircuitBreakerKey(circuitBreaker.PoolId, circuitBreaker.MsgType), b)
+}
+
+// DeleteCircuitBreaker deletes from kvstore the circuit breaker of the pool and the message type
+func (k Keeper) DeleteCircuitBreaker(ctx sdk.Context, poolID uint64, msgType string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCircuitBreakerKey(poolID, msgType))
+}
+
+// IterateAllCircuitBreakers iterates over all the enabled circuit breakers in ascending order of the pool id
+func (k Keeper) IterateAllCircuitBreakers(ctx sdk.Context, cb func(circuitBreaker types.CircuitBreaker) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.CircuitBreakerKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		circuitBreaker := types.MustUnmarshalCircuitBreaker(k.cdc, iterator.Value())
+		if cb(circuitBreaker) {
+			break
+		}
+	}
+}
+
+// GetAllCircuitBreakers returns all the enabled circuit breakers
+func (k Keeper) GetAllCircuitBreakers(ctx sdk.Context) (circuitBreakers []types.CircuitBreaker) {
+	k.IterateAllCircuitBreakers(ctx, func(circuitBreaker types.CircuitBreaker) bool {
+		circuitBreakers = append(circuitBreakers, circuitBreaker)
+		return false
+	})
+	return circuitBreakers
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/tendermint/liquidity/app"
 	"github.com/tendermint/liquidity/x/liquidity"
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
 	"github.com/tendermint/liquidity/x/liquidity/types"
 )
 
@@ -354,3 +355,125 @@ func TestIterateAllBatchMsgs(t *testing.T) {
 	require.Equal(t, types.PoolBatch{}, batch)
 	require.False(t, found)
 }
+
+func TestMsgStatesByAddressIndex(t *testing.T) {
+	simapp, ctx := createTestInput()
+	simapp.LiquidityKeeper.SetParams(ctx, types.DefaultParams())
+
+	denomX, denomY := types.AlphabeticalDenomPair(DenomX, DenomY)
+	denomA, denomB := types.AlphabeticalDenomPair("denomA", "denomB")
+
+	X := sdk.NewInt(1000000000)
+	Y := sdk.NewInt(500000000)
+	A := sdk.NewInt(500000000)
+	B := sdk.NewInt(1000000000)
+
+	addrs := app.AddTestAddrsIncremental(simapp, ctx, 20, sdk.NewInt(10000))
+	poolID := app.TestCreatePool(t, simapp, ctx, X, Y, denomX, denomY, addrs[0])
+	poolID2 := app.TestCreatePool(t, simapp, ctx, A, B, denomA, denomB, addrs[4])
+
+	app.TestDepositPool(t, simapp, ctx, X.QuoRaw(10), Y, addrs[1:2], poolID, false)
+	app.TestDepositPool(t, simapp, ctx, A.QuoRaw(10), B, addrs[1:2], poolID2, false)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	require.Empty(t, simapp.LiquidityKeeper.GetDepositMsgStatesByDepositor(ctx, addrs[1]))
+
+	app.TestDepositPool(t, simapp, ctx, A.QuoRaw(10), B, addrs[1:2], poolID2, false)
+	app.TestDepositPool(t, simapp, ctx, X.QuoRaw(10), Y, addrs[1:2], poolID, false)
+	app.TestDepositPool(t, simapp, ctx, X.QuoRaw(10), Y, addrs[2:3], poolID, false)
+	app.TestWithdrawPool(t, simapp, ctx, sdk.NewInt(50), addrs[1:2], poolID2, false)
+	app.TestWithdrawPool(t, simapp, ctx, sdk.NewInt(50), addrs[1:2], poolID, false)
+	app.TestSwapPool(t, simapp, ctx, []sdk.Coin{sdk.NewCoin(denomX, sdk.NewInt(10000))}, []sdk.Dec{sdk.MustNewDecFromStr("1.1")}, addrs[1:2], poolID, false)
+	app.TestSwapPool(t, simapp, ctx, []sdk.Coin{sdk.NewCoin(denomA, sdk.NewInt(5000))}, []sdk.Dec{sdk.MustNewDecFromStr("1.1")}, addrs[1:2], poolID2, false)
+	app.TestSwapPool(t, simapp, ctx, []sdk.Coin{sdk.NewCoin(denomY, sdk.NewInt(5000))}, []sdk.Dec{sdk.MustNewDecFromStr("1.2")}, addrs[2:3], poolID, false)
+
+	// the messages are ordered by the pool id and the msg index
+	deposits := simapp.LiquidityKeeper.GetDepositMsgStatesByDepositor(ctx, addrs[1])
+	require.Len(t, deposits, 2)
+	require.Equal(t, poolID, deposits[0].Msg.PoolId)
+	require.Equal(t, poolID2, deposits[1].Msg.PoolId)
+	for _, deposit := range deposits {
+		require.Equal(t, addrs[1].String(), deposit.Msg.DepositorAddress)
+	}
+	require.Len(t, simapp.LiquidityKeeper.GetDepositMsgStatesByDepositor(ctx, addrs[2]), 1)
+
+	withdraws := simapp.LiquidityKeeper.GetWithdrawMsgStatesByWithdrawer(ctx, addrs[1])
+	require.Len(t, withdraws, 2)
+	require.Equal(t, poolID, withdraws[0].Msg.PoolId)
+	require.Equal(t, poolID2, withdraws[1].Msg.PoolId)
+	require.Empty(t, simapp.LiquidityKeeper.GetWithdrawMsgStatesByWithdrawer(ctx, addrs[2]))
+
+	swaps := simapp.LiquidityKeeper.GetSwapMsgStatesByRequester(ctx, addrs[1])
+	require.Len(t, swaps, 2)
+	require.Equal(t, poolID, swaps[0].Msg.PoolId)
+	require.Equal(t, poolID2, swaps[1].Msg.PoolId)
+	swaps = simapp.LiquidityKeeper.GetSwapMsgStatesByRequester(ctx, addrs[2])
+	require.Len(t, swaps, 1)
+	require.Equal(t, addrs[2].String(), swaps[0].Msg.SwapRequesterAddress)
+
+	simapp.LiquidityKeeper.DeletePoolBatchSwapMsgState(ctx, poolID, swaps[0].MsgIndex)
+	require.Empty(t, simapp.LiquidityKeeper.GetSwapMsgStatesByRequester(ctx, addrs[2]))
+
+	// the migration to version 3 indexes the messages stored without the indexes
+	store := ctx.KVStore(simapp.GetKey(types.StoreKey))
+	for _, deposit := range deposits {
+		store.Delete(types.GetDepositMsgStateByDepositorIndexKey(addrs[1], deposit.Msg.PoolId, deposit.MsgIndex))
+	}
+	require.Empty(t, simapp.LiquidityKeeper.GetDepositMsgStatesByDepositor(ctx, addrs[1]))
+	require.NoError(t, keeper.NewMigrator(simapp.LiquidityKeeper).Migrate2to3(ctx))
+	require.Equal(t, deposits, simapp.LiquidityKeeper.GetDepositMsgStatesByDepositor(ctx, addrs[1]))
+	require.Len(t, simapp.LiquidityKeeper.GetWithdrawMsgStatesByWithdrawer(ctx, addrs[1]), 2)
+	require.Len(t, simapp.LiquidityKeeper.GetSwapMsgStatesByRequester(ctx, addrs[1]), 2)
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the indexes of the executed messages are deleted with the messages in the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	require.Empty(t, simapp.LiquidityKeeper.GetDepositMsgStatesByDepositor(ctx, addrs[1]))
+	require.Empty(t, simapp.LiquidityKeeper.GetDepositMsgStatesByDepositor(ctx, addrs[2]))
+	require.Empty(t, simapp.LiquidityKeeper.GetWithdrawMsgStatesByWithdrawer(ctx, addrs[1]))
+	require.Empty(t, simapp.LiquidityKeeper.GetSwapMsgStatesByRequester(ctx, addrs[1]))
+}
+
+func TestPoolsByDenomIndex(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	pairCoins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10_000_000), sdk.NewInt64Coin("uusdt", 10_000_000))
+	multiAssetCoins := pairCoins.Add(sdk.NewInt64Coin("uust", 10_000_000))
+	creator := app.AddRandomTestAddr(simapp, ctx, pairCoins.Add(pairCoins...).Add(multiAssetCoins...).
+		Add(params.PoolCreationFee...).Add(params.PoolCreationFee...).Add(params.PoolCreationFee...))
+
+	standardPool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.DefaultPoolTypeID, pairCoins))
+	require.NoError(t, err)
+	stablePool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.StableSwapPoolTypeID, pairCoins))
+	require.NoError(t, err)
+	multiAssetPool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.MultiAssetPoolTypeID, multiAssetCoins))
+	require.NoError(t, err)
+
+	require.Equal(t, []types.Pool{standardPool, stablePool, multiAssetPool}, simapp.LiquidityKeeper.GetPoolsByDenom(ctx, "uusdc"))
+	require.Equal(t, []types.Pool{multiAssetPool}, simapp.LiquidityKeeper.GetPoolsByDenom(ctx, "uust"))
+	require.Empty(t, simapp.LiquidityKeeper.GetPoolsByDenom(ctx, "uatom"))
+	// the pool coin denoms of the pools are not indexed
+	require.Empty(t, simapp.LiquidityKeeper.GetPoolsByDenom(ctx, standardPool.PoolCoinDenom))
+
+	// the pair denoms are sorted, and every pair of the multi-asset pool is indexed
+	require.Equal(t, []types.Pool{standardPool, stablePool, multiAssetPool}, simapp.LiquidityKeeper.GetPoolsByDenomPair(ctx, "uusdt", "uusdc"))
+	require.Equal(t, []types.Pool{multiAssetPool}, simapp.LiquidityKeeper.GetPoolsByDenomPair(ctx, "uusdc", "uust"))
+	require.Equal(t, []types.Pool{multiAssetPool}, simapp.LiquidityKeeper.GetPoolsByDenomPair(ctx, "uust", "uusdt"))
+	require.Empty(t, simapp.LiquidityKeeper.GetPoolsByDenomPair(ctx, "uusdc", "uatom"))
+
+	simapp.LiquidityKeeper.DeletePool(ctx, stablePool)
+	require.Equal(t, []types.Pool{standardPool, multiAssetPool}, simapp.LiquidityKeeper.GetPoolsByDenomPair(ctx, "uusdc", "uusdt"))
+
+	// the migration to version 3 indexes the pools stored without the indexes
+	simapp.LiquidityKeeper.DeletePoolByDenomIndexes(ctx, multiAssetPool)
+	require.Equal(t, []types.Pool{standardPool}, simapp.LiquidityKeeper.GetPoolsByDenom(ctx, "uusdc"))
+	require.NoError(t, keeper.NewMigrator(simapp.LiquidityKeeper).Migrate2to3(ctx))
+	require.Equal(t, []types.Pool{standardPool, multiAssetPool}, simapp.LiquidityKeeper.GetPoolsByDenom(ctx, "uusdc"))
+	require.Equal(t, []types.Pool{multiAssetPool}, simapp.LiquidityKeeper.GetPoolsByDenomPair(ctx, "uusdt", "uust"))
+}
@@ -10,38 +10,62 @@ import (
 )
 
 // Execute Swap of the pool batch, Collect swap messages in batch for transact the same price for each batch and run them on endblock.
-func (k Keeper) SwapExecution(ctx sdk.Context, poolBatch types.PoolBatch) (uint64, error) {
+// It returns the swap results of the pairs of reserve coins whose swap orders were matched, and the next hops of the
+// transacted hops of swap routes.
+func (k Keeper) SwapExecution(ctx sdk.Context, poolBatch types.PoolBatch) (uint64, []types.PairSwapResult, []types.SwapRouteHop, error) {
+	// refund the swap orders canceled since the last batch before matching the others.
+	canceledMsgCount, err := k.RefundCanceledSwaps(ctx, poolBatch)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
 	// get all swap message batch states that are not executed, not succeeded, and not to be deleted.
 	swapMsgStates := k.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, poolBatch)
 	if len(swapMsgStates) == 0 {
-		return 0, nil
+		return canceledMsgCount, nil, nil, nil
 	}
 
 	pool, found := k.GetPool(ctx, poolBatch.PoolId)
 	if !found {
-		return 0, types.ErrPoolNotExists
-	}
-
-	if k.IsDepletedPool(ctx, pool) {
-		return 0, types.ErrDepletedPool
+		return 0, nil, nil, types.ErrPoolNotExists
 	}
 
 	currentHeight := ctx.BlockHeight()
 	// set executed states of all messages to true
-	executedMsgCount := uint64(0)
-	var swapMsgStatesNotToBeDeleted []*types.SwapMsgState
+	executedMsgCount := canceledMsgCount
+	depleted := k.IsDepletedPool(ctx, pool)
+	// the pending orders of a pool whose swaps are halted by the circuit breakers are refunded instead of executed
+	swapHalted := k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapWithinBatch)
+	swapRouteHalted := k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapRoute)
+	dynamicSwapFeeEnabled := k.GetParams(ctx).DynamicSwapFeeEnabled
+	swapFeeRate := k.GetSwapFeeRate(ctx, pool.Id)
+	var swapMsgStatesNotToBeDeleted, swapMsgStatesToBeRefunded []*types.SwapMsgState
 	for _, sms := range swapMsgStates {
 		sms.Executed = true
 		executedMsgCount++
 		if currentHeight > sms.OrderExpiryHeight {
 			sms.ToBeDeleted = true
 		}
-		if err := k.ValidateMsgSwapWithinBatch(ctx, *sms.Msg, pool); err != nil {
+		if depleted || (sms.Route == nil && swapHalted) || (sms.Route != nil && swapRouteHalted) {
+			sms.ToBeDeleted = true
+		} else if err := k.ValidateSwapExecution(ctx, *sms.Msg, pool); err != nil {
 			sms.ToBeDeleted = true
 		}
 		if !sms.ToBeDeleted {
+			// the offer coin fee reserved above the dynamic swap fee rate of the batch is released to the requester
+			if dynamicSwapFeeEnabled {
+				offerCoinFee := types.ChargedOfferCoinFee(sms, swapFeeRate)
+				if excess := sms.ReservedOfferCoinFee.Sub(offerCoinFee); excess.IsPositive() {
+					if err := k.ReleaseEscrow(ctx, sms.Msg.GetSwapRequester(), sdk.NewCoins(excess)); err != nil {
+						return executedMsgCount, nil, nil, err
+					}
+					sms.ReservedOfferCoinFee = offerCoinFee
+				}
+			}
 			swapMsgStatesNotToBeDeleted = append(swapMsgStatesNotToBeDeleted, sms)
 		} else {
+			// the orders carried forward from the previous batches are refunded once they are not executable anymore
+			swapMsgStatesToBeRefunded = append(swapMsgStatesToBeRefunded, sms)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSwapTransacted,
@@ -62,30 +86,85 @@ func (k Keeper) SwapExecution(ctx sdk.Context, poolBatch types.PoolBatch) (uint6
 				))
 		}
 	}
+	if err := k.RefundAndDeleteSwaps(ctx, pool.Id, swapMsgStatesToBeRefunded); err != nil {
+		return executedMsgCount, nil, nil, err
+	}
 	k.SetPoolBatchSwapMsgStatesByPointer(ctx, pool.Id, swapMsgStates)
 	swapMsgStates = swapMsgStatesNotToBeDeleted
+	if len(swapMsgStates) == 0 {
+		return executedMsgCount, nil, nil, nil
+	}
 
 	types.ValidateStateAndExpireOrders(swapMsgStates, currentHeight, false)
 
-	// get reserve coins from the liquidity pool and calculate the current pool price (p = x / y)
+	var swapResults []types.PairSwapResult
+	var routeHops []types.SwapRouteHop
+	// match the orders of each pair of reserve coins separately, a pool with two reserve coins has only one pair
+	for i := 0; i < len(pool.ReserveCoinDenoms)-1; i++ {
+		for j := i + 1; j < len(pool.ReserveCoinDenoms); j++ {
+			denomX, denomY := pool.ReserveCoinDenoms[i], pool.ReserveCoinDenoms[j]
+			var pairSwapMsgStates []*types.SwapMsgState
+			for _, sms := range swapMsgStates {
+				if (sms.Msg.OfferCoin.Denom == denomX && sms.Msg.DemandCoinDenom == denomY) ||
+					(sms.Msg.OfferCoin.Denom == denomY && sms.Msg.DemandCoinDenom == denomX) {
+					pairSwapMsgStates = append(pairSwapMsgStates, sms)
+				}
+			}
+			if len(pairSwapMsgStates) == 0 {
+				continue
+			}
+			swapResult, pairRouteHops, err := k.PairSwapExecution(ctx, pool, pairSwapMsgStates, denomX, denomY)
+			if err != nil {
+				return executedMsgCount, nil, nil, err
+			}
+			if swapResult != nil {
+				swapResults = append(swapResults, *swapResult)
+			}
+			routeHops = append(routeHops, pairRouteHops...)
+		}
+	}
+
+	return executedMsgCount, swapResults, routeHops, nil
+}
+
+// PairSwapExecution matches the swap orders between the given pair of reserve coins of the pool at a single swap price
+// and transacts them with the pool. It returns the swap result of the pair, nil if the orders were refunded without a swap price,
+// and the next hops of the transacted hops of swap routes.
+func (k Keeper) PairSwapExecution(ctx sdk.Context, pool types.Pool, swapMsgStates []*types.SwapMsgState, denomX, denomY string) (*types.PairSwapResult, []types.SwapRouteHop, error) {
+	currentHeight := ctx.BlockHeight()
+
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return nil, nil, types.ErrPoolTypeNotExists
+	}
+	params := k.GetParams(ctx)
+
+	// get reserve coins from the liquidity pool and calculate the current pool price on the swap curve of the pool type
 	reserveCoins := k.GetReserveCoins(ctx, pool)
+	reserveX := reserveCoins.AmountOf(denomX).ToDec()
+	reserveY := reserveCoins.AmountOf(denomY).ToDec()
+	curve, X, Y := poolCurve.CurrentSwapCurve(ctx, k, pool, params, denomX, denomY, reserveX, reserveY)
+	currentPoolPrice := curve.Price(X, Y)
 
-	X := reserveCoins[0].Amount.ToDec()
-	Y := reserveCoins[1].Amount.ToDec()
-	currentPoolPrice := X.Quo(Y)
-	denomX := reserveCoins[0].Denom
-	denomY := reserveCoins[1].Denom
+	// size the exact-output orders to their demand coin amounts and reject the ones not able to receive them before
+	// matching, so that the swap price is found by the orders as transacted
+	swapMsgStates, err := k.SizeExactOutputSwaps(ctx, pool, swapMsgStates, denomX, denomY, curve, X, Y)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(swapMsgStates) == 0 {
+		return nil, nil, nil
+	}
 
 	// make orderMap, orderbook by sort orderMap
 	orderMap, xToY, yToX := types.MakeOrderMap(swapMsgStates, denomX, denomY, false)
 	orderBook := orderMap.SortOrderBook()
 
 	// check orderbook validity and compute batchResult(direction, swapPrice, ..)
-	result, found := orderBook.Match(X, Y)
+	result, found := orderBook.Match(curve, X, Y, params.MaxOrderPriceDeviation)
 
-	if !found || X.Quo(Y).IsZero() {
-		err := k.RefundSwaps(ctx, pool, swapMsgStates)
-		return executedMsgCount, err
+	if !found || currentPoolPrice.IsZero() {
+		return nil, nil, k.RefundSwaps(ctx, pool, swapMsgStates)
 	}
 
 	// find order match, calculate pool delta with the total x, y amounts for the invariant check
@@ -102,9 +181,9 @@ func (k Keeper) SwapExecution(ctx sdk.Context, poolBatch types.PoolBatch) (uint6
 		poolYDelta = poolYDeltaXtoY.Add(poolYDeltaYtoX)
 	}
 
-	xToY, yToX, X, Y, poolXDelta2, poolYDelta2 := types.UpdateSwapMsgStates(X, Y, xToY, yToX, matchResultXtoY, matchResultYtoX)
+	xToY, yToX, _, _, poolXDelta2, poolYDelta2 := types.UpdateSwapMsgStates(X, Y, xToY, yToX, matchResultXtoY, matchResultYtoX)
 
-	lastPrice := X.Quo(Y)
+	lastPrice := poolCurve.PriceAfterSwap(pool, params, denomX, denomY, reserveX, reserveY, curve, result, poolXDelta2, poolYDelta2)
 
 	if BatchLogicInvariantCheckFlag {
 		SwapMatchingInvariants(xToY, yToX, matchResultXtoY, matchResultYtoX)
@@ -117,7 +196,7 @@ func (k Keeper) SwapExecution(ctx sdk.Context, poolBatch types.PoolBatch) (uint6
 	orderMapExecuted, _, _ := types.MakeOrderMap(append(xToY, yToX...), denomX, denomY, true)
 	orderBookExecuted := orderMapExecuted.SortOrderBook()
 	if !orderBookExecuted.Validate(lastPrice) {
-		return executedMsgCount, types.ErrOrderBookInvalidity
+		return nil, nil, types.ErrOrderBookInvalidity
 	}
 
 	types.ValidateStateAndExpireOrders(xToY, currentHeight, true)
@@ -127,7 +206,7 @@ func (k Keeper) SwapExecution(ctx sdk.Context, poolBatch types.PoolBatch) (uint6
 	matchResultMap := make(map[uint64]types.MatchResult)
 	for _, match := range append(matchResultXtoY, matchResultYtoX...) {
 		if _, ok := matchResultMap[match.SwapMsgState.MsgIndex]; ok {
-			return executedMsgCount, fmt.Errorf("duplicate match order")
+			return nil, nil, fmt.Errorf("duplicate match order")
 		}
 		matchResultMap[match.SwapMsgState.MsgIndex] = match
 	}
@@ -139,9 +218,189 @@ func (k Keeper) SwapExecution(ctx sdk.Context, poolBatch types.PoolBatch) (uint6
 	}
 
 	// execute transact, refund, expire, send coins with escrow, update state by TransactAndRefundSwapLiquidityPool
-	if err := k.TransactAndRefundSwapLiquidityPool(ctx, swapMsgStates, matchResultMap, pool, result); err != nil {
-		return executedMsgCount, err
+	routeHops, err := k.TransactAndRefundSwapLiquidityPool(ctx, swapMsgStates, matchResultMap, pool, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if BatchLogicInvariantCheckFlag && len(reserveCoins) > 2 {
+		MultiAssetSwapInvariant(denomX, denomY, reserveCoins, k.GetReserveCoins(ctx, pool))
 	}
 
-	return executedMsgCount, nil
+	// sum up the volumes and the fees of the orders as transacted on settlement
+	swapResult := types.PairSwapResult{
+		DenomX:     denomX,
+		DenomY:     denomY,
+		SwapPrice:  result.SwapPrice,
+		XToYVolume: sdk.ZeroInt(),
+		YToXVolume: sdk.ZeroInt(),
+		FeeCoins:   sdk.NewCoins(),
+	}
+	for _, sms := range swapMsgStates {
+		match, ok := matchResultMap[sms.MsgIndex]
+		if !ok {
+			continue
+		}
+		if match.OrderDirection == types.DirectionXtoY {
+			swapResult.XToYVolume = swapResult.XToYVolume.Add(match.TransactedCoinAmt.TruncateInt())
+		} else {
+			swapResult.YToXVolume = swapResult.YToXVolume.Add(match.TransactedCoinAmt.TruncateInt())
+		}
+		swapResult.FeeCoins = swapResult.FeeCoins.
+			Add(sdk.NewCoin(sms.Msg.OfferCoin.Denom, match.OfferCoinFeeAmt.TruncateInt())).
+			Add(sdk.NewCoin(sms.Msg.DemandCoinDenom, match.ExchangedCoinFeeAmt.TruncateInt()))
+	}
+
+	poolCurve.AfterSwap(ctx, k, pool, lastPrice)
+	return &swapResult, routeHops, nil
+}
+
+// SimulateSwapWithinBatch matches the given swap order with the swap orders in the current batch of the pool as PairSwapExecution
+// does, and returns the batch result and the match result of the given order, without writing to the store. The
+// exact-output orders are sized to their demand coin amounts before matching.
+func (k Keeper) SimulateSwapWithinBatch(ctx sdk.Context, msg types.MsgSwapWithinBatch) (types.BatchResult, types.MatchResult, bool, error) {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.BatchResult{}, types.MatchResult{}, false, types.ErrPoolNotExists
+	}
+	if k.IsDepletedPool(ctx, pool) {
+		return types.BatchResult{}, types.MatchResult{}, false, types.ErrDepletedPool
+	}
+	if err := k.ValidateCircuitBreaker(ctx, pool.Id, types.TypeMsgSwapWithinBatch); err != nil {
+		return types.BatchResult{}, types.MatchResult{}, false, err
+	}
+	poolBatch, found := k.GetPoolBatch(ctx, pool.Id)
+	if !found {
+		return types.BatchResult{}, types.MatchResult{}, false, types.ErrPoolBatchNotExists
+	}
+	params := k.GetParams(ctx)
+	swapFeeRate := k.GetSwapFeeRate(ctx, pool.Id)
+	msg.OfferCoinFee = types.GetOfferCoinFee(msg.OfferCoin, swapFeeRate)
+	if err := k.ValidateMsgSwapWithinBatch(ctx, msg, pool); err != nil {
+		return types.BatchResult{}, types.MatchResult{}, false, err
+	}
+	msg.OrderPrice = types.MinDemandOrderPrice(msg)
+
+	// the swap orders of the pair in the current batch which would be matched with the given order
+	currentHeight := ctx.BlockHeight()
+	denomX, denomY := types.AlphabeticalDenomPair(msg.OfferCoin.Denom, msg.DemandCoinDenom)
+	var swapMsgStates []*types.SwapMsgState
+	for _, sms := range k.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, poolBatch) {
+		if currentHeight > sms.OrderExpiryHeight || k.ValidateSwapExecution(ctx, *sms.Msg, pool) != nil {
+			continue
+		}
+		if params.DynamicSwapFeeEnabled {
+			sms.ReservedOfferCoinFee = types.ChargedOfferCoinFee(sms, swapFeeRate)
+		}
+		if (sms.Msg.OfferCoin.Denom == denomX && sms.Msg.DemandCoinDenom == denomY) ||
+			(sms.Msg.OfferCoin.Denom == denomY && sms.Msg.DemandCoinDenom == denomX) {
+			swapMsgStates = append(swapMsgStates, sms)
+		}
+	}
+	simulated := &types.SwapMsgState{
+		MsgHeight:            currentHeight,
+		MsgIndex:             poolBatch.SwapMsgIndex,
+		OrderExpiryHeight:    currentHeight,
+		ExchangedOfferCoin:   sdk.NewCoin(msg.OfferCoin.Denom, sdk.ZeroInt()),
+		RemainingOfferCoin:   msg.OfferCoin,
+		ReservedOfferCoinFee: msg.OfferCoinFee,
+		Msg:                  &msg,
+	}
+	swapMsgStates = append(swapMsgStates, simulated)
+
+	poolCurve, found := types.GetPoolCurve(pool.TypeId)
+	if !found {
+		return types.BatchResult{}, types.MatchResult{}, false, types.ErrPoolTypeNotExists
+	}
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	curve, X, Y := poolCurve.CurrentSwapCurve(ctx, k, pool, params, denomX, denomY, reserveCoins.AmountOf(denomX).ToDec(), reserveCoins.AmountOf(denomY).ToDec())
+	if curve.Price(X, Y).IsZero() {
+		return types.BatchResult{}, types.MatchResult{}, false, nil
+	}
+
+	swapMsgStates, _ = types.SizeExactOutputOrders(swapMsgStates, denomX, denomY, curve, X, Y, params.MaxOrderPriceDeviation, currentHeight)
+	orderMap, xToY, yToX := types.MakeOrderMap(swapMsgStates, denomX, denomY, false)
+	result, found := orderMap.SortOrderBook().Match(curve, X, Y, params.MaxOrderPriceDeviation)
+	if !found || result.MatchType == types.NoMatch {
+		return result, types.MatchResult{}, false, nil
+	}
+
+	var matchResults []types.MatchResult
+	if msg.OfferCoin.Denom == denomX {
+		matchResults, _, _ = types.FindOrderMatch(types.DirectionXtoY, xToY, result.EX, result.SwapPrice, currentHeight)
+	} else {
+		matchResults, _, _ = types.FindOrderMatch(types.DirectionYtoX, yToX, result.EY, result.SwapPrice, currentHeight)
+	}
+	for _, match := range matchResults {
+		if match.SwapMsgState == simulated {
+			return result, match, true, nil
+		}
+	}
+	return result, types.MatchResult{}, false, nil
+}
+
+// SizeExactOutputSwaps sizes the exact-output swap orders of the pair to their demand coin amounts at the swap price of
+// the batch before the orders are matched, and refunds the unused offer coins and offer coin fees of the sized orders.
+// The exact-output orders not able to receive their demand coin amounts are refunded instead. It returns the swap
+// orders to be matched.
+func (k Keeper) SizeExactOutputSwaps(ctx sdk.Context, pool types.Pool, swapMsgStates []*types.SwapMsgState, denomX, denomY string, curve types.SwapCurve, X, Y sdk.Dec) ([]*types.SwapMsgState, error) {
+	// the exact-output orders as held in escrow before sizing
+	held := make(map[uint64]types.SwapMsgState)
+	for _, sms := range swapMsgStates {
+		if sms.Msg.SwapTypeId == types.ExactOutputSwapTypeID {
+			held[sms.MsgIndex] = *sms
+		}
+	}
+	if len(held) == 0 {
+		return swapMsgStates, nil
+	}
+
+	params := k.GetParams(ctx)
+	swapMsgStates, rejected := types.SizeExactOutputOrders(swapMsgStates, denomX, denomY, curve, X, Y, params.MaxOrderPriceDeviation, ctx.BlockHeight())
+	for _, sms := range swapMsgStates {
+		heldSms, ok := held[sms.MsgIndex]
+		if !ok {
+			continue
+		}
+		unused := heldSms.RemainingOfferCoin.Sub(sms.RemainingOfferCoin).Add(heldSms.ReservedOfferCoinFee.Sub(sms.ReservedOfferCoinFee))
+		if unused.IsPositive() {
+			if err := k.ReleaseEscrow(ctx, sms.Msg.GetSwapRequester(), sdk.NewCoins(unused)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(rejected) == 0 {
+		return swapMsgStates, nil
+	}
+
+	poolBatch, _ := k.GetPoolBatch(ctx, pool.Id)
+	for _, sms := range rejected {
+		// the rejected order is refunded with its offer coin and offer coin fee as held before sizing
+		sms.RemainingOfferCoin = held[sms.MsgIndex].RemainingOfferCoin
+		sms.ReservedOfferCoinFee = held[sms.MsgIndex].ReservedOfferCoinFee
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapTransacted,
+				sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+				sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(sms.MsgIndex, 10)),
+				sdk.NewAttribute(types.AttributeValueSwapRequester, sms.Msg.GetSwapRequester().String()),
+				sdk.NewAttribute(types.AttributeValueSwapTypeId, strconv.FormatUint(uint64(sms.Msg.SwapTypeId), 10)),
+				sdk.NewAttribute(types.AttributeValueOfferCoinDenom, sms.Msg.OfferCoin.Denom),
+				sdk.NewAttribute(types.AttributeValueOfferCoinAmount, sms.Msg.OfferCoin.Amount.String()),
+				sdk.NewAttribute(types.AttributeValueDemandCoinDenom, sms.Msg.DemandCoinDenom),
+				sdk.NewAttribute(types.AttributeValueOrderPrice, sms.Msg.OrderPrice.String()),
+				sdk.NewAttribute(types.AttributeValueRemainingOfferCoinAmount, sms.RemainingOfferCoin.Amount.String()),
+				sdk.NewAttribute(types.AttributeValueExchangedOfferCoinAmount, sms.ExchangedOfferCoin.Amount.String()),
+				sdk.NewAttribute(types.AttributeValueDemandCoinAmount, sms.Msg.DemandCoinAmount.String()),
+				sdk.NewAttribute(types.AttributeValueReservedOfferCoinFeeAmount, sms.ReservedOfferCoinFee.Amount.String()),
+				sdk.NewAttribute(types.AttributeValueOrderExpiryHeight, strconv.FormatInt(sms.OrderExpiryHeight, 10)),
+				sdk.NewAttribute(types.AttributeValueSuccess, types.Failure),
+				sdk.NewAttribute(types.AttributeValueFailureReason, types.FailureReasonExactOutputNotFillable),
+			))
+	}
+	if err := k.RefundAndDeleteSwaps(ctx, pool.Id, rejected); err != nil {
+		return nil, err
+	}
+	return swapMsgStates, nil
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/tendermint/liquidity/app"
 	"github.com/tendermint/liquidity/x/liquidity"
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
 	"github.com/tendermint/liquidity/x/liquidity/types"
 )
 
@@ -347,6 +348,83 @@ func TestSwapWithDepletedPool(t *testing.T) {
 	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
 }
 
+func TestSwapPriceBand(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 10_000_000), sdk.NewInt64Coin(DenomY, 10_000_000))
+	require.NoError(t, err)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.MaxOrderPriceDeviation = sdk.NewDecWithPrec(5, 2)
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	lower, upper := types.PriceBand(sdk.OneDec(), params.MaxOrderPriceDeviation)
+
+	// the slippage of the swap route orders is narrowed to the max order price deviation
+	offerCoin := sdk.NewInt64Coin(DenomX, 900_000)
+	require.Equal(t, upper, simapp.LiquidityKeeper.GetSwapRouteOrderPrice(ctx, pool, offerCoin, DenomY))
+	require.Equal(t, lower, simapp.LiquidityKeeper.GetSwapRouteOrderPrice(ctx, pool, sdk.NewInt64Coin(DenomY, 900_000), DenomX))
+
+	offerCoinFee := types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)
+	addr := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(offerCoinFee)))
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the order prices out of the price band are rejected
+	for _, orderPrice := range []sdk.Dec{sdk.NewDec(10), upper.Add(sdk.SmallestDec()), lower.Sub(sdk.SmallestDec())} {
+		_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+			addr, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, orderPrice, params.SwapFeeRate), 0)
+		require.ErrorIs(t, err, types.ErrOrderPriceOutOfBand)
+	}
+
+	// the order at the edge of the price band is accepted and executed within the band
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		addr, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, upper, params.SwapFeeRate), 0)
+	require.NoError(t, err)
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	results := simapp.LiquidityKeeper.GetPoolBatchResults(ctx, pool.Id)
+	require.NotEmpty(t, results)
+	result := results[len(results)-1]
+	require.Len(t, result.SwapResults, 1)
+	require.True(t, result.SwapResults[0].SwapPrice.LTE(upper))
+	require.True(t, result.SwapResults[0].XToYVolume.IsPositive())
+}
+
+func TestSwapPriceBandPendingOrder(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 10_000_000), sdk.NewInt64Coin(DenomY, 10_000_000))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(1)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.UnitBatchHeight = 2
+	params.MaxOrderPriceDeviation = sdk.NewDecWithPrec(5, 2)
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	_, upper := types.PriceBand(sdk.OneDec(), params.MaxOrderPriceDeviation)
+
+	offerCoin := sdk.NewInt64Coin(DenomX, 900_000)
+	addr := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		addr, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, upper, params.SwapFeeRate), 4)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the price band is narrowed while the order is pending, leaving its order price beyond the band
+	ctx = ctx.WithBlockHeight(2)
+	params.MaxOrderPriceDeviation = sdk.NewDecWithPrec(1, 2)
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	_, upper = types.PriceBand(sdk.OneDec(), params.MaxOrderPriceDeviation)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the order is not refunded but executed at the order price clamped to the band
+	results := simapp.LiquidityKeeper.GetPoolBatchResults(ctx, pool.Id)
+	require.NotEmpty(t, results)
+	result := results[len(results)-1]
+	require.Len(t, result.SwapResults, 1)
+	require.True(t, result.SwapResults[0].SwapPrice.LTE(upper))
+	require.True(t, result.SwapResults[0].XToYVolume.IsPositive())
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addr, DenomY).Amount.IsPositive())
+}
+
 func createPool(simapp *app.LiquidityApp, ctx sdk.Context, X, Y sdk.Int, denomX, denomY string) (types.Pool, error) {
 	params := simapp.LiquidityKeeper.GetParams(ctx)
 
@@ -355,3 +433,215 @@ func createPool(simapp *app.LiquidityApp, ctx sdk.Context, X, Y sdk.Int, denomX,
 
 	return simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addr, types.DefaultPoolTypeID, coins))
 }
+
+func TestMultiAssetPoolSwap(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	reserveCoins := sdk.NewCoins(
+		sdk.NewCoin("denoma", sdk.NewInt(1_000_000_000)),
+		sdk.NewCoin("denomb", sdk.NewInt(1_000_000_000)),
+		sdk.NewCoin("denomc", sdk.NewInt(1_000_000_000)),
+	)
+	creator := app.AddRandomTestAddr(simapp, ctx, reserveCoins.Add(params.PoolCreationFee...))
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.MultiAssetPoolTypeID, reserveCoins))
+	require.NoError(t, err)
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// a swap between reserve coins which are not in the pool is rejected
+	offerCoin := sdk.NewCoin("denoma", sdk.NewInt(1_000_000))
+	addr := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		addr, pool.Id, types.DefaultSwapTypeID, offerCoin, "denomd", sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate), 0)
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+
+	// swaps on different pairs of the pool are matched separately
+	type order struct {
+		offerDenom, demandDenom string
+		price                   sdk.Dec
+	}
+	var addrs []sdk.AccAddress
+	for _, o := range []order{
+		{"denoma", "denomc", sdk.MustNewDecFromStr("1.1")},
+		{"denomb", "denomc", sdk.MustNewDecFromStr("1.1")},
+		{"denomc", "denomb", sdk.MustNewDecFromStr("0.9")},
+	} {
+		offerCoin := sdk.NewCoin(o.offerDenom, sdk.NewInt(1_000_000))
+		addr := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+		_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+			addr, pool.Id, types.DefaultSwapTypeID, offerCoin, o.demandDenom, o.price, params.SwapFeeRate), 0)
+		require.NoError(t, err)
+		addrs = append(addrs, addr)
+	}
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[0], "denomc").IsPositive())
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], "denomc").IsPositive())
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[2], "denomb").IsPositive())
+
+	// the pair which has no orders keeps its reserves
+	after := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	require.True(t, after.AmountOf("denoma").GT(reserveCoins.AmountOf("denoma")))
+	require.True(t, after.AmountOf("denomc").LT(reserveCoins.AmountOf("denomc")))
+	require.True(t, simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool).Equal(params.InitPoolCoinMintAmount))
+}
+
+func TestWeightedPoolSwap(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	// the weighted pool price is (800/80)/(200/20) = 1, while the pool price X/Y would be 4
+	reserveCoins := sdk.NewCoins(sdk.NewCoin("denomx", sdk.NewInt(800_000_000)), sdk.NewCoin("denomy", sdk.NewInt(200_000_000)))
+	creator := app.AddRandomTestAddr(simapp, ctx, reserveCoins.Add(params.PoolCreationFee...).Add(reserveCoins...))
+
+	_, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.WeightedPoolTypeID, reserveCoins))
+	require.ErrorIs(t, err, types.ErrBadReserveCoinWeights)
+	msg := types.NewMsgCreatePool(creator, types.DefaultPoolTypeID, reserveCoins)
+	msg.ReserveCoinWeights = []uint32{80, 20}
+	_, err = simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.ErrorIs(t, err, types.ErrBadReserveCoinWeights)
+
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreateWeightedPool(creator, reserveCoins, []uint32{80, 20}))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{80, 20}, pool.ReserveCoinWeights)
+	require.NoError(t, simapp.LiquidityKeeper.ValidatePool(ctx, &pool))
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	offerCoin := sdk.NewCoin("denomx", sdk.NewInt(1_000_000))
+	addr := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		addr, pool.Id, types.DefaultSwapTypeID, offerCoin, "denomy", sdk.MustNewDecFromStr("1.01"), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the order is matched around the weighted pool price
+	demandAmt := simapp.BankKeeper.GetBalance(ctx, addr, "denomy").Amount
+	require.True(t, demandAmt.GT(sdk.NewInt(980_000)), demandAmt.String())
+	require.True(t, demandAmt.LT(sdk.NewInt(1_000_000)), demandAmt.String())
+
+	// the weighted pool price after the swap stays within the order price
+	after := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	lastPrice := types.WeightedPoolPrice(after.AmountOf("denomx").ToDec(), after.AmountOf("denomy").ToDec(), sdk.NewDec(80), sdk.NewDec(20))
+	require.True(t, lastPrice.GT(sdk.OneDec()))
+	require.True(t, lastPrice.LTE(sdk.MustNewDecFromStr("1.01")))
+}
+
+func TestStableSwapPoolSwap(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	// a standard pool and a stable pool with the same balanced reserves of pegged coins
+	standardReserveCoins := sdk.NewCoins(sdk.NewCoin("denomx", sdk.NewInt(10_000_000)), sdk.NewCoin("denomy", sdk.NewInt(10_000_000)))
+	stableReserveCoins := sdk.NewCoins(sdk.NewCoin("denoma", sdk.NewInt(10_000_000)), sdk.NewCoin("denomb", sdk.NewInt(10_000_000)))
+	creator := app.AddRandomTestAddr(simapp, ctx, standardReserveCoins.Add(stableReserveCoins...).Add(params.PoolCreationFee...).Add(params.PoolCreationFee...))
+
+	standardPool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.DefaultPoolTypeID, standardReserveCoins))
+	require.NoError(t, err)
+	stablePool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.StableSwapPoolTypeID, stableReserveCoins))
+	require.NoError(t, err)
+	require.NoError(t, simapp.LiquidityKeeper.ValidatePool(ctx, &stablePool))
+
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	swap := func(pool types.Pool, offerCoin sdk.Coin, demandCoinDenom string) sdk.AccAddress {
+		addr := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+		_, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+			addr, pool.Id, types.DefaultSwapTypeID, offerCoin, demandCoinDenom, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate), 0)
+		require.NoError(t, err)
+		return addr
+	}
+	standardAddr := swap(standardPool, sdk.NewCoin("denomx", sdk.NewInt(500_000)), "denomy")
+	stableAddr := swap(stablePool, sdk.NewCoin("denoma", sdk.NewInt(500_000)), "denomb")
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the stable pool gives much lower slippage than the standard pool
+	standardDemandAmt := simapp.BankKeeper.GetBalance(ctx, standardAddr, "denomy").Amount
+	stableDemandAmt := simapp.BankKeeper.GetBalance(ctx, stableAddr, "denomb").Amount
+	require.True(t, standardDemandAmt.LT(sdk.NewInt(480_000)), standardDemandAmt.String())
+	require.True(t, stableDemandAmt.GT(sdk.NewInt(498_000)), stableDemandAmt.String())
+	require.True(t, stableDemandAmt.LT(sdk.NewInt(500_000)), stableDemandAmt.String())
+
+	// the pool price on the StableSwap curve after the swap stays within the order price
+	after := simapp.LiquidityKeeper.GetReserveCoins(ctx, stablePool)
+	curve := types.NewStableSwapCurve(stablePool.Amplification)
+	lastPrice := curve.Price(after.AmountOf("denoma").ToDec(), after.AmountOf("denomb").ToDec())
+	require.True(t, lastPrice.GT(sdk.OneDec()))
+	require.True(t, lastPrice.LTE(sdk.MustNewDecFromStr("1.1")))
+}
+
+func TestStableSwapPoolAmplification(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	reserveCoins := sdk.NewCoins(sdk.NewCoin("denoma", sdk.NewInt(10_000_000)), sdk.NewCoin("denomb", sdk.NewInt(10_000_000)))
+	otherReserveCoins := sdk.NewCoins(sdk.NewCoin("denomc", sdk.NewInt(10_000_000)), sdk.NewCoin("denomd", sdk.NewInt(10_000_000)))
+	creator := app.AddRandomTestAddr(simapp, ctx, reserveCoins.Add(otherReserveCoins...).Add(params.PoolCreationFee...).Add(params.PoolCreationFee...))
+
+	// the amplification is given only to the stable pool type
+	msg := types.NewMsgCreatePool(creator, types.DefaultPoolTypeID, reserveCoins)
+	msg.Amplification = 10
+	_, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.ErrorIs(t, err, types.ErrBadAmplification)
+
+	// the stable pool created without the amplification keeps the param at its creation
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, types.StableSwapPoolTypeID, reserveCoins))
+	require.NoError(t, err)
+	require.Equal(t, params.StableSwapAmplification, pool.Amplification)
+	msg = types.NewMsgCreatePool(creator, types.StableSwapPoolTypeID, otherReserveCoins)
+	msg.Amplification = 10
+	otherPool, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), otherPool.Amplification)
+
+	params.StableSwapAmplification = 1
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	pool, _ = simapp.LiquidityKeeper.GetPool(ctx, pool.Id)
+	require.Equal(t, types.DefaultStableSwapAmplification, pool.Amplification)
+	require.NoError(t, simapp.LiquidityKeeper.ValidatePool(ctx, &pool))
+}
+
+func TestSimulateSwapWithinBatch(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(1)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// an order already queued in the batch in the opposite direction
+	offerCoinY := sdk.NewInt64Coin(DenomY, 5000)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoinY.Add(types.GetOfferCoinFee(offerCoinY, params.SwapFeeRate)))),
+		pool.Id, types.DefaultSwapTypeID, offerCoinY, DenomX, sdk.MustNewDecFromStr("0.9"), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+
+	offerCoin := sdk.NewInt64Coin(DenomX, 20000)
+	msg := types.NewMsgSwapWithinBatch(
+		app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)))),
+		pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate)
+
+	res, err := keeper.Querier{Keeper: simapp.LiquidityKeeper}.SimulateSwap(sdk.WrapSDKContext(ctx), &types.QuerySimulateSwapRequest{
+		PoolId:          pool.Id,
+		SwapTypeId:      msg.SwapTypeId,
+		OfferCoin:       msg.OfferCoin.String(),
+		DemandCoinDenom: msg.DemandCoinDenom,
+		OrderPrice:      msg.OrderPrice.String(),
+	})
+	require.NoError(t, err)
+	require.True(t, res.Matched)
+	require.Equal(t, types.Increasing.String(), res.PriceDirection)
+	// nothing is written to the store by the simulation
+	require.Len(t, simapp.LiquidityKeeper.GetAllPoolBatchSwapMsgStatesAsPointer(ctx, types.PoolBatch{PoolId: pool.Id}), 1)
+
+	// the simulated outcome is the one of the batch executed with the order
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, 0)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	balances := simapp.BankKeeper.GetAllBalances(ctx, msg.GetSwapRequester())
+	require.Equal(t, res.ExchangedDemandCoin.Amount, balances.AmountOf(DenomY))
+	require.True(t, offerCoin.Add(msg.OfferCoinFee).Sub(res.TransactedCoin).Sub(res.OfferCoinFee).Amount.Equal(balances.AmountOf(DenomX)))
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// UpdatePriceAccumulator stores the price accumulator of the executed batch, accumulating the last pool prices of the
+// pairs of reserve coins for the seconds elapsed since the last update and setting the pool prices after the batch as
+// the last prices. It prunes the accumulators of the pool older than the latest batches within the retention.
+func (k Keeper) UpdatePriceAccumulator(ctx sdk.Context, poolBatch types.PoolBatch, retention uint32) {
+	if retention > 0 {
+		pool, found := k.GetPool(ctx, poolBatch.PoolId)
+		if !found {
+			return
+		}
+		lastAccumulator, lastFound := k.GetLatestPriceAccumulatorAt(ctx, pool.Id, ctx.BlockTime())
+		accumulator := types.PriceAccumulator{
+			PoolId:     pool.Id,
+			BatchIndex: poolBatch.Index,
+			Height:     ctx.BlockHeight(),
+			Time:       ctx.BlockTime(),
+		}
+		for i := 0; i < len(pool.ReserveCoinDenoms)-1; i++ {
+			for j := i + 1; j < len(pool.ReserveCoinDenoms); j++ {
+				denomX, denomY := pool.ReserveCoinDenoms[i], pool.ReserveCoinDenoms[j]
+				cumulativePrice := sdk.ZeroDec()
+				if lastFound {
+					if lastPair, ok := lastAccumulator.GetPairAccumulator(denomX, denomY); ok {
+						cumulativePrice = lastPair.CumulativePriceAt(lastAccumulator.Time, ctx.BlockTime())
+					}
+				}
+				accumulator.PairAccumulators = append(accumulator.PairAccumulators, types.PairPriceAccumulator{
+					DenomX:          denomX,
+					DenomY:          denomY,
+					LastPrice:       k.GetPairPrice(ctx, pool, denomX, denomY),
+					CumulativePrice: cumulativePrice,
+				})
+			}
+		}
+		k.SetPriceAccumulator(ctx, accumulator)
+	}
+
+	var prunedBatchIndexes []uint64
+	k.IteratePriceAccumulators(ctx, poolBatch.PoolId, func(accumulator types.PriceAccumulator) bool {
+		if accumulator.BatchIndex+uint64(retention) > poolBatch.Index {
+			return true
+		}
+		prunedBatchIndexes = append(prunedBatchIndexes, accumulator.BatchIndex)
+		return false
+	})
+	for _, batchIndex := range prunedBatchIndexes {
+		k.DeletePriceAccumulator(ctx, poolBatch.PoolId, batchIndex)
+	}
+}
+
+// GetTimeWeightedAveragePrice returns the time-weighted average pool price of the pair of reserve coins of the pool
+// between the start time and the end time, the exchange ratio of X/Y where denomX and denomY are sorted alphabetically.
+// The window must not end after the current block time, and must start at or after the update of the oldest price
+// accumulator of the pool kept in the store.
+func (k Keeper) GetTimeWeightedAveragePrice(ctx sdk.Context, pool types.Pool, denomX, denomY string, startTime, endTime time.Time) (sdk.Dec, error) {
+	denomX, denomY = types.AlphabeticalDenomPair(denomX, denomY)
+	if denomX == denomY || !pool.HasReserveCoinDenom(denomX) || !pool.HasReserveCoinDenom(denomY) {
+		return sdk.Dec{}, types.ErrNotMatchedReserveCoin
+	}
+	if !startTime.Before(endTime) {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrTwapWindowNotCovered, "start time must be before end time")
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrTwapWindowNotCovered, "end time must not be after the block time")
+	}
+
+	cumulativePriceAt := func(t time.Time) (sdk.Dec, error) {
+		accumulator, found := k.GetLatestPriceAccumulatorAt(ctx, pool.Id, t)
+		if !found {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrTwapWindowNotCovered, "no price accumulator at or before %s", t.UTC().Format(time.RFC3339))
+		}
+		pair, found := accumulator.GetPairAccumulator(denomX, denomY)
+		if !found {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrTwapWindowNotCovered, "no price accumulator of %s/%s", denomX, denomY)
+		}
+		return pair.CumulativePriceAt(accumulator.Time, t), nil
+	}
+
+	startCumulativePrice, err := cumulativePriceAt(startTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	endCumulativePrice, err := cumulativePriceAt(endTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return endCumulativePrice.Sub(startCumulativePrice).Quo(types.ElapsedSeconds(startTime, endTime)), nil
+}
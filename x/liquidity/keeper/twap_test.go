@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/app"
+	"github.com/tendermint/liquidity/x/liquidity"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestTimeWeightedAveragePrice(t *testing.T) {
+	simapp, ctx, pool, _, err := createTestPool(sdk.NewInt64Coin(DenomX, 1000000), sdk.NewInt64Coin(DenomY, 1000000))
+	require.NoError(t, err)
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.PriceAccumulatorRetention = 3
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	offsets := []time.Duration{0, 10 * time.Second, 30 * time.Second, 60 * time.Second}
+	var lastPrices []sdk.Dec
+	for i, offset := range offsets {
+		ctx = ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(startTime.Add(offset))
+		liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+		offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+		requester := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+		_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx,
+			types.NewMsgSwapWithinBatch(requester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate), 0)
+		require.NoError(t, err)
+
+		liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+		accumulators := simapp.LiquidityKeeper.GetPriceAccumulators(ctx, pool.Id)
+		accumulator := accumulators[len(accumulators)-1]
+		require.Equal(t, ctx.BlockTime(), accumulator.Time)
+		pair, found := accumulator.GetPairAccumulator(DenomX, DenomY)
+		require.True(t, found)
+		require.Equal(t, simapp.LiquidityKeeper.GetPairPrice(ctx, pool, DenomX, DenomY), pair.LastPrice)
+		lastPrices = append(lastPrices, pair.LastPrice)
+	}
+
+	// only the accumulators of the latest batches within the retention are kept
+	accumulators := simapp.LiquidityKeeper.GetPriceAccumulators(ctx, pool.Id)
+	require.Len(t, accumulators, 3)
+	require.Equal(t, uint64(2), accumulators[0].BatchIndex)
+	require.Equal(t, uint64(4), accumulators[2].BatchIndex)
+	pair, _ := accumulators[2].GetPairAccumulator(DenomX, DenomY)
+	expCumulativePrice := lastPrices[0].MulInt64(10).Add(lastPrices[1].MulInt64(20)).Add(lastPrices[2].MulInt64(30))
+	require.Equal(t, expCumulativePrice, pair.CumulativePrice)
+
+	// the swaps of X for Y raise the price of X/Y after every batch
+	for i := 1; i < len(lastPrices); i++ {
+		require.True(t, lastPrices[i].GT(lastPrices[i-1]))
+	}
+
+	ctx = ctx.WithBlockTime(startTime.Add(80 * time.Second))
+	k := simapp.LiquidityKeeper
+
+	twap, err := k.GetTimeWeightedAveragePrice(ctx, pool, DenomX, DenomY, startTime.Add(10*time.Second), startTime.Add(30*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, lastPrices[1], twap)
+
+	twap, err = k.GetTimeWeightedAveragePrice(ctx, pool, DenomY, DenomX, startTime.Add(20*time.Second), startTime.Add(80*time.Second))
+	require.NoError(t, err)
+	endCumulativePrice := pair.CumulativePrice.Add(lastPrices[3].MulInt64(20))
+	startCumulativePrice := lastPrices[0].MulInt64(10).Add(lastPrices[1].MulInt64(10))
+	expTwap := endCumulativePrice.Sub(startCumulativePrice).Quo(sdk.NewDec(60))
+	require.Equal(t, expTwap, twap)
+
+	// the accumulator of the first batch was pruned
+	_, err = k.GetTimeWeightedAveragePrice(ctx, pool, DenomX, DenomY, startTime.Add(5*time.Second), startTime.Add(30*time.Second))
+	require.ErrorIs(t, err, types.ErrTwapWindowNotCovered)
+
+	_, err = k.GetTimeWeightedAveragePrice(ctx, pool, DenomX, DenomY, startTime.Add(30*time.Second), startTime.Add(90*time.Second))
+	require.ErrorIs(t, err, types.ErrTwapWindowNotCovered)
+
+	_, err = k.GetTimeWeightedAveragePrice(ctx, pool, DenomX, DenomY, startTime.Add(30*time.Second), startTime.Add(30*time.Second))
+	require.ErrorIs(t, err, types.ErrTwapWindowNotCovered)
+
+	_, err = k.GetTimeWeightedAveragePrice(ctx, pool, DenomX, DenomA, startTime.Add(10*time.Second), startTime.Add(30*time.Second))
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+
+	// no accumulators are kept with the zero retention
+	params.PriceAccumulatorRetention = 0
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(5)
+	liquidity.BeginBlocker(ctx, k)
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	requester := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+	_, err = k.SwapWithinBatch(ctx,
+		types.NewMsgSwapWithinBatch(requester, pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, k)
+	require.Empty(t, k.GetPriceAccumulators(ctx, pool.Id))
+}
@@ -0,0 +1,50 @@
+package liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// NewProposalHandler returns a handler for the liquidity module governance proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.PoolFeeRatesProposal:
+			return keeper.HandlePoolFeeRatesProposal(ctx, k, c)
+		case *types.CircuitBreakerProposal:
+			return keeper.HandleCircuitBreakerProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized liquidity proposal content type: %T", c)
+		}
+	}
+}
+
+// NewParamChangeProposalHandler wraps the handler of the param change proposals to validate the liquidity params as a
+// whole after the changes, since the param change validates each param by itself. The changes leaving the params
+// inconsistent, such as the min pool fee rate over the max pool fee rate, fail the proposal.
+func NewParamChangeProposalHandler(k keeper.Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+		c, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range c.Changes {
+			if change.Subspace == types.ModuleName {
+				if err := k.GetParams(ctx).Validate(); err != nil {
+					return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+				}
+				break
+			}
+		}
+		return nil
+	}
+}
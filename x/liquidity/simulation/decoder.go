@@ -49,6 +49,66 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &msgStateB)
 			return fmt.Sprintf("%v\n%v", msgStateA, msgStateB)
 
+		case bytes.Equal(kvA.Key[:1], types.PositionKeyPrefix):
+			var positionA, positionB types.Position
+			cdc.MustUnmarshal(kvA.Value, &positionA)
+			cdc.MustUnmarshal(kvB.Value, &positionB)
+			return fmt.Sprintf("%v\n%v", positionA, positionB)
+
+		case bytes.Equal(kvA.Key[:1], types.PoolPriceKeyPrefix):
+			var priceA, priceB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &priceA)
+			cdc.MustUnmarshal(kvB.Value, &priceB)
+			return fmt.Sprintf("%v\n%v", priceA.Dec, priceB.Dec)
+
+		case bytes.Equal(kvA.Key[:1], types.RewardPlanKeyPrefix):
+			var planA, planB types.RewardPlan
+			cdc.MustUnmarshal(kvA.Value, &planA)
+			cdc.MustUnmarshal(kvB.Value, &planB)
+			return fmt.Sprintf("%v\n%v", planA, planB)
+
+		case bytes.Equal(kvA.Key[:1], types.RewardAccumulatorKeyPrefix):
+			var accumulatorA, accumulatorB types.RewardAccumulator
+			cdc.MustUnmarshal(kvA.Value, &accumulatorA)
+			cdc.MustUnmarshal(kvB.Value, &accumulatorB)
+			return fmt.Sprintf("%v\n%v", accumulatorA, accumulatorB)
+
+		case bytes.Equal(kvA.Key[:1], types.StakeKeyPrefix):
+			var stakeA, stakeB types.Stake
+			cdc.MustUnmarshal(kvA.Value, &stakeA)
+			cdc.MustUnmarshal(kvB.Value, &stakeB)
+			return fmt.Sprintf("%v\n%v", stakeA, stakeB)
+
+		case bytes.Equal(kvA.Key[:1], types.BondKeyPrefix):
+			var bondA, bondB types.Bond
+			cdc.MustUnmarshal(kvA.Value, &bondA)
+			cdc.MustUnmarshal(kvB.Value, &bondB)
+			return fmt.Sprintf("%v\n%v", bondA, bondB)
+
+		case bytes.Equal(kvA.Key[:1], types.CollectedProtocolFeesKeyPrefix):
+			var feesA, feesB types.CollectedProtocolFees
+			cdc.MustUnmarshal(kvA.Value, &feesA)
+			cdc.MustUnmarshal(kvB.Value, &feesB)
+			return fmt.Sprintf("%v\n%v", feesA, feesB)
+
+		case bytes.Equal(kvA.Key[:1], types.PoolFeeRateKeyPrefix):
+			var feeRateA, feeRateB types.PoolFeeRate
+			cdc.MustUnmarshal(kvA.Value, &feeRateA)
+			cdc.MustUnmarshal(kvB.Value, &feeRateB)
+			return fmt.Sprintf("%v\n%v", feeRateA, feeRateB)
+
+		case bytes.Equal(kvA.Key[:1], types.DynamicSwapFeeKeyPrefix):
+			var dynamicSwapFeeA, dynamicSwapFeeB types.DynamicSwapFee
+			cdc.MustUnmarshal(kvA.Value, &dynamicSwapFeeA)
+			cdc.MustUnmarshal(kvB.Value, &dynamicSwapFeeB)
+			return fmt.Sprintf("%v\n%v", dynamicSwapFeeA, dynamicSwapFeeB)
+
+		case bytes.Equal(kvA.Key[:1], types.CircuitBreakerKeyPrefix):
+			var circuitBreakerA, circuitBreakerB types.CircuitBreaker
+			cdc.MustUnmarshal(kvA.Value, &circuitBreakerA)
+			cdc.MustUnmarshal(kvB.Value, &circuitBreakerB)
+			return fmt.Sprintf("%v\n%v", circuitBreakerA, circuitBreakerB)
+
 		default:
 			panic(fmt.Sprintf("invalid liquidity key prefix %X", kvA.Key[:1]))
 		}
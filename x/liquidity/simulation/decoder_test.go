@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -59,6 +60,53 @@ func TestDecodeLiquidityStore(t *testing.T) {
 		ToBeDeleted: true,
 		Msg:         &types.MsgSwapWithinBatch{PoolId: uint64(1)},
 	}
+	position := types.Position{
+		Id:           uint64(1),
+		PoolId:       uint64(1),
+		OwnerAddress: reserveAccAddr1.String(),
+		LowerPrice:   sdk.MustNewDecFromStr("0.9"),
+		UpperPrice:   sdk.MustNewDecFromStr("1.1"),
+		Liquidity:    sdk.NewDec(1000000),
+	}
+	poolPrice := sdk.DecProto{Dec: sdk.OneDec()}
+	rewardPlan := types.RewardPlan{
+		Id:            uint64(1),
+		PoolId:        uint64(1),
+		FunderAddress: reserveAccAddr1.String(),
+		RewardCoins:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000)),
+		StartHeight:   int64(1),
+		EndHeight:     int64(101),
+	}
+	rewardAccumulator := types.RewardAccumulator{
+		PoolId:         uint64(1),
+		TotalStaked:    sdk.NewInt(1000),
+		RewardPerShare: sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 10)),
+	}
+	stake := types.Stake{
+		PoolId:         uint64(1),
+		StakerAddress:  reserveAccAddr1.String(),
+		Amount:         sdk.NewInt(1000),
+		RewardPerShare: sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 5)),
+	}
+	bond := types.Bond{
+		Id:           uint64(1),
+		OwnerAddress: reserveAccAddr1.String(),
+		PoolCoin:     sdk.NewInt64Coin("pool", 1000),
+		Duration:     24 * time.Hour,
+	}
+	collectedProtocolFees := types.CollectedProtocolFees{
+		PoolId:       uint64(1),
+		SwapFeeCoins: sdk.NewCoins(sdk.NewInt64Coin("denomX", 30)),
+	}
+	poolFeeRate := types.NewPoolFeeRate(uint64(1), sdk.NewDecWithPrec(5, 4), sdk.ZeroDec())
+	dynamicSwapFee := types.DynamicSwapFee{
+		PoolId:      uint64(1),
+		BatchIndex:  uint64(2),
+		Volatility:  sdk.NewDecWithPrec(5, 2),
+		SwapFeeRate: sdk.NewDecWithPrec(45, 4),
+	}
+
+	circuitBreaker := types.NewCircuitBreaker(uint64(1), types.TypeMsgSwapWithinBatch, true)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -68,6 +116,16 @@ func TestDecodeLiquidityStore(t *testing.T) {
 			{Key: types.PoolBatchDepositMsgStateIndexKeyPrefix, Value: cdc.MustMarshal(&depositMsgState)},
 			{Key: types.PoolBatchWithdrawMsgStateIndexKeyPrefix, Value: cdc.MustMarshal(&withdrawMsgState)},
 			{Key: types.PoolBatchSwapMsgStateIndexKeyPrefix, Value: cdc.MustMarshal(&swapMsgState)},
+			{Key: types.PositionKeyPrefix, Value: cdc.MustMarshal(&position)},
+			{Key: types.PoolPriceKeyPrefix, Value: cdc.MustMarshal(&poolPrice)},
+			{Key: types.RewardPlanKeyPrefix, Value: cdc.MustMarshal(&rewardPlan)},
+			{Key: types.RewardAccumulatorKeyPrefix, Value: cdc.MustMarshal(&rewardAccumulator)},
+			{Key: types.StakeKeyPrefix, Value: cdc.MustMarshal(&stake)},
+			{Key: types.BondKeyPrefix, Value: cdc.MustMarshal(&bond)},
+			{Key: types.CollectedProtocolFeesKeyPrefix, Value: cdc.MustMarshal(&collectedProtocolFees)},
+			{Key: types.PoolFeeRateKeyPrefix, Value: cdc.MustMarshal(&poolFeeRate)},
+			{Key: types.DynamicSwapFeeKeyPrefix, Value: cdc.MustMarshal(&dynamicSwapFee)},
+			{Key: types.CircuitBreakerKeyPrefix, Value: cdc.MustMarshal(&circuitBreaker)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -82,6 +140,16 @@ func TestDecodeLiquidityStore(t *testing.T) {
 		{"PoolBatchDepositMsgStateIndex", fmt.Sprintf("%v\n%v", depositMsgState, depositMsgState)},
 		{"PoolBatchWithdrawMsgStateIndex", fmt.Sprintf("%v\n%v", withdrawMsgState, withdrawMsgState)},
 		{"PoolBatchSwapMsgStateIndex", fmt.Sprintf("%v\n%v", swapMsgState, swapMsgState)},
+		{"Position", fmt.Sprintf("%v\n%v", position, position)},
+		{"PoolPrice", fmt.Sprintf("%v\n%v", poolPrice.Dec, poolPrice.Dec)},
+		{"RewardPlan", fmt.Sprintf("%v\n%v", rewardPlan, rewardPlan)},
+		{"RewardAccumulator", fmt.Sprintf("%v\n%v", rewardAccumulator, rewardAccumulator)},
+		{"Stake", fmt.Sprintf("%v\n%v", stake, stake)},
+		{"Bond", fmt.Sprintf("%v\n%v", bond, bond)},
+		{"CollectedProtocolFees", fmt.Sprintf("%v\n%v", collectedProtocolFees, collectedProtocolFees)},
+		{"PoolFeeRate", fmt.Sprintf("%v\n%v", poolFeeRate, poolFeeRate)},
+		{"DynamicSwapFee", fmt.Sprintf("%v\n%v", dynamicSwapFee, dynamicSwapFee)},
+		{"CircuitBreaker", fmt.Sprintf("%v\n%v", circuitBreaker, circuitBreaker)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
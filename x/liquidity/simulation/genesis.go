@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -17,15 +18,33 @@ import (
 
 // Simulation parameter constants
 const (
-	LiquidityPoolTypes     = "liquidity_pool_types"
-	MinInitDepositAmount   = "min_init_deposit_amount"
-	InitPoolCoinMintAmount = "init_pool_coin_mint_amount"
-	MaxReserveCoinAmount   = "max_reserve_coin_amount"
-	PoolCreationFee        = "pool_creation_fee"
-	SwapFeeRate            = "swap_fee_rate"
-	WithdrawFeeRate        = "withdraw_fee_rate"
-	MaxOrderAmountRatio    = "max_order_amount_ratio"
-	UnitBatchHeight        = "unit_batch_height"
+	LiquidityPoolTypes               = "liquidity_pool_types"
+	MinInitDepositAmount             = "min_init_deposit_amount"
+	InitPoolCoinMintAmount           = "init_pool_coin_mint_amount"
+	MaxReserveCoinAmount             = "max_reserve_coin_amount"
+	PoolCreationFee                  = "pool_creation_fee"
+	SwapFeeRate                      = "swap_fee_rate"
+	WithdrawFeeRate                  = "withdraw_fee_rate"
+	MaxOrderAmountRatio              = "max_order_amount_ratio"
+	UnitBatchHeight                  = "unit_batch_height"
+	StableSwapAmplification          = "stable_swap_amplification"
+	MaxOrderLifespan                 = "max_order_lifespan"
+	BatchResultRetention             = "batch_result_retention"
+	PriceAccumulatorRetention        = "price_accumulator_retention"
+	RewardPlanCreationFee            = "reward_plan_creation_fee"
+	BondDurations                    = "bond_durations"
+	ProtocolFeeRate                  = "protocol_fee_rate"
+	WithdrawProtocolFeeEnabled       = "withdraw_protocol_fee_enabled"
+	MinPoolFeeRate                   = "min_pool_fee_rate"
+	MaxPoolFeeRate                   = "max_pool_fee_rate"
+	DynamicSwapFeeEnabled            = "dynamic_swap_fee_enabled"
+	DynamicSwapFeeLookback           = "dynamic_swap_fee_lookback"
+	DynamicSwapFeeSensitivity        = "dynamic_swap_fee_sensitivity"
+	DynamicSwapFeeDecayRate          = "dynamic_swap_fee_decay_rate"
+	CircuitBreakerAdmins             = "circuit_breaker_admins"
+	CircuitBreakerPriceMoveThreshold = "circuit_breaker_price_move_threshold"
+	CircuitBreakerPriceMoveLookback  = "circuit_breaker_price_move_lookback"
+	MaxOrderPriceDeviation           = "max_order_price_deviation"
 )
 
 // GenLiquidityPoolTypes return default PoolType temporarily, It will be randomized in the liquidity v2
@@ -90,6 +109,109 @@ func GenUnitBatchHeight(r *rand.Rand) uint32 {
 	return uint32(simulation.RandIntBetween(r, int(types.DefaultUnitBatchHeight), 20))
 }
 
+// GenStableSwapAmplification randomized StableSwapAmplification ranging from 1 to 1000
+func GenStableSwapAmplification(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 1, 1000))
+}
+
+// GenMaxOrderLifespan randomized MaxOrderLifespan ranging from 0 to 100
+func GenMaxOrderLifespan(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 0, 100))
+}
+
+// GenBatchResultRetention randomized BatchResultRetention ranging from 20 to 100, not shorter than the lookbacks
+func GenBatchResultRetention(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 20, 100))
+}
+
+// GenPriceAccumulatorRetention randomized PriceAccumulatorRetention ranging from 0 to 1000
+func GenPriceAccumulatorRetention(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 0, 1000))
+}
+
+// GenRewardPlanCreationFee randomized RewardPlanCreationFee of the bond denom ranging from 1000000 to 10000000
+func GenRewardPlanCreationFee(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(simulation.RandIntBetween(r, 1e6, 1e7)))))
+}
+
+// GenBondDurations randomized BondDurations of a base duration ranging from 1 to 24 hours, and 7 and 14 times of it
+func GenBondDurations(r *rand.Rand) []time.Duration {
+	base := time.Duration(simulation.RandIntBetween(r, 1, 24)) * time.Hour
+	return []time.Duration{base, 7 * base, 14 * base}
+}
+
+// GenProtocolFeeRate randomized ProtocolFeeRate ranging from 0 to 0.5
+func GenProtocolFeeRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 5e4)), 5)
+}
+
+// GenWithdrawProtocolFeeEnabled randomized WithdrawProtocolFeeEnabled
+func GenWithdrawProtocolFeeEnabled(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenMinPoolFeeRate randomized MinPoolFeeRate ranging from 0 to 0.001
+func GenMinPoolFeeRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 1e2)), 5)
+}
+
+// GenMaxPoolFeeRate randomized MaxPoolFeeRate ranging from 0.01 to 1
+func GenMaxPoolFeeRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1e3, 1e5)), 5)
+}
+
+// GenDynamicSwapFeeEnabled randomized DynamicSwapFeeEnabled
+func GenDynamicSwapFeeEnabled(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenDynamicSwapFeeLookback randomized DynamicSwapFeeLookback ranging from 2 to 20
+func GenDynamicSwapFeeLookback(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 2, 21))
+}
+
+// GenDynamicSwapFeeSensitivity randomized DynamicSwapFeeSensitivity ranging from 0 to 20
+func GenDynamicSwapFeeSensitivity(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 2e3)), 2)
+}
+
+// GenDynamicSwapFeeDecayRate randomized DynamicSwapFeeDecayRate ranging from 0.01 to 1
+func GenDynamicSwapFeeDecayRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 101)), 2)
+}
+
+// GenCircuitBreakerAdmins randomized CircuitBreakerAdmins of up to two of the accounts
+func GenCircuitBreakerAdmins(r *rand.Rand, accs []simulation.Account) []string {
+	admins := []string{}
+	if len(accs) == 0 {
+		return admins
+	}
+	adminMap := make(map[string]bool)
+	for i := r.Intn(3); i > 0; i-- {
+		acc, _ := simulation.RandomAcc(r, accs)
+		if !adminMap[acc.Address.String()] {
+			admins = append(admins, acc.Address.String())
+			adminMap[acc.Address.String()] = true
+		}
+	}
+	return admins
+}
+
+// GenCircuitBreakerPriceMoveThreshold randomized CircuitBreakerPriceMoveThreshold ranging from 0 to 2
+func GenCircuitBreakerPriceMoveThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 201)), 2)
+}
+
+// GenCircuitBreakerPriceMoveLookback randomized CircuitBreakerPriceMoveLookback ranging from 1 to 20
+func GenCircuitBreakerPriceMoveLookback(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 1, 21))
+}
+
+// GenMaxOrderPriceDeviation randomized MaxOrderPriceDeviation ranging from 0 to 0.5
+func GenMaxOrderPriceDeviation(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 51)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for liquidity
 func RandomizedGenState(simState *module.SimulationState) {
 	var liquidityPoolTypes []types.PoolType
@@ -146,19 +268,146 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { unitBatchHeight = GenUnitBatchHeight(r) },
 	)
 
+	var stableSwapAmplification uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, StableSwapAmplification, &stableSwapAmplification, simState.Rand,
+		func(r *rand.Rand) { stableSwapAmplification = GenStableSwapAmplification(r) },
+	)
+
+	var maxOrderLifespan uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxOrderLifespan, &maxOrderLifespan, simState.Rand,
+		func(r *rand.Rand) { maxOrderLifespan = GenMaxOrderLifespan(r) },
+	)
+
+	var batchResultRetention uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BatchResultRetention, &batchResultRetention, simState.Rand,
+		func(r *rand.Rand) { batchResultRetention = GenBatchResultRetention(r) },
+	)
+
+	var priceAccumulatorRetention uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PriceAccumulatorRetention, &priceAccumulatorRetention, simState.Rand,
+		func(r *rand.Rand) { priceAccumulatorRetention = GenPriceAccumulatorRetention(r) },
+	)
+
+	var rewardPlanCreationFee sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RewardPlanCreationFee, &rewardPlanCreationFee, simState.Rand,
+		func(r *rand.Rand) { rewardPlanCreationFee = GenRewardPlanCreationFee(r) },
+	)
+
+	var bondDurations []time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BondDurations, &bondDurations, simState.Rand,
+		func(r *rand.Rand) { bondDurations = GenBondDurations(r) },
+	)
+
+	var protocolFeeRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ProtocolFeeRate, &protocolFeeRate, simState.Rand,
+		func(r *rand.Rand) { protocolFeeRate = GenProtocolFeeRate(r) },
+	)
+
+	var withdrawProtocolFeeEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, WithdrawProtocolFeeEnabled, &withdrawProtocolFeeEnabled, simState.Rand,
+		func(r *rand.Rand) { withdrawProtocolFeeEnabled = GenWithdrawProtocolFeeEnabled(r) },
+	)
+
+	var minPoolFeeRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinPoolFeeRate, &minPoolFeeRate, simState.Rand,
+		func(r *rand.Rand) { minPoolFeeRate = GenMinPoolFeeRate(r) },
+	)
+
+	var maxPoolFeeRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxPoolFeeRate, &maxPoolFeeRate, simState.Rand,
+		func(r *rand.Rand) { maxPoolFeeRate = GenMaxPoolFeeRate(r) },
+	)
+
+	var dynamicSwapFeeEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DynamicSwapFeeEnabled, &dynamicSwapFeeEnabled, simState.Rand,
+		func(r *rand.Rand) { dynamicSwapFeeEnabled = GenDynamicSwapFeeEnabled(r) },
+	)
+
+	var dynamicSwapFeeLookback uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DynamicSwapFeeLookback, &dynamicSwapFeeLookback, simState.Rand,
+		func(r *rand.Rand) { dynamicSwapFeeLookback = GenDynamicSwapFeeLookback(r) },
+	)
+
+	var dynamicSwapFeeSensitivity sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DynamicSwapFeeSensitivity, &dynamicSwapFeeSensitivity, simState.Rand,
+		func(r *rand.Rand) { dynamicSwapFeeSensitivity = GenDynamicSwapFeeSensitivity(r) },
+	)
+
+	var dynamicSwapFeeDecayRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DynamicSwapFeeDecayRate, &dynamicSwapFeeDecayRate, simState.Rand,
+		func(r *rand.Rand) { dynamicSwapFeeDecayRate = GenDynamicSwapFeeDecayRate(r) },
+	)
+
+	var circuitBreakerAdmins []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CircuitBreakerAdmins, &circuitBreakerAdmins, simState.Rand,
+		func(r *rand.Rand) { circuitBreakerAdmins = GenCircuitBreakerAdmins(r, simState.Accounts) },
+	)
+
+	var circuitBreakerPriceMoveThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CircuitBreakerPriceMoveThreshold, &circuitBreakerPriceMoveThreshold, simState.Rand,
+		func(r *rand.Rand) { circuitBreakerPriceMoveThreshold = GenCircuitBreakerPriceMoveThreshold(r) },
+	)
+
+	var circuitBreakerPriceMoveLookback uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CircuitBreakerPriceMoveLookback, &circuitBreakerPriceMoveLookback, simState.Rand,
+		func(r *rand.Rand) { circuitBreakerPriceMoveLookback = GenCircuitBreakerPriceMoveLookback(r) },
+	)
+
+	var maxOrderPriceDeviation sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxOrderPriceDeviation, &maxOrderPriceDeviation, simState.Rand,
+		func(r *rand.Rand) { maxOrderPriceDeviation = GenMaxOrderPriceDeviation(r) },
+	)
+
 	liquidityGenesis := types.GenesisState{
 		Params: types.Params{
-			PoolTypes:              liquidityPoolTypes,
-			MinInitDepositAmount:   minInitDepositAmount,
-			InitPoolCoinMintAmount: initPoolCoinMintAmount,
-			MaxReserveCoinAmount:   maxReserveCoinAmount,
-			PoolCreationFee:        poolCreationFee,
-			SwapFeeRate:            swapFeeRate,
-			WithdrawFeeRate:        withdrawFeeRate,
-			MaxOrderAmountRatio:    maxOrderAmountRatio,
-			UnitBatchHeight:        unitBatchHeight,
+			PoolTypes:                        liquidityPoolTypes,
+			MinInitDepositAmount:             minInitDepositAmount,
+			InitPoolCoinMintAmount:           initPoolCoinMintAmount,
+			MaxReserveCoinAmount:             maxReserveCoinAmount,
+			PoolCreationFee:                  poolCreationFee,
+			SwapFeeRate:                      swapFeeRate,
+			WithdrawFeeRate:                  withdrawFeeRate,
+			MaxOrderAmountRatio:              maxOrderAmountRatio,
+			UnitBatchHeight:                  unitBatchHeight,
+			StableSwapAmplification:          stableSwapAmplification,
+			MaxOrderLifespan:                 maxOrderLifespan,
+			BatchResultRetention:             batchResultRetention,
+			PriceAccumulatorRetention:        priceAccumulatorRetention,
+			RewardPlanCreationFee:            rewardPlanCreationFee,
+			BondDurations:                    bondDurations,
+			ProtocolFeeRate:                  protocolFeeRate,
+			WithdrawProtocolFeeEnabled:       withdrawProtocolFeeEnabled,
+			MinPoolFeeRate:                   minPoolFeeRate,
+			MaxPoolFeeRate:                   maxPoolFeeRate,
+			DynamicSwapFeeEnabled:            dynamicSwapFeeEnabled,
+			DynamicSwapFeeLookback:           dynamicSwapFeeLookback,
+			DynamicSwapFeeSensitivity:        dynamicSwapFeeSensitivity,
+			DynamicSwapFeeDecayRate:          dynamicSwapFeeDecayRate,
+			CircuitBreakerAdmins:             circuitBreakerAdmins,
+			CircuitBreakerPriceMoveThreshold: circuitBreakerPriceMoveThreshold,
+			CircuitBreakerPriceMoveLookback:  circuitBreakerPriceMoveLookback,
+			MaxOrderPriceDeviation:           maxOrderPriceDeviation,
 		},
-		PoolRecords: []types.PoolRecord{},
+		PoolRecords:     []types.PoolRecord{},
+		CircuitBreakers: []types.CircuitBreaker{},
 	}
 
 	bz, _ := json.MarshalIndent(&liquidityGenesis, "", " ")
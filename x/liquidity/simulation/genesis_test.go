@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -53,6 +54,12 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec5, liquidityGenesis.Params.WithdrawFeeRate)
 	require.Equal(t, dec6, liquidityGenesis.Params.MaxOrderAmountRatio)
 	require.Equal(t, uint32(6), liquidityGenesis.Params.UnitBatchHeight)
+	require.Equal(t, uint32(136), liquidityGenesis.Params.StableSwapAmplification)
+	require.Equal(t, uint32(47), liquidityGenesis.Params.MaxOrderLifespan)
+	require.Equal(t, uint32(67), liquidityGenesis.Params.BatchResultRetention)
+	require.Equal(t, uint32(888), liquidityGenesis.Params.PriceAccumulatorRetention)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5292790)), liquidityGenesis.Params.RewardPlanCreationFee)
+	require.Equal(t, []time.Duration{6 * time.Hour, 42 * time.Hour, 84 * time.Hour}, liquidityGenesis.Params.BondDurations)
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
@@ -329,6 +329,11 @@ func SimulateMsgSwapWithinBatch(ak types.AccountKeeper, bk types.BankKeeper, k k
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapWithinBatch, "unable to pick liquidity pool"), nil, nil
 		}
 
+		// the swaps of the pool can be halted by the automatic circuit breaker
+		if k.IsCircuitBreakerEnabled(ctx, pool.Id, types.TypeMsgSwapWithinBatch) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapWithinBatch, "circuit breaker is enabled"), nil, nil
+		}
+
 		reserveCoinDenomA := pool.ReserveCoinDenoms[0]
 		reserveCoinDenomB := pool.ReserveCoinDenoms[1]
 
@@ -353,6 +358,13 @@ func SimulateMsgSwapWithinBatch(ak types.AccountKeeper, bk types.BankKeeper, k k
 		params := k.GetParams(ctx)
 		params.SwapFeeRate = swapFeeRate
 		k.SetParams(ctx, params)
+		// the pool can apply its own or the dynamic swap fee rate instead
+		swapFeeRate = k.GetSwapFeeRate(ctx, pool.Id)
+
+		denomX, denomY := types.AlphabeticalDenomPair(offerCoin.Denom, demandCoinDenom)
+		if !types.IsWithinPriceBand(orderPrice, k.GetPairPrice(ctx, pool, denomX, denomY), params.MaxOrderPriceDeviation) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapWithinBatch, "order price is out of the price band"), nil, nil
+		}
 
 		msg := types.NewMsgSwapWithinBatch(swapRequester, pool.Id, types.DefaultSwapTypeID, offerCoin, demandCoinDenom, orderPrice, swapFeeRate)
 
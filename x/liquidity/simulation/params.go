@@ -51,5 +51,25 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", GenUnitBatchHeight(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyStableSwapAmplification),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenStableSwapAmplification(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxOrderLifespan),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxOrderLifespan(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBatchResultRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenBatchResultRetention(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPriceAccumulatorRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenPriceAccumulatorRetention(r))
+			},
+		),
 	}
 }
@@ -26,11 +26,15 @@ func TestParamChanges(t *testing.T) {
 		{"liquidity/WithdrawFeeRate", "WithdrawFeeRate", "\"0.112010000000000000\"", "liquidity"},
 		{"liquidity/MaxOrderAmountRatio", "MaxOrderAmountRatio", "\"0.560680000000000000\"", "liquidity"},
 		{"liquidity/UnitBatchHeight", "UnitBatchHeight", "19", "liquidity"},
+		{"liquidity/StableSwapAmplification", "StableSwapAmplification", "999", "liquidity"},
+		{"liquidity/MaxOrderLifespan", "MaxOrderLifespan", "56", "liquidity"},
+		{"liquidity/BatchResultRetention", "BatchResultRetention", "40", "liquidity"},
+		{"liquidity/PriceAccumulatorRetention", "PriceAccumulatorRetention", "694", "liquidity"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 7)
+	require.Len(t, paramChanges, 11)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
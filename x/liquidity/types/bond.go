@@ -0,0 +1,55 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// BondsEscrowAcc is the account escrowing the pool coins of the bonds.
+var BondsEscrowAcc = sdk.AccAddress(address.Module(ModuleName, []byte("BondsEscrowAcc")))
+
+// Validate validates Bond.
+func (bond Bond) Validate() error {
+	if bond.Id == 0 {
+		return ErrBondNotExists
+	}
+	if _, err := sdk.AccAddressFromBech32(bond.OwnerAddress); err != nil {
+		return ErrInvalidBondOwnerAddr
+	}
+	if err := bond.PoolCoin.Validate(); err != nil {
+		return err
+	}
+	if !bond.PoolCoin.IsPositive() {
+		return ErrBadPoolCoinAmount
+	}
+	if bond.Duration <= 0 {
+		return ErrBadBondDuration
+	}
+	return nil
+}
+
+// GetOwner returns the owner of the bond.
+func (bond Bond) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(bond.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// IsUnbonding returns whether the unbonding of the bond has begun.
+func (bond Bond) IsUnbonding() bool {
+	return !bond.UnbondTime.IsZero()
+}
+
+// IsBondDuration returns whether the duration is one of the bond durations of the params.
+func (p Params) IsBondDuration(duration time.Duration) bool {
+	for _, bondDuration := range p.BondDurations {
+		if duration == bondDuration {
+			return true
+		}
+	}
+	return false
+}
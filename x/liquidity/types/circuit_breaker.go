@@ -0,0 +1,98 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PriceMoveCircuitBreakerMsgTypes are the types of the messages halted for a pool by the automatic circuit breaker
+// when the clearing price of the pool moves beyond the circuit breaker price move threshold.
+var PriceMoveCircuitBreakerMsgTypes = []string{
+	TypeMsgSwapWithinBatch,
+	TypeMsgSwapRoute,
+}
+
+// CircuitBreakerMsgTypes are the types of the messages which circuit breakers can halt. The messages withdrawing or
+// cancelling from the pools are never halted so that the users can always exit.
+var CircuitBreakerMsgTypes = []string{
+	TypeMsgCreatePool,
+	TypeMsgDepositWithinBatch,
+	TypeMsgSwapWithinBatch,
+	TypeMsgDepositToRange,
+	TypeMsgSwapRoute,
+	TypeMsgCreateRewardPlan,
+	TypeMsgStake,
+	TypeMsgBond,
+}
+
+// NewCircuitBreaker returns a new CircuitBreaker of the pool and the message type.
+func NewCircuitBreaker(poolID uint64, msgType string, enabled bool) CircuitBreaker {
+	return CircuitBreaker{
+		PoolId:  poolID,
+		MsgType: msgType,
+		Enabled: enabled,
+	}
+}
+
+// Validate validates that the message type of CircuitBreaker is empty or can be halted, and that the circuit breakers
+// of MsgCreatePool apply to all pools.
+func (cb CircuitBreaker) Validate() error {
+	if cb.MsgType == "" {
+		return nil
+	}
+	if !IsCircuitBreakerMsgType(cb.MsgType) {
+		return sdkerrors.Wrapf(ErrBadCircuitBreaker, "unknown message type %s", cb.MsgType)
+	}
+	if cb.MsgType == TypeMsgCreatePool && cb.PoolId != 0 {
+		return sdkerrors.Wrapf(ErrBadCircuitBreaker, "%s applies to all pools", cb.MsgType)
+	}
+	return nil
+}
+
+// IsCircuitBreakerMsgType returns whether circuit breakers can halt the message type.
+func IsCircuitBreakerMsgType(msgType string) bool {
+	for _, t := range CircuitBreakerMsgTypes {
+		if t == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateCircuitBreakers validates the circuit breakers, which must have distinct pools and message types.
+func ValidateCircuitBreakers(circuitBreakers []CircuitBreaker) error {
+	keys := make(map[string]bool)
+	for _, cb := range circuitBreakers {
+		if err := cb.Validate(); err != nil {
+			return err
+		}
+		key := string(GetCircuitBreakerKey(cb.PoolId, cb.MsgType))
+		if keys[key] {
+			return sdkerrors.Wrapf(ErrBadCircuitBreaker, "duplicate circuit breaker of pool %d and message type %q", cb.PoolId, cb.MsgType)
+		}
+		keys[key] = true
+	}
+	return nil
+}
+
+// PriceMove returns the largest relative move |price - reference| / reference of the clearing price of each pair of
+// reserve coins in the latest batch result from the reference prices, the clearing prices of the pair in the previous
+// batch results. The pairs not matched in the previous batch results have no price move.
+func PriceMove(latest PoolBatchResult, previous []PoolBatchResult) sdk.Dec {
+	move := sdk.ZeroDec()
+	for _, swapResult := range latest.SwapResults {
+		if swapResult.SwapPrice.IsNil() || !swapResult.SwapPrice.IsPositive() {
+			continue
+		}
+		for _, result := range previous {
+			for _, reference := range result.SwapResults {
+				if reference.DenomX != swapResult.DenomX || reference.DenomY != swapResult.DenomY ||
+					reference.SwapPrice.IsNil() || !reference.SwapPrice.IsPositive() {
+					continue
+				}
+				move = sdk.MaxDec(move, swapResult.SwapPrice.Sub(reference.SwapPrice).Abs().Quo(reference.SwapPrice))
+			}
+		}
+	}
+	return move
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestPriceMove(t *testing.T) {
+	result := func(prices ...sdk.Dec) types.PoolBatchResult {
+		var swapResults []types.PairSwapResult
+		for i, price := range prices {
+			swapResults = append(swapResults, types.PairSwapResult{
+				DenomX:    "denomA",
+				DenomY:    []string{"denomB", "denomC"}[i],
+				SwapPrice: price,
+			})
+		}
+		return types.PoolBatchResult{SwapResults: swapResults}
+	}
+
+	require.Equal(t, sdk.ZeroDec(), types.PriceMove(result(sdk.NewDec(2)), nil))
+	require.Equal(t, sdk.ZeroDec(), types.PriceMove(result(), []types.PoolBatchResult{result(sdk.NewDec(2))}))
+	require.Equal(t, sdk.ZeroDec(), types.PriceMove(result(sdk.NewDec(2)), []types.PoolBatchResult{result(sdk.NewDec(2))}))
+	// the price moves in both directions from each of the reference prices
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), types.PriceMove(result(sdk.NewDec(3)), []types.PoolBatchResult{
+		result(sdk.NewDec(2)), result(sdk.NewDecWithPrec(25, 1)),
+	}))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), types.PriceMove(result(sdk.NewDec(1)), []types.PoolBatchResult{
+		result(sdk.NewDec(2)), result(sdk.NewDecWithPrec(15, 1)),
+	}))
+	// the largest move of the pairs decides the price move, and the pairs not matched before are left out
+	require.Equal(t, sdk.NewDec(4), types.PriceMove(result(sdk.NewDec(2), sdk.NewDec(10)), []types.PoolBatchResult{
+		result(sdk.NewDec(2), sdk.NewDec(2)), result(sdk.NewDec(3)),
+	}))
+	require.Equal(t, sdk.ZeroDec(), types.PriceMove(result(sdk.NewDec(2), sdk.NewDec(10)), []types.PoolBatchResult{
+		result(sdk.NewDec(2)), result(),
+	}))
+}
+
+func TestCircuitBreaker_Validate(t *testing.T) {
+	for _, msgType := range append([]string{""}, types.CircuitBreakerMsgTypes...) {
+		require.NoError(t, types.NewCircuitBreaker(0, msgType, true).Validate())
+	}
+	for _, msgType := range types.PriceMoveCircuitBreakerMsgTypes {
+		require.True(t, types.IsCircuitBreakerMsgType(msgType))
+		require.NoError(t, types.NewCircuitBreaker(1, msgType, true).Validate())
+	}
+	require.ErrorIs(t, types.NewCircuitBreaker(1, types.TypeMsgCancelSwap, true).Validate(), types.ErrBadCircuitBreaker)
+	require.ErrorIs(t, types.NewCircuitBreaker(1, types.TypeMsgCreatePool, true).Validate(), types.ErrBadCircuitBreaker)
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers concrete types on the codec.
@@ -14,6 +15,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDepositWithinBatch{}, "liquidity/MsgDepositWithinBatch", nil)
 	cdc.RegisterConcrete(&MsgWithdrawWithinBatch{}, "liquidity/MsgWithdrawWithinBatch", nil)
 	cdc.RegisterConcrete(&MsgSwapWithinBatch{}, "liquidity/MsgSwapWithinBatch", nil)
+	cdc.RegisterConcrete(&MsgDepositToRange{}, "liquidity/MsgDepositToRange", nil)
+	cdc.RegisterConcrete(&MsgWithdrawFromRange{}, "liquidity/MsgWithdrawFromRange", nil)
+	cdc.RegisterConcrete(&MsgCancelSwap{}, "liquidity/MsgCancelSwap", nil)
+	cdc.RegisterConcrete(&MsgSwapRoute{}, "liquidity/MsgSwapRoute", nil)
+	cdc.RegisterConcrete(&MsgCreateRewardPlan{}, "liquidity/MsgCreateRewardPlan", nil)
+	cdc.RegisterConcrete(&MsgStake{}, "liquidity/MsgStake", nil)
+	cdc.RegisterConcrete(&MsgUnstake{}, "liquidity/MsgUnstake", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "liquidity/MsgClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgBond{}, "liquidity/MsgBond", nil)
+	cdc.RegisterConcrete(&MsgBeginUnbond{}, "liquidity/MsgBeginUnbond", nil)
+	cdc.RegisterConcrete(&MsgSetCircuitBreaker{}, "liquidity/MsgSetCircuitBreaker", nil)
+	cdc.RegisterConcrete(&PoolFeeRatesProposal{}, "liquidity/PoolFeeRatesProposal", nil)
+	cdc.RegisterConcrete(&CircuitBreakerProposal{}, "liquidity/CircuitBreakerProposal", nil)
 }
 
 // RegisterInterfaces registers the x/liquidity interface types with the
@@ -24,6 +38,21 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDepositWithinBatch{},
 		&MsgWithdrawWithinBatch{},
 		&MsgSwapWithinBatch{},
+		&MsgDepositToRange{},
+		&MsgWithdrawFromRange{},
+		&MsgCancelSwap{},
+		&MsgSwapRoute{},
+		&MsgCreateRewardPlan{},
+		&MsgStake{},
+		&MsgUnstake{},
+		&MsgClaimRewards{},
+		&MsgBond{},
+		&MsgBeginUnbond{},
+		&MsgSetCircuitBreaker{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&PoolFeeRatesProposal{},
+		&CircuitBreakerProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
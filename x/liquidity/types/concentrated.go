@@ -0,0 +1,431 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatePriceRange validates the price range of a position of the concentrated liquidity pool, which must be within
+// MinPositionPrice and MaxPositionPrice.
+func ValidatePriceRange(lowerPrice, upperPrice sdk.Dec) error {
+	if lowerPrice.IsNil() || upperPrice.IsNil() ||
+		lowerPrice.LT(MinPositionPrice) || upperPrice.GT(MaxPositionPrice) || !lowerPrice.LT(upperPrice) {
+		return ErrBadPriceRange
+	}
+	return nil
+}
+
+// Validate validates Position.
+func (position Position) Validate() error {
+	if position.Id == 0 {
+		return ErrPositionNotExists
+	}
+	if _, err := sdk.AccAddressFromBech32(position.OwnerAddress); err != nil {
+		return ErrInvalidDepositorAddr
+	}
+	if err := ValidatePriceRange(position.LowerPrice, position.UpperPrice); err != nil {
+		return err
+	}
+	if position.Liquidity.IsNil() || !position.Liquidity.IsPositive() {
+		return ErrNoLiquidity
+	}
+	return nil
+}
+
+// GetOwner returns the owner of the position.
+func (position Position) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(position.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// ZeroFeeGrowth returns the fee growth of no fees.
+func ZeroFeeGrowth() FeeGrowth {
+	return FeeGrowth{X: sdk.ZeroDec(), Y: sdk.ZeroDec()}
+}
+
+// orZero returns the fee growth with the unset fee growths as zero.
+func (g FeeGrowth) orZero() FeeGrowth {
+	if g.X.IsNil() {
+		g.X = sdk.ZeroDec()
+	}
+	if g.Y.IsNil() {
+		g.Y = sdk.ZeroDec()
+	}
+	return g
+}
+
+// Add returns the sum of the fee growths.
+func (g FeeGrowth) Add(other FeeGrowth) FeeGrowth {
+	g, other = g.orZero(), other.orZero()
+	return FeeGrowth{X: g.X.Add(other.X), Y: g.Y.Add(other.Y)}
+}
+
+// Sub returns the difference of the fee growths.
+func (g FeeGrowth) Sub(other FeeGrowth) FeeGrowth {
+	g, other = g.orZero(), other.orZero()
+	return FeeGrowth{X: g.X.Sub(other.X), Y: g.Y.Sub(other.Y)}
+}
+
+// Validate validates FeeGrowth.
+func (g FeeGrowth) Validate() error {
+	if g.X.IsNil() || g.Y.IsNil() {
+		return ErrBadFeeGrowth
+	}
+	return nil
+}
+
+// NewPriceTick returns a new bound of the price ranges at the price. The fee growth accrued before the bound is
+// initialized is regarded as accrued below it, so the fee growth outside is the global fee growth while the pool
+// price is at or above the bound.
+func NewPriceTick(poolID uint64, price, poolPrice sdk.Dec, feeGrowthGlobal FeeGrowth) PriceTick {
+	feeGrowthOutside := ZeroFeeGrowth()
+	if poolPrice.GTE(price) {
+		feeGrowthOutside = feeGrowthGlobal.orZero()
+	}
+	return PriceTick{PoolId: poolID, Price: price, FeeGrowthOutside: feeGrowthOutside}
+}
+
+// Cross returns the bound with the fee growth outside flipped to the other side, as the pool price crosses it.
+func (tick PriceTick) Cross(feeGrowthGlobal FeeGrowth) PriceTick {
+	tick.FeeGrowthOutside = feeGrowthGlobal.Sub(tick.FeeGrowthOutside)
+	return tick
+}
+
+// Validate validates PriceTick.
+func (tick PriceTick) Validate() error {
+	if tick.Price.IsNil() || tick.Price.LT(MinPositionPrice) || tick.Price.GT(MaxPositionPrice) {
+		return ErrBadPriceRange
+	}
+	return tick.FeeGrowthOutside.Validate()
+}
+
+// FeeGrowthInside returns the fee growth accrued while the pool price was within the price range [lower, upper) of
+// the bounds, the global fee growth less the fee growths below the lower bound and at or above the upper bound.
+func FeeGrowthInside(feeGrowthGlobal FeeGrowth, lower, upper PriceTick, poolPrice sdk.Dec) FeeGrowth {
+	below := lower.FeeGrowthOutside
+	if poolPrice.LT(lower.Price) {
+		below = feeGrowthGlobal.Sub(lower.FeeGrowthOutside)
+	}
+	above := upper.FeeGrowthOutside
+	if poolPrice.GTE(upper.Price) {
+		above = feeGrowthGlobal.Sub(upper.FeeGrowthOutside)
+	}
+	return feeGrowthGlobal.Sub(below).Sub(above)
+}
+
+// IsActive returns whether the position provides its liquidity at the pool price, which is within its price range
+// [lower, upper).
+func (position Position) IsActive(poolPrice sdk.Dec) bool {
+	return poolPrice.GTE(position.LowerPrice) && poolPrice.LT(position.UpperPrice)
+}
+
+// Fees returns the fees of X and Y accrued to the position with the fee growth inside its price range since the
+// snapshot of the position.
+func (position Position) Fees(feeGrowthInside FeeGrowth) (x, y sdk.Dec) {
+	growth := feeGrowthInside.Sub(position.FeeGrowthInsideLast)
+	x = sdk.MaxDec(position.Liquidity.MulTruncate(growth.X), sdk.ZeroDec())
+	y = sdk.MaxDec(position.Liquidity.MulTruncate(growth.Y), sdk.ZeroDec())
+	return x, y
+}
+
+// sqrtPrice returns the square root of the price.
+func sqrtPrice(price sdk.Dec) sdk.Dec {
+	sqrt, err := price.ApproxSqrt()
+	if err != nil {
+		panic(err)
+	}
+	return sqrt
+}
+
+// clampPrice returns the price bounded within the price range.
+func clampPrice(price, lowerPrice, upperPrice sdk.Dec) sdk.Dec {
+	return sdk.MinDec(sdk.MaxDec(price, lowerPrice), upperPrice)
+}
+
+// PositionAmounts returns the amounts of X and Y held by the liquidity within the price range at the pool price,
+// X = L(√P - √Pa) and Y = L(1/√P - 1/√Pb) with the pool price P bounded within the price range [Pa, Pb].
+// The position holds only Y below the price range and only X above it.
+func PositionAmounts(liquidity, lowerPrice, upperPrice, price sdk.Dec) (x, y sdk.Dec) {
+	return positionAmounts(liquidity, sqrtPrice(lowerPrice), sqrtPrice(upperPrice), sqrtPrice(clampPrice(price, lowerPrice, upperPrice)))
+}
+
+func positionAmounts(liquidity, sqrtLowerPrice, sqrtUpperPrice, sqrtPoolPrice sdk.Dec) (x, y sdk.Dec) {
+	x = liquidity.Mul(sqrtPoolPrice.Sub(sqrtLowerPrice))
+	y = liquidity.Quo(sqrtPoolPrice).Sub(liquidity.Quo(sqrtUpperPrice))
+	return x, y
+}
+
+// LiquidityForAmounts returns the largest liquidity within the price range at the pool price that the amounts of
+// X and Y can provide. The liquidity is truncated, so the amounts of the liquidity never exceed the given amounts.
+func LiquidityForAmounts(x, y, lowerPrice, upperPrice, price sdk.Dec) sdk.Dec {
+	price = clampPrice(price, lowerPrice, upperPrice)
+	sqrtP, sqrtA, sqrtB := sqrtPrice(price), sqrtPrice(lowerPrice), sqrtPrice(upperPrice)
+	liquidity := sdk.Dec{}
+	if price.GT(lowerPrice) {
+		liquidity = x.QuoTruncate(sqrtP.Sub(sqrtA))
+	}
+	if price.LT(upperPrice) {
+		// L = Y / (1/√P - 1/√Pb) = Y√P√Pb / (√Pb - √P)
+		liquidityY := y.MulTruncate(sqrtP).MulTruncate(sqrtB).QuoTruncate(sqrtB.Sub(sqrtP))
+		if liquidity.IsNil() || liquidityY.LT(liquidity) {
+			liquidity = liquidityY
+		}
+	}
+	return liquidity
+}
+
+// concentratedPrecision is the relative precision of the prices found by bisection on the concentrated liquidity curve.
+var concentratedPrecision = sdk.NewDecWithPrec(1, 15)
+
+// searchPrice returns the bounds of the interval within [lo, hi] where the predicate, monotonic in the price, turns
+// from false at lo to true at hi, found by bisection.
+func searchPrice(lo, hi sdk.Dec, pred func(p sdk.Dec) bool) (sdk.Dec, sdk.Dec) {
+	for i := 0; i < 256 && hi.Sub(lo).GT(sdk.MaxDec(hi.Mul(concentratedPrecision), sdk.SmallestDec())); i++ {
+		mid := lo.Add(hi).QuoInt64(2)
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return lo, hi
+}
+
+// positionRange is the liquidity of a position with the square roots of its price range.
+type positionRange struct {
+	sqrtLowerPrice sdk.Dec
+	sqrtUpperPrice sdk.Dec
+	lowerPrice     sdk.Dec
+	upperPrice     sdk.Dec
+	liquidity      sdk.Dec
+}
+
+// ConcentratedCurve is the swap curve of the concentrated liquidity pool, in which each position provides its
+// liquidity only while the pool price is within its price range. The pool price is kept in the store, since it can
+// not be derived from the reserves, so the reserves given to the SwapCurve methods are ignored.
+type ConcentratedCurve struct {
+	price  sdk.Dec
+	ranges []positionRange
+	// amounts of X and Y held by the positions at the pool price
+	x, y sdk.Dec
+}
+
+var _ SwapCurve = ConcentratedCurve{}
+
+// NewConcentratedCurve returns a new ConcentratedCurve of the positions at the pool price.
+func NewConcentratedCurve(price sdk.Dec, positions []Position) ConcentratedCurve {
+	ranges := make([]positionRange, 0, len(positions))
+	for _, position := range positions {
+		ranges = append(ranges, positionRange{
+			sqrtLowerPrice: sqrtPrice(position.LowerPrice),
+			sqrtUpperPrice: sqrtPrice(position.UpperPrice),
+			lowerPrice:     position.LowerPrice,
+			upperPrice:     position.UpperPrice,
+			liquidity:      position.Liquidity,
+		})
+	}
+	c := ConcentratedCurve{price: price, ranges: ranges}
+	c.x, c.y = c.Amounts(price)
+	return c
+}
+
+// Amounts returns the total amounts of X and Y held by the positions at the price p.
+func (c ConcentratedCurve) Amounts(p sdk.Dec) (x, y sdk.Dec) {
+	x, y = sdk.ZeroDec(), sdk.ZeroDec()
+	sqrtP := sqrtPrice(p)
+	for _, r := range c.ranges {
+		sqrtPoolPrice := sqrtP
+		if p.LTE(r.lowerPrice) {
+			sqrtPoolPrice = r.sqrtLowerPrice
+		} else if p.GTE(r.upperPrice) {
+			sqrtPoolPrice = r.sqrtUpperPrice
+		}
+		rx, ry := positionAmounts(r.liquidity, r.sqrtLowerPrice, r.sqrtUpperPrice, sqrtPoolPrice)
+		x, y = x.Add(rx), y.Add(ry)
+	}
+	return x, y
+}
+
+// Price implements SwapCurve.
+func (c ConcentratedCurve) Price(_, _ sdk.Dec) sdk.Dec {
+	return c.price
+}
+
+// SwapPrice implements SwapCurve. The swap price is found by bisection within MinPositionPrice and MaxPositionPrice,
+// where the executable amounts are cleared together with the positions active between the pool price and the swap price.
+func (c ConcentratedCurve) SwapPrice(direction PriceDirection, _, _, ex, ey sdk.Dec) sdk.Dec {
+	var lo, hi sdk.Dec
+	switch direction {
+	case Increasing:
+		// the price increases while EX is over the X needed for EY and the Y provided by the pool at the price
+		lo, hi = searchPrice(c.price, MaxPositionPrice, func(p sdk.Dec) bool {
+			return ex.LTE(p.Mul(ey.Add(c.PoolY(c.x, c.y, p))))
+		})
+	case Decreasing:
+		// the price decreases while the X needed for EY is over EX and the X provided by the pool at the price
+		lo, hi = searchPrice(MinPositionPrice, c.price, func(p sdk.Dec) bool {
+			return p.Mul(ey).GT(ex.Add(c.PoolX(c.x, c.y, p)))
+		})
+	default:
+		return c.price
+	}
+	return lo.Add(hi).QuoInt64(2)
+}
+
+// PoolY implements SwapCurve.
+func (c ConcentratedCurve) PoolY(_, _, p sdk.Dec) sdk.Dec {
+	_, yp := c.Amounts(p)
+	return c.y.Sub(yp)
+}
+
+// PoolX implements SwapCurve.
+func (c ConcentratedCurve) PoolX(_, _, p sdk.Dec) sdk.Dec {
+	xp, _ := c.Amounts(p)
+	return c.x.Sub(xp)
+}
+
+// PriceAfterSwap returns the pool price after the pool provided the reserve coins in the price direction of the batch
+// at the swap price. The pool price is the one at which the positions provide the given amounts, so the amounts of the
+// positions are always covered by the reserves. When any price within an interval without liquidity provides the given
+// amounts, the pool price nearest to the swap price is chosen.
+func (c ConcentratedCurve) PriceAfterSwap(direction PriceDirection, swapPrice, poolXDelta, poolYDelta sdk.Dec) sdk.Dec {
+	switch direction {
+	case Increasing:
+		yOut := poolYDelta.Neg()
+		_, lower := searchPrice(c.price, MaxPositionPrice, func(p sdk.Dec) bool {
+			return c.PoolY(c.x, c.y, p).GTE(yOut)
+		})
+		upper, _ := searchPrice(lower, MaxPositionPrice, func(p sdk.Dec) bool {
+			return c.PoolY(c.x, c.y, p).GT(yOut)
+		})
+		return clampPrice(swapPrice, lower, sdk.MaxDec(lower, upper))
+	case Decreasing:
+		xOut := poolXDelta.Neg()
+		upper, _ := searchPrice(MinPositionPrice, c.price, func(p sdk.Dec) bool {
+			return c.PoolX(c.x, c.y, p).LT(xOut)
+		})
+		_, lower := searchPrice(MinPositionPrice, upper, func(p sdk.Dec) bool {
+			return c.PoolX(c.x, c.y, p).LTE(xOut)
+		})
+		return clampPrice(swapPrice, sdk.MinDec(lower, upper), upper)
+	default:
+		return c.price
+	}
+}
+
+// ConcentratedPoolCurve is the PoolCurve of the concentrated liquidity pool type. The liquidity is provided to price
+// ranges as positions instead of the pool coin, so the deposits and the withdrawals within batch are not supported.
+type ConcentratedPoolCurve struct{}
+
+// InitPool implements PoolCurve.
+func (ConcentratedPoolCurve) InitPool(*Pool, Params) {}
+
+// ValidatePool implements PoolCurve. The reserve coin weights and the amplification are not allowed.
+func (ConcentratedPoolCurve) ValidatePool(pool Pool) error {
+	if len(pool.ReserveCoinWeights) > 0 {
+		return ErrBadReserveCoinWeights
+	}
+	if pool.Amplification != 0 {
+		return ErrBadAmplification
+	}
+	return nil
+}
+
+// PoolPrice implements PoolCurve. It returns the reserve ratio X/Y, with which the pool price of a new pool is
+// initialized, while the pool price of an existing pool is kept in the store.
+func (ConcentratedPoolCurve) PoolPrice(_ Pool, _ Params, _, _ string, x, y sdk.Dec) sdk.Dec {
+	return x.Quo(y)
+}
+
+// SwapCurve implements PoolCurve. It returns the curve of a single full range position holding the reserves, while
+// the swap orders of an existing pool are matched with the ConcentratedCurve of its positions.
+func (c ConcentratedPoolCurve) SwapCurve(pool Pool, params Params, denomX, denomY string, x, y sdk.Dec) (SwapCurve, sdk.Dec, sdk.Dec) {
+	price := c.PoolPrice(pool, params, denomX, denomY, x, y)
+	return NewConcentratedCurve(price, []Position{{
+		LowerPrice: MinPositionPrice,
+		UpperPrice: MaxPositionPrice,
+		Liquidity:  LiquidityForAmounts(x, y, MinPositionPrice, MaxPositionPrice, price),
+	}}), x, y
+}
+
+// CurrentSwapCurve implements PoolCurve. It returns the ConcentratedCurve of the positions of the pool at the pool
+// price kept in the store, with which the reserves are ignored.
+func (ConcentratedPoolCurve) CurrentSwapCurve(ctx sdk.Context, k PoolStateKeeper, pool Pool, _ Params, _, _ string, x, y sdk.Dec) (SwapCurve, sdk.Dec, sdk.Dec) {
+	return NewConcentratedCurve(k.GetPoolPrice(ctx, pool.Id), k.GetPositionsByPool(ctx, pool.Id)), x, y
+}
+
+// PriceAfterSwap implements PoolCurve. It is the pool price at which the positions of the ConcentratedCurve provide
+// the pool deltas.
+func (ConcentratedPoolCurve) PriceAfterSwap(_ Pool, _ Params, _, _ string, _, _ sdk.Dec, curve SwapCurve, result BatchResult, poolXDelta, poolYDelta sdk.Dec) sdk.Dec {
+	return curve.(ConcentratedCurve).PriceAfterSwap(result.PriceDirection, result.SwapPrice, poolXDelta, poolYDelta)
+}
+
+// AfterSwap implements PoolCurve. The swap fees are accrued to the positions active at the pool price after the
+// swap, which is kept in the store.
+func (ConcentratedPoolCurve) AfterSwap(ctx sdk.Context, k PoolStateKeeper, pool Pool, lastPrice sdk.Dec) {
+	k.AccrueFeeGrowth(ctx, pool, lastPrice)
+	k.SetPoolPrice(ctx, pool.Id, lastPrice)
+}
+
+// ValidateInitialDeposit implements PoolCurve. The pool price initialized with the ratio of the deposit coins must be
+// within MinPositionPrice and MaxPositionPrice.
+func (c ConcentratedPoolCurve) ValidateInitialDeposit(pool Pool, depositCoins sdk.Coins) error {
+	depositCoins = depositCoins.Sort()
+	price := c.PoolPrice(pool, Params{}, depositCoins[0].Denom, depositCoins[1].Denom, depositCoins[0].Amount.ToDec(), depositCoins[1].Amount.ToDec())
+	if price.LT(MinPositionPrice) || price.GT(MaxPositionPrice) {
+		return ErrBadPriceRange
+	}
+	return nil
+}
+
+// HasPoolCoin implements PoolCurve. The liquidity is provided as positions instead.
+func (ConcentratedPoolCurve) HasPoolCoin() bool {
+	return false
+}
+
+// InitPoolState implements PoolCurve. The pool price is initialized with the ratio of the deposit coins, and the
+// deposit coins are provided as a full range position of the pool creator.
+func (c ConcentratedPoolCurve) InitPoolState(ctx sdk.Context, k PoolStateKeeper, pool Pool, poolCreator sdk.AccAddress, depositCoins sdk.Coins) error {
+	depositCoins = depositCoins.Sort()
+	k.SetPoolPrice(ctx, pool.Id, c.PoolPrice(pool, Params{}, depositCoins[0].Denom, depositCoins[1].Denom, depositCoins[0].Amount.ToDec(), depositCoins[1].Amount.ToDec()))
+	_, _, err := k.DepositToRange(ctx, NewMsgDepositToRange(poolCreator, pool.Id, MinPositionPrice, MaxPositionPrice, depositCoins))
+	return err
+}
+
+// IsDepleted implements PoolCurve. The reserves can hold a single reserve coin while the pool price is outside of the
+// price ranges, so the pool is depleted only when no position is left.
+func (ConcentratedPoolCurve) IsDepleted(ctx sdk.Context, k PoolStateKeeper, pool Pool, _ sdk.Coins, _ sdk.Int) bool {
+	return len(k.GetPositionsByPool(ctx, pool.Id)) == 0
+}
+
+// MaxOrderReserve implements PoolCurve. The reserve of the offer coin can be empty while the pool price is outside of
+// the price ranges, so it is the reserve of the demand coin valued at the order price.
+func (ConcentratedPoolCurve) MaxOrderReserve(pool Pool, reserveCoins sdk.Coins, offerCoinDenom, demandCoinDenom string, orderPrice sdk.Dec) sdk.Dec {
+	reserveAmt := reserveCoins.AmountOf(demandCoinDenom).ToDec()
+	if offerCoinDenom == pool.ReserveCoinDenoms[0] {
+		return reserveAmt.Mul(orderPrice)
+	}
+	return reserveAmt.Quo(orderPrice)
+}
+
+// ValidateDepositCoins implements PoolCurve. The pool coin is not supported.
+func (ConcentratedPoolCurve) ValidateDepositCoins(Pool, sdk.Coins) error {
+	return ErrPoolCoinNotSupported
+}
+
+// ValidateWithdrawCoinDenom implements PoolCurve. The pool coin is not supported.
+func (ConcentratedPoolCurve) ValidateWithdrawCoinDenom(Pool, string) error {
+	return ErrPoolCoinNotSupported
+}
+
+// DepositMint implements PoolCurve. The pool coin is not supported.
+func (ConcentratedPoolCurve) DepositMint(Pool, Params, sdk.Coins, sdk.Int, sdk.Coins, PoolFeeRate) (sdk.Int, sdk.Coins, error) {
+	return sdk.Int{}, nil, ErrPoolCoinNotSupported
+}
+
+// WithdrawPayout implements PoolCurve. The pool coin is not supported.
+func (ConcentratedPoolCurve) WithdrawPayout(Pool, Params, sdk.Coins, sdk.Int, sdk.Int, string, PoolFeeRate) (sdk.Coins, sdk.Coins, error) {
+	return nil, nil, ErrPoolCoinNotSupported
+}
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestValidatePriceRange(t *testing.T) {
+	for _, tc := range []struct {
+		lowerPrice, upperPrice sdk.Dec
+		expectedErr            error
+	}{
+		{sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("1.1"), nil},
+		{types.MinPositionPrice, types.MaxPositionPrice, nil},
+		{sdk.MustNewDecFromStr("1.1"), sdk.MustNewDecFromStr("0.9"), types.ErrBadPriceRange},
+		{sdk.OneDec(), sdk.OneDec(), types.ErrBadPriceRange},
+		{sdk.ZeroDec(), sdk.OneDec(), types.ErrBadPriceRange},
+		{sdk.OneDec(), types.MaxPositionPrice.MulInt64(2), types.ErrBadPriceRange},
+		{sdk.Dec{}, sdk.OneDec(), types.ErrBadPriceRange},
+	} {
+		require.ErrorIs(t, types.ValidatePriceRange(tc.lowerPrice, tc.upperPrice), tc.expectedErr)
+	}
+}
+
+func TestPositionAmounts(t *testing.T) {
+	lowerPrice, upperPrice := sdk.MustNewDecFromStr("0.25"), sdk.NewDec(4)
+	liquidity := sdk.NewDec(1000000)
+
+	// X = L(√P - √Pa) and Y = L(1/√P - 1/√Pb) within the price range
+	x, y := types.PositionAmounts(liquidity, lowerPrice, upperPrice, sdk.OneDec())
+	require.Equal(t, sdk.NewDec(500000), x)
+	require.Equal(t, sdk.NewDec(500000), y)
+
+	// the position holds only Y below the price range and only X above it
+	x, y = types.PositionAmounts(liquidity, lowerPrice, upperPrice, sdk.MustNewDecFromStr("0.1"))
+	require.True(t, x.IsZero())
+	require.Equal(t, sdk.NewDec(1500000), y)
+	x, y = types.PositionAmounts(liquidity, lowerPrice, upperPrice, sdk.NewDec(10))
+	require.Equal(t, sdk.NewDec(1500000), x)
+	require.True(t, y.IsZero())
+
+	// the liquidity is bounded by the smaller side of the deposit amounts
+	require.Equal(t, liquidity, types.LiquidityForAmounts(sdk.NewDec(500000), sdk.NewDec(800000), lowerPrice, upperPrice, sdk.OneDec()))
+	require.Equal(t, liquidity, types.LiquidityForAmounts(sdk.NewDec(900000), sdk.NewDec(500000), lowerPrice, upperPrice, sdk.OneDec()))
+	require.Equal(t, liquidity, types.LiquidityForAmounts(sdk.ZeroDec(), sdk.NewDec(1500000), lowerPrice, upperPrice, sdk.MustNewDecFromStr("0.1")))
+	require.True(t, types.LiquidityForAmounts(sdk.NewDec(1500000), sdk.ZeroDec(), lowerPrice, upperPrice, sdk.OneDec()).IsZero())
+}
+
+func TestConcentratedCurve(t *testing.T) {
+	positions := []types.Position{
+		{LowerPrice: sdk.MustNewDecFromStr("0.25"), UpperPrice: sdk.NewDec(4), Liquidity: sdk.NewDec(1000000)},
+		{LowerPrice: sdk.MustNewDecFromStr("0.9"), UpperPrice: sdk.MustNewDecFromStr("1.1"), Liquidity: sdk.NewDec(10000000)},
+		{LowerPrice: sdk.NewDec(2), UpperPrice: sdk.NewDec(3), Liquidity: sdk.NewDec(10000000)},
+	}
+	curve := types.NewConcentratedCurve(sdk.OneDec(), positions)
+	require.Equal(t, sdk.OneDec(), curve.Price(sdk.ZeroDec(), sdk.ZeroDec()))
+
+	// the position above the pool price holds only Y
+	x, y := curve.Amounts(sdk.OneDec())
+	activeX, activeY := types.NewConcentratedCurve(sdk.OneDec(), positions[:2]).Amounts(sdk.OneDec())
+	_, inactiveY := types.PositionAmounts(positions[2].Liquidity, positions[2].LowerPrice, positions[2].UpperPrice, sdk.OneDec())
+	require.Equal(t, activeX, x)
+	require.Equal(t, activeY.Add(inactiveY), y)
+
+	// buying Y with X increases the pool price to the swap price, where the pool provides Y with the active liquidity
+	ex := sdk.NewDec(100000)
+	swapPrice := curve.SwapPrice(types.Increasing, x, y, ex, sdk.ZeroDec())
+	require.True(t, swapPrice.GT(sdk.OneDec()))
+	require.True(t, swapPrice.LT(sdk.MustNewDecFromStr("1.1")))
+	poolY := curve.PoolY(x, y, swapPrice)
+	require.InDelta(t, ex.Quo(swapPrice).MustFloat64(), poolY.MustFloat64(), 1)
+
+	// the concentrated liquidity gives much lower slippage than the full range liquidity of the same reserves
+	fullRange := types.ConstantProductCurve{}
+	require.True(t, swapPrice.LT(sdk.MustNewDecFromStr("1.02")))
+	require.True(t, fullRange.SwapPrice(types.Increasing, activeX, activeX, ex, sdk.ZeroDec()).GT(sdk.MustNewDecFromStr("1.19")))
+
+	// the price moves through the gap without liquidity to the next price range
+	ex = sdk.NewDec(2000000)
+	swapPrice = curve.SwapPrice(types.Increasing, x, y, ex, sdk.ZeroDec())
+	require.True(t, swapPrice.GT(sdk.NewDec(2)))
+	require.InDelta(t, ex.Quo(swapPrice).MustFloat64(), curve.PoolY(x, y, swapPrice).MustFloat64(), 1)
+
+	// selling Y for X decreases the pool price to the swap price, where the pool provides X
+	ey := sdk.NewDec(100000)
+	swapPrice = curve.SwapPrice(types.Decreasing, x, y, sdk.ZeroDec(), ey)
+	require.True(t, swapPrice.LT(sdk.OneDec()))
+	require.True(t, swapPrice.GT(sdk.MustNewDecFromStr("0.9")))
+	poolX := curve.PoolX(x, y, swapPrice)
+	require.InDelta(t, ey.Mul(swapPrice).MustFloat64(), poolX.MustFloat64(), 1)
+
+	// the pool price after the swap is the price at which the positions provided the reserve coins
+	price := curve.PriceAfterSwap(types.Decreasing, swapPrice, poolX.Neg(), ey)
+	require.True(t, price.Sub(swapPrice).Abs().LTE(swapPrice.Mul(sdk.NewDecWithPrec(1, 12))))
+	afterX, _ := curve.Amounts(price)
+	require.True(t, afterX.LTE(x.Sub(poolX)))
+
+	// the pool price moves to the swap price within the gap without liquidity
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"),
+		types.NewConcentratedCurve(sdk.MustNewDecFromStr("1.1"), positions[1:]).PriceAfterSwap(types.Increasing, sdk.MustNewDecFromStr("1.5"), sdk.ZeroDec(), sdk.ZeroDec()))
+}
@@ -0,0 +1,195 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SwapCurve is the pool price function of a pair of reserve coins X and Y, which the batch matching uses
+// to find the swap price and the amount of the reserve coins provided by the pool under the ESPM constraint.
+type SwapCurve interface {
+	// Price returns the pool price, the price of Y in X, with the reserves x and y.
+	Price(x, y sdk.Dec) sdk.Dec
+	// SwapPrice returns the swap price at which the executable amounts ex and ey are cleared together with the pool
+	// in the given price direction, leaving the pool price equal to the swap price.
+	SwapPrice(direction PriceDirection, x, y, ex, ey sdk.Dec) sdk.Dec
+	// PoolY returns the amount of Y provided by the pool when the pool price increases to the swap price p.
+	PoolY(x, y, p sdk.Dec) sdk.Dec
+	// PoolX returns the amount of X provided by the pool when the pool price decreases to the swap price p.
+	PoolX(x, y, p sdk.Dec) sdk.Dec
+}
+
+var (
+	_ SwapCurve = ConstantProductCurve{}
+	_ SwapCurve = StableSwapCurve{}
+)
+
+// ConstantProductCurve is the pool price function X/Y of the standard liquidity pool.
+type ConstantProductCurve struct{}
+
+// Price implements SwapCurve.
+func (ConstantProductCurve) Price(x, y sdk.Dec) sdk.Dec {
+	return x.Quo(y)
+}
+
+// SwapPrice implements SwapCurve.
+func (ConstantProductCurve) SwapPrice(_ PriceDirection, x, y, ex, ey sdk.Dec) sdk.Dec {
+	return x.Add(ex.MulInt64(2)).Quo(y.Add(ey.MulInt64(2))) // P_s = (X + 2EX) / (Y + 2EY)
+}
+
+// PoolY implements SwapCurve.
+func (ConstantProductCurve) PoolY(x, y, p sdk.Dec) sdk.Dec {
+	return p.Mul(y).Sub(x).Quo(p.MulInt64(2)) // (P_s * Y - X) / 2P_s
+}
+
+// PoolX implements SwapCurve.
+func (ConstantProductCurve) PoolX(x, y, p sdk.Dec) sdk.Dec {
+	return x.Sub(p.Mul(y)).QuoInt64(2) // (X - P_s * Y) / 2
+}
+
+// stableSwapPrecision is the relative precision of the prices and amounts found on the StableSwap curve.
+var stableSwapPrecision = sdk.NewDecWithPrec(1, 15)
+
+// stableSwapMaxSteps is the maximum number of steps of each iterative search on the StableSwap curve. The batch matching
+// evaluates each price level of the order book with a few swap price and pool amount searches, so the number of the pool
+// price evaluations, and the work of the EndBlocker where no gas is charged, is bounded by a small multiple of
+// stableSwapMaxSteps^2 for each price level.
+const stableSwapMaxSteps = 64
+
+// StableSwapCurve is the StableSwap curve 4A(x+y) + D = 4AD + D^3/(4xy) of two reserve coins, which gives much lower
+// slippage than the constant product curve between pegged coins while the reserves are balanced.
+type StableSwapCurve struct {
+	Amplification sdk.Dec
+}
+
+// NewStableSwapCurve returns a new StableSwapCurve with the given amplification coefficient.
+func NewStableSwapCurve(amplification uint32) StableSwapCurve {
+	return StableSwapCurve{Amplification: sdk.NewDec(int64(amplification))}
+}
+
+// Invariant returns the invariant D of the reserves x and y, computed by Newton's method which converges from x+y
+// within a few steps.
+func (c StableSwapCurve) Invariant(x, y sdk.Dec) sdk.Dec {
+	s := x.Add(y)
+	if !x.IsPositive() || !y.IsPositive() {
+		return s
+	}
+	ann := c.Amplification.MulInt64(4)
+	d := s
+	for i := 0; i < stableSwapMaxSteps; i++ {
+		dP := d.Quo(x.MulInt64(2)).Mul(d.Quo(y.MulInt64(2))).Mul(d) // D^3 / 4xy
+		prev := d
+		d = ann.Mul(s).Add(dP.MulInt64(2)).Mul(d).Quo(ann.Sub(sdk.OneDec()).Mul(d).Add(dP.MulInt64(3)))
+		if d.Sub(prev).Abs().LTE(sdk.SmallestDec()) {
+			break
+		}
+	}
+	return d
+}
+
+// Price implements SwapCurve. The pool price is the ratio of the partial derivatives of the invariant,
+// (4A + D^3/(4xy^2)) / (4A + D^3/(4x^2y)).
+func (c StableSwapCurve) Price(x, y sdk.Dec) sdk.Dec {
+	d := c.Invariant(x, y)
+	ann := c.Amplification.MulInt64(4)
+	k := d.Quo(x.MulInt64(2)).Mul(d.Quo(y.MulInt64(2))).Mul(d) // D^3 / 4xy
+	return ann.Add(k.Quo(y)).Quo(ann.Add(k.Quo(x)))
+}
+
+// SwapPrice implements SwapCurve. Clearing ex and ey at the swap price p leaves the pool with the reserves
+// x' = x + ex - p*ey and y' = y + ey - ex/p, so the swap price is the root of the excess of the pool price of x', y'
+// over p.
+func (c StableSwapCurve) SwapPrice(direction PriceDirection, x, y, ex, ey sdk.Dec) sdk.Dec {
+	// excess returns the pool price after clearing at the price p over p, or nil with its sign if the reserves run out
+	excess := func(p sdk.Dec) (sdk.Dec, bool) {
+		xp := x.Add(ex).Sub(p.Mul(ey))
+		yp := y.Add(ey).Sub(ex.Quo(p))
+		if !xp.IsPositive() {
+			return sdk.Dec{}, false
+		} else if !yp.IsPositive() {
+			return sdk.Dec{}, true
+		}
+		e := c.Price(xp, yp).Sub(p)
+		return e, e.IsPositive()
+	}
+
+	lo, hi := c.Price(x, y), c.Price(x, y)
+	if direction == Increasing {
+		for i := 0; i < stableSwapMaxSteps; i++ {
+			if _, positive := excess(hi); !positive {
+				break
+			}
+			lo, hi = hi, hi.MulInt64(2)
+		}
+	} else {
+		for i := 0; i < stableSwapMaxSteps; i++ {
+			if _, positive := excess(lo); positive {
+				break
+			}
+			lo, hi = lo.QuoInt64(2), lo
+		}
+	}
+	return findStableSwapRoot(lo, hi, excess)
+}
+
+// PoolY implements SwapCurve. The pool trades at the price p along x' + p*y' = x + p*y until its pool price becomes p.
+func (c StableSwapCurve) PoolY(x, y, p sdk.Dec) sdk.Dec {
+	return y.Sub(c.balancedY(x, y, p))
+}
+
+// PoolX implements SwapCurve. The pool trades at the price p along x' + p*y' = x + p*y until its pool price becomes p.
+func (c StableSwapCurve) PoolX(x, y, p sdk.Dec) sdk.Dec {
+	v := x.Add(p.Mul(y))
+	return x.Sub(v.Sub(p.Mul(c.balancedY(x, y, p))))
+}
+
+// balancedY returns the reserve y' of the pool whose pool price is p after trading at the price p from the reserves x, y.
+func (c StableSwapCurve) balancedY(x, y, p sdk.Dec) sdk.Dec {
+	v := x.Add(p.Mul(y))
+	// the pool price decreases as y' increases along the line, from infinity at y' = 0 to zero at y' = v/p
+	return findStableSwapRoot(sdk.ZeroDec(), v.Quo(p), func(yp sdk.Dec) (sdk.Dec, bool) {
+		xp := v.Sub(p.Mul(yp))
+		if !yp.IsPositive() {
+			return sdk.Dec{}, true
+		} else if !xp.IsPositive() {
+			return sdk.Dec{}, false
+		}
+		e := c.Price(xp, yp).Sub(p)
+		return e, e.IsPositive()
+	})
+}
+
+// findStableSwapRoot returns the root of the decreasing function f between lo and hi, where f is positive at lo and
+// not positive at hi. f returns its value and whether it is positive, or a nil value with its sign where it is not
+// defined. The root is found by the Illinois variant of the regula falsi method, which converges superlinearly, and
+// the interval is bisected where f is not defined.
+func findStableSwapRoot(lo, hi sdk.Dec, f func(sdk.Dec) (sdk.Dec, bool)) sdk.Dec {
+	fLo, _ := f(lo)
+	fHi, _ := f(hi)
+	side := 0
+	for i := 0; i < stableSwapMaxSteps && hi.Sub(lo).GT(hi.Mul(stableSwapPrecision)); i++ {
+		mid := lo.Add(hi).QuoInt64(2)
+		if !fLo.IsNil() && !fHi.IsNil() && fLo.GT(fHi) {
+			if next := hi.Sub(fHi.Mul(hi.Sub(lo)).Quo(fHi.Sub(fLo))); next.GT(lo) && next.LT(hi) {
+				mid = next
+			}
+		}
+		fMid, positive := f(mid)
+		switch {
+		case !fMid.IsNil() && fMid.IsZero():
+			return mid
+		case positive:
+			lo, fLo = mid, fMid
+			if side == 1 && !fHi.IsNil() {
+				fHi = fHi.QuoInt64(2)
+			}
+			side = 1
+		default:
+			hi, fHi = mid, fMid
+			if side == -1 && !fLo.IsNil() {
+				fLo = fLo.QuoInt64(2)
+			}
+			side = -1
+		}
+	}
+	return lo.Add(hi).QuoInt64(2)
+}
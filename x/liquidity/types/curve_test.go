@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestConstantProductCurve(t *testing.T) {
+	curve := types.ConstantProductCurve{}
+	x, y := sdk.NewDec(1000000), sdk.NewDec(2000000)
+
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), curve.Price(x, y))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), curve.SwapPrice(types.Staying, x, y, sdk.ZeroDec(), sdk.ZeroDec()))
+
+	// the pool provides nothing at its own pool price
+	require.True(t, curve.PoolY(x, y, curve.Price(x, y)).IsZero())
+	require.True(t, curve.PoolX(x, y, curve.Price(x, y)).IsZero())
+}
+
+func TestStableSwapCurve(t *testing.T) {
+	curve := types.NewStableSwapCurve(types.DefaultStableSwapAmplification)
+	x, y := sdk.NewDec(1000000), sdk.NewDec(1000000)
+
+	// the invariant equals the sum of the reserves and the pool price is one while the reserves are balanced
+	require.Equal(t, x.Add(y), curve.Invariant(x, y))
+	require.Equal(t, sdk.OneDec(), curve.Price(x, y))
+
+	// the pool price of the imbalanced reserves stays much closer to one than the constant product curve
+	price := curve.Price(sdk.NewDec(1200000), sdk.NewDec(800000))
+	require.True(t, price.GT(sdk.OneDec()))
+	require.True(t, price.LT(sdk.MustNewDecFromStr("1.01")))
+
+	// buying Y with X increases the pool price to the swap price, where the pool provides Y
+	ex := sdk.NewDec(10000)
+	swapPrice := curve.SwapPrice(types.Increasing, x, y, ex, sdk.ZeroDec())
+	require.True(t, swapPrice.GT(sdk.OneDec()))
+	require.True(t, swapPrice.LT(sdk.MustNewDecFromStr("1.0001")))
+	poolY := curve.PoolY(x, y, swapPrice)
+	require.True(t, poolY.IsPositive())
+	require.InDelta(t, ex.Quo(swapPrice).MustFloat64(), poolY.MustFloat64(), 1)
+
+	// selling Y for X decreases the pool price to the swap price, where the pool provides X
+	ey := sdk.NewDec(10000)
+	swapPrice = curve.SwapPrice(types.Decreasing, x, y, sdk.ZeroDec(), ey)
+	require.True(t, swapPrice.LT(sdk.OneDec()))
+	require.True(t, swapPrice.GT(sdk.MustNewDecFromStr("0.9999")))
+	poolX := curve.PoolX(x, y, swapPrice)
+	require.True(t, poolX.IsPositive())
+	require.InDelta(t, ey.Mul(swapPrice).MustFloat64(), poolX.MustFloat64(), 1)
+}
+
+func TestStableSwapCurveInvariant(t *testing.T) {
+	for _, amplification := range []uint32{1, types.DefaultStableSwapAmplification, types.MaxStableSwapAmplification} {
+		curve := types.NewStableSwapCurve(amplification)
+		ann := curve.Amplification.MulInt64(4)
+		for _, reserves := range [][2]int64{{1000000, 1000000}, {1200000, 800000}, {1000000, 3000000}, {1, 1000000000000}} {
+			x, y := sdk.NewDec(reserves[0]), sdk.NewDec(reserves[1])
+			d := curve.Invariant(x, y)
+
+			// 4A(x+y) + D = 4AD + D^3/(4xy)
+			lhs := ann.Mul(x.Add(y)).Add(d)
+			rhs := ann.Mul(d).Add(d.Quo(x.MulInt64(2)).Mul(d.Quo(y.MulInt64(2))).Mul(d))
+			require.True(t, lhs.Sub(rhs).Abs().LTE(lhs.Mul(sdk.NewDecWithPrec(1, 12))),
+				"amplification %d, reserves %v: %s != %s", amplification, reserves, lhs, rhs)
+		}
+	}
+}
+
+func BenchmarkStableSwapCurveMatch(b *testing.B) {
+	curve := types.NewStableSwapCurve(types.DefaultStableSwapAmplification)
+	x, y := sdk.NewDec(1000000000), sdk.NewDec(1000000000)
+
+	// the buy orders at 100 price levels above the pool price and the sell orders at 100 price levels below it
+	orderMap := make(types.OrderMap)
+	for i := int64(1); i <= 100; i++ {
+		buyPrice, sellPrice := sdk.OneDec().Add(sdk.NewDecWithPrec(i, 4)), sdk.OneDec().Sub(sdk.NewDecWithPrec(i, 4))
+		orderMap[buyPrice.String()] = types.Order{Price: buyPrice, BuyOfferAmt: sdk.NewInt(1000000), SellOfferAmt: sdk.ZeroInt()}
+		orderMap[sellPrice.String()] = types.Order{Price: sellPrice, BuyOfferAmt: sdk.ZeroInt(), SellOfferAmt: sdk.NewInt(500000)}
+	}
+	orderBook := orderMap.SortOrderBook()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		orderBook.Match(curve, x, y, sdk.ZeroDec())
+	}
+}
+
+func BenchmarkStableSwapCurveSwapPrice(b *testing.B) {
+	curve := types.NewStableSwapCurve(types.DefaultStableSwapAmplification)
+	x, y, ex := sdk.NewDec(1000000000), sdk.NewDec(1000000000), sdk.NewDec(10000000)
+
+	for i := 0; i < b.N; i++ {
+		curve.PoolY(x, y, curve.SwapPrice(types.Increasing, x, y, ex, sdk.ZeroDec()))
+	}
+}
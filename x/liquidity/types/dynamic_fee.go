@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates that the volatility and the swap fee rate of DynamicSwapFee are not negative and the swap fee
+// rate does not exceed one.
+func (fee DynamicSwapFee) Validate() error {
+	if fee.Volatility.IsNil() || fee.Volatility.IsNegative() ||
+		fee.SwapFeeRate.IsNil() || fee.SwapFeeRate.IsNegative() || fee.SwapFeeRate.GT(sdk.OneDec()) {
+		return ErrBadDynamicSwapFee
+	}
+	return nil
+}
+
+// PriceVolatility returns the largest relative range (max - min) / min of the clearing prices of each pair of reserve
+// coins in the batch results. The pairs matched in less than two of the batch results have no volatility.
+func PriceVolatility(results []PoolBatchResult) sdk.Dec {
+	type priceRange struct {
+		min, max sdk.Dec
+		count    int
+	}
+	ranges := make(map[string]*priceRange)
+	var pairs []string
+	for _, result := range results {
+		for _, swapResult := range result.SwapResults {
+			if swapResult.SwapPrice.IsNil() || !swapResult.SwapPrice.IsPositive() {
+				continue
+			}
+			pair := swapResult.DenomX + "/" + swapResult.DenomY
+			r, ok := ranges[pair]
+			if !ok {
+				ranges[pair] = &priceRange{min: swapResult.SwapPrice, max: swapResult.SwapPrice, count: 1}
+				pairs = append(pairs, pair)
+				continue
+			}
+			r.min = sdk.MinDec(r.min, swapResult.SwapPrice)
+			r.max = sdk.MaxDec(r.max, swapResult.SwapPrice)
+			r.count++
+		}
+	}
+
+	volatility := sdk.ZeroDec()
+	for _, pair := range pairs {
+		r := ranges[pair]
+		if r.count < 2 {
+			continue
+		}
+		volatility = sdk.MaxDec(volatility, r.max.Sub(r.min).Quo(r.min))
+	}
+	return volatility
+}
+
+// NextDynamicSwapFeeRate returns the swap fee rate following the current rate for the price volatility. The target
+// rate is the base rate raised by the sensitivity per unit of volatility, not exceeding the max rate. The swap fee rate
+// rises to the target rate at once, and otherwise decays toward it by the decay rate share of its excess.
+func NextDynamicSwapFeeRate(current, base, maxRate, volatility, sensitivity, decayRate sdk.Dec) sdk.Dec {
+	target := sdk.MinDec(base.Mul(sdk.OneDec().Add(sensitivity.Mul(volatility))), sdk.MaxDec(base, maxRate))
+	if current.IsNil() || current.LTE(target) {
+		return target
+	}
+	return current.Sub(current.Sub(target).Mul(decayRate))
+}
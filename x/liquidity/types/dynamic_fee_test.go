@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestPriceVolatility(t *testing.T) {
+	result := func(prices ...sdk.Dec) types.PoolBatchResult {
+		var swapResults []types.PairSwapResult
+		for i, price := range prices {
+			swapResults = append(swapResults, types.PairSwapResult{
+				DenomX:    "denomA",
+				DenomY:    []string{"denomB", "denomC"}[i],
+				SwapPrice: price,
+			})
+		}
+		return types.PoolBatchResult{SwapResults: swapResults}
+	}
+
+	require.Equal(t, sdk.ZeroDec(), types.PriceVolatility(nil))
+	require.Equal(t, sdk.ZeroDec(), types.PriceVolatility([]types.PoolBatchResult{result(sdk.NewDec(2))}))
+	require.Equal(t, sdk.ZeroDec(), types.PriceVolatility([]types.PoolBatchResult{
+		result(sdk.NewDec(2)), result(sdk.NewDec(2)),
+	}))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), types.PriceVolatility([]types.PoolBatchResult{
+		result(sdk.NewDec(2)), result(sdk.NewDec(3)), result(sdk.NewDecWithPrec(25, 1)),
+	}))
+	// the most volatile pair decides the volatility, and the pairs matched once are left out
+	require.Equal(t, sdk.OneDec(), types.PriceVolatility([]types.PoolBatchResult{
+		result(sdk.NewDec(2), sdk.NewDec(10)), result(sdk.NewDec(3)), result(sdk.NewDec(4)),
+	}))
+	// the batch results without the matched pairs are skipped
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), types.PriceVolatility([]types.PoolBatchResult{
+		result(sdk.NewDec(2)), result(), result(sdk.NewDec(3)),
+	}))
+}
+
+func TestNextDynamicSwapFeeRate(t *testing.T) {
+	base := sdk.NewDecWithPrec(3, 3)
+	maxRate := sdk.NewDecWithPrec(1, 2)
+	sensitivity := sdk.NewDec(10)
+	decayRate := sdk.NewDecWithPrec(5, 1)
+
+	for _, tc := range []struct {
+		name       string
+		current    sdk.Dec
+		volatility sdk.Dec
+		expected   sdk.Dec
+	}{
+		{"Calm", base, sdk.ZeroDec(), base},
+		{"Rise", base, sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(6, 3)},
+		{"RiseToMax", base, sdk.OneDec(), maxRate},
+		{"RiseFromHigher", sdk.NewDecWithPrec(4, 3), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(6, 3)},
+		{"Decay", sdk.NewDecWithPrec(7, 3), sdk.ZeroDec(), sdk.NewDecWithPrec(5, 3)},
+		{"DecayTowardTarget", sdk.NewDecWithPrec(8, 3), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(7, 3)},
+		{"NotSet", sdk.Dec{}, sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(6, 3)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.NextDynamicSwapFeeRate(tc.current, base, maxRate, tc.volatility, sensitivity, decayRate))
+		})
+	}
+
+	// the max rate lower than the base rate keeps the swap fee rate at the base rate
+	require.Equal(t, base, types.NextDynamicSwapFeeRate(base, base, sdk.NewDecWithPrec(1, 3), sdk.OneDec(), sensitivity, decayRate))
+}
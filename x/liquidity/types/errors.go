@@ -6,45 +6,84 @@ import (
 
 // liquidity module sentinel errors
 var (
-	ErrPoolNotExists                = sdkerrors.Register(ModuleName, 1, "pool not exists")
-	ErrPoolTypeNotExists            = sdkerrors.Register(ModuleName, 2, "pool type not exists")
-	ErrEqualDenom                   = sdkerrors.Register(ModuleName, 3, "reserve coin denomination are equal")
-	ErrInvalidDenom                 = sdkerrors.Register(ModuleName, 4, "invalid denom")
-	ErrNumOfReserveCoin             = sdkerrors.Register(ModuleName, 5, "invalid number of reserve coin")
-	ErrNumOfPoolCoin                = sdkerrors.Register(ModuleName, 6, "invalid number of pool coin")
-	ErrInsufficientPool             = sdkerrors.Register(ModuleName, 7, "insufficient pool")
-	ErrInsufficientBalance          = sdkerrors.Register(ModuleName, 8, "insufficient coin balance")
-	ErrLessThanMinInitDeposit       = sdkerrors.Register(ModuleName, 9, "deposit coin less than MinInitDepositAmount")
-	ErrNotImplementedYet            = sdkerrors.Register(ModuleName, 10, "not implemented yet")
-	ErrPoolAlreadyExists            = sdkerrors.Register(ModuleName, 11, "the pool already exists")
-	ErrPoolBatchNotExists           = sdkerrors.Register(ModuleName, 12, "pool batch not exists")
-	ErrOrderBookInvalidity          = sdkerrors.Register(ModuleName, 13, "orderbook is not validity")
-	ErrBatchNotExecuted             = sdkerrors.Register(ModuleName, 14, "the liquidity pool batch is not executed")
-	ErrInvalidPoolCreatorAddr       = sdkerrors.Register(ModuleName, 15, "invalid pool creator address")
-	ErrInvalidDepositorAddr         = sdkerrors.Register(ModuleName, 16, "invalid pool depositor address")
-	ErrInvalidWithdrawerAddr        = sdkerrors.Register(ModuleName, 17, "invalid pool withdrawer address")
-	ErrInvalidSwapRequesterAddr     = sdkerrors.Register(ModuleName, 18, "invalid pool swap requester address")
-	ErrBadPoolCoinAmount            = sdkerrors.Register(ModuleName, 19, "invalid pool coin amount")
-	ErrBadDepositCoinsAmount        = sdkerrors.Register(ModuleName, 20, "invalid deposit coins amount")
-	ErrBadOfferCoinAmount           = sdkerrors.Register(ModuleName, 21, "invalid offer coin amount")
-	ErrBadOrderingReserveCoin       = sdkerrors.Register(ModuleName, 22, "reserve coin denoms not ordered alphabetical")
-	ErrBadOrderPrice                = sdkerrors.Register(ModuleName, 23, "invalid order price")
-	ErrNumOfReserveCoinDenoms       = sdkerrors.Register(ModuleName, 24, "invalid reserve coin denoms")
-	ErrEmptyReserveAccountAddress   = sdkerrors.Register(ModuleName, 25, "empty reserve account address")
-	ErrEmptyPoolCoinDenom           = sdkerrors.Register(ModuleName, 26, "empty pool coin denom")
-	ErrBadOrderingReserveCoinDenoms = sdkerrors.Register(ModuleName, 27, "bad ordering reserve coin denoms")
-	ErrBadReserveAccountAddress     = sdkerrors.Register(ModuleName, 28, "bad reserve account address")
-	ErrBadPoolCoinDenom             = sdkerrors.Register(ModuleName, 29, "bad pool coin denom")
-	ErrInsufficientPoolCreationFee  = sdkerrors.Register(ModuleName, 30, "insufficient balances for pool creation fee")
-	ErrExceededMaxOrderable         = sdkerrors.Register(ModuleName, 31, "can not exceed max order ratio of reserve coins that can be ordered at a order")
-	ErrBadBatchMsgIndex             = sdkerrors.Register(ModuleName, 32, "bad msg index of the batch")
-	ErrSwapTypeNotExists            = sdkerrors.Register(ModuleName, 33, "swap type not exists")
-	ErrLessThanMinOfferAmount       = sdkerrors.Register(ModuleName, 34, "offer amount should be over 100 micro")
-	ErrBadOfferCoinFee              = sdkerrors.Register(ModuleName, 35, "bad offer coin fee")
-	ErrNotMatchedReserveCoin        = sdkerrors.Register(ModuleName, 36, "does not match the reserve coin of the pool")
-	ErrBadPoolTypeID                = sdkerrors.Register(ModuleName, 37, "invalid index of the pool type")
-	ErrExceededReserveCoinLimit     = sdkerrors.Register(ModuleName, 38, "can not exceed reserve coin limit amount")
-	ErrDepletedPool                 = sdkerrors.Register(ModuleName, 39, "the pool is depleted of reserve coin, reinitializing is required by deposit")
-	ErrCircuitBreakerEnabled        = sdkerrors.Register(ModuleName, 40, "circuit breaker is triggered")
-	ErrOverflowAmount               = sdkerrors.Register(ModuleName, 41, "invalid amount that can cause overflow")
+	ErrPoolNotExists                  = sdkerrors.Register(ModuleName, 1, "pool not exists")
+	ErrPoolTypeNotExists              = sdkerrors.Register(ModuleName, 2, "pool type not exists")
+	ErrEqualDenom                     = sdkerrors.Register(ModuleName, 3, "reserve coin denomination are equal")
+	ErrInvalidDenom                   = sdkerrors.Register(ModuleName, 4, "invalid denom")
+	ErrNumOfReserveCoin               = sdkerrors.Register(ModuleName, 5, "invalid number of reserve coin")
+	ErrNumOfPoolCoin                  = sdkerrors.Register(ModuleName, 6, "invalid number of pool coin")
+	ErrInsufficientPool               = sdkerrors.Register(ModuleName, 7, "insufficient pool")
+	ErrInsufficientBalance            = sdkerrors.Register(ModuleName, 8, "insufficient coin balance")
+	ErrLessThanMinInitDeposit         = sdkerrors.Register(ModuleName, 9, "deposit coin less than MinInitDepositAmount")
+	ErrNotImplementedYet              = sdkerrors.Register(ModuleName, 10, "not implemented yet")
+	ErrPoolAlreadyExists              = sdkerrors.Register(ModuleName, 11, "the pool already exists")
+	ErrPoolBatchNotExists             = sdkerrors.Register(ModuleName, 12, "pool batch not exists")
+	ErrOrderBookInvalidity            = sdkerrors.Register(ModuleName, 13, "orderbook is not validity")
+	ErrBatchNotExecuted               = sdkerrors.Register(ModuleName, 14, "the liquidity pool batch is not executed")
+	ErrInvalidPoolCreatorAddr         = sdkerrors.Register(ModuleName, 15, "invalid pool creator address")
+	ErrInvalidDepositorAddr           = sdkerrors.Register(ModuleName, 16, "invalid pool depositor address")
+	ErrInvalidWithdrawerAddr          = sdkerrors.Register(ModuleName, 17, "invalid pool withdrawer address")
+	ErrInvalidSwapRequesterAddr       = sdkerrors.Register(ModuleName, 18, "invalid pool swap requester address")
+	ErrBadPoolCoinAmount              = sdkerrors.Register(ModuleName, 19, "invalid pool coin amount")
+	ErrBadDepositCoinsAmount          = sdkerrors.Register(ModuleName, 20, "invalid deposit coins amount")
+	ErrBadOfferCoinAmount             = sdkerrors.Register(ModuleName, 21, "invalid offer coin amount")
+	ErrBadOrderingReserveCoin         = sdkerrors.Register(ModuleName, 22, "reserve coin denoms not ordered alphabetical")
+	ErrBadOrderPrice                  = sdkerrors.Register(ModuleName, 23, "invalid order price")
+	ErrNumOfReserveCoinDenoms         = sdkerrors.Register(ModuleName, 24, "invalid reserve coin denoms")
+	ErrEmptyReserveAccountAddress     = sdkerrors.Register(ModuleName, 25, "empty reserve account address")
+	ErrEmptyPoolCoinDenom             = sdkerrors.Register(ModuleName, 26, "empty pool coin denom")
+	ErrBadOrderingReserveCoinDenoms   = sdkerrors.Register(ModuleName, 27, "bad ordering reserve coin denoms")
+	ErrBadReserveAccountAddress       = sdkerrors.Register(ModuleName, 28, "bad reserve account address")
+	ErrBadPoolCoinDenom               = sdkerrors.Register(ModuleName, 29, "bad pool coin denom")
+	ErrInsufficientPoolCreationFee    = sdkerrors.Register(ModuleName, 30, "insufficient balances for pool creation fee")
+	ErrExceededMaxOrderable           = sdkerrors.Register(ModuleName, 31, "can not exceed max order ratio of reserve coins that can be ordered at a order")
+	ErrBadBatchMsgIndex               = sdkerrors.Register(ModuleName, 32, "bad msg index of the batch")
+	ErrSwapTypeNotExists              = sdkerrors.Register(ModuleName, 33, "swap type not exists")
+	ErrLessThanMinOfferAmount         = sdkerrors.Register(ModuleName, 34, "offer amount should be over 100 micro")
+	ErrBadOfferCoinFee                = sdkerrors.Register(ModuleName, 35, "bad offer coin fee")
+	ErrNotMatchedReserveCoin          = sdkerrors.Register(ModuleName, 36, "does not match the reserve coin of the pool")
+	ErrBadPoolTypeID                  = sdkerrors.Register(ModuleName, 37, "invalid index of the pool type")
+	ErrExceededReserveCoinLimit       = sdkerrors.Register(ModuleName, 38, "can not exceed reserve coin limit amount")
+	ErrDepletedPool                   = sdkerrors.Register(ModuleName, 39, "the pool is depleted of reserve coin, reinitializing is required by deposit")
+	ErrCircuitBreakerEnabled          = sdkerrors.Register(ModuleName, 40, "circuit breaker is triggered")
+	ErrOverflowAmount                 = sdkerrors.Register(ModuleName, 41, "invalid amount that can cause overflow")
+	ErrBadReserveCoinWeights          = sdkerrors.Register(ModuleName, 42, "invalid reserve coin weights")
+	ErrPoolCoinNotSupported           = sdkerrors.Register(ModuleName, 43, "pool coin is not supported by the pool type")
+	ErrPositionNotExists              = sdkerrors.Register(ModuleName, 44, "position not exists")
+	ErrBadPriceRange                  = sdkerrors.Register(ModuleName, 45, "invalid price range")
+	ErrNotPositionOwner               = sdkerrors.Register(ModuleName, 46, "not the owner of the position")
+	ErrNoLiquidity                    = sdkerrors.Register(ModuleName, 47, "no liquidity provided within the price range")
+	ErrExceededMaxOrderLifespan       = sdkerrors.Register(ModuleName, 48, "can not exceed max lifespan of the swap order")
+	ErrSwapMsgStateNotExists          = sdkerrors.Register(ModuleName, 49, "swap msg state not exists")
+	ErrNotSwapRequester               = sdkerrors.Register(ModuleName, 50, "not the swap requester of the swap order")
+	ErrBadSwapRoute                   = sdkerrors.Register(ModuleName, 51, "invalid swap route")
+	ErrBadMinDemandCoinAmount         = sdkerrors.Register(ModuleName, 52, "invalid min demand coin amount")
+	ErrBadDemandCoinAmount            = sdkerrors.Register(ModuleName, 53, "invalid demand coin amount")
+	ErrBadPoolBatchResult             = sdkerrors.Register(ModuleName, 54, "invalid pool batch result")
+	ErrBadPriceAccumulator            = sdkerrors.Register(ModuleName, 55, "invalid price accumulator")
+	ErrTwapWindowNotCovered           = sdkerrors.Register(ModuleName, 56, "window not covered by the price accumulators")
+	ErrInvalidFunderAddr              = sdkerrors.Register(ModuleName, 57, "invalid funder address")
+	ErrInvalidStakerAddr              = sdkerrors.Register(ModuleName, 58, "invalid staker address")
+	ErrBadRewardPlan                  = sdkerrors.Register(ModuleName, 59, "invalid reward plan")
+	ErrRewardPlanNotExists            = sdkerrors.Register(ModuleName, 60, "reward plan not exists")
+	ErrStakeNotExists                 = sdkerrors.Register(ModuleName, 61, "stake not exists")
+	ErrInsufficientStake              = sdkerrors.Register(ModuleName, 62, "insufficient staked pool coin")
+	ErrInsufficientRewardPlanFee      = sdkerrors.Register(ModuleName, 63, "insufficient balances for reward plan creation fee")
+	ErrBadRewardAccumulator           = sdkerrors.Register(ModuleName, 64, "invalid reward accumulator")
+	ErrInvalidBondOwnerAddr           = sdkerrors.Register(ModuleName, 65, "invalid bond owner address")
+	ErrBondNotExists                  = sdkerrors.Register(ModuleName, 66, "bond not exists")
+	ErrBadBondDuration                = sdkerrors.Register(ModuleName, 67, "invalid bond duration")
+	ErrNotBondOwner                   = sdkerrors.Register(ModuleName, 68, "not the owner of the bond")
+	ErrBondUnbonding                  = sdkerrors.Register(ModuleName, 69, "bond is already unbonding")
+	ErrBadCollectedProtocolFees       = sdkerrors.Register(ModuleName, 70, "invalid collected protocol fees")
+	ErrBadPoolFeeRate                 = sdkerrors.Register(ModuleName, 71, "invalid pool fee rate")
+	ErrPoolFeeRateOutOfBounds         = sdkerrors.Register(ModuleName, 72, "pool fee rate out of the bounds of the params")
+	ErrBadDynamicSwapFee              = sdkerrors.Register(ModuleName, 73, "invalid dynamic swap fee")
+	ErrBadCircuitBreaker              = sdkerrors.Register(ModuleName, 74, "invalid circuit breaker")
+	ErrInvalidCircuitBreakerAdminAddr = sdkerrors.Register(ModuleName, 75, "invalid circuit breaker admin address")
+	ErrNotCircuitBreakerAdmin         = sdkerrors.Register(ModuleName, 76, "address is not a circuit breaker admin")
+	ErrOrderPriceOutOfBand            = sdkerrors.Register(ModuleName, 77, "order price out of the price band of the pool price")
+	ErrBadWithdrawCoinDenom           = sdkerrors.Register(ModuleName, 78, "invalid withdraw coin denom")
+	ErrBadAmplification               = sdkerrors.Register(ModuleName, 79, "invalid amplification")
+	ErrBadFeeGrowth                   = sdkerrors.Register(ModuleName, 80, "invalid fee growth")
 )
@@ -2,13 +2,31 @@ package types
 
 // Event types for the liquidity module.
 const (
-	EventTypeCreatePool          = TypeMsgCreatePool
-	EventTypeDepositWithinBatch  = TypeMsgDepositWithinBatch
-	EventTypeWithdrawWithinBatch = TypeMsgWithdrawWithinBatch
-	EventTypeSwapWithinBatch     = TypeMsgSwapWithinBatch
-	EventTypeDepositToPool       = "deposit_to_pool"
-	EventTypeWithdrawFromPool    = "withdraw_from_pool"
-	EventTypeSwapTransacted      = "swap_transacted"
+	EventTypeCreatePool            = TypeMsgCreatePool
+	EventTypeDepositWithinBatch    = TypeMsgDepositWithinBatch
+	EventTypeWithdrawWithinBatch   = TypeMsgWithdrawWithinBatch
+	EventTypeSwapWithinBatch       = TypeMsgSwapWithinBatch
+	EventTypeDepositToPool         = "deposit_to_pool"
+	EventTypeWithdrawFromPool      = "withdraw_from_pool"
+	EventTypeSwapTransacted        = "swap_transacted"
+	EventTypeDepositToRange        = TypeMsgDepositToRange
+	EventTypeWithdrawFromRange     = TypeMsgWithdrawFromRange
+	EventTypeCancelSwap            = TypeMsgCancelSwap
+	EventTypeSwapCanceled          = "swap_canceled"
+	EventTypeSwapRoute             = TypeMsgSwapRoute
+	EventTypeSwapRouteRefunded     = "swap_route_refunded"
+	EventTypeCreateRewardPlan      = TypeMsgCreateRewardPlan
+	EventTypeStake                 = TypeMsgStake
+	EventTypeUnstake               = TypeMsgUnstake
+	EventTypeClaimRewards          = TypeMsgClaimRewards
+	EventTypeRewardPlanFinished    = "reward_plan_finished"
+	EventTypeBond                  = TypeMsgBond
+	EventTypeBeginUnbond           = TypeMsgBeginUnbond
+	EventTypeUnbondCompleted       = "unbond_completed"
+	EventTypeProtocolFeeCollected  = "protocol_fee_collected"
+	EventTypeDynamicSwapFeeUpdated = "dynamic_swap_fee_updated"
+	EventTypeSetCircuitBreaker     = TypeMsgSetCircuitBreaker
+	EventTypeCircuitBreakerTripped = "circuit_breaker_tripped"
 
 	AttributeValuePoolId         = "pool_id"      //nolint:golint
 	AttributeValuePoolTypeId     = "pool_type_id" //nolint:golint
@@ -27,6 +45,10 @@ const (
 	AttributeValueExchangedCoinFeeAmount = "exchanged_coin_fee_amount"
 	AttributeValueDemandCoinDenom        = "demand_coin_denom"
 	AttributeValueOrderPrice             = "order_price"
+	AttributeValuePoolIds                = "pool_ids" //nolint:golint
+	AttributeValueMinDemandCoinAmount    = "min_demand_coin_amount"
+	AttributeValueDemandCoinAmount       = "demand_coin_amount"
+	AttributeValueRefundedCoinAmount     = "refunded_coin_amount"
 
 	AttributeValueDepositor        = "depositor"
 	AttributeValueRefundedCoins    = "refunded_coins"
@@ -46,8 +68,45 @@ const (
 	AttributeValueReservedOfferCoinFeeAmount = "reserved_offer_coin_fee_amount"
 	AttributeValueOrderExpiryHeight          = "order_expiry_height"
 
+	AttributeValuePositionId = "position_id" //nolint:golint
+	AttributeValueOwner      = "owner"
+	AttributeValueLowerPrice = "lower_price"
+	AttributeValueUpperPrice = "upper_price"
+	AttributeValueLiquidity  = "liquidity"
+
+	AttributeValuePlanId           = "plan_id" //nolint:golint
+	AttributeValueFunder           = "funder"
+	AttributeValueRewardCoins      = "reward_coins"
+	AttributeValueStartHeight      = "start_height"
+	AttributeValueEndHeight        = "end_height"
+	AttributeValueStaker           = "staker"
+	AttributeValueRewards          = "rewards"
+	AttributeValueDistributedCoins = "distributed_coins"
+
+	AttributeValueBondId     = "bond_id" //nolint:golint
+	AttributeValueDuration   = "duration"
+	AttributeValueUnbondTime = "unbond_time"
+
+	AttributeValueFeeType          = "fee_type"
+	AttributeValueProtocolFeeCoins = "protocol_fee_coins"
+
+	AttributeValueVolatility  = "volatility"
+	AttributeValueSwapFeeRate = "swap_fee_rate"
+
+	AttributeValueMsgType = "msg_type"
+	AttributeValueEnabled = "enabled"
+
+	AttributeValuePriceMove = "price_move"
+
 	AttributeValueCategory = ModuleName
 
 	Success = "success"
 	Failure = "failure"
+
+	AttributeValueFailureReason = "failure_reason"
+
+	FailureReasonExactOutputNotFillable = "exact_output_not_fillable"
+
+	FeeTypeSwap     = "swap"
+	FeeTypeWithdraw = "withdraw"
 )
@@ -31,3 +31,13 @@ type AccountKeeper interface {
 type DistributionKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// PoolStateKeeper defines the expected keeper of the pool state kept besides the reserves and the pool coin supply,
+// such as the pool price and the positions of the concentrated liquidity pool, used by the PoolCurve of a pool type
+type PoolStateKeeper interface {
+	GetPoolPrice(ctx sdk.Context, poolID uint64) sdk.Dec
+	SetPoolPrice(ctx sdk.Context, poolID uint64, price sdk.Dec)
+	GetPositionsByPool(ctx sdk.Context, poolID uint64) []Position
+	DepositToRange(ctx sdk.Context, msg *MsgDepositToRange) (Position, sdk.Coins, error)
+	AccrueFeeGrowth(ctx sdk.Context, pool Pool, poolPrice sdk.Dec)
+}
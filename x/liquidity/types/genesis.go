@@ -1,16 +1,22 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // NewGenesisState returns new GenesisState.
-func NewGenesisState(params Params, liquidityPoolRecords []PoolRecord) *GenesisState {
+func NewGenesisState(params Params, liquidityPoolRecords []PoolRecord, circuitBreakers []CircuitBreaker) *GenesisState {
 	return &GenesisState{
-		Params:      params,
-		PoolRecords: liquidityPoolRecords,
+		Params:          params,
+		PoolRecords:     liquidityPoolRecords,
+		CircuitBreakers: circuitBreakers,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []PoolRecord{})
+	return NewGenesisState(DefaultParams(), []PoolRecord{}, []CircuitBreaker{})
 }
 
 // ValidateGenesis validates GenesisState.
@@ -18,10 +24,29 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	poolIDs := make(map[uint64]bool)
 	for _, record := range data.PoolRecords {
 		if err := record.Validate(); err != nil {
 			return err
 		}
+		poolIDs[record.Pool.Id] = true
+	}
+	return ValidateGenesisCircuitBreakers(data.CircuitBreakers, poolIDs)
+}
+
+// ValidateGenesisCircuitBreakers validates that the circuit breakers of GenesisState are valid and enabled, and apply
+// to all pools or to one of the pools of the genesis.
+func ValidateGenesisCircuitBreakers(circuitBreakers []CircuitBreaker, poolIDs map[uint64]bool) error {
+	if err := ValidateCircuitBreakers(circuitBreakers); err != nil {
+		return err
+	}
+	for _, cb := range circuitBreakers {
+		if !cb.Enabled {
+			return sdkerrors.Wrapf(ErrBadCircuitBreaker, "disabled circuit breaker of pool %d", cb.PoolId)
+		}
+		if cb.PoolId != 0 && !poolIDs[cb.PoolId] {
+			return sdkerrors.Wrapf(ErrPoolNotExists, "circuit breaker of pool %d", cb.PoolId)
+		}
 	}
 	return nil
 }
@@ -40,5 +65,193 @@ func (record PoolRecord) Validate() error {
 		(len(record.SwapMsgStates) != 0 && record.PoolBatch.SwapMsgIndex != record.SwapMsgStates[len(record.SwapMsgStates)-1].MsgIndex+1) {
 		return ErrBadBatchMsgIndex
 	}
+	if err := record.ValidateBatchResults(); err != nil {
+		return err
+	}
+	if err := record.ValidatePriceAccumulators(); err != nil {
+		return err
+	}
+	if err := record.ValidatePositions(); err != nil {
+		return err
+	}
+	if err := record.ValidateRewards(); err != nil {
+		return err
+	}
+	if err := record.ValidateBonds(); err != nil {
+		return err
+	}
+	if err := record.ValidateCollectedProtocolFees(); err != nil {
+		return err
+	}
+	if err := record.ValidatePoolFeeRate(); err != nil {
+		return err
+	}
+	return record.ValidateDynamicSwapFee()
+}
+
+// ValidateBatchResults validates that the batch results of PoolRecord belong to the pool and are sorted by the batch index
+// of the executed batches.
+func (record PoolRecord) ValidateBatchResults() error {
+	for i, result := range record.BatchResults {
+		if result.PoolId != record.Pool.Id || result.BatchIndex > record.PoolBatch.Index ||
+			(i > 0 && result.BatchIndex <= record.BatchResults[i-1].BatchIndex) {
+			return ErrBadPoolBatchResult
+		}
+	}
+	return nil
+}
+
+// ValidatePriceAccumulators validates that the price accumulators of PoolRecord belong to the pool and are sorted by the
+// batch index and the time of the executed batches.
+func (record PoolRecord) ValidatePriceAccumulators() error {
+	for i, accumulator := range record.PriceAccumulators {
+		if accumulator.PoolId != record.Pool.Id || accumulator.BatchIndex > record.PoolBatch.Index {
+			return ErrBadPriceAccumulator
+		}
+		if i > 0 {
+			prev := record.PriceAccumulators[i-1]
+			if accumulator.BatchIndex <= prev.BatchIndex || accumulator.Time.Before(prev.Time) {
+				return ErrBadPriceAccumulator
+			}
+		}
+		for _, pair := range accumulator.PairAccumulators {
+			if pair.LastPrice.IsNil() || pair.LastPrice.IsNegative() || pair.CumulativePrice.IsNil() || pair.CumulativePrice.IsNegative() {
+				return ErrBadPriceAccumulator
+			}
+		}
+	}
+	return nil
+}
+
+// ValidatePositions validates the positions, the pool price, the fee growth and the price ticks of the concentrated
+// liquidity pool of PoolRecord.
+func (record PoolRecord) ValidatePositions() error {
+	if record.Pool.TypeId != ConcentratedPoolTypeID {
+		if len(record.Positions) > 0 || len(record.PriceTicks) > 0 {
+			return ErrBadPoolTypeID
+		}
+		return nil
+	}
+	if record.PoolPrice.IsNil() || record.PoolPrice.LT(MinPositionPrice) || record.PoolPrice.GT(MaxPositionPrice) {
+		return ErrBadPriceRange
+	}
+	if feeGrowthGlobal := record.FeeGrowthGlobal.orZero(); feeGrowthGlobal.X.IsNegative() || feeGrowthGlobal.Y.IsNegative() {
+		return ErrBadFeeGrowth
+	}
+	for _, tick := range record.PriceTicks {
+		if tick.PoolId != record.Pool.Id {
+			return ErrPoolNotExists
+		}
+		if err := tick.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, position := range record.Positions {
+		if position.PoolId != record.Pool.Id {
+			return ErrPoolNotExists
+		}
+		if err := position.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateRewards validates that the reward plans, the reward accumulator and the stakes of PoolRecord belong to the
+// pool, and that the stakes sum up to the total staked amount of the accumulator without exceeding its reward per share.
+func (record PoolRecord) ValidateRewards() error {
+	for _, plan := range record.RewardPlans {
+		if plan.PoolId != record.Pool.Id {
+			return ErrBadRewardPlan
+		}
+		if err := plan.Validate(); err != nil {
+			return err
+		}
+	}
+	accumulator := record.RewardAccumulator
+	if accumulator.PoolId == 0 {
+		if len(record.Stakes) > 0 {
+			return ErrBadRewardAccumulator
+		}
+		return nil
+	}
+	if accumulator.PoolId != record.Pool.Id {
+		return ErrBadRewardAccumulator
+	}
+	if err := accumulator.Validate(); err != nil {
+		return err
+	}
+	totalStaked := sdk.ZeroInt()
+	for _, stake := range record.Stakes {
+		if stake.PoolId != record.Pool.Id {
+			return ErrStakeNotExists
+		}
+		if err := stake.Validate(); err != nil {
+			return err
+		}
+		if _, hasNeg := accumulator.RewardPerShare.SafeSub(stake.RewardPerShare); hasNeg {
+			return ErrBadRewardAccumulator
+		}
+		totalStaked = totalStaked.Add(stake.Amount)
+	}
+	if !totalStaked.Equal(accumulator.TotalStaked) {
+		return ErrBadRewardAccumulator
+	}
 	return nil
 }
+
+// ValidateBonds validates that the bonds of PoolRecord lock the pool coin of the pool and are sorted by the bond id.
+func (record PoolRecord) ValidateBonds() error {
+	for i, bond := range record.Bonds {
+		if bond.PoolCoin.Denom != record.Pool.PoolCoinDenom {
+			return ErrBadPoolCoinDenom
+		}
+		if err := bond.Validate(); err != nil {
+			return err
+		}
+		if i > 0 && bond.Id <= record.Bonds[i-1].Id {
+			return ErrBondNotExists
+		}
+	}
+	return nil
+}
+
+// ValidateCollectedProtocolFees validates that the collected protocol fees of PoolRecord belong to the pool.
+func (record PoolRecord) ValidateCollectedProtocolFees() error {
+	fees := record.CollectedProtocolFees
+	if fees.PoolId == 0 {
+		return nil
+	}
+	if fees.PoolId != record.Pool.Id {
+		return ErrBadCollectedProtocolFees
+	}
+	if err := fees.SwapFeeCoins.Validate(); err != nil {
+		return err
+	}
+	return fees.WithdrawFeeCoins.Validate()
+}
+
+// ValidatePoolFeeRate validates that the fee rates of PoolRecord set by governance belong to the pool.
+func (record PoolRecord) ValidatePoolFeeRate() error {
+	feeRate := record.PoolFeeRate
+	if feeRate.PoolId == 0 {
+		return nil
+	}
+	if feeRate.PoolId != record.Pool.Id {
+		return ErrBadPoolFeeRate
+	}
+	return feeRate.Validate()
+}
+
+// ValidateDynamicSwapFee validates that the dynamic swap fee of PoolRecord belongs to the pool and was updated by an
+// executed batch of the pool.
+func (record PoolRecord) ValidateDynamicSwapFee() error {
+	fee := record.DynamicSwapFee
+	if fee.PoolId == 0 {
+		return nil
+	}
+	if fee.PoolId != record.Pool.Id || fee.BatchIndex > record.PoolBatch.Index {
+		return ErrBadDynamicSwapFee
+	}
+	return fee.Validate()
+}
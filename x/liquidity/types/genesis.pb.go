@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -31,6 +32,32 @@ type PoolRecord struct {
 	DepositMsgStates  []DepositMsgState  `protobuf:"bytes,4,rep,name=deposit_msg_states,json=depositMsgStates,proto3" json:"deposit_msg_states" yaml:"deposit_msg_states"`
 	WithdrawMsgStates []WithdrawMsgState `protobuf:"bytes,5,rep,name=withdraw_msg_states,json=withdrawMsgStates,proto3" json:"withdraw_msg_states" yaml:"withdraw_msg_states"`
 	SwapMsgStates     []SwapMsgState     `protobuf:"bytes,6,rep,name=swap_msg_states,json=swapMsgStates,proto3" json:"swap_msg_states" yaml:"swap_msg_states"`
+	// price ranges of the concentrated liquidity pool, empty for the other pool types
+	Positions []Position `protobuf:"bytes,7,rep,name=positions,proto3" json:"positions" yaml:"positions"`
+	// pool price of the concentrated liquidity pool, zero for the other pool types
+	PoolPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=pool_price,json=poolPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_price" yaml:"pool_price"`
+	// results of the latest executed batches of the pool in ascending order of the batch index
+	BatchResults []PoolBatchResult `protobuf:"bytes,9,rep,name=batch_results,json=batchResults,proto3" json:"batch_results" yaml:"batch_results"`
+	// price accumulators of the latest executed batches of the pool in ascending order of the batch index
+	PriceAccumulators []PriceAccumulator `protobuf:"bytes,10,rep,name=price_accumulators,json=priceAccumulators,proto3" json:"price_accumulators" yaml:"price_accumulators"`
+	// reward plans of the pool coin stakers of the pool which have not ended
+	RewardPlans []RewardPlan `protobuf:"bytes,11,rep,name=reward_plans,json=rewardPlans,proto3" json:"reward_plans" yaml:"reward_plans"`
+	// reward accumulator of the pool coin staked to the pool
+	RewardAccumulator RewardAccumulator `protobuf:"bytes,12,opt,name=reward_accumulator,json=rewardAccumulator,proto3" json:"reward_accumulator" yaml:"reward_accumulator"`
+	// pool coin stakes of the pool
+	Stakes []Stake `protobuf:"bytes,13,rep,name=stakes,proto3" json:"stakes" yaml:"stakes"`
+	// bonds of the pool coin of the pool
+	Bonds []Bond `protobuf:"bytes,14,rep,name=bonds,proto3" json:"bonds" yaml:"bonds"`
+	// protocol fees collected from the fees of the pool
+	CollectedProtocolFees CollectedProtocolFees `protobuf:"bytes,15,opt,name=collected_protocol_fees,json=collectedProtocolFees,proto3" json:"collected_protocol_fees" yaml:"collected_protocol_fees"`
+	// fee rates of the pool set by governance, with zero pool_id if the pool uses the fee rate params
+	PoolFeeRate PoolFeeRate `protobuf:"bytes,16,opt,name=pool_fee_rate,json=poolFeeRate,proto3" json:"pool_fee_rate" yaml:"pool_fee_rate"`
+	// swap fee rate of the pool raised by the price volatility, with zero pool_id if not updated yet
+	DynamicSwapFee DynamicSwapFee `protobuf:"bytes,17,opt,name=dynamic_swap_fee,json=dynamicSwapFee,proto3" json:"dynamic_swap_fee" yaml:"dynamic_swap_fee"`
+	// fee growth per unit of liquidity of the concentrated liquidity pool, zero for the other pool types
+	FeeGrowthGlobal FeeGrowth `protobuf:"bytes,18,opt,name=fee_growth_global,json=feeGrowthGlobal,proto3" json:"fee_growth_global" yaml:"fee_growth_global"`
+	// bounds of the price ranges of the positions of the concentrated liquidity pool, empty for the other pool types
+	PriceTicks []PriceTick `protobuf:"bytes,19,rep,name=price_ticks,json=priceTicks,proto3" json:"price_ticks" yaml:"price_ticks"`
 }
 
 func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
@@ -108,11 +135,97 @@ func (m *PoolRecord) GetSwapMsgStates() []SwapMsgState {
 	return nil
 }
 
+func (m *PoolRecord) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *PoolRecord) GetBatchResults() []PoolBatchResult {
+	if m != nil {
+		return m.BatchResults
+	}
+	return nil
+}
+
+func (m *PoolRecord) GetPriceAccumulators() []PriceAccumulator {
+	if m != nil {
+		return m.PriceAccumulators
+	}
+	return nil
+}
+
+func (m *PoolRecord) GetRewardPlans() []RewardPlan {
+	if m != nil {
+		return m.RewardPlans
+	}
+	return nil
+}
+
+func (m *PoolRecord) GetRewardAccumulator() RewardAccumulator {
+	if m != nil {
+		return m.RewardAccumulator
+	}
+	return RewardAccumulator{}
+}
+
+func (m *PoolRecord) GetStakes() []Stake {
+	if m != nil {
+		return m.Stakes
+	}
+	return nil
+}
+
+func (m *PoolRecord) GetBonds() []Bond {
+	if m != nil {
+		return m.Bonds
+	}
+	return nil
+}
+
+func (m *PoolRecord) GetCollectedProtocolFees() CollectedProtocolFees {
+	if m != nil {
+		return m.CollectedProtocolFees
+	}
+	return CollectedProtocolFees{}
+}
+
+func (m *PoolRecord) GetPoolFeeRate() PoolFeeRate {
+	if m != nil {
+		return m.PoolFeeRate
+	}
+	return PoolFeeRate{}
+}
+
+func (m *PoolRecord) GetDynamicSwapFee() DynamicSwapFee {
+	if m != nil {
+		return m.DynamicSwapFee
+	}
+	return DynamicSwapFee{}
+}
+
+func (m *PoolRecord) GetFeeGrowthGlobal() FeeGrowth {
+	if m != nil {
+		return m.FeeGrowthGlobal
+	}
+	return FeeGrowth{}
+}
+
+func (m *PoolRecord) GetPriceTicks() []PriceTick {
+	if m != nil {
+		return m.PriceTicks
+	}
+	return nil
+}
+
 // GenesisState defines the liquidity module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters for the liquidity module.
 	Params      Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	PoolRecords []PoolRecord `protobuf:"bytes,2,rep,name=pool_records,json=poolRecords,proto3" json:"pool_records" yaml:"pools"`
+	// circuit breakers enabled for the pools or all pools
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,3,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers" yaml:"circuit_breakers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -158,39 +271,69 @@ func init() {
 }
 
 var fileDescriptor_7dc104913a173687 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xed, 0x36, 0x8d, 0xe0, 0x92, 0x0a, 0x7a, 0x8d, 0x90, 0x1b, 0x55, 0x4e, 0x39, 0x21,
-	0x11, 0x55, 0x60, 0x2b, 0x65, 0xeb, 0x68, 0x21, 0x31, 0x54, 0x95, 0xd0, 0x75, 0x40, 0x62, 0x89,
-	0x2e, 0xf1, 0xc9, 0xb1, 0x14, 0xe7, 0x0e, 0xbf, 0x2b, 0x26, 0x0b, 0x03, 0x13, 0x23, 0x1f, 0xa1,
-	0x03, 0xdf, 0x83, 0xb5, 0x63, 0x47, 0xa6, 0x0a, 0x25, 0x0b, 0x33, 0x9f, 0x00, 0xf9, 0x7c, 0x38,
-	0xa6, 0x44, 0x31, 0x93, 0x4f, 0xe7, 0xff, 0xff, 0xff, 0x7b, 0x4f, 0xf7, 0x1e, 0x3a, 0x56, 0x7c,
-	0x16, 0xf2, 0x34, 0x89, 0x67, 0xca, 0x9f, 0xc6, 0xef, 0x2e, 0xe3, 0x30, 0x56, 0x73, 0xff, 0xfd,
-	0x60, 0xc4, 0x15, 0x1b, 0xf8, 0x11, 0x9f, 0x71, 0x88, 0xc1, 0x93, 0xa9, 0x50, 0x02, 0x1f, 0xae,
-	0xb4, 0x5e, 0xa9, 0xf5, 0x8c, 0xb6, 0xfb, 0x6c, 0x63, 0xd2, 0x4a, 0xaf, 0xb3, 0xba, 0x9d, 0x48,
-	0x44, 0x42, 0x1f, 0xfd, 0xfc, 0x54, 0xdc, 0x92, 0xaf, 0x3b, 0x08, 0xbd, 0x16, 0x62, 0x4a, 0xf9,
-	0x58, 0xa4, 0x21, 0x3e, 0x43, 0x0d, 0x29, 0xc4, 0xd4, 0xb1, 0x8f, 0xec, 0x7e, 0xeb, 0x84, 0x78,
-	0x9b, 0xf8, 0x5e, 0xee, 0x0b, 0xf6, 0xaf, 0x6f, 0x7b, 0xd6, 0xaf, 0xdb, 0x5e, 0x6b, 0xce, 0x92,
-	0xe9, 0x29, 0xc9, 0xdd, 0x84, 0xea, 0x10, 0x9c, 0xa0, 0xdd, 0xfc, 0x3b, 0x4c, 0xb8, 0x62, 0x21,
-	0x53, 0xcc, 0xd9, 0xd2, 0xa9, 0xc7, 0xf5, 0xa9, 0xe7, 0xc6, 0x11, 0x1c, 0x9a, 0xf4, 0xce, 0x2a,
-	0xbd, 0x8c, 0x23, 0xb4, 0x2d, 0x2b, 0x5a, 0xcc, 0x10, 0xd2, 0xff, 0x47, 0x4c, 0x8d, 0x27, 0xce,
-	0xb6, 0x66, 0x3d, 0xfd, 0x8f, 0x0e, 0x72, 0x79, 0x70, 0x60, 0x40, 0x7b, 0x15, 0x90, 0x0e, 0x22,
-	0xf4, 0xbe, 0xfc, 0xa3, 0xc2, 0x1f, 0x11, 0x0e, 0xb9, 0x14, 0x10, 0xab, 0x61, 0x02, 0xd1, 0x10,
-	0x14, 0x53, 0x1c, 0x9c, 0xc6, 0xd1, 0x76, 0xbf, 0x75, 0xf2, 0x7c, 0x33, 0xea, 0x65, 0xe1, 0x3b,
-	0x87, 0xe8, 0x22, 0x77, 0x05, 0x8f, 0x0d, 0xf0, 0xa0, 0x00, 0xfe, 0x1b, 0x4b, 0xe8, 0xc3, 0xf0,
-	0x6f, 0x0f, 0xe0, 0x4f, 0x36, 0xda, 0xcf, 0x62, 0x35, 0x09, 0x53, 0x96, 0x55, 0x2b, 0xd8, 0xd1,
-	0x15, 0x78, 0x9b, 0x2b, 0x78, 0x63, 0x8c, 0x65, 0x09, 0xc4, 0x94, 0xd0, 0x2d, 0x4a, 0x58, 0x13,
-	0x4c, 0xe8, 0x5e, 0x76, 0xc7, 0x05, 0x38, 0x45, 0x0f, 0x20, 0x63, 0xb2, 0xca, 0x6f, 0x6a, 0x7e,
-	0xcd, 0xc3, 0x5e, 0x64, 0x4c, 0x96, 0x6c, 0xd7, 0xb0, 0x1f, 0x15, 0xec, 0x3b, 0x81, 0x84, 0xee,
-	0x42, 0x45, 0x0d, 0xe4, 0x9b, 0x8d, 0xda, 0xaf, 0x8a, 0xd5, 0xd0, 0x37, 0x38, 0x40, 0x4d, 0xc9,
-	0x52, 0x96, 0x80, 0x19, 0xd5, 0x27, 0x35, 0x0f, 0xad, 0xb5, 0x41, 0x23, 0xa7, 0x52, 0xe3, 0xc4,
-	0x0c, 0xe9, 0x01, 0x1a, 0xa6, 0x7a, 0xf6, 0xc1, 0xd9, 0xd2, 0x5d, 0xf4, 0xeb, 0x47, 0xa6, 0x58,
-	0x96, 0xa0, 0x63, 0x7a, 0x68, 0xaf, 0x66, 0x06, 0x08, 0x6d, 0xc9, 0x52, 0x01, 0xa7, 0xf7, 0x3e,
-	0x5f, 0xf5, 0xac, 0x9f, 0x57, 0x3d, 0x2b, 0x38, 0xbb, 0x5e, 0xb8, 0xf6, 0xcd, 0xc2, 0xb5, 0x7f,
-	0x2c, 0x5c, 0xfb, 0xcb, 0xd2, 0xb5, 0x6e, 0x96, 0xae, 0xf5, 0x7d, 0xe9, 0x5a, 0x6f, 0x07, 0x51,
-	0xac, 0x26, 0x97, 0x23, 0x6f, 0x2c, 0x12, 0x7f, 0xed, 0x46, 0x7f, 0xa8, 0x9c, 0xd5, 0x5c, 0x72,
-	0x18, 0x35, 0xf5, 0xf2, 0xbe, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x7f, 0x3e, 0x7e, 0x4c,
-	0x04, 0x00, 0x00,
+	// 990 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xc1, 0x6e, 0xdb, 0x36,
+	0x18, 0xc7, 0xad, 0x38, 0xcd, 0x1a, 0xda, 0x69, 0x6c, 0x26, 0x5d, 0xd9, 0xac, 0xb0, 0x3d, 0x6e,
+	0xc8, 0xb2, 0xa2, 0xb5, 0x91, 0xf6, 0xd6, 0xdb, 0x94, 0x22, 0x39, 0x14, 0x1d, 0x02, 0x76, 0xc0,
+	0x80, 0x1d, 0x26, 0xd0, 0x12, 0xe3, 0x08, 0x96, 0x4c, 0x8d, 0x1f, 0x3d, 0x37, 0x97, 0x61, 0xdb,
+	0x69, 0xc7, 0x01, 0x7b, 0x81, 0xbe, 0xc6, 0xde, 0x20, 0xc7, 0x1e, 0x87, 0x1d, 0x82, 0x21, 0xb9,
+	0xec, 0xbc, 0x27, 0x18, 0x44, 0xd1, 0xb6, 0xa2, 0xa4, 0x96, 0x4f, 0x26, 0xec, 0xff, 0xff, 0xff,
+	0xfb, 0x64, 0x7d, 0xfc, 0x48, 0xf4, 0x58, 0x8b, 0x51, 0x20, 0x54, 0x1c, 0x8e, 0x74, 0x2f, 0x0a,
+	0x7f, 0x18, 0x87, 0x41, 0xa8, 0xcf, 0x7a, 0x3f, 0xee, 0xf7, 0x85, 0xe6, 0xfb, 0xbd, 0x81, 0x18,
+	0x09, 0x08, 0xa1, 0x9b, 0x28, 0xa9, 0x25, 0x7e, 0x34, 0xd7, 0x76, 0x67, 0xda, 0xae, 0xd5, 0xee,
+	0x3c, 0x59, 0x98, 0x34, 0xd7, 0x9b, 0xac, 0x9d, 0xed, 0x81, 0x1c, 0x48, 0xb3, 0xec, 0xa5, 0xab,
+	0xec, 0x5b, 0x7a, 0xde, 0x40, 0xe8, 0x58, 0xca, 0x88, 0x09, 0x5f, 0xaa, 0x00, 0xbf, 0x42, 0xab,
+	0x89, 0x94, 0x11, 0x71, 0x3a, 0xce, 0x5e, 0xed, 0x19, 0xed, 0x2e, 0xe2, 0x77, 0x53, 0x9f, 0xbb,
+	0x75, 0x7e, 0xd1, 0xae, 0xfc, 0x77, 0xd1, 0xae, 0x9d, 0xf1, 0x38, 0x7a, 0x41, 0x53, 0x37, 0x65,
+	0x26, 0x04, 0xc7, 0x68, 0x23, 0xfd, 0xf4, 0x62, 0xa1, 0x79, 0xc0, 0x35, 0x27, 0x2b, 0x26, 0xf5,
+	0x71, 0x79, 0xea, 0x6b, 0xeb, 0x70, 0x1f, 0xd9, 0xf4, 0xed, 0x79, 0xfa, 0x2c, 0x8e, 0xb2, 0x7a,
+	0x92, 0xd3, 0x62, 0x8e, 0x90, 0xf9, 0xbd, 0xcf, 0xb5, 0x7f, 0x4a, 0xaa, 0x86, 0xf5, 0xc5, 0x12,
+	0x4f, 0x90, 0xca, 0xdd, 0x87, 0x16, 0xd4, 0xcc, 0x81, 0x4c, 0x10, 0x65, 0xeb, 0xc9, 0x54, 0x85,
+	0x7f, 0x42, 0x38, 0x10, 0x89, 0x84, 0x50, 0x7b, 0x31, 0x0c, 0x3c, 0xd0, 0x5c, 0x0b, 0x20, 0xab,
+	0x9d, 0xea, 0x5e, 0xed, 0xd9, 0xd3, 0xc5, 0xa8, 0x97, 0x99, 0xef, 0x35, 0x0c, 0xde, 0xa4, 0x2e,
+	0xf7, 0x53, 0x0b, 0x7c, 0x98, 0x01, 0x6f, 0xc6, 0x52, 0xd6, 0x08, 0xae, 0x7b, 0x00, 0xff, 0xea,
+	0xa0, 0xad, 0x49, 0xa8, 0x4f, 0x03, 0xc5, 0x27, 0xf9, 0x0a, 0xee, 0x98, 0x0a, 0xba, 0x8b, 0x2b,
+	0xf8, 0xd6, 0x1a, 0x67, 0x25, 0x50, 0x5b, 0xc2, 0x4e, 0x56, 0xc2, 0x2d, 0xc1, 0x94, 0x35, 0x27,
+	0x05, 0x17, 0x60, 0x85, 0x36, 0x61, 0xc2, 0x93, 0x3c, 0x7f, 0xad, 0x53, 0x2d, 0x7f, 0xb1, 0x6f,
+	0x26, 0x3c, 0x99, 0xb1, 0x5b, 0x96, 0xfd, 0x71, 0xc6, 0x2e, 0x04, 0x52, 0xb6, 0x01, 0x39, 0x35,
+	0xe0, 0xef, 0xd1, 0xba, 0xf9, 0x2b, 0x42, 0x39, 0x02, 0xf2, 0x91, 0xa1, 0xed, 0x96, 0xbd, 0xda,
+	0x4c, 0xee, 0x12, 0x4b, 0x6a, 0x4c, 0xdf, 0xac, 0x8d, 0x31, 0x2f, 0xd6, 0xae, 0x71, 0xdf, 0xf6,
+	0x4e, 0xa2, 0x42, 0x5f, 0x90, 0xbb, 0x1d, 0x67, 0x6f, 0xdd, 0x3d, 0x48, 0x8d, 0x7f, 0x5f, 0xb4,
+	0x77, 0x07, 0xa1, 0x3e, 0x1d, 0xf7, 0xbb, 0xbe, 0x8c, 0x7b, 0xbe, 0x84, 0x58, 0x82, 0xfd, 0x78,
+	0x0a, 0xc1, 0xb0, 0xa7, 0xcf, 0x12, 0x01, 0xdd, 0x97, 0xc2, 0x2f, 0x34, 0x8f, 0x49, 0xb2, 0xcd,
+	0x73, 0x9c, 0xae, 0x71, 0x82, 0x36, 0x4c, 0x47, 0x79, 0x4a, 0xc0, 0x38, 0xd2, 0x40, 0xd6, 0x97,
+	0xe9, 0x9b, 0x59, 0x8b, 0x32, 0xe3, 0x2a, 0xee, 0x88, 0x6b, 0x89, 0x94, 0xd5, 0xfb, 0x73, 0x29,
+	0xe0, 0x9f, 0x1d, 0x84, 0x4d, 0x1d, 0x1e, 0xf7, 0xfd, 0x71, 0x3c, 0x8e, 0xb8, 0x96, 0x0a, 0x08,
+	0x5a, 0xa6, 0x5b, 0x4c, 0xcd, 0x5f, 0xcd, 0x6d, 0xc5, 0x86, 0xbd, 0x99, 0x4b, 0x59, 0x33, 0x29,
+	0x98, 0x00, 0x9f, 0xa2, 0xba, 0x12, 0x13, 0xae, 0x02, 0x2f, 0x89, 0xf8, 0x08, 0x48, 0xcd, 0xb0,
+	0xf7, 0x16, 0xb3, 0x99, 0x71, 0x1c, 0x47, 0x7c, 0xe4, 0x7e, 0x62, 0xa9, 0x5b, 0x19, 0x35, 0x9f,
+	0x45, 0x59, 0x4d, 0xcd, 0x84, 0x80, 0x7f, 0x71, 0x10, 0xb6, 0x3f, 0xe7, 0xaa, 0x22, 0x75, 0x33,
+	0x07, 0x7a, 0xcb, 0x00, 0x17, 0x3c, 0xed, 0xcd, 0x60, 0xca, 0x9a, 0xaa, 0xe8, 0xc2, 0x0c, 0xad,
+	0x81, 0xe6, 0x43, 0x01, 0x64, 0xc3, 0x3c, 0xe7, 0x67, 0x25, 0x3b, 0x22, 0xd5, 0xba, 0xf7, 0x2d,
+	0x6a, 0xc3, 0x6e, 0x05, 0x13, 0x40, 0x99, 0x4d, 0xc2, 0x5f, 0xa3, 0x3b, 0x7d, 0x39, 0x0a, 0x80,
+	0xdc, 0xeb, 0x54, 0xcb, 0x67, 0xb2, 0x2b, 0x47, 0x81, 0xbb, 0x6d, 0x13, 0xeb, 0xb6, 0x47, 0x52,
+	0x3b, 0x65, 0x59, 0x0c, 0xfe, 0xc3, 0x41, 0x0f, 0x7c, 0x19, 0x45, 0xc2, 0xd7, 0x22, 0xf0, 0xcc,
+	0x29, 0xe0, 0xcb, 0xc8, 0x3b, 0x11, 0x02, 0xc8, 0xa6, 0xf9, 0xb3, 0x9e, 0x2f, 0x46, 0x1c, 0x4c,
+	0xcd, 0xc7, 0xd6, 0x7b, 0x28, 0x04, 0xb8, 0xbb, 0x96, 0xd9, 0xca, 0x98, 0x1f, 0x20, 0x50, 0x76,
+	0xdf, 0xbf, 0xcd, 0x8e, 0x87, 0xf6, 0xac, 0x38, 0x11, 0xc2, 0x53, 0x5c, 0x0b, 0xd2, 0x30, 0xa5,
+	0x7c, 0x59, 0xbe, 0x39, 0x0e, 0x85, 0x60, 0xe9, 0x44, 0xb9, 0xed, 0xa8, 0x98, 0xa6, 0x51, 0x56,
+	0x4b, 0xe6, 0x52, 0x3c, 0x41, 0x8d, 0xe0, 0x6c, 0xc4, 0xe3, 0xd0, 0xf7, 0xcc, 0xe0, 0x39, 0x11,
+	0x82, 0x34, 0x0d, 0xef, 0x49, 0xc9, 0x10, 0xcf, 0x5c, 0xe9, 0x24, 0x3b, 0x14, 0xc2, 0x6d, 0x5b,
+	0xe4, 0x03, 0x3b, 0xc3, 0x0b, 0x99, 0x94, 0xdd, 0x0b, 0xae, 0x19, 0xf0, 0x18, 0x35, 0xd3, 0x92,
+	0x06, 0x4a, 0x4e, 0xf4, 0xa9, 0x37, 0x88, 0x64, 0x9f, 0x47, 0x04, 0x2f, 0x73, 0x52, 0x1d, 0x0a,
+	0x71, 0x64, 0x5c, 0x6e, 0xc7, 0x42, 0x49, 0x06, 0xbd, 0x91, 0x47, 0xd9, 0xe6, 0xc9, 0x54, 0x7c,
+	0x64, 0xbe, 0xc1, 0x01, 0xaa, 0x65, 0xdb, 0x55, 0x87, 0xfe, 0x10, 0xc8, 0x56, 0xa7, 0x5a, 0x0e,
+	0x34, 0xfb, 0xff, 0x9b, 0xd0, 0x1f, 0xba, 0x3b, 0x16, 0x88, 0xf3, 0x1b, 0xdf, 0x24, 0x51, 0x86,
+	0x92, 0xa9, 0x0c, 0xe8, 0x9f, 0x2b, 0xa8, 0x7e, 0x94, 0x5d, 0x5f, 0xcc, 0xd4, 0xc6, 0x2e, 0x5a,
+	0x4b, 0xb8, 0xe2, 0x31, 0xd8, 0xeb, 0xc4, 0xe7, 0x25, 0x44, 0xa3, 0x75, 0x57, 0x53, 0x1c, 0xb3,
+	0x4e, 0xcc, 0x91, 0x39, 0xe4, 0x3d, 0x65, 0xee, 0x27, 0x40, 0x56, 0x96, 0x99, 0x1f, 0xf3, 0x0b,
+	0x4d, 0x71, 0x2b, 0xa4, 0x59, 0x60, 0xbb, 0x21, 0x53, 0x00, 0x7e, 0x8b, 0x1a, 0x7e, 0xa8, 0xfc,
+	0x71, 0xa8, 0xbd, 0xbe, 0x12, 0x7c, 0x28, 0x14, 0x90, 0x6a, 0xa7, 0x5a, 0xde, 0x0d, 0x07, 0x99,
+	0xcb, 0xcd, 0x4c, 0xc5, 0x6e, 0x28, 0x66, 0x52, 0xb6, 0xe9, 0x5f, 0x33, 0xc0, 0x8b, 0xbb, 0xbf,
+	0xbd, 0x6b, 0x57, 0xfe, 0x7d, 0xd7, 0xae, 0xb8, 0xaf, 0xce, 0x2f, 0x5b, 0xce, 0xfb, 0xcb, 0x96,
+	0xf3, 0xcf, 0x65, 0xcb, 0xf9, 0xfd, 0xaa, 0x55, 0x79, 0x7f, 0xd5, 0xaa, 0xfc, 0x75, 0xd5, 0xaa,
+	0x7c, 0xb7, 0x9f, 0x3b, 0x7d, 0x6e, 0xbd, 0xef, 0xbd, 0xcd, 0xad, 0xcd, 0x61, 0xd4, 0x5f, 0x33,
+	0x5b, 0xee, 0xf9, 0xff, 0x03, 0x00, 0xc2, 0xfb, 0xdb, 0x27, 0x6a, 0x0a, 0x00, 0x00,
 }
 
 func (m *PoolRecord) Marshal() (dAtA []byte, err error) {
@@ -213,6 +356,172 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceTicks) > 0 {
+		for iNdEx := len(m.PriceTicks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceTicks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	{
+		size, err := m.FeeGrowthGlobal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size, err := m.DynamicSwapFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size, err := m.PoolFeeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.CollectedProtocolFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.Bonds) > 0 {
+		for iNdEx := len(m.Bonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size, err := m.RewardAccumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.RewardPlans) > 0 {
+		for iNdEx := len(m.RewardPlans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PriceAccumulators) > 0 {
+		for iNdEx := len(m.PriceAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BatchResults) > 0 {
+		for iNdEx := len(m.BatchResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.PoolPrice.Size()
+		i -= size
+		if _, err := m.PoolPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.SwapMsgStates) > 0 {
 		for iNdEx := len(m.SwapMsgStates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -308,6 +617,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PoolRecords) > 0 {
 		for iNdEx := len(m.PoolRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -376,6 +699,60 @@ func (m *PoolRecord) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PoolPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BatchResults) > 0 {
+		for _, e := range m.BatchResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceAccumulators) > 0 {
+		for _, e := range m.PriceAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardPlans) > 0 {
+		for _, e := range m.RewardPlans {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RewardAccumulator.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Bonds) > 0 {
+		for _, e := range m.Bonds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CollectedProtocolFees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PoolFeeRate.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.DynamicSwapFee.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.FeeGrowthGlobal.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.PriceTicks) > 0 {
+		for _, e := range m.PriceTicks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -393,6 +770,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -632,25 +1015,462 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchResults = append(m.BatchResults, PoolBatchResult{})
+			if err := m.BatchResults[len(m.BatchResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceAccumulators = append(m.PriceAccumulators, PriceAccumulator{})
+			if err := m.PriceAccumulators[len(m.PriceAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPlans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPlans = append(m.RewardPlans, RewardPlan{})
+			if err := m.RewardPlans[len(m.RewardPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakes = append(m.Stakes, Stake{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bonds = append(m.Bonds, Bond{})
+			if err := m.Bonds[len(m.Bonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectedProtocolFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolFeeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTicks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceTicks = append(m.PriceTicks, PriceTick{})
+			if err := m.PriceTicks[len(m.PriceTicks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
@@ -749,6 +1569,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
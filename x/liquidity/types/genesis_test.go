@@ -2,9 +2,11 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/tendermint/liquidity/x/liquidity/types"
 )
@@ -31,6 +33,30 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			"bad msg index of the batch",
 		},
+		{
+			"DisabledCircuitBreaker",
+			func(genState *types.GenesisState) {
+				genState.CircuitBreakers = []types.CircuitBreaker{types.NewCircuitBreaker(0, "", false)}
+			},
+			"disabled circuit breaker of pool 0: invalid circuit breaker",
+		},
+		{
+			"DuplicateCircuitBreaker",
+			func(genState *types.GenesisState) {
+				genState.CircuitBreakers = []types.CircuitBreaker{
+					types.NewCircuitBreaker(0, types.TypeMsgBond, true),
+					types.NewCircuitBreaker(0, types.TypeMsgBond, true),
+				}
+			},
+			"duplicate circuit breaker of pool 0 and message type \"bond\": invalid circuit breaker",
+		},
+		{
+			"CircuitBreakerOfMissingPool",
+			func(genState *types.GenesisState) {
+				genState.CircuitBreakers = []types.CircuitBreaker{types.NewCircuitBreaker(1, types.TypeMsgBond, true)}
+			},
+			"circuit breaker of pool 1: pool not exists",
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -40,6 +66,13 @@ func TestValidateGenesis(t *testing.T) {
 			require.EqualError(t, err, tc.errString)
 		})
 	}
+
+	genState := types.DefaultGenesisState()
+	genState.CircuitBreakers = []types.CircuitBreaker{
+		types.NewCircuitBreaker(0, types.TypeMsgCreatePool, true),
+		types.NewCircuitBreaker(0, "", true),
+	}
+	require.NoError(t, types.ValidateGenesis(*genState))
 }
 
 func TestPoolRecord_Validate(t *testing.T) {
@@ -150,3 +183,354 @@ func TestPoolRecord_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPoolRecord_ValidateBatchResults(t *testing.T) {
+	testCases := []struct {
+		name         string
+		batchResults []types.PoolBatchResult
+		shouldFail   bool
+	}{
+		{"NoBatchResults", nil, false},
+		{"ValidBatchResults", []types.PoolBatchResult{{PoolId: 1, BatchIndex: 3}, {PoolId: 1, BatchIndex: 5}}, false},
+		{"MismatchingPoolId", []types.PoolBatchResult{{PoolId: 2, BatchIndex: 3}}, true},
+		{"BatchIndexAfterPoolBatch", []types.PoolBatchResult{{PoolId: 1, BatchIndex: 6}}, true},
+		{"UnsortedBatchResults", []types.PoolBatchResult{{PoolId: 1, BatchIndex: 5}, {PoolId: 1, BatchIndex: 3}}, true},
+		{"DuplicateBatchResults", []types.PoolBatchResult{{PoolId: 1, BatchIndex: 3}, {PoolId: 1, BatchIndex: 3}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			poolRecord := types.PoolRecord{
+				Pool: types.Pool{Id: 1},
+				PoolBatch: types.PoolBatch{
+					PoolId:           1,
+					Index:            5,
+					DepositMsgIndex:  1,
+					WithdrawMsgIndex: 1,
+					SwapMsgIndex:     1,
+				},
+				BatchResults: tc.batchResults,
+			}
+			err := poolRecord.Validate()
+			if tc.shouldFail {
+				require.ErrorIs(t, err, types.ErrBadPoolBatchResult)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPoolRecord_ValidatePriceAccumulators(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	accumulator := func(batchIndex uint64, t time.Time, lastPrice, cumulativePrice sdk.Dec) types.PriceAccumulator {
+		return types.PriceAccumulator{
+			PoolId:     1,
+			BatchIndex: batchIndex,
+			Time:       t,
+			PairAccumulators: []types.PairPriceAccumulator{
+				{DenomX: "denomX", DenomY: "denomY", LastPrice: lastPrice, CumulativePrice: cumulativePrice},
+			},
+		}
+	}
+	testCases := []struct {
+		name         string
+		accumulators []types.PriceAccumulator
+		shouldFail   bool
+	}{
+		{"NoAccumulators", nil, false},
+		{"ValidAccumulators", []types.PriceAccumulator{
+			accumulator(3, startTime, sdk.OneDec(), sdk.ZeroDec()),
+			accumulator(5, startTime.Add(time.Second), sdk.OneDec(), sdk.OneDec()),
+		}, false},
+		{"MismatchingPoolId", []types.PriceAccumulator{{PoolId: 2, BatchIndex: 3}}, true},
+		{"BatchIndexAfterPoolBatch", []types.PriceAccumulator{accumulator(6, startTime, sdk.OneDec(), sdk.ZeroDec())}, true},
+		{"UnsortedBatchIndexes", []types.PriceAccumulator{
+			accumulator(5, startTime, sdk.OneDec(), sdk.ZeroDec()),
+			accumulator(3, startTime.Add(time.Second), sdk.OneDec(), sdk.OneDec()),
+		}, true},
+		{"UnsortedTimes", []types.PriceAccumulator{
+			accumulator(3, startTime.Add(time.Second), sdk.OneDec(), sdk.ZeroDec()),
+			accumulator(5, startTime, sdk.OneDec(), sdk.OneDec()),
+		}, true},
+		{"NilPrice", []types.PriceAccumulator{accumulator(3, startTime, sdk.Dec{}, sdk.ZeroDec())}, true},
+		{"NegativeCumulativePrice", []types.PriceAccumulator{accumulator(3, startTime, sdk.OneDec(), sdk.NewDec(-1))}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			poolRecord := types.PoolRecord{
+				Pool: types.Pool{Id: 1},
+				PoolBatch: types.PoolBatch{
+					PoolId:           1,
+					Index:            5,
+					DepositMsgIndex:  1,
+					WithdrawMsgIndex: 1,
+					SwapMsgIndex:     1,
+				},
+				PriceAccumulators: tc.accumulators,
+			}
+			err := poolRecord.Validate()
+			if tc.shouldFail {
+				require.ErrorIs(t, err, types.ErrBadPriceAccumulator)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPoolRecord_ValidateRewards(t *testing.T) {
+	funder := sdk.AccAddress(crypto.AddressHash([]byte("funder"))).String()
+	staker1 := sdk.AccAddress(crypto.AddressHash([]byte("staker1"))).String()
+	staker2 := sdk.AccAddress(crypto.AddressHash([]byte("staker2"))).String()
+	rewardPerShare := sdk.NewDecCoins(sdk.NewInt64DecCoin("denomX", 2))
+	plan := types.RewardPlan{
+		Id:               1,
+		PoolId:           1,
+		FunderAddress:    funder,
+		RewardCoins:      sdk.NewCoins(sdk.NewInt64Coin("denomX", 1000)),
+		DistributedCoins: sdk.NewCoins(sdk.NewInt64Coin("denomX", 300)),
+		StartHeight:      10,
+		EndHeight:        20,
+	}
+	accumulator := types.RewardAccumulator{PoolId: 1, TotalStaked: sdk.NewInt(300), RewardPerShare: rewardPerShare}
+	stakes := []types.Stake{
+		{PoolId: 1, StakerAddress: staker1, Amount: sdk.NewInt(100), RewardPerShare: rewardPerShare},
+		{PoolId: 1, StakerAddress: staker2, Amount: sdk.NewInt(200)},
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(record *types.PoolRecord)
+		expectedErr error
+	}{
+		{"Valid", func(record *types.PoolRecord) {}, nil},
+		{"NoRewards", func(record *types.PoolRecord) {
+			record.RewardPlans, record.RewardAccumulator, record.Stakes = nil, types.RewardAccumulator{}, nil
+		}, nil},
+		{"MismatchingPlanPoolId", func(record *types.PoolRecord) { record.RewardPlans[0].PoolId = 2 }, types.ErrBadRewardPlan},
+		{"PlanEndBeforeStart", func(record *types.PoolRecord) { record.RewardPlans[0].EndHeight = 10 }, types.ErrBadRewardPlan},
+		{"PlanOverDistributed", func(record *types.PoolRecord) {
+			record.RewardPlans[0].DistributedCoins = sdk.NewCoins(sdk.NewInt64Coin("denomX", 1001))
+		}, types.ErrBadRewardPlan},
+		{"StakesWithoutAccumulator", func(record *types.PoolRecord) {
+			record.RewardAccumulator = types.RewardAccumulator{}
+		}, types.ErrBadRewardAccumulator},
+		{"MismatchingAccumulatorPoolId", func(record *types.PoolRecord) {
+			record.RewardAccumulator.PoolId = 2
+		}, types.ErrBadRewardAccumulator},
+		{"MismatchingTotalStaked", func(record *types.PoolRecord) {
+			record.RewardAccumulator.TotalStaked = sdk.NewInt(301)
+		}, types.ErrBadRewardAccumulator},
+		{"StakeRewardPerShareExceeded", func(record *types.PoolRecord) {
+			record.Stakes[0].RewardPerShare = sdk.NewDecCoins(sdk.NewInt64DecCoin("denomX", 3))
+		}, types.ErrBadRewardAccumulator},
+		{"MismatchingStakePoolId", func(record *types.PoolRecord) { record.Stakes[0].PoolId = 2 }, types.ErrStakeNotExists},
+		{"InvalidStakerAddress", func(record *types.PoolRecord) { record.Stakes[0].StakerAddress = "" }, types.ErrInvalidStakerAddr},
+		{"ZeroStake", func(record *types.PoolRecord) { record.Stakes[0].Amount = sdk.ZeroInt() }, types.ErrInsufficientStake},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			poolRecord := types.PoolRecord{
+				Pool: types.Pool{Id: 1},
+				PoolBatch: types.PoolBatch{
+					PoolId:           1,
+					Index:            1,
+					DepositMsgIndex:  1,
+					WithdrawMsgIndex: 1,
+					SwapMsgIndex:     1,
+				},
+				RewardPlans:       []types.RewardPlan{plan},
+				RewardAccumulator: accumulator,
+				Stakes:            append([]types.Stake{}, stakes...),
+			}
+			tc.malleate(&poolRecord)
+			err := poolRecord.Validate()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPoolRecord_ValidateBonds(t *testing.T) {
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("owner"))).String()
+	bonds := []types.Bond{
+		{Id: 1, OwnerAddress: owner, PoolCoin: sdk.NewInt64Coin("pool1", 100), Duration: 24 * time.Hour},
+		{
+			Id: 3, OwnerAddress: owner, PoolCoin: sdk.NewInt64Coin("pool1", 200), Duration: 7 * 24 * time.Hour,
+			UnbondTime: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(record *types.PoolRecord)
+		expectedErr error
+	}{
+		{"Valid", func(record *types.PoolRecord) {}, nil},
+		{"NoBonds", func(record *types.PoolRecord) { record.Bonds = nil }, nil},
+		{"MismatchingPoolCoinDenom", func(record *types.PoolRecord) { record.Bonds[0].PoolCoin.Denom = "pool2" }, types.ErrBadPoolCoinDenom},
+		{"ZeroBondId", func(record *types.PoolRecord) { record.Bonds[0].Id = 0 }, types.ErrBondNotExists},
+		{"UnsortedBonds", func(record *types.PoolRecord) { record.Bonds[0].Id = 3 }, types.ErrBondNotExists},
+		{"InvalidOwnerAddress", func(record *types.PoolRecord) { record.Bonds[0].OwnerAddress = "" }, types.ErrInvalidBondOwnerAddr},
+		{"ZeroPoolCoin", func(record *types.PoolRecord) { record.Bonds[0].PoolCoin.Amount = sdk.ZeroInt() }, types.ErrBadPoolCoinAmount},
+		{"ZeroDuration", func(record *types.PoolRecord) { record.Bonds[0].Duration = 0 }, types.ErrBadBondDuration},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			poolRecord := types.PoolRecord{
+				Pool: types.Pool{Id: 1, PoolCoinDenom: "pool1"},
+				PoolBatch: types.PoolBatch{
+					PoolId:           1,
+					Index:            1,
+					DepositMsgIndex:  1,
+					WithdrawMsgIndex: 1,
+					SwapMsgIndex:     1,
+				},
+				Bonds: append([]types.Bond{}, bonds...),
+			}
+			tc.malleate(&poolRecord)
+			err := poolRecord.Validate()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPoolRecord_ValidateCollectedProtocolFees(t *testing.T) {
+	testCases := []struct {
+		name        string
+		malleate    func(record *types.PoolRecord)
+		expectedErr string
+	}{
+		{"Valid", func(record *types.PoolRecord) {}, ""},
+		{"NotCollected", func(record *types.PoolRecord) { record.CollectedProtocolFees = types.CollectedProtocolFees{} }, ""},
+		{"MismatchingPoolId", func(record *types.PoolRecord) { record.CollectedProtocolFees.PoolId = 2 }, "invalid collected protocol fees"},
+		{"InvalidSwapFeeCoins", func(record *types.PoolRecord) {
+			record.CollectedProtocolFees.SwapFeeCoins = sdk.Coins{sdk.NewInt64Coin("denomY", 30), sdk.NewInt64Coin("denomX", 30)}
+		}, "denomination denomX is not sorted"},
+		{"InvalidWithdrawFeeCoins", func(record *types.PoolRecord) {
+			record.CollectedProtocolFees.WithdrawFeeCoins = sdk.Coins{sdk.Coin{Denom: "denomX", Amount: sdk.ZeroInt()}}
+		}, "coin 0denomX amount is not positive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			poolRecord := types.PoolRecord{
+				Pool: types.Pool{Id: 1, PoolCoinDenom: "pool1"},
+				PoolBatch: types.PoolBatch{
+					PoolId:           1,
+					Index:            1,
+					DepositMsgIndex:  1,
+					WithdrawMsgIndex: 1,
+					SwapMsgIndex:     1,
+				},
+				CollectedProtocolFees: types.CollectedProtocolFees{
+					PoolId:           1,
+					SwapFeeCoins:     sdk.NewCoins(sdk.NewInt64Coin("denomX", 30), sdk.NewInt64Coin("denomY", 20)),
+					WithdrawFeeCoins: sdk.NewCoins(sdk.NewInt64Coin("denomX", 10)),
+				},
+			}
+			tc.malleate(&poolRecord)
+			err := poolRecord.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPoolRecord_ValidatePoolFeeRate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		malleate    func(record *types.PoolRecord)
+		expectedErr string
+	}{
+		{"Valid", func(record *types.PoolRecord) {}, ""},
+		{"NotSet", func(record *types.PoolRecord) { record.PoolFeeRate = types.PoolFeeRate{} }, ""},
+		{"MismatchingPoolId", func(record *types.PoolRecord) { record.PoolFeeRate.PoolId = 2 }, "invalid pool fee rate"},
+		{"NilSwapFeeRate", func(record *types.PoolRecord) { record.PoolFeeRate.SwapFeeRate = sdk.Dec{} }, "invalid pool fee rate"},
+		{"TooLargeWithdrawFeeRate", func(record *types.PoolRecord) {
+			record.PoolFeeRate.WithdrawFeeRate = sdk.NewDec(2)
+		}, "invalid pool fee rate"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			poolRecord := types.PoolRecord{
+				Pool: types.Pool{Id: 1, PoolCoinDenom: "pool1"},
+				PoolBatch: types.PoolBatch{
+					PoolId:           1,
+					Index:            1,
+					DepositMsgIndex:  1,
+					WithdrawMsgIndex: 1,
+					SwapMsgIndex:     1,
+				},
+				PoolFeeRate: types.NewPoolFeeRate(1, sdk.NewDecWithPrec(5, 4), sdk.ZeroDec()),
+			}
+			tc.malleate(&poolRecord)
+			err := poolRecord.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPoolRecord_ValidateDynamicSwapFee(t *testing.T) {
+	testCases := []struct {
+		name        string
+		malleate    func(record *types.PoolRecord)
+		expectedErr string
+	}{
+		{"Valid", func(record *types.PoolRecord) {}, ""},
+		{"NotSet", func(record *types.PoolRecord) { record.DynamicSwapFee = types.DynamicSwapFee{} }, ""},
+		{"MismatchingPoolId", func(record *types.PoolRecord) { record.DynamicSwapFee.PoolId = 2 }, "invalid dynamic swap fee"},
+		{"FutureBatchIndex", func(record *types.PoolRecord) { record.DynamicSwapFee.BatchIndex = 3 }, "invalid dynamic swap fee"},
+		{"NilVolatility", func(record *types.PoolRecord) { record.DynamicSwapFee.Volatility = sdk.Dec{} }, "invalid dynamic swap fee"},
+		{"NegativeSwapFeeRate", func(record *types.PoolRecord) {
+			record.DynamicSwapFee.SwapFeeRate = sdk.NewDec(-1)
+		}, "invalid dynamic swap fee"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			poolRecord := types.PoolRecord{
+				Pool: types.Pool{Id: 1, PoolCoinDenom: "pool1"},
+				PoolBatch: types.PoolBatch{
+					PoolId:           1,
+					Index:            2,
+					DepositMsgIndex:  1,
+					WithdrawMsgIndex: 1,
+					SwapMsgIndex:     1,
+				},
+				DynamicSwapFee: types.DynamicSwapFee{
+					PoolId:      1,
+					BatchIndex:  1,
+					Volatility:  sdk.NewDecWithPrec(5, 2),
+					SwapFeeRate: sdk.NewDecWithPrec(45, 4),
+				},
+			}
+			tc.malleate(&poolRecord)
+			err := poolRecord.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
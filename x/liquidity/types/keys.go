@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -28,12 +31,54 @@ var (
 
 	PoolKeyPrefix                  = []byte{0x11}
 	PoolByReserveAccIndexKeyPrefix = []byte{0x12}
+	PoolByDenomIndexKeyPrefix      = []byte{0x13}
+	PoolByDenomPairIndexKeyPrefix  = []byte{0x14}
 
 	PoolBatchKeyPrefix = []byte{0x22}
 
 	PoolBatchDepositMsgStateIndexKeyPrefix  = []byte{0x31}
 	PoolBatchWithdrawMsgStateIndexKeyPrefix = []byte{0x32}
 	PoolBatchSwapMsgStateIndexKeyPrefix     = []byte{0x33}
+
+	DepositMsgStateByDepositorIndexKeyPrefix   = []byte{0x34}
+	WithdrawMsgStateByWithdrawerIndexKeyPrefix = []byte{0x35}
+	SwapMsgStateByRequesterIndexKeyPrefix      = []byte{0x36}
+
+	// param key for global position IDs of the concentrated liquidity pools
+	GlobalPositionIDKey = []byte("globalPositionId")
+
+	PositionKeyPrefix            = []byte{0x41}
+	PositionByPoolIndexKeyPrefix = []byte{0x42}
+	PoolPriceKeyPrefix           = []byte{0x43}
+	PriceTickKeyPrefix           = []byte{0x44}
+	FeeGrowthGlobalKeyPrefix     = []byte{0x45}
+
+	PoolBatchResultKeyPrefix  = []byte{0x51}
+	PriceAccumulatorKeyPrefix = []byte{0x52}
+
+	// param key for global reward plan IDs
+	GlobalRewardPlanIDKey = []byte("globalRewardPlanId")
+
+	RewardPlanKeyPrefix            = []byte{0x61}
+	RewardPlanByPoolIndexKeyPrefix = []byte{0x62}
+	RewardAccumulatorKeyPrefix     = []byte{0x63}
+	StakeKeyPrefix                 = []byte{0x64}
+	StakeByStakerIndexKeyPrefix    = []byte{0x65}
+
+	// param key for global bond IDs
+	GlobalBondIDKey = []byte("globalBondId")
+
+	BondKeyPrefix             = []byte{0x71}
+	BondByOwnerIndexKeyPrefix = []byte{0x72}
+	UnbondingQueueKeyPrefix   = []byte{0x73}
+
+	CollectedProtocolFeesKeyPrefix = []byte{0x81}
+
+	PoolFeeRateKeyPrefix = []byte{0x91}
+
+	DynamicSwapFeeKeyPrefix = []byte{0xa1}
+
+	CircuitBreakerKeyPrefix = []byte{0xb1}
 )
 
 // GetPoolKey returns kv indexing key of the pool
@@ -49,6 +94,41 @@ func GetPoolByReserveAccIndexKey(reserveAcc sdk.AccAddress) []byte {
 	return append(PoolByReserveAccIndexKeyPrefix, address.MustLengthPrefix(reserveAcc.Bytes())...)
 }
 
+// GetPoolsByDenomPrefix returns prefix of the pools having the reserve coin denom for iteration
+func GetPoolsByDenomPrefix(denom string) []byte {
+	return append(PoolByDenomIndexKeyPrefix, lengthPrefixDenom(denom)...)
+}
+
+// GetPoolByDenomIndexKey returns kv indexing key of the pool indexed by reserve coin denom
+func GetPoolByDenomIndexKey(denom string, poolID uint64) []byte {
+	return append(GetPoolsByDenomPrefix(denom), sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// GetPoolsByDenomPairPrefix returns prefix of the pools having the pair of reserve coin denoms for iteration,
+// the denoms are sorted alphabetically
+func GetPoolsByDenomPairPrefix(denomA, denomB string) []byte {
+	denomA, denomB = AlphabeticalDenomPair(denomA, denomB)
+	return append(append(PoolByDenomPairIndexKeyPrefix, lengthPrefixDenom(denomA)...), lengthPrefixDenom(denomB)...)
+}
+
+// GetPoolByDenomPairIndexKey returns kv indexing key of the pool indexed by pair of reserve coin denoms
+func GetPoolByDenomPairIndexKey(denomA, denomB string, poolID uint64) []byte {
+	return append(GetPoolsByDenomPairPrefix(denomA, denomB), sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// ParsePoolIDFromDenomIndexKey returns the pool id from the key of the index by denom or pair of denoms,
+// with or without the prefix of the denoms
+func ParsePoolIDFromDenomIndexKey(key []byte) uint64 {
+	if len(key) < 8 {
+		panic(fmt.Sprintf("invalid pool index key length %d", len(key)))
+	}
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+func lengthPrefixDenom(denom string) []byte {
+	return append([]byte{byte(len(denom))}, denom...)
+}
+
 // GetPoolBatchKey returns kv indexing key of the pool batch indexed by pool id
 func GetPoolBatchKey(poolID uint64) []byte {
 	key := make([]byte, 9)
@@ -107,3 +187,277 @@ func GetPoolBatchSwapMsgStateIndexKey(poolID, msgIndex uint64) []byte {
 	copy(key[9:17], sdk.Uint64ToBigEndian(msgIndex))
 	return key
 }
+
+// GetDepositMsgStatesByDepositorPrefix returns prefix of the deposit message states of the depositor in the latest
+// batches of all pools for iteration
+func GetDepositMsgStatesByDepositorPrefix(depositor sdk.AccAddress) []byte {
+	return append(DepositMsgStateByDepositorIndexKeyPrefix, address.MustLengthPrefix(depositor.Bytes())...)
+}
+
+// GetDepositMsgStateByDepositorIndexKey returns kv indexing key of the deposit message state indexed by depositor
+func GetDepositMsgStateByDepositorIndexKey(depositor sdk.AccAddress, poolID, msgIndex uint64) []byte {
+	return append(GetDepositMsgStatesByDepositorPrefix(depositor), getPoolMsgIndexKeySuffix(poolID, msgIndex)...)
+}
+
+// GetWithdrawMsgStatesByWithdrawerPrefix returns prefix of the withdraw message states of the withdrawer in the latest
+// batches of all pools for iteration
+func GetWithdrawMsgStatesByWithdrawerPrefix(withdrawer sdk.AccAddress) []byte {
+	return append(WithdrawMsgStateByWithdrawerIndexKeyPrefix, address.MustLengthPrefix(withdrawer.Bytes())...)
+}
+
+// GetWithdrawMsgStateByWithdrawerIndexKey returns kv indexing key of the withdraw message state indexed by withdrawer
+func GetWithdrawMsgStateByWithdrawerIndexKey(withdrawer sdk.AccAddress, poolID, msgIndex uint64) []byte {
+	return append(GetWithdrawMsgStatesByWithdrawerPrefix(withdrawer), getPoolMsgIndexKeySuffix(poolID, msgIndex)...)
+}
+
+// GetSwapMsgStatesByRequesterPrefix returns prefix of the swap message states of the swap requester in the latest
+// batches of all pools for iteration
+func GetSwapMsgStatesByRequesterPrefix(requester sdk.AccAddress) []byte {
+	return append(SwapMsgStateByRequesterIndexKeyPrefix, address.MustLengthPrefix(requester.Bytes())...)
+}
+
+// GetSwapMsgStateByRequesterIndexKey returns kv indexing key of the swap message state indexed by swap requester
+func GetSwapMsgStateByRequesterIndexKey(requester sdk.AccAddress, poolID, msgIndex uint64) []byte {
+	return append(GetSwapMsgStatesByRequesterPrefix(requester), getPoolMsgIndexKeySuffix(poolID, msgIndex)...)
+}
+
+// ParsePoolMsgIndexFromAddressIndexKey returns the pool id and the msg index of the message state from the key of the
+// index by address, with or without the prefix of the address
+func ParsePoolMsgIndexFromAddressIndexKey(key []byte) (poolID, msgIndex uint64) {
+	if len(key) < 16 {
+		panic(fmt.Sprintf("invalid message state index key length %d", len(key)))
+	}
+	suffix := key[len(key)-16:]
+	return sdk.BigEndianToUint64(suffix[:8]), sdk.BigEndianToUint64(suffix[8:])
+}
+
+func getPoolMsgIndexKeySuffix(poolID, msgIndex uint64) []byte {
+	suffix := make([]byte, 16)
+	copy(suffix[0:8], sdk.Uint64ToBigEndian(poolID))
+	copy(suffix[8:16], sdk.Uint64ToBigEndian(msgIndex))
+	return suffix
+}
+
+// GetPositionKey returns kv indexing key of the position
+func GetPositionKey(positionID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PositionKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(positionID))
+	return key
+}
+
+// GetPositionsByPoolPrefix returns prefix of the positions of the pool indexed by pool id for iteration
+func GetPositionsByPoolPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PositionByPoolIndexKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPositionByPoolIndexKey returns kv indexing key of the position indexed by pool id
+func GetPositionByPoolIndexKey(poolID, positionID uint64) []byte {
+	key := make([]byte, 17)
+	key[0] = PositionByPoolIndexKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	copy(key[9:17], sdk.Uint64ToBigEndian(positionID))
+	return key
+}
+
+// GetPoolPriceKey returns kv indexing key of the pool price of the concentrated liquidity pool
+func GetPoolPriceKey(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PoolPriceKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPriceTicksPrefix returns prefix of the price ticks of the concentrated liquidity pool for iteration
+func GetPriceTicksPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PriceTickKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPriceTickKey returns kv indexing key of the price tick of the concentrated liquidity pool at the price
+func GetPriceTickKey(poolID uint64, price sdk.Dec) []byte {
+	return append(GetPriceTicksPrefix(poolID), sdk.SortableDecBytes(price)...)
+}
+
+// GetFeeGrowthGlobalKey returns kv indexing key of the global fee growth of the concentrated liquidity pool
+func GetFeeGrowthGlobalKey(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = FeeGrowthGlobalKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPoolBatchResultsPrefix returns prefix of the batch results of the pool for iteration
+func GetPoolBatchResultsPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PoolBatchResultKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPoolBatchResultKey returns kv indexing key of the result of the executed batch of the pool
+func GetPoolBatchResultKey(poolID, batchIndex uint64) []byte {
+	key := make([]byte, 17)
+	key[0] = PoolBatchResultKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	copy(key[9:17], sdk.Uint64ToBigEndian(batchIndex))
+	return key
+}
+
+// GetPriceAccumulatorsPrefix returns prefix of the price accumulators of the pool for iteration
+func GetPriceAccumulatorsPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PriceAccumulatorKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPriceAccumulatorKey returns kv indexing key of the price accumulator updated at the executed batch of the pool
+func GetPriceAccumulatorKey(poolID, batchIndex uint64) []byte {
+	key := make([]byte, 17)
+	key[0] = PriceAccumulatorKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	copy(key[9:17], sdk.Uint64ToBigEndian(batchIndex))
+	return key
+}
+
+// GetRewardPlanKey returns kv indexing key of the reward plan
+func GetRewardPlanKey(planID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = RewardPlanKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(planID))
+	return key
+}
+
+// GetRewardPlansByPoolPrefix returns prefix of the reward plans of the pool indexed by pool id for iteration
+func GetRewardPlansByPoolPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = RewardPlanByPoolIndexKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetRewardPlanByPoolIndexKey returns kv indexing key of the reward plan indexed by pool id
+func GetRewardPlanByPoolIndexKey(poolID, planID uint64) []byte {
+	key := make([]byte, 17)
+	key[0] = RewardPlanByPoolIndexKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	copy(key[9:17], sdk.Uint64ToBigEndian(planID))
+	return key
+}
+
+// GetRewardAccumulatorKey returns kv indexing key of the reward accumulator of the pool
+func GetRewardAccumulatorKey(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = RewardAccumulatorKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetStakesByPoolPrefix returns prefix of the stakes of the pool coin of the pool for iteration
+func GetStakesByPoolPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = StakeKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetStakeKey returns kv indexing key of the stake of the staker in the pool
+func GetStakeKey(poolID uint64, staker sdk.AccAddress) []byte {
+	return append(GetStakesByPoolPrefix(poolID), address.MustLengthPrefix(staker.Bytes())...)
+}
+
+// GetStakesByStakerPrefix returns prefix of the stakes of the staker in all pools for iteration
+func GetStakesByStakerPrefix(staker sdk.AccAddress) []byte {
+	return append(StakeByStakerIndexKeyPrefix, address.MustLengthPrefix(staker.Bytes())...)
+}
+
+// GetStakeByStakerIndexKey returns kv indexing key of the stake indexed by staker
+func GetStakeByStakerIndexKey(staker sdk.AccAddress, poolID uint64) []byte {
+	return append(GetStakesByStakerPrefix(staker), sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// ParsePoolIDFromStakeByStakerIndexKey returns the pool id from the key of the stake index by staker,
+// with or without the prefix of the staker
+func ParsePoolIDFromStakeByStakerIndexKey(key []byte) uint64 {
+	if len(key) < 8 {
+		panic(fmt.Sprintf("invalid stake index key length %d", len(key)))
+	}
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+// GetBondKey returns kv indexing key of the bond
+func GetBondKey(bondID uint64) []byte {
+	return append(BondKeyPrefix, sdk.Uint64ToBigEndian(bondID)...)
+}
+
+// GetBondsByOwnerPrefix returns prefix of the bonds of the owner for iteration
+func GetBondsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(BondByOwnerIndexKeyPrefix, address.MustLengthPrefix(owner.Bytes())...)
+}
+
+// GetBondByOwnerIndexKey returns kv indexing key of the bond indexed by owner
+func GetBondByOwnerIndexKey(owner sdk.AccAddress, bondID uint64) []byte {
+	return append(GetBondsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(bondID)...)
+}
+
+// GetUnbondingQueueTimePrefix returns prefix of the unbonding bonds with the unbond time for iteration
+func GetUnbondingQueueTimePrefix(unbondTime time.Time) []byte {
+	return append(UnbondingQueueKeyPrefix, sdk.FormatTimeBytes(unbondTime)...)
+}
+
+// GetUnbondingQueueKey returns kv indexing key of the unbonding bond in the queue ordered by the unbond time
+func GetUnbondingQueueKey(unbondTime time.Time, bondID uint64) []byte {
+	return append(GetUnbondingQueueTimePrefix(unbondTime), sdk.Uint64ToBigEndian(bondID)...)
+}
+
+// ParseBondIDFromIndexKey returns the bond id from the key of the bond index by owner or the unbonding queue,
+// with or without the prefix
+func ParseBondIDFromIndexKey(key []byte) uint64 {
+	if len(key) < 8 {
+		panic(fmt.Sprintf("invalid bond index key length %d", len(key)))
+	}
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+// GetCollectedProtocolFeesKey returns kv indexing key of the protocol fees collected from the pool
+func GetCollectedProtocolFeesKey(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = CollectedProtocolFeesKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPoolFeeRateKey returns kv indexing key of the fee rates of the pool set by governance
+func GetPoolFeeRateKey(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PoolFeeRateKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetDynamicSwapFeeKey returns kv indexing key of the dynamic swap fee of the pool
+func GetDynamicSwapFeeKey(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = DynamicSwapFeeKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetCircuitBreakerKey returns kv indexing key of the circuit breaker of the pool and the message type
+func GetCircuitBreakerKey(poolID uint64, msgType string) []byte {
+	return append(GetCircuitBreakersByPoolPrefix(poolID), msgType...)
+}
+
+// GetCircuitBreakersByPoolPrefix returns prefix of the circuit breakers of the pool in the kvstore
+func GetCircuitBreakersByPoolPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = CircuitBreakerKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
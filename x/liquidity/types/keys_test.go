@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/tendermint/liquidity/x/liquidity/types"
@@ -72,3 +74,108 @@ func (s *keysTestSuite) TestGetLiquidityPoolBatchSwapMsgIndex() {
 	s.Require().Equal([]byte{0x33, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
 		types.GetPoolBatchSwapMsgStateIndexKey(0, 0))
 }
+
+func (s *keysTestSuite) TestGetPositionKeys() {
+	s.Require().Equal([]byte{0x41, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPositionKey(10))
+	s.Require().Equal([]byte{0x42, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPositionsByPoolPrefix(10))
+	s.Require().Equal([]byte{0x42, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3}, types.GetPositionByPoolIndexKey(10, 3))
+	s.Require().Equal([]byte{0x43, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPoolPriceKey(10))
+}
+
+func (s *keysTestSuite) TestGetPoolBatchResultKeys() {
+	s.Require().Equal([]byte{0x51, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPoolBatchResultsPrefix(10))
+	s.Require().Equal([]byte{0x51, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3}, types.GetPoolBatchResultKey(10, 3))
+}
+
+func (s *keysTestSuite) TestGetPriceAccumulatorKeys() {
+	s.Require().Equal([]byte{0x52, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPriceAccumulatorsPrefix(10))
+	s.Require().Equal([]byte{0x52, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3}, types.GetPriceAccumulatorKey(10, 3))
+}
+
+func (s *keysTestSuite) TestGetRewardKeys() {
+	s.Require().Equal([]byte{0x61, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetRewardPlanKey(10))
+	s.Require().Equal([]byte{0x62, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetRewardPlansByPoolPrefix(10))
+	s.Require().Equal([]byte{0x62, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3}, types.GetRewardPlanByPoolIndexKey(10, 3))
+	s.Require().Equal([]byte{0x63, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetRewardAccumulatorKey(10))
+
+	addr := sdk.AccAddress([]byte{0x1, 0x2})
+	s.Require().Equal([]byte{0x64, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetStakesByPoolPrefix(10))
+	s.Require().Equal([]byte{0x64, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0x2, 0x1, 0x2}, types.GetStakeKey(10, addr))
+	s.Require().Equal([]byte{0x65, 0x2, 0x1, 0x2}, types.GetStakesByStakerPrefix(addr))
+	key := types.GetStakeByStakerIndexKey(addr, 10)
+	s.Require().Equal([]byte{0x65, 0x2, 0x1, 0x2, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, key)
+
+	s.Require().Equal(uint64(10), types.ParsePoolIDFromStakeByStakerIndexKey(key))
+	s.Require().Equal(uint64(10), types.ParsePoolIDFromStakeByStakerIndexKey(key[4:]))
+	s.Require().Panics(func() { types.ParsePoolIDFromStakeByStakerIndexKey(key[:4]) })
+}
+
+func (s *keysTestSuite) TestGetBondKeys() {
+	s.Require().Equal([]byte{0x71, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetBondKey(10))
+
+	addr := sdk.AccAddress([]byte{0x1, 0x2})
+	s.Require().Equal([]byte{0x72, 0x2, 0x1, 0x2}, types.GetBondsByOwnerPrefix(addr))
+	key := types.GetBondByOwnerIndexKey(addr, 10)
+	s.Require().Equal([]byte{0x72, 0x2, 0x1, 0x2, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, key)
+	s.Require().Equal(uint64(10), types.ParseBondIDFromIndexKey(key))
+
+	unbondTime := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.Require().Equal(append([]byte{0x73}, []byte("2022-03-01T00:00:00.000000000")...), types.GetUnbondingQueueTimePrefix(unbondTime))
+	key = types.GetUnbondingQueueKey(unbondTime, 10)
+	s.Require().Equal(append(types.GetUnbondingQueueTimePrefix(unbondTime), 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa), key)
+	s.Require().Equal(uint64(10), types.ParseBondIDFromIndexKey(key))
+	s.Require().Panics(func() { types.ParseBondIDFromIndexKey(key[:4]) })
+}
+
+func (s *keysTestSuite) TestGetCollectedProtocolFeesKey() {
+	s.Require().Equal([]byte{0x81, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetCollectedProtocolFeesKey(10))
+}
+
+func (s *keysTestSuite) TestGetPoolFeeRateKey() {
+	s.Require().Equal([]byte{0x91, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPoolFeeRateKey(10))
+}
+
+func (s *keysTestSuite) TestGetCircuitBreakerKey() {
+	s.Require().Equal([]byte{0xb1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetCircuitBreakersByPoolPrefix(10))
+	s.Require().Equal([]byte{0xb1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0x62, 0x6f, 0x6e, 0x64}, types.GetCircuitBreakerKey(10, "bond"))
+	s.Require().Equal(types.GetCircuitBreakersByPoolPrefix(0), types.GetCircuitBreakerKey(0, ""))
+}
+
+func (s *keysTestSuite) TestGetDynamicSwapFeeKey() {
+	s.Require().Equal([]byte{0xa1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetDynamicSwapFeeKey(10))
+}
+
+func (s *keysTestSuite) TestGetMsgStateByAddressIndexKeys() {
+	addr := sdk.AccAddress([]byte{0x1, 0x2})
+	s.Require().Equal([]byte{0x34, 0x2, 0x1, 0x2}, types.GetDepositMsgStatesByDepositorPrefix(addr))
+	s.Require().Equal([]byte{0x35, 0x2, 0x1, 0x2}, types.GetWithdrawMsgStatesByWithdrawerPrefix(addr))
+	s.Require().Equal([]byte{0x36, 0x2, 0x1, 0x2}, types.GetSwapMsgStatesByRequesterPrefix(addr))
+
+	key := types.GetSwapMsgStateByRequesterIndexKey(addr, 10, 3)
+	s.Require().Equal([]byte{0x36, 0x2, 0x1, 0x2, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3}, key)
+	s.Require().Equal(append(types.GetDepositMsgStatesByDepositorPrefix(addr), key[4:]...), types.GetDepositMsgStateByDepositorIndexKey(addr, 10, 3))
+	s.Require().Equal(append(types.GetWithdrawMsgStatesByWithdrawerPrefix(addr), key[4:]...), types.GetWithdrawMsgStateByWithdrawerIndexKey(addr, 10, 3))
+
+	poolID, msgIndex := types.ParsePoolMsgIndexFromAddressIndexKey(key)
+	s.Require().Equal(uint64(10), poolID)
+	s.Require().Equal(uint64(3), msgIndex)
+	poolID, msgIndex = types.ParsePoolMsgIndexFromAddressIndexKey(key[4:])
+	s.Require().Equal(uint64(10), poolID)
+	s.Require().Equal(uint64(3), msgIndex)
+	s.Require().Panics(func() { types.ParsePoolMsgIndexFromAddressIndexKey(key[:4]) })
+}
+
+func (s *keysTestSuite) TestGetPoolByDenomIndexKeys() {
+	s.Require().Equal([]byte{0x13, 0x4, 0x75, 0x75, 0x73, 0x64}, types.GetPoolsByDenomPrefix("uusd"))
+	s.Require().Equal([]byte{0x13, 0x4, 0x75, 0x75, 0x73, 0x64, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPoolByDenomIndexKey("uusd", 10))
+
+	pairPrefix := []byte{0x14, 0x5, 0x75, 0x61, 0x74, 0x6f, 0x6d, 0x4, 0x75, 0x75, 0x73, 0x64}
+	s.Require().Equal(pairPrefix, types.GetPoolsByDenomPairPrefix("uatom", "uusd"))
+	s.Require().Equal(pairPrefix, types.GetPoolsByDenomPairPrefix("uusd", "uatom"))
+	key := types.GetPoolByDenomPairIndexKey("uusd", "uatom", 10)
+	s.Require().Equal(append(pairPrefix, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa), key)
+
+	s.Require().Equal(uint64(10), types.ParsePoolIDFromDenomIndexKey(key))
+	s.Require().Equal(uint64(10), types.ParsePoolIDFromDenomIndexKey(key[len(pairPrefix):]))
+	s.Require().Panics(func() { types.ParsePoolIDFromDenomIndexKey(pairPrefix[:4]) })
+}
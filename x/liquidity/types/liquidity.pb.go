@@ -9,16 +9,21 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -97,6 +102,46 @@ type Params struct {
 	UnitBatchHeight uint32 `protobuf:"varint,9,opt,name=unit_batch_height,json=unitBatchHeight,proto3" json:"unit_batch_height,omitempty" yaml:"unit_batch_height"`
 	// Circuit breaker enables or disables transaction messages in liquidity module.
 	CircuitBreakerEnabled bool `protobuf:"varint,10,opt,name=circuit_breaker_enabled,json=circuitBreakerEnabled,proto3" json:"circuit_breaker_enabled,omitempty" yaml:"circuit_breaker_enabled"`
+	// The amplification coefficient of the StableSwap curve of the stable liquidity pools.
+	StableSwapAmplification uint32 `protobuf:"varint,11,opt,name=stable_swap_amplification,json=stableSwapAmplification,proto3" json:"stable_swap_amplification,omitempty" yaml:"stable_swap_amplification"`
+	// The maximum lifespan in blocks of the swap orders.
+	MaxOrderLifespan uint32 `protobuf:"varint,12,opt,name=max_order_lifespan,json=maxOrderLifespan,proto3" json:"max_order_lifespan,omitempty" yaml:"max_order_lifespan"`
+	// The number of the latest executed batches of each pool whose results are kept in the store.
+	BatchResultRetention uint32 `protobuf:"varint,13,opt,name=batch_result_retention,json=batchResultRetention,proto3" json:"batch_result_retention,omitempty" yaml:"batch_result_retention"`
+	// The number of the latest executed batches of each pool whose price accumulators are kept in the store for the
+	// time-weighted average prices.
+	PriceAccumulatorRetention uint32 `protobuf:"varint,14,opt,name=price_accumulator_retention,json=priceAccumulatorRetention,proto3" json:"price_accumulator_retention,omitempty" yaml:"price_accumulator_retention"`
+	// Fee paid to create a reward plan for the pool coin stakers of a pool, collected in the community pool.
+	RewardPlanCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=reward_plan_creation_fee,json=rewardPlanCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_plan_creation_fee" yaml:"reward_plan_creation_fee"`
+	// Durations allowed to bond pool coins for, each of which is the unbonding period of the bond.
+	BondDurations []time.Duration `protobuf:"bytes,16,rep,name=bond_durations,json=bondDurations,proto3,stdduration" json:"bond_durations" yaml:"bond_durations"`
+	// Share of the swap fees collected by the pools which is sent to the community pool as the protocol fee.
+	ProtocolFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=protocol_fee_rate,json=protocolFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_rate" yaml:"protocol_fee_rate"`
+	// Whether the protocol fee is also collected from the withdraw fees collected by the pools.
+	WithdrawProtocolFeeEnabled bool `protobuf:"varint,18,opt,name=withdraw_protocol_fee_enabled,json=withdrawProtocolFeeEnabled,proto3" json:"withdraw_protocol_fee_enabled,omitempty" yaml:"withdraw_protocol_fee_enabled"`
+	// Lower bound of the swap fee rates and the withdraw fee rates set for individual pools by governance.
+	MinPoolFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=min_pool_fee_rate,json=minPoolFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_pool_fee_rate" yaml:"min_pool_fee_rate"`
+	// Upper bound of the swap fee rates and the withdraw fee rates set for individual pools by governance.
+	MaxPoolFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=max_pool_fee_rate,json=maxPoolFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_pool_fee_rate" yaml:"max_pool_fee_rate"`
+	// Whether the swap fee rate of each pool is raised by the price volatility of its latest batches.
+	DynamicSwapFeeEnabled bool `protobuf:"varint,21,opt,name=dynamic_swap_fee_enabled,json=dynamicSwapFeeEnabled,proto3" json:"dynamic_swap_fee_enabled,omitempty" yaml:"dynamic_swap_fee_enabled"`
+	// The number of the latest batch results of each pool whose clearing prices are used for the price volatility.
+	DynamicSwapFeeLookback uint32 `protobuf:"varint,22,opt,name=dynamic_swap_fee_lookback,json=dynamicSwapFeeLookback,proto3" json:"dynamic_swap_fee_lookback,omitempty" yaml:"dynamic_swap_fee_lookback"`
+	// Increase of the swap fee rate relative to the base swap fee rate per unit of price volatility.
+	DynamicSwapFeeSensitivity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=dynamic_swap_fee_sensitivity,json=dynamicSwapFeeSensitivity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dynamic_swap_fee_sensitivity" yaml:"dynamic_swap_fee_sensitivity"`
+	// Share of the excess of the dynamic swap fee rate over its target which decays at each executed batch.
+	DynamicSwapFeeDecayRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,24,opt,name=dynamic_swap_fee_decay_rate,json=dynamicSwapFeeDecayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dynamic_swap_fee_decay_rate" yaml:"dynamic_swap_fee_decay_rate"`
+	// Bech32-encoded addresses of the emergency admins allowed to set the circuit breakers with MsgSetCircuitBreaker.
+	CircuitBreakerAdmins []string `protobuf:"bytes,25,rep,name=circuit_breaker_admins,json=circuitBreakerAdmins,proto3" json:"circuit_breaker_admins,omitempty" yaml:"circuit_breaker_admins"`
+	// Relative move of the clearing price from the clearing prices of the previous batches which halts the swaps of the
+	// pool, or zero to disable the automatic circuit breaker.
+	CircuitBreakerPriceMoveThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=circuit_breaker_price_move_threshold,json=circuitBreakerPriceMoveThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"circuit_breaker_price_move_threshold" yaml:"circuit_breaker_price_move_threshold"`
+	// The number of the previous batch results of each pool whose clearing prices are the reference prices of the
+	// automatic circuit breaker.
+	CircuitBreakerPriceMoveLookback uint32 `protobuf:"varint,27,opt,name=circuit_breaker_price_move_lookback,json=circuitBreakerPriceMoveLookback,proto3" json:"circuit_breaker_price_move_lookback,omitempty" yaml:"circuit_breaker_price_move_lookback"`
+	// Maximum relative deviation of the order price of a swap order from the pool price, which also bounds the swap
+	// price of the batch, or zero to accept any order price.
+	MaxOrderPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,28,opt,name=max_order_price_deviation,json=maxOrderPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_order_price_deviation" yaml:"max_order_price_deviation"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -143,6 +188,11 @@ type Pool struct {
 	ReserveAccountAddress string `protobuf:"bytes,4,opt,name=reserve_account_address,json=reserveAccountAddress,proto3" json:"reserve_account_address,omitempty" yaml:"reserve_account_address"`
 	// denom of pool coin of the pool
 	PoolCoinDenom string `protobuf:"bytes,5,opt,name=pool_coin_denom,json=poolCoinDenom,proto3" json:"pool_coin_denom,omitempty" yaml:"pool_coin_denom"`
+	// weights of the reserve coins in the same order as reserve_coin_denoms, empty if the pool is not weighted
+	ReserveCoinWeights []uint32 `protobuf:"varint,6,rep,packed,name=reserve_coin_weights,json=reserveCoinWeights,proto3" json:"reserve_coin_weights,omitempty" yaml:"reserve_coin_weights"`
+	// amplification coefficient of the StableSwap curve of the stable pool, fixed at the pool creation, zero if the pool
+	// is not stable
+	Amplification uint32 `protobuf:"varint,7,opt,name=amplification,proto3" json:"amplification,omitempty" yaml:"amplification"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -393,6 +443,8 @@ type SwapMsgState struct {
 	ReservedOfferCoinFee types.Coin `protobuf:"bytes,9,opt,name=reserved_offer_coin_fee,json=reservedOfferCoinFee,proto3" json:"reserved_offer_coin_fee" yaml:"reserved_offer_coin_fee"`
 	// MsgSwapWithinBatch
 	Msg *MsgSwapWithinBatch `protobuf:"bytes,10,opt,name=msg,proto3" json:"msg,omitempty" yaml:"msg"`
+	// remaining hops of the swap route of this message, empty if this message is not a hop of MsgSwapRoute
+	Route *SwapRoute `protobuf:"bytes,11,opt,name=route,proto3" json:"route,omitempty" yaml:"route"`
 }
 
 func (m *SwapMsgState) Reset()         { *m = SwapMsgState{} }
@@ -428,1226 +480,8742 @@ func (m *SwapMsgState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SwapMsgState proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*PoolType)(nil), "tendermint.liquidity.v1beta1.PoolType")
-	proto.RegisterType((*Params)(nil), "tendermint.liquidity.v1beta1.Params")
-	proto.RegisterType((*Pool)(nil), "tendermint.liquidity.v1beta1.Pool")
-	proto.RegisterType((*PoolMetadata)(nil), "tendermint.liquidity.v1beta1.PoolMetadata")
-	proto.RegisterType((*PoolBatch)(nil), "tendermint.liquidity.v1beta1.PoolBatch")
-	proto.RegisterType((*DepositMsgState)(nil), "tendermint.liquidity.v1beta1.DepositMsgState")
-	proto.RegisterType((*WithdrawMsgState)(nil), "tendermint.liquidity.v1beta1.WithdrawMsgState")
-	proto.RegisterType((*SwapMsgState)(nil), "tendermint.liquidity.v1beta1.SwapMsgState")
+// SwapRoute defines the remaining hops of a swap routed by MsgSwapRoute. The exchanged demand coin of a hop is
+// swapped in the batch of the next pool of the route.
+type SwapRoute struct {
+	// ids of the pools of the remaining hops, empty on the last hop
+	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+	// denoms of the demand coins of the remaining hops
+	DemandCoinDenoms []string `protobuf:"bytes,2,rep,name=demand_coin_denoms,json=demandCoinDenoms,proto3" json:"demand_coin_denoms,omitempty" yaml:"demand_coin_denoms"`
+	// minimum amount of demand coin to be received from the last hop
+	MinDemandCoinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_demand_coin_amount,json=minDemandCoinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_demand_coin_amount" yaml:"min_demand_coin_amount"`
 }
 
-func init() {
-	proto.RegisterFile("tendermint/liquidity/v1beta1/liquidity.proto", fileDescriptor_714a3e326c5b7d34)
+func (m *SwapRoute) Reset()         { *m = SwapRoute{} }
+func (m *SwapRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapRoute) ProtoMessage()    {}
+func (*SwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{8}
+}
+func (m *SwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRoute.Merge(m, src)
+}
+func (m *SwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRoute.DiscardUnknown(m)
 }
 
-var fileDescriptor_714a3e326c5b7d34 = []byte{
-	// 1940 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0x25, 0x59, 0x96, 0xc6, 0x5f, 0x31, 0xed, 0x78, 0x95, 0x64, 0x23, 0x29, 0xd3, 0x66,
-	0x6b, 0x6c, 0x63, 0x59, 0x96, 0x64, 0xc7, 0x76, 0x7b, 0x21, 0x6d, 0x6b, 0xd7, 0x42, 0xdd, 0x04,
-	0x4c, 0xda, 0x6d, 0x36, 0xbb, 0xd0, 0x52, 0xe4, 0x48, 0x62, 0x2d, 0x92, 0x0a, 0x39, 0x8a, 0xe5,
-	0x16, 0x0b, 0xf4, 0xb8, 0x87, 0x16, 0x28, 0x84, 0x1e, 0x8a, 0x16, 0x68, 0x17, 0x06, 0x8a, 0x05,
-	0x5a, 0xec, 0xa9, 0xed, 0x1f, 0xd0, 0x5b, 0x8e, 0x39, 0x16, 0x3d, 0xa8, 0x6d, 0x72, 0x29, 0x8a,
-	0xa2, 0x07, 0xfd, 0x05, 0xc5, 0x7c, 0x50, 0xa4, 0x6d, 0xda, 0x6a, 0x00, 0x1d, 0xd7, 0x17, 0x53,
-	0x6f, 0xde, 0xc7, 0xef, 0xbd, 0xf7, 0x9b, 0x37, 0x43, 0x82, 0x7b, 0x18, 0x59, 0x3a, 0x72, 0x4c,
-	0xc3, 0xc2, 0x6b, 0x2d, 0xe3, 0x59, 0xc7, 0xd0, 0x0d, 0x7c, 0xb2, 0xf6, 0x7c, 0xbd, 0x86, 0xb0,
-	0xba, 0xee, 0x4b, 0x72, 0x6d, 0xc7, 0xc6, 0xb6, 0xf8, 0xb6, 0xaf, 0x9d, 0xf3, 0xd7, 0xb8, 0xf6,
-	0xcd, 0xbb, 0x57, 0xfa, 0xc2, 0x5d, 0xe6, 0xe4, 0xe6, 0x52, 0xc3, 0x6e, 0xd8, 0xf4, 0x71, 0x8d,
-	0x3c, 0x71, 0xe9, 0x5b, 0x9a, 0xed, 0x9a, 0xb6, 0x5b, 0x65, 0x0b, 0x9a, 0x6d, 0x58, 0x7c, 0x81,
-	0xfd, 0xd3, 0x56, 0x1b, 0xc8, 0x5a, 0xb5, 0xdb, 0xc8, 0x52, 0xdb, 0xc6, 0xf3, 0xc2, 0x9a, 0xdd,
-	0xc6, 0x86, 0x6d, 0xb9, 0x6b, 0xaa, 0x65, 0xd9, 0x58, 0xa5, 0xcf, 0x4c, 0x11, 0x7e, 0x16, 0x05,
-	0x89, 0x87, 0xb6, 0xdd, 0x7a, 0x7c, 0xd2, 0x46, 0x62, 0x0e, 0x44, 0x0c, 0x3d, 0x25, 0x64, 0x85,
-	0x95, 0x59, 0x39, 0xdd, 0x93, 0xe6, 0x2a, 0x51, 0xb8, 0x0e, 0x4f, 0x23, 0xf1, 0x8e, 0x61, 0xe1,
-	0x62, 0x61, 0xd0, 0xcf, 0x24, 0x4f, 0x54, 0xb3, 0xb5, 0x03, 0x0d, 0x1d, 0x2a, 0x11, 0x43, 0x17,
-	0xcb, 0x20, 0x66, 0xa9, 0x26, 0x4a, 0x45, 0xb2, 0xc2, 0x4a, 0x52, 0x2e, 0xf4, 0xa4, 0x6c, 0x25,
-	0x0d, 0x77, 0x6d, 0xcb, 0xc5, 0xaa, 0x85, 0x1f, 0x3a, 0xb6, 0xde, 0xd1, 0xf0, 0x77, 0xbc, 0xd4,
-	0x48, 0x14, 0x38, 0xe8, 0x67, 0xa6, 0x99, 0x0f, 0x62, 0x08, 0x15, 0x6a, 0x2f, 0xaa, 0x60, 0xc9,
-	0x34, 0xac, 0xaa, 0x83, 0x5c, 0xe4, 0x3c, 0x47, 0x55, 0x92, 0x4e, 0xd5, 0xea, 0x98, 0xa9, 0x28,
-	0x45, 0x92, 0x67, 0x48, 0x0a, 0x67, 0x90, 0xdc, 0x62, 0x5e, 0xc2, 0xcc, 0xa0, 0xb2, 0x60, 0x1a,
-	0x96, 0xc2, 0xa4, 0xbb, 0xb6, 0x61, 0x7d, 0xb7, 0x63, 0xd2, 0x10, 0x6a, 0xf7, 0x62, 0x88, 0xd8,
-	0xe8, 0x10, 0x21, 0x66, 0x24, 0x84, 0xda, 0x3d, 0x17, 0x62, 0x0b, 0x4c, 0xeb, 0xc8, 0xd5, 0x1c,
-	0x83, 0x16, 0x3b, 0x35, 0x49, 0x8b, 0xb2, 0x3c, 0xe8, 0x67, 0x44, 0xe6, 0x28, 0xb0, 0x08, 0x95,
-	0xa0, 0xea, 0x4e, 0xec, 0x5f, 0x9f, 0x67, 0x04, 0xf8, 0xa7, 0x69, 0x10, 0x7f, 0xa8, 0x3a, 0xaa,
-	0xe9, 0x8a, 0x9f, 0x00, 0xd0, 0xb6, 0xed, 0x56, 0x15, 0x9f, 0xb4, 0x91, 0x9b, 0x12, 0xb2, 0xd1,
-	0x95, 0xe9, 0xc2, 0x3b, 0xb9, 0xab, 0xe8, 0x94, 0xf3, 0x9a, 0x28, 0xdf, 0x78, 0xd1, 0xcf, 0x4c,
-	0x0c, 0xfa, 0x99, 0x05, 0x16, 0xd5, 0xf7, 0x03, 0x95, 0x64, 0x9b, 0x2b, 0xb9, 0xe2, 0x6f, 0x05,
-	0xf0, 0x16, 0x29, 0x9e, 0x61, 0x19, 0xb8, 0xaa, 0xa3, 0xb6, 0xed, 0x1a, 0xb8, 0xaa, 0x9a, 0x76,
-	0xc7, 0xc2, 0xbc, 0x9d, 0xcd, 0x9e, 0x74, 0xbd, 0x92, 0x84, 0xeb, 0x79, 0xfa, 0x07, 0x4f, 0x23,
-	0x53, 0xae, 0x7e, 0x94, 0x3b, 0xb0, 0x30, 0xf1, 0xff, 0xb7, 0x7e, 0xe6, 0x9d, 0x86, 0x81, 0x9b,
-	0x9d, 0x5a, 0x4e, 0xb3, 0xcd, 0x35, 0xc6, 0x46, 0xfe, 0x6f, 0xd5, 0xd5, 0x8f, 0xd6, 0x68, 0x44,
-	0xa2, 0x3d, 0xe8, 0x67, 0xd2, 0x7e, 0xaf, 0x42, 0xc2, 0x41, 0x85, 0x34, 0xff, 0xc0, 0x32, 0xf0,
-	0x1e, 0x93, 0x4b, 0x54, 0x2c, 0x7e, 0x21, 0x80, 0x9b, 0x54, 0x9d, 0x66, 0x40, 0x2b, 0x4f, 0x52,
-	0xf7, 0x40, 0x46, 0x29, 0xc8, 0xa3, 0xb1, 0x81, 0xbc, 0xc3, 0xa9, 0x7d, 0x69, 0x44, 0xa8, 0x2c,
-	0x93, 0x45, 0x52, 0x67, 0xd2, 0xf1, 0x43, 0xc3, 0xf2, 0x90, 0xfe, 0x8e, 0xd4, 0xf2, 0x3c, 0x4b,
-	0x38, 0xcc, 0x18, 0x85, 0x69, 0xf5, 0xa4, 0x5b, 0x95, 0x79, 0x0f, 0xe6, 0xf8, 0x2a, 0x1a, 0x1e,
-	0x94, 0x54, 0xf4, 0x0c, 0x3b, 0x39, 0xce, 0x97, 0x02, 0x58, 0x60, 0xa9, 0x39, 0x88, 0x0e, 0x81,
-	0x6a, 0x1d, 0xa1, 0xd4, 0x24, 0x65, 0xd7, 0x8d, 0x1c, 0x0b, 0x95, 0xab, 0xa9, 0x2e, 0x1a, 0x92,
-	0x8a, 0x18, 0xcb, 0x9f, 0x09, 0x3d, 0x69, 0xbb, 0xf2, 0xcd, 0xa7, 0x3f, 0x86, 0x3a, 0xb2, 0x6c,
-	0x13, 0xee, 0x64, 0x61, 0x47, 0xc5, 0xb6, 0x09, 0xef, 0x65, 0x21, 0x0f, 0xb8, 0x93, 0xf5, 0x73,
-	0x83, 0x9f, 0x7e, 0x7c, 0x1a, 0x49, 0x92, 0xcc, 0x88, 0xb5, 0xcb, 0xd9, 0x98, 0x0a, 0xb0, 0x31,
-	0x18, 0x1e, 0xfe, 0xfe, 0xef, 0x99, 0x95, 0xff, 0x23, 0x6f, 0xea, 0x4b, 0x99, 0x27, 0xf6, 0xbb,
-	0xdc, 0xbc, 0x8c, 0x90, 0xf8, 0x13, 0x01, 0xcc, 0xba, 0xc7, 0x6a, 0x9b, 0xb8, 0xaa, 0x3a, 0x2a,
-	0x46, 0xa9, 0x38, 0x2d, 0xf8, 0x47, 0x3d, 0x69, 0xb1, 0x32, 0x05, 0xf3, 0xb9, 0x7c, 0xbe, 0xe8,
-	0x15, 0x7a, 0x0f, 0x69, 0x6f, 0x50, 0xe8, 0x3d, 0xa4, 0x0d, 0xfa, 0x99, 0x25, 0x06, 0xfb, 0x4c,
-	0x08, 0xa8, 0x4c, 0x93, 0xdf, 0x65, 0x84, 0x14, 0x15, 0x23, 0xf1, 0xa7, 0x02, 0x58, 0x38, 0x36,
-	0x70, 0x53, 0x77, 0xd4, 0x63, 0x1f, 0xc6, 0x14, 0x85, 0xf1, 0xc9, 0x98, 0x60, 0xf0, 0xea, 0x5d,
-	0x08, 0x03, 0x95, 0x79, 0x4f, 0xe6, 0xc1, 0xf9, 0x95, 0x00, 0x96, 0x09, 0x2f, 0x6c, 0x47, 0x47,
-	0x0e, 0x27, 0x04, 0xd1, 0x35, 0xec, 0x54, 0x82, 0x62, 0x42, 0x63, 0xc2, 0x74, 0xdb, 0xe7, 0xe0,
-	0xc5, 0x58, 0x50, 0x59, 0x34, 0xd5, 0xee, 0x03, 0x22, 0x67, 0xe4, 0x53, 0x88, 0x54, 0x7c, 0x02,
-	0x16, 0x3a, 0x64, 0x83, 0xd5, 0x54, 0xac, 0x35, 0xab, 0x4d, 0x64, 0x34, 0x9a, 0x38, 0x95, 0xa4,
-	0x23, 0x78, 0x35, 0xec, 0xbc, 0xe1, 0x79, 0x5f, 0xb0, 0x81, 0xca, 0x3c, 0x91, 0xc9, 0x44, 0xf4,
-	0x3e, 0x95, 0x88, 0x26, 0x78, 0x4b, 0x33, 0x1c, 0xad, 0x43, 0x34, 0x1d, 0xa4, 0x1e, 0x21, 0xa7,
-	0x8a, 0x2c, 0xb5, 0xd6, 0x42, 0x7a, 0x0a, 0x64, 0x85, 0x95, 0x84, 0xbc, 0xd1, 0x93, 0xae, 0x55,
-	0xa6, 0x60, 0x5d, 0x6d, 0xb9, 0x08, 0x9e, 0x46, 0x62, 0x35, 0xdb, 0x6e, 0xf9, 0x5b, 0xe9, 0x12,
-	0x5b, 0xa8, 0x5c, 0xe7, 0x2b, 0x32, 0x5b, 0xd8, 0x67, 0xf2, 0x9d, 0xc4, 0x2f, 0x3f, 0xcf, 0x4c,
-	0xd0, 0xb1, 0xfd, 0x9b, 0x18, 0x88, 0x91, 0xa1, 0x20, 0x96, 0x86, 0xa7, 0x67, 0x4c, 0xfe, 0xfa,
-	0xb9, 0x6c, 0x36, 0x4b, 0xff, 0xee, 0x67, 0x22, 0x86, 0x7e, 0xf1, 0x0c, 0xfd, 0x36, 0x98, 0x22,
-	0x55, 0xad, 0x1a, 0x3a, 0x9d, 0xbb, 0xb3, 0xf2, 0xd7, 0xc2, 0x0a, 0x31, 0xc7, 0x8c, 0xb8, 0x26,
-	0x54, 0xe2, 0xe4, 0xe9, 0x40, 0x17, 0xeb, 0x60, 0xf1, 0xcc, 0x00, 0xa0, 0x3b, 0xd4, 0x4d, 0x45,
-	0xb3, 0xd1, 0x95, 0xa4, 0xbc, 0x49, 0x86, 0xe3, 0xe2, 0x53, 0xb6, 0x6d, 0x7f, 0x00, 0xef, 0xb1,
-	0x87, 0x27, 0xf0, 0xe3, 0x41, 0x3f, 0x73, 0x93, 0x39, 0x0c, 0x31, 0x86, 0xca, 0x82, 0xe3, 0x8f,
-	0x8e, 0x3d, 0x2a, 0xa3, 0xc7, 0x85, 0xa7, 0xab, 0x6a, 0x1a, 0x6d, 0xb4, 0xaa, 0xeb, 0x0e, 0x72,
-	0x5d, 0x3e, 0xe2, 0x1a, 0x3d, 0x49, 0xae, 0xac, 0x41, 0x46, 0x96, 0xf5, 0x4d, 0x5d, 0x7f, 0x86,
-	0x5c, 0x7c, 0xdc, 0x39, 0x7a, 0x9e, 0xff, 0xe1, 0x8f, 0xb4, 0x93, 0xba, 0x55, 0xac, 0xeb, 0xf5,
-	0x67, 0xdb, 0xcd, 0xc2, 0xb1, 0xe3, 0x6e, 0x15, 0x35, 0xa7, 0xe4, 0xd4, 0x4d, 0x42, 0xbf, 0x39,
-	0x42, 0x3f, 0x49, 0xd3, 0x24, 0xe6, 0xcc, 0x6f, 0xc8, 0x25, 0xd1, 0xa0, 0x72, 0x9d, 0xaf, 0x48,
-	0x6c, 0x81, 0x1b, 0x8a, 0x3f, 0x13, 0xc0, 0xbc, 0x3f, 0xb7, 0x69, 0x2a, 0xfc, 0x08, 0x46, 0x3d,
-	0xe9, 0xfd, 0x4a, 0x99, 0x8e, 0x9e, 0xbd, 0xe2, 0x86, 0x94, 0xdf, 0xdd, 0x5d, 0xdf, 0xdc, 0xdf,
-	0xdf, 0xd8, 0xde, 0x2a, 0x6f, 0xe7, 0xe5, 0x7c, 0xa9, 0xb4, 0xbb, 0x5f, 0xd8, 0xde, 0x94, 0x4a,
-	0xf9, 0x0d, 0x59, 0xda, 0xde, 0x2d, 0x6e, 0xad, 0xef, 0x17, 0xb7, 0xb6, 0x8a, 0xf7, 0x37, 0xb6,
-	0xb7, 0xf7, 0xb6, 0x37, 0xcb, 0x85, 0xf2, 0xfd, 0xfc, 0x6e, 0xa1, 0x9c, 0x2f, 0x48, 0x85, 0xa2,
-	0x54, 0x22, 0xf7, 0x97, 0xe5, 0xe0, 0x24, 0x1b, 0xc6, 0x82, 0xca, 0x6c, 0x9b, 0x9f, 0x0c, 0xb4,
-	0x64, 0x94, 0x20, 0x02, 0x25, 0xc8, 0x5f, 0x62, 0x60, 0x86, 0x10, 0xe4, 0x10, 0x61, 0x55, 0x57,
-	0xb1, 0x2a, 0xbe, 0x07, 0xa6, 0xa8, 0xf5, 0x90, 0x2d, 0xb9, 0x30, 0xb6, 0x78, 0x3a, 0x7e, 0xf7,
-	0xb9, 0x00, 0x2a, 0x71, 0xf2, 0x74, 0xa0, 0x8b, 0xff, 0x11, 0xc0, 0xb2, 0x8f, 0x03, 0xdb, 0x58,
-	0x6d, 0x55, 0xdd, 0x4e, 0xbb, 0xdd, 0x3a, 0xa1, 0x5c, 0xba, 0x72, 0xaa, 0xff, 0x5a, 0xe8, 0x49,
-	0x6e, 0xa5, 0x1e, 0x18, 0xea, 0x63, 0x29, 0x50, 0xd8, 0x99, 0x00, 0x3f, 0x3d, 0x8d, 0x24, 0xbc,
-	0x03, 0x81, 0x9f, 0x07, 0xb7, 0xcf, 0x57, 0x31, 0x88, 0x1e, 0x2a, 0x8b, 0x5e, 0x31, 0x1f, 0x13,
-	0xf1, 0x23, 0x2a, 0x15, 0xff, 0x2b, 0x80, 0xd9, 0x20, 0x61, 0x19, 0xcf, 0xaf, 0xcc, 0xf2, 0x4b,
-	0xa1, 0x27, 0xd5, 0x2a, 0x8f, 0x83, 0x67, 0x97, 0xb7, 0x1b, 0x42, 0x81, 0xde, 0xcb, 0x9e, 0xd7,
-	0x7c, 0x72, 0x56, 0xb3, 0x70, 0xd5, 0x21, 0xb7, 0x74, 0x71, 0x53, 0xb9, 0x6f, 0x76, 0xc0, 0xcd,
-	0x04, 0xb6, 0x9e, 0x1b, 0xe0, 0xd0, 0x1f, 0x62, 0x20, 0x49, 0x38, 0x44, 0x27, 0xde, 0xf8, 0x08,
-	0x74, 0x1f, 0x4c, 0x1a, 0x96, 0x8e, 0xba, 0x94, 0x2e, 0x31, 0xf9, 0xce, 0x05, 0x37, 0x83, 0x7e,
-	0x66, 0xc6, 0xbb, 0x18, 0xe9, 0xa8, 0x0b, 0x15, 0xa6, 0x2f, 0x1e, 0x82, 0x99, 0x1a, 0x6a, 0x18,
-	0x96, 0x37, 0xc3, 0xc9, 0x6d, 0x2c, 0x2a, 0xbf, 0x4b, 0x46, 0x6c, 0x9c, 0x56, 0x13, 0x9e, 0x46,
-	0x26, 0x3d, 0x0f, 0x8b, 0xcc, 0x43, 0xd0, 0x00, 0x2a, 0xd3, 0xf4, 0x27, 0x1f, 0xde, 0x4f, 0xc0,
-	0x82, 0x77, 0x29, 0x34, 0xdd, 0x46, 0x95, 0x61, 0x8a, 0x51, 0x4c, 0xab, 0x61, 0x98, 0x52, 0xde,
-	0x8d, 0xfa, 0x9c, 0x0d, 0x54, 0xe6, 0xb9, 0xec, 0xd0, 0x6d, 0x1c, 0x50, 0xa4, 0x1f, 0x01, 0x71,
-	0x78, 0x6c, 0xfa, 0xbe, 0x27, 0x2f, 0x29, 0xdb, 0xa0, 0x9f, 0xb9, 0x71, 0xee, 0xac, 0x0d, 0x38,
-	0xbf, 0xe6, 0x09, 0x87, 0xde, 0x1f, 0x82, 0x39, 0x7a, 0x37, 0xf0, 0x3d, 0xc7, 0xa9, 0xe7, 0x77,
-	0xc3, 0x3c, 0x5f, 0x0f, 0x5c, 0x26, 0x02, 0x5e, 0x67, 0x88, 0x60, 0xe8, 0x71, 0x0b, 0x24, 0x50,
-	0x17, 0x69, 0x1d, 0x8c, 0x74, 0x7a, 0x89, 0x48, 0xc8, 0x6f, 0xf7, 0xa4, 0x78, 0x25, 0x86, 0x9d,
-	0x0e, 0x1a, 0xf4, 0x33, 0xf3, 0xcc, 0x87, 0xa7, 0x02, 0x95, 0xa1, 0x76, 0x80, 0x2d, 0x7f, 0x8c,
-	0x82, 0xf9, 0xbd, 0x61, 0x1d, 0x1e, 0x61, 0x72, 0x2f, 0x78, 0x0f, 0x00, 0x12, 0x93, 0xf7, 0x4b,
-	0xa0, 0xfd, 0x5a, 0x09, 0xef, 0x17, 0x7f, 0x73, 0xf0, 0xd5, 0xa1, 0x92, 0x34, 0xdd, 0x06, 0xef,
-	0x95, 0x0c, 0x92, 0x7e, 0xb6, 0x8c, 0x37, 0x77, 0xc3, 0xb2, 0xbd, 0xe6, 0x7b, 0xe1, 0x89, 0x26,
-	0xcc, 0xb0, 0x24, 0xa3, 0x6f, 0x92, 0xa4, 0xf8, 0x2d, 0x90, 0x74, 0x3b, 0x9a, 0x86, 0x90, 0x8e,
-	0x74, 0xca, 0x90, 0x84, 0x7c, 0x3b, 0x68, 0xca, 0xa3, 0x0e, 0x75, 0xa0, 0xe2, 0xeb, 0x8b, 0xfb,
-	0x60, 0x16, 0xdb, 0xd5, 0x1a, 0xaa, 0xea, 0xa8, 0x85, 0x48, 0xec, 0x49, 0xea, 0xe0, 0x4e, 0xd0,
-	0x01, 0xdf, 0xc3, 0x67, 0xf4, 0xa0, 0x32, 0x8d, 0x6d, 0x19, 0xed, 0xb1, 0x5f, 0xe2, 0xf7, 0x40,
-	0xd4, 0x74, 0x1b, 0xb4, 0xd3, 0xd3, 0x85, 0xe2, 0xd5, 0xaf, 0x65, 0x87, 0x6e, 0x83, 0x77, 0xe2,
-	0x03, 0x03, 0x37, 0x0d, 0x8b, 0x6e, 0x60, 0x79, 0x6e, 0xd0, 0xcf, 0x80, 0x61, 0x7d, 0xa0, 0x42,
-	0xfc, 0xc1, 0x3f, 0x47, 0xc1, 0xb5, 0x0f, 0x7c, 0x82, 0x7d, 0xd5, 0xb6, 0x31, 0xb7, 0xed, 0xfb,
-	0xc1, 0xb6, 0x95, 0x46, 0xb6, 0xcd, 0x6b, 0xc5, 0xc8, 0xbe, 0xfd, 0x22, 0x01, 0x66, 0x1e, 0xb1,
-	0x2d, 0xfc, 0x55, 0xcf, 0xc6, 0xdc, 0x33, 0x15, 0x2c, 0xb2, 0x97, 0x0b, 0xd4, 0x6d, 0x1b, 0xce,
-	0x89, 0x57, 0xd3, 0x38, 0xad, 0xe9, 0x7a, 0x78, 0x4d, 0xf9, 0xd5, 0x36, 0xc4, 0x0e, 0x2a, 0x0b,
-	0x54, 0xba, 0x4f, 0x85, 0xbc, 0xc8, 0x5f, 0x08, 0x60, 0x09, 0x75, 0xb5, 0xa6, 0x6a, 0x35, 0x90,
-	0x5e, 0xb5, 0xeb, 0x75, 0xe4, 0xd0, 0x93, 0x9b, 0x4e, 0xdf, 0x2b, 0x2f, 0x17, 0x1f, 0xf6, 0xa4,
-	0x52, 0xe5, 0x1b, 0x23, 0xae, 0x16, 0x9b, 0x97, 0x5e, 0x81, 0x6e, 0x79, 0xa5, 0xbf, 0x18, 0x1b,
-	0x2a, 0xe2, 0x50, 0xfc, 0x80, 0x48, 0x89, 0x19, 0x45, 0xea, 0x20, 0x53, 0x35, 0x2c, 0xc3, 0x6a,
-	0x04, 0x91, 0x26, 0xc6, 0x82, 0xb4, 0x34, 0x0a, 0x69, 0x58, 0x6c, 0xa8, 0x88, 0x43, 0xb1, 0x8f,
-	0xf4, 0x4b, 0xff, 0x75, 0x21, 0x98, 0x16, 0xfd, 0xde, 0x90, 0x1c, 0x05, 0xf6, 0x69, 0x4f, 0x2a,
-	0x54, 0xee, 0x8e, 0x00, 0xbb, 0x71, 0x09, 0xd4, 0xb3, 0x6f, 0x0f, 0xe7, 0x83, 0x43, 0x65, 0xc9,
-	0x5b, 0x19, 0x82, 0x2d, 0x23, 0x24, 0x2a, 0x6c, 0x34, 0x00, 0x0a, 0x2d, 0x3f, 0x72, 0x34, 0x90,
-	0xdd, 0x3e, 0x6a, 0x2c, 0xc8, 0x0f, 0x5e, 0xfc, 0x33, 0x3d, 0xf1, 0xe2, 0x55, 0x5a, 0x78, 0xf9,
-	0x2a, 0x2d, 0xfc, 0xe3, 0x55, 0x5a, 0xf8, 0xf9, 0xeb, 0xf4, 0xc4, 0xcb, 0xd7, 0xe9, 0x89, 0xbf,
-	0xbe, 0x4e, 0x4f, 0x7c, 0xb8, 0x1e, 0xb8, 0x12, 0x86, 0x7e, 0x08, 0xee, 0x06, 0x9e, 0xe9, 0x0d,
-	0xb1, 0x16, 0xa7, 0x5f, 0x6c, 0x8b, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x80, 0x6e, 0x28,
-	0x85, 0x16, 0x00, 0x00,
+var xxx_messageInfo_SwapRoute proto.InternalMessageInfo
+
+// Position defines the liquidity provided to a concentrated liquidity pool within a price range, which is tracked
+// as its own record instead of the pool coin.
+type Position struct {
+	// id of the position
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// account address of the owner of the position
+	OwnerAddress string `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	// lower bound of the price range of the position, the price is the exchange ratio of X/Y
+	LowerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=lower_price,json=lowerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_price" yaml:"lower_price"`
+	// upper bound of the price range of the position, the price is the exchange ratio of X/Y
+	UpperPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=upper_price,json=upperPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_price" yaml:"upper_price"`
+	// liquidity of the position, active while the pool price is within the price range
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// fee growth inside the price range of the position when the position was created, or when its fees were paid out
+	FeeGrowthInsideLast FeeGrowth `protobuf:"bytes,7,opt,name=fee_growth_inside_last,json=feeGrowthInsideLast,proto3" json:"fee_growth_inside_last" yaml:"fee_growth_inside_last"`
 }
 
-func (this *PoolType) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{9}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*PoolType)
-	if !ok {
-		that2, ok := that.(PoolType)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+// FeeGrowth defines the swap fees of X and Y accrued per unit of liquidity of the concentrated liquidity pool. The fee
+// growth inside a price range is the difference of the accumulated fee growths, so it can be negative.
+type FeeGrowth struct {
+	// fee growth of X per unit of liquidity
+	X github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=x,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"x" yaml:"x"`
+	// fee growth of Y per unit of liquidity
+	Y github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=y,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"y" yaml:"y"`
+}
+
+func (m *FeeGrowth) Reset()         { *m = FeeGrowth{} }
+func (m *FeeGrowth) String() string { return proto.CompactTextString(m) }
+func (*FeeGrowth) ProtoMessage()    {}
+func (*FeeGrowth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{10}
+}
+func (m *FeeGrowth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeGrowth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeGrowth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.MinReserveCoinNum != that1.MinReserveCoinNum {
-		return false
-	}
-	if this.MaxReserveCoinNum != that1.MaxReserveCoinNum {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	return true
 }
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *FeeGrowth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeGrowth.Merge(m, src)
+}
+func (m *FeeGrowth) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeGrowth) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeGrowth.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_FeeGrowth proto.InternalMessageInfo
+
+// PriceTick defines a bound of the price ranges of the positions of the concentrated liquidity pool, with the fee
+// growth accrued while the pool price was on the other side of the bound.
+type PriceTick struct {
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// price of the bound
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// fee growth accrued while the pool price was on the other side of the bound
+	FeeGrowthOutside FeeGrowth `protobuf:"bytes,3,opt,name=fee_growth_outside,json=feeGrowthOutside,proto3" json:"fee_growth_outside" yaml:"fee_growth_outside"`
+}
+
+func (m *PriceTick) Reset()         { *m = PriceTick{} }
+func (m *PriceTick) String() string { return proto.CompactTextString(m) }
+func (*PriceTick) ProtoMessage()    {}
+func (*PriceTick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{11}
+}
+func (m *PriceTick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceTick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceTick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.PoolTypes) != len(that1.PoolTypes) {
-		return false
-	}
-	for i := range this.PoolTypes {
-		if !this.PoolTypes[i].Equal(&that1.PoolTypes[i]) {
-			return false
+}
+func (m *PriceTick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceTick.Merge(m, src)
+}
+func (m *PriceTick) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceTick) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceTick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceTick proto.InternalMessageInfo
+
+// PoolBatchResult defines the result of an executed batch of the liquidity pool, kept for the number of the latest
+// batches set by the BatchResultRetention param.
+type PoolBatchResult struct {
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// index of the executed batch
+	BatchIndex uint64 `protobuf:"varint,2,opt,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty" yaml:"batch_index"`
+	// height where the batch was executed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// results of the pairs of reserve coins whose swap orders were matched in the batch
+	SwapResults []PairSwapResult `protobuf:"bytes,4,rep,name=swap_results,json=swapResults,proto3" json:"swap_results" yaml:"swap_results"`
+	// reserve coins of the pool after the execution of the batch
+	ReserveCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=reserve_coins,json=reserveCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve_coins" yaml:"reserve_coins"`
+}
+
+func (m *PoolBatchResult) Reset()         { *m = PoolBatchResult{} }
+func (m *PoolBatchResult) String() string { return proto.CompactTextString(m) }
+func (*PoolBatchResult) ProtoMessage()    {}
+func (*PoolBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{12}
+}
+func (m *PoolBatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolBatchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.MinInitDepositAmount.Equal(that1.MinInitDepositAmount) {
-		return false
-	}
-	if !this.InitPoolCoinMintAmount.Equal(that1.InitPoolCoinMintAmount) {
-		return false
-	}
-	if !this.MaxReserveCoinAmount.Equal(that1.MaxReserveCoinAmount) {
-		return false
-	}
-	if len(this.PoolCreationFee) != len(that1.PoolCreationFee) {
-		return false
-	}
-	for i := range this.PoolCreationFee {
-		if !this.PoolCreationFee[i].Equal(&that1.PoolCreationFee[i]) {
-			return false
+}
+func (m *PoolBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolBatchResult.Merge(m, src)
+}
+func (m *PoolBatchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolBatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolBatchResult proto.InternalMessageInfo
+
+// PairSwapResult defines the swap result of a pair of reserve coins of the pool in an executed batch.
+type PairSwapResult struct {
+	// denom of the reserve coin X of the pair
+	DenomX string `protobuf:"bytes,1,opt,name=denom_x,json=denomX,proto3" json:"denom_x,omitempty" yaml:"denom_x"`
+	// denom of the reserve coin Y of the pair
+	DenomY string `protobuf:"bytes,2,opt,name=denom_y,json=denomY,proto3" json:"denom_y,omitempty" yaml:"denom_y"`
+	// clearing price of the batch, the exchange ratio of X/Y
+	SwapPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_price,json=swapPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_price" yaml:"swap_price"`
+	// amount of the coin X transacted by the X to Y swap orders
+	XToYVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=x_to_y_volume,json=xToYVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"x_to_y_volume" yaml:"x_to_y_volume"`
+	// amount of the coin Y transacted by the Y to X swap orders
+	YToXVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=y_to_x_volume,json=yToXVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"y_to_x_volume" yaml:"y_to_x_volume"`
+	// swap fees collected by the pool from the offer coins and the exchanged demand coins
+	FeeCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee_coins,json=feeCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_coins" yaml:"fee_coins"`
+}
+
+func (m *PairSwapResult) Reset()         { *m = PairSwapResult{} }
+func (m *PairSwapResult) String() string { return proto.CompactTextString(m) }
+func (*PairSwapResult) ProtoMessage()    {}
+func (*PairSwapResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{13}
+}
+func (m *PairSwapResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairSwapResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairSwapResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.SwapFeeRate.Equal(that1.SwapFeeRate) {
-		return false
-	}
-	if !this.WithdrawFeeRate.Equal(that1.WithdrawFeeRate) {
-		return false
-	}
-	if !this.MaxOrderAmountRatio.Equal(that1.MaxOrderAmountRatio) {
-		return false
-	}
-	if this.UnitBatchHeight != that1.UnitBatchHeight {
-		return false
-	}
-	if this.CircuitBreakerEnabled != that1.CircuitBreakerEnabled {
-		return false
-	}
-	return true
 }
-func (this *Pool) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *PairSwapResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairSwapResult.Merge(m, src)
+}
+func (m *PairSwapResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairSwapResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairSwapResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairSwapResult proto.InternalMessageInfo
+
+// PriceAccumulator defines the cumulative prices of the pairs of reserve coins of the liquidity pool updated at an
+// executed batch, kept for the number of the latest batches set by the PriceAccumulatorRetention param. The
+// time-weighted average price between two points of time is the difference of the cumulative prices divided by the
+// elapsed time.
+type PriceAccumulator struct {
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// index of the executed batch which updated the accumulator
+	BatchIndex uint64 `protobuf:"varint,2,opt,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty" yaml:"batch_index"`
+	// height where the accumulator was updated
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// block time when the accumulator was updated
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// cumulative prices of the pairs of reserve coins of the pool
+	PairAccumulators []PairPriceAccumulator `protobuf:"bytes,5,rep,name=pair_accumulators,json=pairAccumulators,proto3" json:"pair_accumulators" yaml:"pair_accumulators"`
+}
+
+func (m *PriceAccumulator) Reset()         { *m = PriceAccumulator{} }
+func (m *PriceAccumulator) String() string { return proto.CompactTextString(m) }
+func (*PriceAccumulator) ProtoMessage()    {}
+func (*PriceAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{14}
+}
+func (m *PriceAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *PriceAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAccumulator.Merge(m, src)
+}
+func (m *PriceAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAccumulator.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Pool)
-	if !ok {
-		that2, ok := that.(Pool)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_PriceAccumulator proto.InternalMessageInfo
+
+// PairPriceAccumulator defines the cumulative price of a pair of reserve coins of the pool.
+type PairPriceAccumulator struct {
+	// denom of the reserve coin X of the pair
+	DenomX string `protobuf:"bytes,1,opt,name=denom_x,json=denomX,proto3" json:"denom_x,omitempty" yaml:"denom_x"`
+	// denom of the reserve coin Y of the pair
+	DenomY string `protobuf:"bytes,2,opt,name=denom_y,json=denomY,proto3" json:"denom_y,omitempty" yaml:"denom_y"`
+	// pool price of the pair after the batch, the exchange ratio of X/Y in effect until the next update
+	LastPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price" yaml:"last_price"`
+	// sum of the pool prices of the pair weighted by the seconds each of them was in effect
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price" yaml:"cumulative_price"`
+}
+
+func (m *PairPriceAccumulator) Reset()         { *m = PairPriceAccumulator{} }
+func (m *PairPriceAccumulator) String() string { return proto.CompactTextString(m) }
+func (*PairPriceAccumulator) ProtoMessage()    {}
+func (*PairPriceAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{15}
+}
+func (m *PairPriceAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairPriceAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairPriceAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.TypeId != that1.TypeId {
-		return false
-	}
-	if len(this.ReserveCoinDenoms) != len(that1.ReserveCoinDenoms) {
-		return false
-	}
-	for i := range this.ReserveCoinDenoms {
-		if this.ReserveCoinDenoms[i] != that1.ReserveCoinDenoms[i] {
-			return false
-		}
-	}
-	if this.ReserveAccountAddress != that1.ReserveAccountAddress {
-		return false
-	}
-	if this.PoolCoinDenom != that1.PoolCoinDenom {
-		return false
-	}
-	return true
 }
-func (this *PoolMetadata) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PairPriceAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairPriceAccumulator.Merge(m, src)
+}
+func (m *PairPriceAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairPriceAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairPriceAccumulator.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*PoolMetadata)
-	if !ok {
-		that2, ok := that.(PoolMetadata)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PoolId != that1.PoolId {
-		return false
-	}
-	if !this.PoolCoinTotalSupply.Equal(&that1.PoolCoinTotalSupply) {
-		return false
-	}
-	if len(this.ReserveCoins) != len(that1.ReserveCoins) {
-		return false
-	}
-	for i := range this.ReserveCoins {
-		if !this.ReserveCoins[i].Equal(&that1.ReserveCoins[i]) {
-			return false
+var xxx_messageInfo_PairPriceAccumulator proto.InternalMessageInfo
+
+// RewardPlan defines the reward coins funded for the pool coin stakers of the liquidity pool, streamed in equal parts
+// at every block from the start height until the end height in proportion to the staked pool coin.
+type RewardPlan struct {
+	// id of the reward plan
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// account address of the funder of the reward plan, refunded the reward coins not distributed
+	FunderAddress string `protobuf:"bytes,3,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty" yaml:"funder_address"`
+	// reward coins funded for the reward plan
+	RewardCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=reward_coins,json=rewardCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_coins" yaml:"reward_coins"`
+	// reward coins distributed to the pool coin stakers so far
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins" yaml:"distributed_coins"`
+	// height of the first block distributing the reward coins
+	StartHeight int64 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// height of the block after the last block distributing the reward coins
+	EndHeight int64 `protobuf:"varint,7,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// reward coins truncated from the distributions of the reward plan, whose whole part is refunded to the funder
+	// when the reward plan ends
+	TruncatedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,8,rep,name=truncated_rewards,json=truncatedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"truncated_rewards" yaml:"truncated_rewards"`
+}
+
+func (m *RewardPlan) Reset()         { *m = RewardPlan{} }
+func (m *RewardPlan) String() string { return proto.CompactTextString(m) }
+func (*RewardPlan) ProtoMessage()    {}
+func (*RewardPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{16}
+}
+func (m *RewardPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *PoolBatch) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RewardPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPlan.Merge(m, src)
+}
+func (m *RewardPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPlan.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*PoolBatch)
-	if !ok {
-		that2, ok := that.(PoolBatch)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_RewardPlan proto.InternalMessageInfo
+
+// RewardAccumulator defines the pool coin staked to the liquidity pool and the reward coins distributed per unit of
+// the staked pool coin since the first stake.
+type RewardAccumulator struct {
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// total amount of the pool coin staked to the pool
+	TotalStaked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_staked,json=totalStaked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_staked" yaml:"total_staked"`
+	// cumulative reward coins distributed per unit of the staked pool coin
+	RewardPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=reward_per_share,json=rewardPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_share" yaml:"reward_per_share"`
+	// reward coins truncated from the payouts of the rewards and the decimals left over by the ended reward plans,
+	// which are not refunded to any funder
+	TruncatedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=truncated_rewards,json=truncatedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"truncated_rewards" yaml:"truncated_rewards"`
+}
+
+func (m *RewardAccumulator) Reset()         { *m = RewardAccumulator{} }
+func (m *RewardAccumulator) String() string { return proto.CompactTextString(m) }
+func (*RewardAccumulator) ProtoMessage()    {}
+func (*RewardAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{17}
+}
+func (m *RewardAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PoolId != that1.PoolId {
-		return false
-	}
-	if this.Index != that1.Index {
-		return false
-	}
-	if this.BeginHeight != that1.BeginHeight {
-		return false
-	}
-	if this.DepositMsgIndex != that1.DepositMsgIndex {
-		return false
-	}
-	if this.WithdrawMsgIndex != that1.WithdrawMsgIndex {
-		return false
-	}
-	if this.SwapMsgIndex != that1.SwapMsgIndex {
-		return false
-	}
-	if this.Executed != that1.Executed {
-		return false
-	}
-	return true
 }
-func (m *PoolType) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RewardAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardAccumulator.Merge(m, src)
+}
+func (m *RewardAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardAccumulator.DiscardUnknown(m)
 }
 
-func (m *PoolType) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RewardAccumulator proto.InternalMessageInfo
+
+// Stake defines the pool coin of the liquidity pool staked by an account for the rewards of the reward plans.
+type Stake struct {
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// account address of the staker
+	StakerAddress string `protobuf:"bytes,2,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty" yaml:"staker_address"`
+	// amount of the staked pool coin
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	// reward per share of the reward accumulator of the pool when the rewards of the stake were last claimed
+	RewardPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=reward_per_share,json=rewardPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_share" yaml:"reward_per_share"`
 }
 
-func (m *PoolType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.MaxReserveCoinNum != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxReserveCoinNum))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MinReserveCoinNum != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MinReserveCoinNum))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *Stake) Reset()         { *m = Stake{} }
+func (m *Stake) String() string { return proto.CompactTextString(m) }
+func (*Stake) ProtoMessage()    {}
+func (*Stake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{18}
+}
+func (m *Stake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Stake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stake.Merge(m, src)
+}
+func (m *Stake) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stake) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stake.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Stake proto.InternalMessageInfo
+
+// Bond defines the pool coin locked by the owner for a bond duration. The pool coin is returned to the owner the bond
+// duration after the unbonding of the bond begins.
+type Bond struct {
+	// id of the bond
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	// account address of the owner of the bond
+	OwnerAddress string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	// pool coin locked by the bond
+	PoolCoin types.Coin `protobuf:"bytes,3,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin" yaml:"pool_coin"`
+	// bond duration, which is the unbonding period of the bond
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// time when the pool coin is returned to the owner, zero until the unbonding of the bond begins
+	UnbondTime time.Time `protobuf:"bytes,5,opt,name=unbond_time,json=unbondTime,proto3,stdtime" json:"unbond_time" yaml:"unbond_time"`
+}
+
+func (m *Bond) Reset()         { *m = Bond{} }
+func (m *Bond) String() string { return proto.CompactTextString(m) }
+func (*Bond) ProtoMessage()    {}
+func (*Bond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{19}
+}
+func (m *Bond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Bond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bond.Merge(m, src)
+}
+func (m *Bond) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bond) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bond.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Bond proto.InternalMessageInfo
+
+// CollectedProtocolFees defines the total protocol fees collected from the fees of a liquidity pool and sent to the
+// community pool.
+type CollectedProtocolFees struct {
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// total protocol fees collected from the swap fees of the pool
+	SwapFeeCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=swap_fee_coins,json=swapFeeCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fee_coins" yaml:"swap_fee_coins"`
+	// total protocol fees collected from the withdraw fees of the pool
+	WithdrawFeeCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdraw_fee_coins,json=withdrawFeeCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdraw_fee_coins" yaml:"withdraw_fee_coins"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CircuitBreakerEnabled {
-		i--
-		if m.CircuitBreakerEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *CollectedProtocolFees) Reset()         { *m = CollectedProtocolFees{} }
+func (m *CollectedProtocolFees) String() string { return proto.CompactTextString(m) }
+func (*CollectedProtocolFees) ProtoMessage()    {}
+func (*CollectedProtocolFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{20}
+}
+func (m *CollectedProtocolFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectedProtocolFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectedProtocolFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.UnitBatchHeight != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.UnitBatchHeight))
-		i--
-		dAtA[i] = 0x48
+		return b[:n], nil
 	}
-	{
-		size := m.MaxOrderAmountRatio.Size()
-		i -= size
-		if _, err := m.MaxOrderAmountRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.WithdrawFeeRate.Size()
-		i -= size
-		if _, err := m.WithdrawFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.SwapFeeRate.Size()
-		i -= size
-		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.PoolCreationFee) > 0 {
-		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	{
-		size := m.MaxReserveCoinAmount.Size()
-		i -= size
-		if _, err := m.MaxReserveCoinAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.InitPoolCoinMintAmount.Size()
-		i -= size
-		if _, err := m.InitPoolCoinMintAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MinInitDepositAmount.Size()
-		i -= size
-		if _, err := m.MinInitDepositAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.PoolTypes) > 0 {
-		for iNdEx := len(m.PoolTypes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Pool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CollectedProtocolFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectedProtocolFees.Merge(m, src)
+}
+func (m *CollectedProtocolFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectedProtocolFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectedProtocolFees.DiscardUnknown(m)
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CollectedProtocolFees proto.InternalMessageInfo
+
+// PoolFeeRate defines the swap fee rate and the withdraw fee rate of a liquidity pool set by governance, overriding
+// the swap_fee_rate and withdraw_fee_rate params for the pool.
+type PoolFeeRate struct {
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// swap fee rate of the pool
+	SwapFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate" yaml:"swap_fee_rate"`
+	// withdraw fee rate of the pool
+	WithdrawFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=withdraw_fee_rate,json=withdrawFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"withdraw_fee_rate" yaml:"withdraw_fee_rate"`
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolCoinDenom) > 0 {
-		i -= len(m.PoolCoinDenom)
-		copy(dAtA[i:], m.PoolCoinDenom)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.PoolCoinDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ReserveAccountAddress) > 0 {
-		i -= len(m.ReserveAccountAddress)
-		copy(dAtA[i:], m.ReserveAccountAddress)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ReserveAccountAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ReserveCoinDenoms) > 0 {
-		for iNdEx := len(m.ReserveCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ReserveCoinDenoms[iNdEx])
-			copy(dAtA[i:], m.ReserveCoinDenoms[iNdEx])
-			i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ReserveCoinDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *PoolFeeRate) Reset()         { *m = PoolFeeRate{} }
+func (m *PoolFeeRate) String() string { return proto.CompactTextString(m) }
+func (*PoolFeeRate) ProtoMessage()    {}
+func (*PoolFeeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{21}
+}
+func (m *PoolFeeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolFeeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolFeeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TypeId != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.TypeId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PoolMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PoolFeeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolFeeRate.Merge(m, src)
+}
+func (m *PoolFeeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolFeeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolFeeRate.DiscardUnknown(m)
 }
 
-func (m *PoolMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PoolFeeRate proto.InternalMessageInfo
+
+// PoolFeeRatesProposal defines a governance proposal to set the swap fee rates and the withdraw fee rates of
+// individual liquidity pools within the min_pool_fee_rate and max_pool_fee_rate params.
+type PoolFeeRatesProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// fee rates to set for the pools
+	PoolFeeRates []PoolFeeRate `protobuf:"bytes,3,rep,name=pool_fee_rates,json=poolFeeRates,proto3" json:"pool_fee_rates" yaml:"pool_fee_rates"`
 }
 
-func (m *PoolMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReserveCoins) > 0 {
-		for iNdEx := len(m.ReserveCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReserveCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size, err := m.PoolCoinTotalSupply.MarshalToSizedBuffer(dAtA[:i])
+func (m *PoolFeeRatesProposal) Reset()      { *m = PoolFeeRatesProposal{} }
+func (*PoolFeeRatesProposal) ProtoMessage() {}
+func (*PoolFeeRatesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{22}
+}
+func (m *PoolFeeRatesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolFeeRatesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolFeeRatesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.PoolId != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PoolBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PoolFeeRatesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolFeeRatesProposal.Merge(m, src)
+}
+func (m *PoolFeeRatesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolFeeRatesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolFeeRatesProposal.DiscardUnknown(m)
 }
 
-func (m *PoolBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PoolFeeRatesProposal proto.InternalMessageInfo
+
+// PoolFeeRatesProposalWithDeposit defines a PoolFeeRatesProposal with a deposit, used to submit the proposal from a
+// JSON file.
+type PoolFeeRatesProposalWithDeposit struct {
+	Title        string        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description  string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PoolFeeRates []PoolFeeRate `protobuf:"bytes,3,rep,name=pool_fee_rates,json=poolFeeRates,proto3" json:"pool_fee_rates" yaml:"pool_fee_rates"`
+	Deposit      string        `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
 }
 
-func (m *PoolBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Executed {
-		i--
-		if m.Executed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *PoolFeeRatesProposalWithDeposit) Reset()         { *m = PoolFeeRatesProposalWithDeposit{} }
+func (m *PoolFeeRatesProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*PoolFeeRatesProposalWithDeposit) ProtoMessage()    {}
+func (*PoolFeeRatesProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{23}
+}
+func (m *PoolFeeRatesProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolFeeRatesProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolFeeRatesProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SwapMsgIndex != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.SwapMsgIndex))
-		i--
-		dAtA[i] = 0x30
+		return b[:n], nil
 	}
-	if m.WithdrawMsgIndex != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.WithdrawMsgIndex))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.DepositMsgIndex != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.DepositMsgIndex))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.BeginHeight != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.BeginHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Index != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DepositMsgState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PoolFeeRatesProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolFeeRatesProposalWithDeposit.Merge(m, src)
+}
+func (m *PoolFeeRatesProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolFeeRatesProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolFeeRatesProposalWithDeposit.DiscardUnknown(m)
 }
 
-func (m *DepositMsgState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PoolFeeRatesProposalWithDeposit proto.InternalMessageInfo
+
+// DynamicSwapFee defines the swap fee rate of a liquidity pool raised by the price volatility of its latest batches,
+// updated at each executed batch of the pool.
+type DynamicSwapFee struct {
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// index of the executed batch which updated the swap fee rate
+	BatchIndex uint64 `protobuf:"varint,2,opt,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty" yaml:"batch_index"`
+	// relative range of the clearing prices of the latest batch results of the pool
+	Volatility github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=volatility,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility" yaml:"volatility"`
+	// swap fee rate of the pool for the following batches
+	SwapFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate" yaml:"swap_fee_rate"`
 }
 
-func (m *DepositMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Msg != nil {
-		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+func (m *DynamicSwapFee) Reset()         { *m = DynamicSwapFee{} }
+func (m *DynamicSwapFee) String() string { return proto.CompactTextString(m) }
+func (*DynamicSwapFee) ProtoMessage()    {}
+func (*DynamicSwapFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{24}
+}
+func (m *DynamicSwapFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSwapFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSwapFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if m.ToBeDeleted {
-		i--
-		if m.ToBeDeleted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *DynamicSwapFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSwapFee.Merge(m, src)
+}
+func (m *DynamicSwapFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSwapFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSwapFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSwapFee proto.InternalMessageInfo
+
+// CircuitBreaker defines a circuit breaker halting a type of messages of a liquidity pool, or of all liquidity pools
+// if pool_id is zero, or all the types of messages the circuit breaker applies to if msg_type is empty.
+type CircuitBreaker struct {
+	// id of the pool, all pools if zero
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// type of the messages, all types if empty
+	MsgType string `protobuf:"bytes,2,opt,name=msg_type,json=msgType,proto3" json:"msg_type" yaml:"msg_type"`
+	// whether the circuit breaker halts the messages
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled" yaml:"enabled"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{25}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
+		return b[:n], nil
 	}
-	if m.Succeeded {
-		i--
-		if m.Succeeded {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+// CircuitBreakerProposal defines a governance proposal to enable or disable circuit breakers.
+type CircuitBreakerProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// circuit breakers to enable or disable
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,3,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers" yaml:"circuit_breakers"`
+}
+
+func (m *CircuitBreakerProposal) Reset()      { *m = CircuitBreakerProposal{} }
+func (*CircuitBreakerProposal) ProtoMessage() {}
+func (*CircuitBreakerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{26}
+}
+func (m *CircuitBreakerProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if m.Executed {
-		i--
-		if m.Executed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *CircuitBreakerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerProposal.Merge(m, src)
+}
+func (m *CircuitBreakerProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerProposal proto.InternalMessageInfo
+
+// CircuitBreakerProposalWithDeposit defines a CircuitBreakerProposal with a deposit, used to submit the proposal from
+// a JSON file.
+type CircuitBreakerProposalWithDeposit struct {
+	Title           string           `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description     string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,3,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers" yaml:"circuit_breakers"`
+	Deposit         string           `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *CircuitBreakerProposalWithDeposit) Reset()         { *m = CircuitBreakerProposalWithDeposit{} }
+func (m *CircuitBreakerProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerProposalWithDeposit) ProtoMessage()    {}
+func (*CircuitBreakerProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{27}
+}
+func (m *CircuitBreakerProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MsgIndex != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.MsgHeight != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CircuitBreakerProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerProposalWithDeposit.Merge(m, src)
+}
+func (m *CircuitBreakerProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerProposalWithDeposit.DiscardUnknown(m)
 }
 
-func (m *WithdrawMsgState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_CircuitBreakerProposalWithDeposit proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*PoolType)(nil), "tendermint.liquidity.v1beta1.PoolType")
+	proto.RegisterType((*Params)(nil), "tendermint.liquidity.v1beta1.Params")
+	proto.RegisterType((*Pool)(nil), "tendermint.liquidity.v1beta1.Pool")
+	proto.RegisterType((*PoolMetadata)(nil), "tendermint.liquidity.v1beta1.PoolMetadata")
+	proto.RegisterType((*PoolBatch)(nil), "tendermint.liquidity.v1beta1.PoolBatch")
+	proto.RegisterType((*DepositMsgState)(nil), "tendermint.liquidity.v1beta1.DepositMsgState")
+	proto.RegisterType((*WithdrawMsgState)(nil), "tendermint.liquidity.v1beta1.WithdrawMsgState")
+	proto.RegisterType((*SwapMsgState)(nil), "tendermint.liquidity.v1beta1.SwapMsgState")
+	proto.RegisterType((*SwapRoute)(nil), "tendermint.liquidity.v1beta1.SwapRoute")
+	proto.RegisterType((*Position)(nil), "tendermint.liquidity.v1beta1.Position")
+	proto.RegisterType((*FeeGrowth)(nil), "tendermint.liquidity.v1beta1.FeeGrowth")
+	proto.RegisterType((*PriceTick)(nil), "tendermint.liquidity.v1beta1.PriceTick")
+	proto.RegisterType((*PoolBatchResult)(nil), "tendermint.liquidity.v1beta1.PoolBatchResult")
+	proto.RegisterType((*PairSwapResult)(nil), "tendermint.liquidity.v1beta1.PairSwapResult")
+	proto.RegisterType((*PriceAccumulator)(nil), "tendermint.liquidity.v1beta1.PriceAccumulator")
+	proto.RegisterType((*PairPriceAccumulator)(nil), "tendermint.liquidity.v1beta1.PairPriceAccumulator")
+	proto.RegisterType((*RewardPlan)(nil), "tendermint.liquidity.v1beta1.RewardPlan")
+	proto.RegisterType((*RewardAccumulator)(nil), "tendermint.liquidity.v1beta1.RewardAccumulator")
+	proto.RegisterType((*Stake)(nil), "tendermint.liquidity.v1beta1.Stake")
+	proto.RegisterType((*Bond)(nil), "tendermint.liquidity.v1beta1.Bond")
+	proto.RegisterType((*CollectedProtocolFees)(nil), "tendermint.liquidity.v1beta1.CollectedProtocolFees")
+	proto.RegisterType((*PoolFeeRate)(nil), "tendermint.liquidity.v1beta1.PoolFeeRate")
+	proto.RegisterType((*PoolFeeRatesProposal)(nil), "tendermint.liquidity.v1beta1.PoolFeeRatesProposal")
+	proto.RegisterType((*PoolFeeRatesProposalWithDeposit)(nil), "tendermint.liquidity.v1beta1.PoolFeeRatesProposalWithDeposit")
+	proto.RegisterType((*DynamicSwapFee)(nil), "tendermint.liquidity.v1beta1.DynamicSwapFee")
+	proto.RegisterType((*CircuitBreaker)(nil), "tendermint.liquidity.v1beta1.CircuitBreaker")
+	proto.RegisterType((*CircuitBreakerProposal)(nil), "tendermint.liquidity.v1beta1.CircuitBreakerProposal")
+	proto.RegisterType((*CircuitBreakerProposalWithDeposit)(nil), "tendermint.liquidity.v1beta1.CircuitBreakerProposalWithDeposit")
 }
 
-func (m *WithdrawMsgState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("tendermint/liquidity/v1beta1/liquidity.proto", fileDescriptor_714a3e326c5b7d34)
 }
 
-func (m *WithdrawMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Msg != nil {
-		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidity(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+var fileDescriptor_714a3e326c5b7d34 = []byte{
+	// 4538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x5b, 0x6c, 0x1b, 0x57,
+	0x76, 0x1e, 0x3e, 0x24, 0xf2, 0xea, 0x3d, 0x7a, 0x98, 0xf2, 0x43, 0xa4, 0x6f, 0xe2, 0xc4, 0x9b,
+	0xd8, 0x12, 0x45, 0x3d, 0x2c, 0x79, 0xf7, 0x63, 0x87, 0x92, 0x15, 0x9b, 0x88, 0x6b, 0xf5, 0xda,
+	0x4d, 0x62, 0x6b, 0x1d, 0xee, 0x88, 0x73, 0x29, 0x4d, 0x4c, 0xce, 0xd0, 0x33, 0x43, 0x89, 0x4c,
+	0xb1, 0x0b, 0xef, 0x6e, 0x0b, 0x64, 0x77, 0xdb, 0x34, 0x20, 0x50, 0x60, 0xbb, 0x41, 0xdb, 0xd4,
+	0xc0, 0x76, 0xd1, 0x2e, 0xf6, 0xab, 0x28, 0x0a, 0xf4, 0xab, 0xbb, 0x2d, 0x8a, 0xa0, 0x2d, 0x8a,
+	0xb4, 0x28, 0xda, 0xa2, 0x1f, 0x4a, 0x9b, 0xa0, 0x40, 0xb1, 0x28, 0xfa, 0xa1, 0x8f, 0x7e, 0x17,
+	0xf7, 0xc5, 0x99, 0x21, 0x47, 0xa2, 0x2c, 0xd3, 0x4e, 0x9b, 0xae, 0x7f, 0xcc, 0xb9, 0xf7, 0x9e,
+	0xc7, 0x3d, 0xe7, 0xdc, 0x73, 0xcf, 0x39, 0xf7, 0x5e, 0x81, 0x8b, 0x0e, 0x36, 0x34, 0x6c, 0x95,
+	0x75, 0xc3, 0x99, 0x29, 0xe9, 0x0f, 0xaa, 0xba, 0xa6, 0x3b, 0xf5, 0x99, 0x9d, 0xd9, 0x4d, 0xec,
+	0xa8, 0xb3, 0x6e, 0xcb, 0x74, 0xc5, 0x32, 0x1d, 0x53, 0x3e, 0xe3, 0x8e, 0x9e, 0x76, 0xfb, 0xf8,
+	0xe8, 0x53, 0xe7, 0x0f, 0xc5, 0xe5, 0xd4, 0x18, 0x92, 0x53, 0x63, 0x5b, 0xe6, 0x96, 0x49, 0x7f,
+	0xce, 0x90, 0x5f, 0xbc, 0xf5, 0x64, 0xc1, 0xb4, 0xcb, 0xa6, 0x9d, 0x67, 0x1d, 0x05, 0x53, 0x37,
+	0x78, 0x47, 0x72, 0xcb, 0x34, 0xb7, 0x4a, 0x78, 0x86, 0x7e, 0x6d, 0x56, 0x8b, 0x33, 0x8e, 0x5e,
+	0xc6, 0xb6, 0xa3, 0x96, 0x2b, 0x7c, 0xc0, 0x54, 0xeb, 0x00, 0xad, 0x6a, 0xa9, 0x8e, 0x6e, 0x0a,
+	0x04, 0xec, 0xbf, 0xc2, 0xa5, 0x2d, 0x6c, 0x5c, 0x32, 0x2b, 0xd8, 0x50, 0x2b, 0xfa, 0x4e, 0x66,
+	0xc6, 0xac, 0x90, 0x21, 0xf6, 0x8c, 0x6a, 0x18, 0xa6, 0x43, 0x87, 0xdb, 0x6c, 0x20, 0x7c, 0x27,
+	0x0c, 0x62, 0xeb, 0xa6, 0x59, 0xba, 0x5d, 0xaf, 0x60, 0x79, 0x1a, 0x84, 0x74, 0x2d, 0x21, 0xa5,
+	0xa4, 0x0b, 0x03, 0xd9, 0xa9, 0x86, 0x32, 0x98, 0x0b, 0xc3, 0x59, 0xf8, 0x28, 0xd4, 0x53, 0xd5,
+	0x0d, 0x67, 0x2e, 0xb3, 0xbf, 0x97, 0x8c, 0xd7, 0xd5, 0x72, 0xe9, 0x0a, 0xd4, 0x35, 0x88, 0x42,
+	0xba, 0x26, 0xaf, 0x81, 0x88, 0xa1, 0x96, 0x71, 0x22, 0x94, 0x92, 0x2e, 0xc4, 0xb3, 0x99, 0x86,
+	0x92, 0xca, 0x4d, 0xc1, 0x15, 0xd3, 0xb0, 0x1d, 0xd5, 0x70, 0xd6, 0x2d, 0x53, 0xab, 0x16, 0x9c,
+	0x57, 0x85, 0x6c, 0x08, 0x15, 0xb8, 0xbf, 0x97, 0xec, 0x63, 0x38, 0x08, 0x20, 0x44, 0x14, 0x5e,
+	0x56, 0xc1, 0x58, 0x59, 0x37, 0xf2, 0x16, 0xb6, 0xb1, 0xb5, 0x83, 0xf3, 0x44, 0x1e, 0x79, 0xa3,
+	0x5a, 0x4e, 0x84, 0x29, 0x27, 0x69, 0xc6, 0x49, 0xc6, 0xc7, 0xc9, 0x69, 0x86, 0x25, 0x08, 0x0c,
+	0xa2, 0x91, 0xb2, 0x6e, 0x20, 0xd6, 0xba, 0x62, 0xea, 0xc6, 0x2f, 0x54, 0xcb, 0x94, 0x84, 0x5a,
+	0x6b, 0x27, 0x11, 0xe9, 0x4c, 0x42, 0xad, 0x05, 0x92, 0x50, 0x6b, 0x2d, 0x24, 0x96, 0x40, 0x9f,
+	0x86, 0xed, 0x82, 0xa5, 0x53, 0x61, 0x27, 0xa2, 0x54, 0x28, 0x13, 0xfb, 0x7b, 0x49, 0x99, 0x21,
+	0xf2, 0x74, 0x42, 0xe4, 0x1d, 0x7a, 0x25, 0xf2, 0x1f, 0x1f, 0x24, 0x25, 0xf8, 0x30, 0x05, 0x7a,
+	0xd6, 0x55, 0x4b, 0x2d, 0xdb, 0xf2, 0x57, 0x01, 0xa8, 0x98, 0x66, 0x29, 0xef, 0xd4, 0x2b, 0xd8,
+	0x4e, 0x48, 0xa9, 0xf0, 0x85, 0xbe, 0xcc, 0x0b, 0xd3, 0x87, 0xd9, 0xe3, 0xb4, 0x50, 0x62, 0x76,
+	0xf2, 0xc3, 0xbd, 0xe4, 0x89, 0xfd, 0xbd, 0xe4, 0x08, 0xa3, 0xea, 0xe2, 0x81, 0x28, 0x5e, 0xe1,
+	0x83, 0x6c, 0xf9, 0x77, 0x25, 0x70, 0x92, 0x08, 0x4f, 0x37, 0x74, 0x27, 0xaf, 0xe1, 0x8a, 0x69,
+	0xeb, 0x4e, 0x5e, 0x2d, 0x9b, 0x55, 0xc3, 0xe1, 0xea, 0xdc, 0x6e, 0x28, 0xe3, 0xb9, 0x38, 0x9c,
+	0x4d, 0xd3, 0x7f, 0xf0, 0x51, 0xa8, 0xd7, 0xd6, 0xee, 0x4f, 0x5f, 0x37, 0x1c, 0x82, 0xff, 0x5f,
+	0xf6, 0x92, 0x2f, 0x6c, 0xe9, 0xce, 0x76, 0x75, 0x73, 0xba, 0x60, 0x96, 0x67, 0x98, 0x39, 0xf3,
+	0xff, 0x2e, 0xd9, 0xda, 0xfd, 0x19, 0x4a, 0x91, 0x8c, 0xde, 0xdf, 0x4b, 0x4e, 0xb9, 0xba, 0x0a,
+	0x20, 0x07, 0x11, 0x51, 0xfe, 0x75, 0x43, 0x77, 0x56, 0x59, 0xbb, 0x42, 0x9b, 0xe5, 0x1f, 0x4a,
+	0xe0, 0x14, 0x1d, 0x4e, 0x67, 0x40, 0x25, 0x4f, 0xa6, 0x2e, 0x98, 0x0c, 0x53, 0x26, 0xef, 0x77,
+	0x8d, 0xc9, 0x73, 0xdc, 0xb4, 0x0f, 0xa4, 0x08, 0xd1, 0x04, 0xe9, 0x24, 0x72, 0x26, 0x1a, 0xbf,
+	0xa1, 0x1b, 0x82, 0xd3, 0x1f, 0x10, 0x59, 0xb6, 0x5a, 0x09, 0x67, 0x33, 0x42, 0xd9, 0x34, 0x1a,
+	0xca, 0xe9, 0xdc, 0x90, 0x60, 0xb3, 0x7b, 0x12, 0x0d, 0x26, 0x4a, 0x24, 0xea, 0xb3, 0x4e, 0xce,
+	0xe7, 0x47, 0x12, 0x18, 0x61, 0x53, 0xb3, 0x30, 0x75, 0x02, 0xf9, 0x22, 0xc6, 0x89, 0x28, 0xb5,
+	0xae, 0xc9, 0x69, 0x46, 0x6a, 0x7a, 0x53, 0xb5, 0x71, 0xd3, 0xa8, 0x08, 0x70, 0xf6, 0x1d, 0xa9,
+	0xa1, 0x2c, 0xe7, 0x5e, 0xde, 0xf8, 0x65, 0xa8, 0x61, 0xc3, 0x2c, 0xc3, 0x2b, 0x29, 0x58, 0x55,
+	0x1d, 0xb3, 0x0c, 0x2f, 0xa6, 0x20, 0x27, 0x78, 0x25, 0xe5, 0xce, 0x0d, 0x7e, 0xed, 0xde, 0xa3,
+	0x50, 0x9c, 0xcc, 0x8c, 0x40, 0xdb, 0xdc, 0x1a, 0x13, 0x1e, 0x6b, 0xf4, 0x92, 0x87, 0x7f, 0xf8,
+	0x71, 0xf2, 0xc2, 0x11, 0xe6, 0x4d, 0x71, 0xa1, 0x21, 0x02, 0xbf, 0xc2, 0xc1, 0xd7, 0x30, 0x96,
+	0x1f, 0x4a, 0x60, 0xc0, 0xde, 0x55, 0x2b, 0x04, 0x55, 0xde, 0x52, 0x1d, 0x9c, 0xe8, 0xa1, 0x02,
+	0xff, 0x4a, 0x43, 0x19, 0xcd, 0xf5, 0xc2, 0xf4, 0x74, 0x3a, 0x3d, 0x27, 0x04, 0xbd, 0x8a, 0x0b,
+	0x8f, 0x21, 0xe8, 0x55, 0x5c, 0xd8, 0xdf, 0x4b, 0x8e, 0x31, 0xb6, 0x7d, 0x24, 0x20, 0xea, 0x23,
+	0xdf, 0x6b, 0x18, 0x23, 0xd5, 0xc1, 0xf2, 0xaf, 0x49, 0x60, 0x64, 0x57, 0x77, 0xb6, 0x35, 0x4b,
+	0xdd, 0x75, 0xd9, 0xe8, 0xa5, 0x6c, 0x7c, 0xb5, 0x4b, 0x6c, 0x70, 0xe9, 0xb5, 0x91, 0x81, 0x68,
+	0x48, 0xb4, 0x09, 0x76, 0xbe, 0x2f, 0x81, 0x09, 0x62, 0x17, 0xa6, 0xa5, 0x61, 0x8b, 0x1b, 0x44,
+	0x9e, 0x6e, 0x11, 0x89, 0x18, 0xe5, 0x09, 0x77, 0x89, 0xa7, 0xb3, 0xae, 0x0d, 0xb6, 0xd3, 0x82,
+	0x68, 0xb4, 0xac, 0xd6, 0x6e, 0x92, 0x76, 0x66, 0x7c, 0x88, 0xb4, 0xca, 0x77, 0xc0, 0x48, 0x95,
+	0x2c, 0xb0, 0x4d, 0xd5, 0x29, 0x6c, 0xe7, 0xb7, 0xb1, 0xbe, 0xb5, 0xed, 0x24, 0xe2, 0xd4, 0x05,
+	0x5f, 0x0a, 0xda, 0x6f, 0xf8, 0xbc, 0xdb, 0x60, 0x20, 0x1a, 0x22, 0x6d, 0x59, 0xd2, 0x74, 0x8d,
+	0xb6, 0xc8, 0x65, 0x70, 0xb2, 0xa0, 0x5b, 0x85, 0x2a, 0x19, 0x69, 0x61, 0xf5, 0x3e, 0xb6, 0xf2,
+	0xd8, 0x50, 0x37, 0x4b, 0x58, 0x4b, 0x80, 0x94, 0x74, 0x21, 0x96, 0x5d, 0x68, 0x28, 0xc3, 0xb9,
+	0x5e, 0x58, 0x54, 0x4b, 0x36, 0x86, 0x8f, 0x42, 0x91, 0x4d, 0xd3, 0x2c, 0xb9, 0x4b, 0xe9, 0x00,
+	0x58, 0x88, 0xc6, 0x79, 0x4f, 0x96, 0x75, 0x5c, 0x65, 0xed, 0xb2, 0x0d, 0x26, 0x6d, 0x87, 0xfc,
+	0xcc, 0x53, 0xdb, 0x50, 0xcb, 0x95, 0x92, 0x5e, 0xd4, 0x0b, 0xd4, 0x30, 0x13, 0x7d, 0x74, 0x46,
+	0x97, 0x09, 0xc1, 0x28, 0x59, 0x18, 0xbe, 0x39, 0xa5, 0xb8, 0x49, 0x1d, 0x04, 0x0d, 0xd1, 0x49,
+	0xd6, 0x77, 0x6b, 0x57, 0xad, 0x28, 0xde, 0x1e, 0xf9, 0x4d, 0x20, 0xbb, 0xe2, 0x2e, 0xe9, 0x45,
+	0x6c, 0x57, 0x54, 0x23, 0xd1, 0x2f, 0xb6, 0xb0, 0x20, 0x6a, 0x93, 0xad, 0x5a, 0x12, 0x60, 0x10,
+	0x0d, 0x0b, 0x0d, 0xbd, 0xca, 0x9b, 0xe4, 0xb7, 0xc0, 0x04, 0x93, 0xb2, 0x85, 0xed, 0x6a, 0xc9,
+	0xc9, 0x5b, 0xd8, 0xc1, 0x06, 0x9d, 0xd1, 0x00, 0xa5, 0x31, 0x1f, 0x4c, 0x83, 0x5b, 0x42, 0x30,
+	0x28, 0x44, 0x63, 0xb4, 0x03, 0xd1, 0x76, 0x24, 0x9a, 0xe5, 0xb7, 0xc1, 0xe9, 0x8a, 0xa5, 0x17,
+	0x70, 0x5e, 0x2d, 0x14, 0xaa, 0xe5, 0x6a, 0x49, 0x75, 0x4c, 0xcb, 0x43, 0x70, 0x90, 0x12, 0xbc,
+	0xd2, 0x50, 0x46, 0x72, 0x3d, 0xd4, 0xb7, 0xf8, 0x28, 0x42, 0xee, 0x4d, 0x0e, 0x46, 0x00, 0xd1,
+	0x24, 0xed, 0x55, 0xdc, 0x4e, 0x97, 0xf6, 0xa7, 0x12, 0x48, 0x58, 0x78, 0x57, 0xb5, 0xb4, 0x7c,
+	0xa5, 0xa4, 0x1a, 0x7e, 0x7f, 0x38, 0xd4, 0xc9, 0x1f, 0xbe, 0x2b, 0x35, 0x94, 0xa5, 0xdc, 0x4b,
+	0x47, 0xf4, 0x87, 0xc1, 0xee, 0x30, 0xc9, 0x26, 0x70, 0x10, 0x13, 0x8f, 0xe7, 0x15, 0xc7, 0x19,
+	0x9a, 0xf5, 0x92, 0x6a, 0x78, 0x7d, 0xe3, 0xfb, 0x12, 0x18, 0xdc, 0x34, 0x0d, 0x2d, 0x2f, 0x42,
+	0x44, 0x3b, 0x31, 0xcc, 0xe7, 0xc6, 0x82, 0xc8, 0x69, 0x11, 0x44, 0x4e, 0xaf, 0xf2, 0x11, 0xd9,
+	0x3b, 0x0d, 0x65, 0x21, 0x77, 0x6e, 0x03, 0x2e, 0x2d, 0xce, 0xa7, 0xd3, 0x36, 0x99, 0xd1, 0x62,
+	0x7a, 0x7e, 0x89, 0xff, 0x9c, 0xcd, 0xa4, 0x97, 0x17, 0xc9, 0xef, 0x7b, 0x8f, 0x42, 0x43, 0x1b,
+	0xf7, 0x48, 0x68, 0xda, 0x84, 0xe4, 0xf3, 0x1a, 0xe7, 0xa6, 0xe0, 0x23, 0x0b, 0xbf, 0xf7, 0x71,
+	0x52, 0x42, 0x03, 0xa4, 0x51, 0x0c, 0xb7, 0xe5, 0xef, 0x90, 0xcd, 0x88, 0xc6, 0xaa, 0x66, 0xc9,
+	0x75, 0x9b, 0x23, 0xd4, 0x45, 0xbd, 0x49, 0xd4, 0x1e, 0x85, 0xe9, 0xe9, 0xd9, 0x2e, 0x38, 0xcd,
+	0x36, 0x22, 0x10, 0x0d, 0x89, 0x36, 0xe1, 0x34, 0xbf, 0x0e, 0xce, 0x36, 0x7d, 0xab, 0x6f, 0xbc,
+	0x70, 0x21, 0x32, 0x75, 0x21, 0x5f, 0x0c, 0x76, 0x21, 0xcf, 0xb7, 0x78, 0xe7, 0x20, 0x0c, 0x10,
+	0x9d, 0x12, 0xfd, 0xeb, 0x2e, 0x71, 0xe1, 0x4d, 0xbe, 0x2f, 0x01, 0x12, 0xb3, 0xb2, 0xc0, 0xa3,
+	0x29, 0x8c, 0x51, 0x2a, 0x0c, 0xb3, 0xa1, 0xc0, 0xdc, 0x04, 0xf5, 0xd7, 0xad, 0xff, 0xba, 0x20,
+	0x9d, 0x36, 0xaa, 0x10, 0x0d, 0x96, 0x75, 0x63, 0xdd, 0x74, 0x85, 0xf3, 0x6d, 0xc2, 0x9c, 0x5a,
+	0x6b, 0x61, 0x6e, 0xac, 0xfb, 0x9a, 0x6a, 0x23, 0x42, 0x78, 0x51, 0x6b, 0x5e, 0x5e, 0x4c, 0x90,
+	0xd0, 0xea, 0x86, 0x5a, 0xd6, 0x0b, 0xf9, 0xe6, 0x9e, 0x2c, 0x74, 0x34, 0x4e, 0x75, 0xb4, 0x18,
+	0xac, 0x23, 0xbe, 0xe0, 0x0e, 0x02, 0x86, 0x68, 0x9c, 0x77, 0xdd, 0x62, 0x5b, 0xbb, 0xd0, 0xcc,
+	0x03, 0x30, 0xd9, 0x06, 0x53, 0x32, 0xcd, 0xfb, 0x9b, 0x6a, 0xe1, 0x7e, 0x62, 0x82, 0x3a, 0xa9,
+	0xc5, 0x86, 0x32, 0x94, 0x8b, 0xc0, 0xd9, 0x40, 0x37, 0x7f, 0x20, 0x30, 0x44, 0x13, 0x7e, 0x8a,
+	0xaf, 0xf2, 0x0e, 0xf9, 0x0f, 0x24, 0x70, 0xa6, 0x0d, 0xcc, 0xc6, 0x86, 0xad, 0x3b, 0xfa, 0x8e,
+	0xee, 0xd4, 0x13, 0x27, 0x45, 0x7c, 0x3e, 0x2c, 0xc8, 0x1e, 0x5b, 0xf2, 0xcf, 0x1d, 0xc0, 0xa5,
+	0x87, 0x1c, 0x44, 0x93, 0x7e, 0x46, 0x6f, 0xb9, 0x7d, 0xf2, 0xef, 0x4b, 0xe0, 0x74, 0x1b, 0xb0,
+	0x86, 0x0b, 0x6a, 0x9d, 0x59, 0x49, 0x42, 0xb0, 0xda, 0x05, 0x2b, 0x81, 0x07, 0xf0, 0xea, 0x92,
+	0x83, 0xe8, 0xa4, 0x9f, 0xd5, 0x55, 0xd2, 0x45, 0x0d, 0xe7, 0x03, 0x09, 0x4c, 0xb4, 0xee, 0xf1,
+	0xaa, 0x56, 0xd6, 0x0d, 0x3b, 0x31, 0x99, 0x0a, 0x5f, 0x88, 0x67, 0xdf, 0x6a, 0x28, 0x6b, 0xb9,
+	0xd9, 0x0d, 0xc8, 0x88, 0xcf, 0xe2, 0xb9, 0x85, 0xfa, 0xe2, 0xb2, 0xb5, 0x6d, 0x39, 0x97, 0xeb,
+	0xf3, 0xf5, 0x02, 0x5e, 0x28, 0x2d, 0x54, 0x2f, 0xcf, 0xd9, 0x6f, 0x19, 0xb5, 0x6a, 0xba, 0x34,
+	0x37, 0xb7, 0xbb, 0xf3, 0xb6, 0x51, 0xaf, 0x1a, 0xc4, 0x13, 0x0e, 0x6f, 0xdc, 0x23, 0x33, 0x52,
+	0x0a, 0x05, 0x45, 0xd3, 0x2c, 0x6c, 0xdb, 0xee, 0x8e, 0x18, 0x4c, 0x10, 0xa2, 0x31, 0x7f, 0x4c,
+	0xa1, 0xd0, 0x66, 0xf9, 0x27, 0x12, 0x78, 0xbe, 0x15, 0x82, 0xed, 0x70, 0x65, 0x73, 0x07, 0xe7,
+	0x9d, 0x6d, 0x0b, 0xdb, 0xdb, 0x66, 0x49, 0x4b, 0x9c, 0xa2, 0x42, 0x7d, 0x20, 0x84, 0xba, 0xf0,
+	0x24, 0x42, 0x7d, 0x39, 0x98, 0xd3, 0x20, 0xba, 0x10, 0xa5, 0xfc, 0x7c, 0xaf, 0x93, 0x41, 0x37,
+	0xcc, 0x1d, 0x7c, 0x5b, 0x0c, 0x91, 0xdf, 0x95, 0xc0, 0x73, 0x87, 0xe0, 0x6a, 0xae, 0x9c, 0xd3,
+	0x74, 0xe5, 0x7c, 0x39, 0x70, 0xe5, 0xbc, 0xd4, 0x91, 0x25, 0x77, 0x0d, 0x25, 0x0f, 0xe0, 0xa8,
+	0xb9, 0x98, 0x7e, 0x4f, 0x02, 0x93, 0x6e, 0xf0, 0xc3, 0x70, 0x68, 0x78, 0x47, 0x67, 0x81, 0xda,
+	0x19, 0x2a, 0xc9, 0xa2, 0x90, 0x64, 0xe6, 0x49, 0x24, 0x99, 0x6a, 0x8d, 0xb4, 0x5a, 0x88, 0x41,
+	0x34, 0x21, 0x02, 0x2e, 0xca, 0xe6, 0xaa, 0xe8, 0xb8, 0x12, 0xfb, 0xde, 0x07, 0xc9, 0x13, 0xb4,
+	0x04, 0xf0, 0x97, 0x51, 0x10, 0x21, 0xee, 0x4e, 0x9e, 0x6f, 0x56, 0x62, 0x22, 0xd9, 0xe7, 0x5b,
+	0x22, 0xe3, 0xc5, 0xf9, 0x9f, 0xed, 0x25, 0x43, 0xba, 0xd6, 0x5e, 0x8f, 0xf9, 0x12, 0xe8, 0x25,
+	0x1c, 0xe5, 0x75, 0x8d, 0xe6, 0xf0, 0x03, 0xd9, 0xe7, 0x82, 0x82, 0xea, 0x41, 0x06, 0xc4, 0x47,
+	0x42, 0xd4, 0x43, 0x7e, 0x5d, 0xd7, 0xe4, 0x22, 0x18, 0xf5, 0x25, 0x93, 0x34, 0xba, 0xb1, 0x13,
+	0x61, 0xba, 0x3c, 0x16, 0x49, 0xa2, 0x3d, 0xba, 0xc1, 0x42, 0x9e, 0x37, 0xe0, 0x45, 0xf6, 0xe3,
+	0x0e, 0xbc, 0xb7, 0xbf, 0x97, 0x3c, 0x25, 0x82, 0x99, 0x36, 0x60, 0x88, 0x46, 0x2c, 0x37, 0x0d,
+	0x5d, 0xa5, 0x6d, 0xb4, 0xf4, 0x20, 0xc6, 0xaa, 0x85, 0x02, 0x4d, 0x1a, 0x54, 0xb6, 0x74, 0x78,
+	0xba, 0xbc, 0xd5, 0x50, 0xb2, 0xb9, 0x19, 0xb1, 0x14, 0x17, 0x35, 0xed, 0x01, 0xb6, 0x9d, 0xdd,
+	0xea, 0xfd, 0x9d, 0xf4, 0x5b, 0x6f, 0x17, 0xea, 0x45, 0x63, 0xae, 0xa8, 0x15, 0x1f, 0x2c, 0x6f,
+	0x67, 0x76, 0x2d, 0x7b, 0x69, 0xae, 0x60, 0xcd, 0x5b, 0xc5, 0x32, 0x49, 0x65, 0x06, 0xdb, 0xd6,
+	0xe1, 0x94, 0x9f, 0xb3, 0x16, 0x6a, 0x10, 0x8d, 0xf3, 0x1e, 0x85, 0x75, 0x70, 0x40, 0xf9, 0xd7,
+	0x25, 0x30, 0xe4, 0xd6, 0x00, 0xe8, 0x54, 0x78, 0x39, 0x07, 0x37, 0x94, 0x6b, 0xb9, 0x35, 0x9a,
+	0xc6, 0xae, 0xce, 0x2d, 0x28, 0xe9, 0x95, 0x95, 0xd9, 0xc5, 0xab, 0x57, 0x17, 0x96, 0x97, 0xd6,
+	0x96, 0xd3, 0xd9, 0xf4, 0xfc, 0xfc, 0xca, 0xd5, 0xcc, 0xf2, 0xa2, 0x32, 0x9f, 0x5e, 0xc8, 0x2a,
+	0xcb, 0x2b, 0x73, 0x4b, 0xb3, 0x57, 0xe7, 0x96, 0x96, 0xe6, 0x2e, 0x2f, 0x2c, 0x2f, 0xaf, 0x2e,
+	0x2f, 0xae, 0x65, 0xd6, 0x2e, 0xa7, 0x57, 0x32, 0x6b, 0xe9, 0x8c, 0x92, 0x99, 0x53, 0xe6, 0x49,
+	0x2d, 0x6c, 0xc2, 0x9b, 0x15, 0x37, 0x69, 0x41, 0x34, 0x50, 0xe1, 0x55, 0x06, 0x2a, 0x32, 0xf9,
+	0x4d, 0x30, 0xe6, 0x13, 0xee, 0x2e, 0x4d, 0x79, 0xec, 0x44, 0x4f, 0x2a, 0x7c, 0x61, 0x20, 0x7b,
+	0xb1, 0xa1, 0x80, 0x5c, 0x6c, 0x63, 0x29, 0x7d, 0x31, 0x95, 0x49, 0xdf, 0x73, 0x0b, 0x57, 0x41,
+	0x20, 0x10, 0xc9, 0x1e, 0x85, 0xbc, 0xce, 0x1a, 0xe5, 0x35, 0x30, 0xe0, 0x4f, 0x60, 0x7a, 0xa9,
+	0xf5, 0xa4, 0x1a, 0x4a, 0x34, 0x17, 0x9e, 0x4d, 0xa7, 0xdd, 0x44, 0xb8, 0x25, 0x53, 0xf1, 0x83,
+	0x51, 0x43, 0x96, 0xa8, 0x21, 0xff, 0x24, 0x02, 0xfa, 0x89, 0x21, 0xdf, 0xc0, 0x8e, 0xaa, 0xa9,
+	0x8e, 0x2a, 0xbf, 0x02, 0x7a, 0xe9, 0x2c, 0x9b, 0x56, 0x3d, 0x1d, 0x64, 0xd5, 0x62, 0x8c, 0x6b,
+	0xa5, 0xbc, 0x01, 0xa2, 0x1e, 0xf2, 0xeb, 0xba, 0x26, 0xff, 0xa7, 0x04, 0x26, 0x5c, 0x79, 0x39,
+	0xa6, 0xa3, 0x96, 0xf2, 0x76, 0xb5, 0x52, 0x29, 0xd5, 0xa9, 0xcd, 0x1f, 0x1a, 0xb9, 0xbf, 0x2f,
+	0x35, 0x14, 0x3b, 0x57, 0xf4, 0x04, 0xee, 0x5d, 0x51, 0x64, 0x50, 0xdc, 0x0f, 0xbf, 0xf6, 0x28,
+	0x14, 0x13, 0x51, 0x3f, 0x0f, 0x8e, 0xcf, 0xb6, 0x6a, 0xdb, 0xcb, 0x3d, 0x44, 0xa3, 0x42, 0xe9,
+	0xb7, 0x49, 0xf3, 0x2d, 0xda, 0x2a, 0xff, 0x97, 0x04, 0x06, 0xbc, 0x8a, 0x64, 0xeb, 0xf1, 0xd0,
+	0x59, 0xfe, 0x58, 0x6a, 0x28, 0x9b, 0xb9, 0xdb, 0xde, 0xfc, 0x44, 0xac, 0xda, 0x40, 0x46, 0x2f,
+	0xa6, 0x5a, 0x47, 0xde, 0xf1, 0x8f, 0xcc, 0x1c, 0x96, 0xc9, 0x8c, 0xb5, 0x1b, 0x9b, 0xfd, 0x78,
+	0xe9, 0x4b, 0xbf, 0xc7, 0x22, 0x6d, 0x8f, 0x0d, 0xfd, 0x28, 0x02, 0xe2, 0xc4, 0x86, 0x68, 0x96,
+	0xdf, 0x3d, 0x03, 0xba, 0x0c, 0xa2, 0xba, 0xa1, 0xe1, 0x1a, 0x35, 0x97, 0x48, 0xf6, 0x5c, 0x1b,
+	0x9a, 0xfd, 0xbd, 0x64, 0xbf, 0x28, 0x06, 0x6a, 0xb8, 0x06, 0x11, 0x1b, 0x2f, 0xdf, 0x00, 0xfd,
+	0x9b, 0x78, 0x4b, 0x37, 0x44, 0xdd, 0x82, 0x54, 0x20, 0xc3, 0xd9, 0x97, 0x48, 0x18, 0xd6, 0x4c,
+	0x51, 0xa3, 0x02, 0xc3, 0x28, 0x4f, 0x84, 0x3c, 0x00, 0x10, 0xf5, 0xd1, 0x4f, 0x5e, 0xb0, 0xb8,
+	0x03, 0x46, 0x44, 0x21, 0xb4, 0x6c, 0x6f, 0xe5, 0x19, 0x4f, 0x11, 0xca, 0xd3, 0xa5, 0x20, 0x9e,
+	0x12, 0xa2, 0x8a, 0xdc, 0x02, 0x03, 0xd1, 0x10, 0x6f, 0xbb, 0x61, 0x6f, 0x5d, 0xa7, 0x9c, 0x7e,
+	0x05, 0xc8, 0xcd, 0x64, 0xc4, 0xc5, 0x1d, 0x3d, 0x40, 0x6c, 0x6e, 0x95, 0xa0, 0x1d, 0x08, 0xa2,
+	0x61, 0xd1, 0xd8, 0xc4, 0xbe, 0x0e, 0x06, 0x69, 0xec, 0xe5, 0x62, 0xee, 0xa1, 0x98, 0x5f, 0x0a,
+	0xc2, 0x3c, 0xee, 0x29, 0xa0, 0x79, 0xb0, 0xf6, 0x93, 0x86, 0x26, 0xc6, 0x25, 0x10, 0xc3, 0x35,
+	0x5c, 0xa8, 0x3a, 0x58, 0xa3, 0xae, 0x27, 0x96, 0x3d, 0xd3, 0x50, 0x7a, 0x72, 0x11, 0xc7, 0xaa,
+	0xe2, 0xfd, 0xbd, 0xe4, 0x10, 0xc3, 0x21, 0x86, 0x40, 0xd4, 0x1c, 0xed, 0xb1, 0x96, 0x3f, 0x0a,
+	0x83, 0xa1, 0xd5, 0xa6, 0x1c, 0x6e, 0x39, 0x24, 0xe8, 0x7b, 0x05, 0x00, 0x42, 0x93, 0xeb, 0x4b,
+	0xa2, 0xfa, 0xba, 0x10, 0xac, 0x2f, 0x5e, 0x2d, 0x77, 0x87, 0x43, 0x14, 0x2f, 0xdb, 0x5b, 0x5c,
+	0x57, 0x59, 0x10, 0x77, 0x67, 0xcb, 0xec, 0xe6, 0x7c, 0xd0, 0x6c, 0x87, 0x5d, 0x2c, 0x7c, 0xa2,
+	0xb1, 0x72, 0xd0, 0x24, 0xc3, 0x8f, 0x33, 0x49, 0xf9, 0x8b, 0x20, 0x6e, 0x57, 0x0b, 0x05, 0x8c,
+	0x35, 0xac, 0x51, 0x0b, 0x89, 0x65, 0xcf, 0x7a, 0x41, 0x39, 0xd5, 0xe6, 0x18, 0x88, 0xdc, 0xf1,
+	0xf2, 0x55, 0x30, 0xe0, 0x98, 0xf9, 0x4d, 0x12, 0x88, 0x94, 0x30, 0xa1, 0x1d, 0xa5, 0x08, 0xce,
+	0x79, 0x11, 0xf0, 0x35, 0xec, 0x1b, 0x07, 0x51, 0x9f, 0x63, 0x66, 0xf1, 0x2a, 0xfb, 0x92, 0x7f,
+	0x09, 0x84, 0xcb, 0xf6, 0x16, 0xd5, 0x74, 0x5f, 0x66, 0xee, 0xf0, 0xa3, 0x88, 0x1b, 0xf6, 0x16,
+	0xd7, 0xc4, 0xeb, 0xba, 0xb3, 0xad, 0x1b, 0x74, 0x01, 0x67, 0x07, 0xf7, 0xf7, 0x92, 0xa0, 0x29,
+	0x1f, 0x88, 0x08, 0x3e, 0xf8, 0xc7, 0x61, 0x30, 0xfc, 0xba, 0x6b, 0x60, 0x3f, 0x57, 0x5b, 0x97,
+	0xd5, 0xf6, 0x9a, 0x57, 0x6d, 0xf3, 0x1d, 0xd5, 0x26, 0x54, 0xd1, 0x51, 0x6f, 0xff, 0x1e, 0x03,
+	0xfd, 0xb7, 0xd8, 0x12, 0xfe, 0xb9, 0xce, 0xba, 0xac, 0x33, 0x15, 0x8c, 0xb2, 0x04, 0x02, 0xd7,
+	0x2a, 0xba, 0x55, 0x17, 0x32, 0xed, 0xa1, 0x32, 0x9d, 0x0d, 0x96, 0x29, 0x0f, 0xc1, 0x03, 0xe0,
+	0x20, 0x1a, 0xa1, 0xad, 0x57, 0x69, 0x23, 0x17, 0xf2, 0x0f, 0x25, 0x30, 0x86, 0x6b, 0x85, 0x6d,
+	0xd5, 0xd8, 0xc2, 0x5a, 0xde, 0x2c, 0x16, 0xb1, 0x45, 0x77, 0x6e, 0xea, 0x7d, 0x0f, 0x0d, 0x2e,
+	0xee, 0x36, 0x94, 0xf9, 0xdc, 0x8b, 0x1d, 0x42, 0x8b, 0xc5, 0x03, 0x43, 0xa0, 0xd3, 0x42, 0xf4,
+	0xed, 0xb4, 0x21, 0x92, 0x9b, 0xcd, 0x37, 0x49, 0x2b, 0x01, 0xa3, 0x9c, 0x5a, 0xb8, 0xac, 0xea,
+	0x86, 0x6e, 0x6c, 0x79, 0x39, 0x8d, 0x75, 0x85, 0xd3, 0xf9, 0x4e, 0x9c, 0x06, 0xd1, 0xa6, 0x41,
+	0x34, 0x6f, 0x76, 0x39, 0xfd, 0xb1, 0x9b, 0xd6, 0x78, 0xa7, 0x45, 0x6b, 0xca, 0xf1, 0x4e, 0xcc,
+	0x6e, 0x34, 0x94, 0x4c, 0xee, 0x7c, 0x07, 0x66, 0x17, 0x0e, 0x60, 0xd5, 0x9f, 0xe5, 0xb4, 0x12,
+	0x87, 0x48, 0x24, 0x0f, 0xae, 0x58, 0x49, 0x79, 0x18, 0x31, 0xd7, 0x00, 0x28, 0x6b, 0xe9, 0x8e,
+	0xae, 0x81, 0xac, 0xf6, 0x4e, 0x6e, 0x41, 0xbe, 0x09, 0xa2, 0x96, 0x59, 0x75, 0x30, 0x3d, 0x01,
+	0xe9, 0xcb, 0xbc, 0x78, 0x38, 0x56, 0x82, 0x12, 0x91, 0xe1, 0xd9, 0x61, 0x37, 0xe6, 0xa2, 0xf0,
+	0x10, 0x31, 0x3c, 0xf0, 0xef, 0x42, 0x20, 0xde, 0x1c, 0x26, 0xe7, 0x40, 0x8c, 0x87, 0x73, 0xec,
+	0x50, 0x3c, 0x92, 0x9d, 0x69, 0x28, 0x93, 0xb9, 0xe8, 0x06, 0xcc, 0xd0, 0x9a, 0xb4, 0x6a, 0x59,
+	0x6a, 0x3d, 0x65, 0x16, 0x53, 0x4d, 0x2f, 0x31, 0xe4, 0x0b, 0x02, 0x6d, 0x88, 0x7a, 0x59, 0x14,
+	0x68, 0xcb, 0x77, 0x81, 0xac, 0xe1, 0xb2, 0x6a, 0x68, 0xbe, 0x64, 0x37, 0x44, 0x93, 0xdd, 0x8b,
+	0x0d, 0xa5, 0x3f, 0x07, 0x78, 0xb2, 0x7b, 0x17, 0xde, 0x73, 0x23, 0xa4, 0x76, 0x10, 0x88, 0x86,
+	0x59, 0xa3, 0x27, 0xc3, 0x7d, 0x9f, 0x9c, 0xc1, 0xd1, 0x11, 0xee, 0x68, 0xdf, 0xb1, 0x75, 0xb1,
+	0xa1, 0x8c, 0xe5, 0x62, 0x70, 0x79, 0xe1, 0x49, 0x0f, 0x82, 0xcf, 0xba, 0x55, 0xdc, 0x76, 0x62,
+	0xe4, 0x10, 0x8e, 0xf0, 0x24, 0xb8, 0x63, 0x27, 0x71, 0xf0, 0xdd, 0x1e, 0x72, 0xe5, 0x83, 0x94,
+	0xf0, 0x4c, 0xe3, 0x98, 0x85, 0x06, 0x4f, 0x30, 0x1e, 0x7a, 0xa2, 0x60, 0xfc, 0x9b, 0x12, 0x18,
+	0x30, 0x77, 0x0d, 0x5a, 0x1b, 0x63, 0x15, 0x00, 0x26, 0xa0, 0x7b, 0xbe, 0x0a, 0xc0, 0x11, 0x8b,
+	0x71, 0x41, 0x15, 0x00, 0xee, 0x6f, 0x7d, 0x34, 0x20, 0xea, 0xa7, 0xdf, 0x22, 0xdd, 0xaf, 0x83,
+	0xbe, 0x92, 0xb9, 0x2b, 0x2a, 0x36, 0xbc, 0x06, 0xf1, 0x86, 0x28, 0x0a, 0x2d, 0x3f, 0x49, 0x51,
+	0x88, 0x5f, 0xfd, 0xf0, 0xa0, 0x87, 0x08, 0xd0, 0x2f, 0x5a, 0x03, 0x22, 0xa4, 0xab, 0x95, 0x4a,
+	0x93, 0x74, 0xd4, 0x4b, 0x7a, 0x76, 0x7a, 0xb6, 0x0b, 0xa4, 0x3d, 0xe8, 0x21, 0x02, 0xf4, 0x8b,
+	0x91, 0xae, 0x81, 0x78, 0x73, 0x49, 0xf2, 0x53, 0xf3, 0xbb, 0x81, 0xb7, 0x29, 0x8e, 0x43, 0x9c,
+	0xef, 0x93, 0x4d, 0x02, 0x10, 0xb9, 0xc4, 0xe4, 0x6f, 0x49, 0x60, 0x82, 0x14, 0x6e, 0xb7, 0x2c,
+	0x73, 0xd7, 0xd9, 0xce, 0xeb, 0x86, 0xad, 0x6b, 0x38, 0x5f, 0x52, 0x6d, 0x27, 0xd1, 0x7b, 0x14,
+	0xbf, 0xb1, 0x86, 0xf1, 0x2b, 0x14, 0x34, 0x7b, 0xde, 0x9f, 0x59, 0x07, 0x23, 0x85, 0x68, 0xb4,
+	0x28, 0x20, 0xae, 0xd3, 0xe6, 0x57, 0x55, 0xdb, 0xf1, 0xa4, 0x0e, 0xff, 0x24, 0x81, 0x78, 0x13,
+	0xa7, 0x9c, 0x07, 0x52, 0x8d, 0x2e, 0x88, 0x78, 0xf6, 0x17, 0xd9, 0x32, 0xa5, 0x47, 0x2f, 0x4f,
+	0x74, 0x56, 0x1e, 0x63, 0xfc, 0xd5, 0x20, 0x92, 0x6a, 0x84, 0x40, 0x3d, 0x11, 0x7a, 0x2a, 0x04,
+	0xea, 0x10, 0x49, 0x75, 0xcf, 0xcc, 0x7e, 0x1a, 0x02, 0x71, 0xaa, 0xed, 0xdb, 0x7a, 0xe1, 0x7e,
+	0xf7, 0x52, 0xe8, 0x6d, 0x10, 0x65, 0xf6, 0xca, 0x66, 0x81, 0xba, 0xb2, 0x54, 0xfa, 0x3d, 0x67,
+	0xba, 0x10, 0x31, 0x02, 0x72, 0x0d, 0xc8, 0x1e, 0xa5, 0x9a, 0x55, 0x87, 0xa8, 0x2f, 0x11, 0x7e,
+	0x3c, 0x2b, 0x39, 0xc7, 0xad, 0x64, 0xb2, 0xcd, 0x4a, 0x38, 0x42, 0x88, 0x86, 0x9b, 0x16, 0x72,
+	0x93, 0x35, 0x79, 0x84, 0xf8, 0xd7, 0x11, 0x30, 0xd4, 0xac, 0x43, 0xb0, 0x63, 0xec, 0xee, 0x89,
+	0xf2, 0x1a, 0xe8, 0x63, 0xe7, 0xe6, 0xde, 0x80, 0xf7, 0xc5, 0xa0, 0x80, 0x57, 0xf6, 0x9e, 0xb2,
+	0xf3, 0x90, 0x17, 0xd0, 0x2f, 0x16, 0xf4, 0x7e, 0x09, 0xf4, 0xf8, 0x0a, 0x13, 0xcf, 0x07, 0x47,
+	0x8a, 0x03, 0x0c, 0x8d, 0x08, 0x0e, 0x39, 0x8c, 0x5c, 0x02, 0x34, 0x25, 0xe7, 0xc7, 0xf7, 0xa4,
+	0x10, 0x4b, 0xaa, 0x4c, 0x17, 0x3b, 0xdc, 0x39, 0x53, 0x75, 0x8b, 0xee, 0xce, 0x14, 0x28, 0x7b,
+	0x9a, 0xcb, 0x79, 0xd4, 0x93, 0xf3, 0x73, 0x7c, 0xfc, 0xce, 0x0c, 0x1b, 0x68, 0x07, 0x54, 0xb5,
+	0xa2, 0xff, 0x5f, 0xaa, 0x5a, 0x1f, 0x47, 0xc1, 0xa0, 0x5f, 0x6e, 0xf2, 0x12, 0xe8, 0xa5, 0x0c,
+	0xe6, 0x85, 0xdf, 0x49, 0xd2, 0x8a, 0xae, 0x98, 0x9f, 0x6b, 0x3d, 0x7c, 0x14, 0x44, 0x3d, 0xac,
+	0xcb, 0x85, 0x14, 0x0e, 0xc5, 0x0b, 0x79, 0xa7, 0x0d, 0xb2, 0x2e, 0x20, 0xef, 0xc8, 0x3b, 0x00,
+	0x50, 0xfd, 0xb0, 0x75, 0xcc, 0x36, 0xdd, 0xd7, 0xbb, 0xb2, 0xef, 0x8c, 0x78, 0xb4, 0xcf, 0x17,
+	0x73, 0x9c, 0x7c, 0xb0, 0x5d, 0xe7, 0xeb, 0x60, 0xa0, 0x96, 0x77, 0xcc, 0x7c, 0x3d, 0xbf, 0x63,
+	0x96, 0xaa, 0x65, 0xb1, 0xdb, 0x6e, 0x34, 0x14, 0xd9, 0x35, 0xd6, 0x63, 0x87, 0x43, 0x5c, 0x6d,
+	0x3e, 0x0a, 0x10, 0x81, 0xda, 0x6d, 0xf3, 0xce, 0x6b, 0xf4, 0x83, 0xd0, 0xaf, 0x93, 0xde, 0x9a,
+	0xa0, 0x1f, 0x7d, 0x0a, 0xf4, 0x7d, 0x14, 0x20, 0x02, 0xf5, 0xdb, 0xe6, 0x1b, 0x9c, 0xfe, 0x3f,
+	0x4a, 0x20, 0x5e, 0xc4, 0xdc, 0xa2, 0x12, 0x3d, 0x9d, 0xac, 0xfe, 0xb7, 0xa5, 0x86, 0xf2, 0x5a,
+	0xee, 0x5a, 0x27, 0xab, 0x9f, 0x3b, 0x82, 0xbd, 0xcf, 0x05, 0x5b, 0xfa, 0xb0, 0xeb, 0x14, 0x8f,
+	0x61, 0xe5, 0xb1, 0x22, 0x6e, 0xb3, 0xf0, 0x3f, 0x0d, 0x83, 0xe1, 0xf5, 0x96, 0xbb, 0x37, 0x9f,
+	0x3f, 0x87, 0xf9, 0x0a, 0x88, 0x38, 0x3a, 0xb7, 0xdf, 0xbe, 0xcc, 0xa9, 0xb6, 0x2b, 0x35, 0xb7,
+	0xc5, 0xc5, 0xed, 0xec, 0x49, 0x2e, 0x69, 0x7e, 0xf1, 0x99, 0x40, 0xc1, 0xf7, 0xc8, 0x8d, 0x18,
+	0x8a, 0x40, 0xfe, 0x06, 0xb9, 0x08, 0xa3, 0xea, 0x96, 0xf7, 0x1e, 0x93, 0xf0, 0x87, 0x99, 0xce,
+	0xfe, 0xb7, 0x55, 0xd2, 0xd9, 0x54, 0xcb, 0x8d, 0xcb, 0x56, 0xd4, 0x10, 0x0d, 0x93, 0x36, 0x0f,
+	0x88, 0x57, 0x79, 0xbf, 0x15, 0x06, 0x63, 0x41, 0x68, 0x3f, 0x2b, 0x27, 0x45, 0x02, 0xb8, 0xa7,
+	0xe7, 0xa4, 0x5c, 0xec, 0x24, 0x40, 0x55, 0x6d, 0x87, 0x39, 0xa9, 0xef, 0x48, 0x60, 0x98, 0xcf,
+	0x5c, 0xdf, 0xc1, 0xbe, 0xb4, 0x20, 0xcf, 0x6e, 0x4f, 0x2e, 0x2e, 0x3e, 0x61, 0x80, 0x7c, 0x92,
+	0x31, 0xd0, 0x4a, 0x05, 0xa2, 0x21, 0xb7, 0x89, 0x32, 0xe3, 0xd1, 0xcd, 0xef, 0xc4, 0x00, 0x40,
+	0xcd, 0xab, 0x5e, 0x9f, 0x75, 0xea, 0xf6, 0xab, 0x12, 0x18, 0x2c, 0x56, 0x0d, 0xad, 0x2d, 0x77,
+	0x7b, 0xb3, 0x5b, 0xb9, 0x1b, 0x3f, 0x3b, 0xf0, 0x13, 0x81, 0x68, 0x80, 0x35, 0x88, 0xec, 0xed,
+	0xcf, 0x24, 0xd0, 0xcf, 0xef, 0xd1, 0x31, 0xa7, 0x1a, 0xe9, 0xe4, 0x54, 0xbf, 0x21, 0x35, 0x94,
+	0xcb, 0xb9, 0x2f, 0x1c, 0xed, 0x02, 0x5f, 0xb0, 0xd7, 0x1c, 0xf5, 0xdd, 0xdf, 0x3b, 0x86, 0xe3,
+	0xec, 0x63, 0xa0, 0xf4, 0x43, 0xfe, 0x1b, 0x09, 0x8c, 0x68, 0xba, 0xed, 0x58, 0xfa, 0x26, 0xa9,
+	0x42, 0x1e, 0x35, 0x24, 0xfa, 0x96, 0x44, 0x4a, 0x5c, 0x2f, 0x1c, 0x61, 0x1e, 0x87, 0xde, 0xc9,
+	0x6e, 0xa3, 0xfc, 0x78, 0x33, 0x19, 0xf6, 0xc0, 0xb3, 0xe9, 0xdc, 0x00, 0xfd, 0xb6, 0xa3, 0x5a,
+	0x8e, 0xbf, 0x72, 0x79, 0xf8, 0x41, 0x99, 0x17, 0x80, 0x04, 0x8b, 0xe4, 0xf3, 0x9a, 0xf0, 0xb4,
+	0x00, 0x1b, 0x9a, 0x40, 0xd6, 0xeb, 0x2d, 0x2d, 0x67, 0x82, 0x4b, 0xcb, 0xee, 0x70, 0x88, 0xe2,
+	0xd8, 0xd0, 0x38, 0xa2, 0x7f, 0x90, 0xc0, 0x88, 0x63, 0x55, 0x8d, 0x82, 0x4a, 0xa6, 0xca, 0x14,
+	0x60, 0x27, 0x62, 0x54, 0xcc, 0x67, 0x02, 0xc5, 0xbc, 0x8a, 0x0b, 0xcd, 0x2b, 0xf0, 0x8b, 0xb9,
+	0xf3, 0x1d, 0x24, 0x4d, 0xae, 0xe2, 0x10, 0x41, 0xf7, 0x73, 0xa7, 0x10, 0x20, 0xeb, 0x36, 0xf2,
+	0x44, 0xd6, 0x2f, 0x1f, 0xcd, 0x6b, 0x70, 0x71, 0x37, 0x51, 0x30, 0x57, 0xe0, 0x75, 0xde, 0xbf,
+	0x19, 0x05, 0x23, 0xac, 0xf5, 0xa9, 0x6c, 0xbd, 0x0f, 0x25, 0xd0, 0xcf, 0x8f, 0xac, 0x1d, 0xf5,
+	0x3e, 0xd6, 0xb8, 0x3b, 0xbf, 0xd7, 0xb5, 0x37, 0x18, 0xdc, 0x16, 0xbc, 0x34, 0x68, 0x6d, 0x9c,
+	0x1c, 0x87, 0xd3, 0x2f, 0xf9, 0xef, 0x25, 0x30, 0x2c, 0x2e, 0xcd, 0x62, 0x2b, 0x6f, 0x6f, 0xab,
+	0x16, 0x4e, 0x84, 0x8f, 0xa0, 0xc1, 0x6f, 0xd3, 0x4b, 0xbb, 0x2f, 0x76, 0xd4, 0x60, 0x3a, 0x3d,
+	0x7b, 0xa0, 0x0e, 0x4f, 0xfa, 0x2f, 0xed, 0x0a, 0xfa, 0x8f, 0xad, 0xc2, 0x41, 0x7e, 0x5f, 0x17,
+	0x5b, 0xb7, 0x08, 0xfc, 0x01, 0x76, 0x19, 0xf9, 0xfc, 0xd8, 0xe5, 0x0f, 0x22, 0x20, 0x4a, 0x15,
+	0xd8, 0x3d, 0x5b, 0x24, 0xbb, 0x0f, 0xb5, 0x10, 0x77, 0xf7, 0x09, 0x3d, 0x95, 0xdd, 0xc7, 0x4f,
+	0x04, 0xa2, 0x01, 0xd6, 0x20, 0x76, 0x9f, 0x12, 0xe8, 0xf1, 0x55, 0x76, 0x6f, 0x77, 0x27, 0x91,
+	0x18, 0x10, 0xd7, 0x6d, 0x58, 0x1d, 0x97, 0xd3, 0x08, 0x36, 0xff, 0xc8, 0xff, 0x6d, 0xf3, 0xf7,
+	0xd8, 0xc9, 0x9f, 0x47, 0x40, 0x24, 0x6b, 0x1a, 0xda, 0x31, 0x43, 0x9b, 0xf6, 0x62, 0x72, 0xe8,
+	0xd9, 0x17, 0x93, 0xff, 0x42, 0x02, 0xf1, 0xe6, 0x0d, 0x1f, 0x5e, 0xa9, 0x3a, 0x64, 0x0f, 0xff,
+	0xae, 0xd4, 0x50, 0x2a, 0xb9, 0xc2, 0x53, 0xbf, 0x92, 0x14, 0x74, 0x6e, 0x34, 0xdc, 0x72, 0x1f,
+	0x09, 0xa2, 0x98, 0xb8, 0x82, 0x24, 0x23, 0x10, 0x13, 0xf7, 0xf7, 0x79, 0x8a, 0x73, 0xc8, 0xab,
+	0x01, 0x51, 0xf8, 0xe1, 0xa7, 0x2d, 0x02, 0x90, 0xdd, 0xfb, 0x6f, 0xe2, 0x91, 0x37, 0x40, 0x5f,
+	0xd5, 0xa0, 0x4f, 0x03, 0x1c, 0x9d, 0x67, 0xde, 0x87, 0x67, 0x4e, 0x53, 0x1c, 0xaf, 0x28, 0x65,
+	0xbb, 0xc0, 0x2c, 0x81, 0x02, 0xac, 0x85, 0x00, 0x78, 0xac, 0xe8, 0x61, 0x04, 0x8c, 0xaf, 0x98,
+	0xa5, 0x12, 0x2e, 0x38, 0x58, 0xf3, 0x5c, 0xb6, 0xb7, 0xbb, 0xe7, 0x7d, 0x7e, 0x2a, 0xf1, 0x3b,
+	0x30, 0x6e, 0x2a, 0x1f, 0xea, 0x14, 0xad, 0x3d, 0x3c, 0x52, 0xb4, 0x36, 0x77, 0x60, 0xb4, 0x36,
+	0xde, 0xf2, 0x14, 0xed, 0x38, 0x35, 0x29, 0xfe, 0x6e, 0x8d, 0x7e, 0xc9, 0x7f, 0x2b, 0x79, 0xae,
+	0x09, 0xb9, 0x13, 0xe9, 0x78, 0xbf, 0xec, 0x57, 0x9e, 0x30, 0xec, 0x9c, 0x0c, 0x78, 0xcc, 0x76,
+	0x9c, 0xb8, 0xd3, 0xf3, 0xf2, 0xad, 0xb5, 0x04, 0xf1, 0x5e, 0x18, 0xf4, 0x79, 0x9f, 0x0d, 0x74,
+	0x4d, 0xf1, 0xbf, 0xd1, 0xf6, 0xde, 0x30, 0x24, 0xde, 0xa1, 0x36, 0x1f, 0x69, 0x2c, 0x74, 0xef,
+	0x91, 0xc6, 0x11, 0x9e, 0x1f, 0xbe, 0x1f, 0xf8, 0xfc, 0x30, 0xfc, 0x0c, 0x9e, 0x8e, 0x1c, 0xe1,
+	0x35, 0xa2, 0x47, 0x25, 0xff, 0x2d, 0x81, 0x31, 0x8f, 0x4a, 0xec, 0x75, 0xcb, 0xac, 0x98, 0xb6,
+	0x5a, 0x92, 0x5f, 0x00, 0x51, 0x47, 0x77, 0x4a, 0x98, 0x97, 0x15, 0x3c, 0x47, 0xc1, 0xb4, 0x19,
+	0x22, 0xd6, 0xdd, 0xfa, 0xbc, 0x3a, 0x74, 0xe4, 0xe7, 0xd5, 0xb2, 0x01, 0x06, 0x7d, 0xcf, 0x4a,
+	0x84, 0x8d, 0x7f, 0xa1, 0xf3, 0x8b, 0x6a, 0xce, 0x6d, 0xf6, 0xac, 0x7f, 0x11, 0xfa, 0xd1, 0x41,
+	0xd4, 0x5f, 0xf1, 0xcc, 0xec, 0x4a, 0xff, 0x3b, 0x1f, 0x24, 0x4f, 0xf0, 0x3b, 0xdd, 0x27, 0xe0,
+	0x8f, 0x42, 0x20, 0x19, 0x34, 0x71, 0x72, 0x98, 0xce, 0xaf, 0x49, 0x7d, 0xfe, 0x64, 0x20, 0x5f,
+	0x24, 0x45, 0x1f, 0x3a, 0x39, 0x5e, 0x38, 0x91, 0xbd, 0x75, 0x1e, 0xda, 0x01, 0x91, 0x18, 0x72,
+	0x25, 0xc6, 0x25, 0x26, 0xc1, 0xbf, 0x0a, 0x83, 0xc1, 0x55, 0xdf, 0x1b, 0x8e, 0xff, 0x8d, 0xa5,
+	0xc3, 0x77, 0x24, 0x00, 0x76, 0x4c, 0x52, 0xa5, 0x29, 0x91, 0xd3, 0xd3, 0xb0, 0x78, 0xe5, 0xc2,
+	0x57, 0x5b, 0xa6, 0x9b, 0xab, 0x8d, 0xe7, 0xb4, 0x2e, 0x39, 0x88, 0x3c, 0xb4, 0x03, 0x3c, 0x52,
+	0xa4, 0xd5, 0x23, 0xcd, 0x2d, 0x3e, 0x4b, 0x8f, 0xe4, 0x59, 0xf3, 0xdf, 0x0d, 0x81, 0xc1, 0x15,
+	0xdf, 0x03, 0x8d, 0xee, 0x29, 0x73, 0x03, 0x90, 0xfb, 0x5e, 0xf4, 0x2f, 0x1b, 0xf0, 0x75, 0xf0,
+	0xe5, 0x86, 0x32, 0x95, 0x1b, 0x65, 0xac, 0xed, 0xd2, 0x9b, 0x2a, 0xec, 0xcd, 0x30, 0xc1, 0x4c,
+	0x2a, 0x14, 0xc6, 0xd6, 0xcf, 0xf6, 0x92, 0x4d, 0x20, 0x37, 0x5c, 0x11, 0x2d, 0x10, 0xf5, 0x96,
+	0xed, 0x2d, 0xfa, 0x87, 0x30, 0xae, 0x83, 0x5e, 0xf1, 0xaa, 0x8c, 0xdd, 0x1f, 0x9b, 0x21, 0x2f,
+	0x55, 0x7a, 0x48, 0x9a, 0xd4, 0x7c, 0x54, 0x46, 0xd8, 0xe4, 0x83, 0x5c, 0x36, 0x9b, 0xcf, 0xc9,
+	0x44, 0x97, 0x47, 0x1a, 0xdf, 0x0c, 0x81, 0x89, 0x95, 0x96, 0xe7, 0x2a, 0xcf, 0xcc, 0x07, 0xd6,
+	0xc0, 0x70, 0xcb, 0x83, 0x1a, 0xe1, 0x01, 0x3a, 0x9c, 0xf1, 0xf9, 0x39, 0xce, 0x26, 0xfd, 0xc9,
+	0x40, 0x2b, 0x4e, 0x52, 0xbf, 0xf4, 0x01, 0xb4, 0x7a, 0xc3, 0x3f, 0x09, 0x81, 0x73, 0xc1, 0x42,
+	0x78, 0xb6, 0xfe, 0xf0, 0x33, 0x93, 0xc7, 0x71, 0x3d, 0x63, 0xf6, 0xe6, 0x87, 0xff, 0x36, 0x75,
+	0xe2, 0xc3, 0x4f, 0xa6, 0xa4, 0x8f, 0x3e, 0x99, 0x92, 0xfe, 0xf5, 0x93, 0x29, 0xe9, 0xbd, 0x4f,
+	0xa7, 0x4e, 0x7c, 0xf4, 0xe9, 0xd4, 0x89, 0x7f, 0xfe, 0x74, 0xea, 0xc4, 0xdd, 0x59, 0xcf, 0x0a,
+	0x0e, 0xfc, 0xcb, 0x34, 0x35, 0xcf, 0x6f, 0xba, 0xa0, 0x37, 0x7b, 0x68, 0xc4, 0x3d, 0xf7, 0x3f,
+	0x03, 0x00, 0x07, 0x22, 0x45, 0x30, 0x16, 0x47, 0x00, 0x00,
+}
+
+func (this *PoolType) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.ToBeDeleted {
-		i--
-		if m.ToBeDeleted {
-			dAtA[i] = 1
+
+	that1, ok := that.(*PoolType)
+	if !ok {
+		that2, ok := that.(PoolType)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.Succeeded {
-		i--
-		if m.Succeeded {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Executed {
-		i--
-		if m.Executed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if this.Id != that1.Id {
+		return false
 	}
-	if m.MsgIndex != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgIndex))
-		i--
-		dAtA[i] = 0x10
+	if this.Name != that1.Name {
+		return false
 	}
-	if m.MsgHeight != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
-		i--
-		dAtA[i] = 0x8
+	if this.MinReserveCoinNum != that1.MinReserveCoinNum {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SwapMsgState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaxReserveCoinNum != that1.MaxReserveCoinNum {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SwapMsgState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Description != that1.Description {
+		return false
+	}
+	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SwapMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Msg != nil {
-		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	{
-		size, err := m.ReservedOfferCoinFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.RemainingOfferCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	if len(this.PoolTypes) != len(that1.PoolTypes) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.ExchangedOfferCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	for i := range this.PoolTypes {
+		if !this.PoolTypes[i].Equal(&that1.PoolTypes[i]) {
+			return false
 		}
-		i -= size
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.OrderExpiryHeight != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.OrderExpiryHeight))
-		i--
-		dAtA[i] = 0x30
+	if !this.MinInitDepositAmount.Equal(that1.MinInitDepositAmount) {
+		return false
 	}
-	if m.ToBeDeleted {
-		i--
-		if m.ToBeDeleted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	if !this.InitPoolCoinMintAmount.Equal(that1.InitPoolCoinMintAmount) {
+		return false
 	}
-	if m.Succeeded {
-		i--
-		if m.Succeeded {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	if !this.MaxReserveCoinAmount.Equal(that1.MaxReserveCoinAmount) {
+		return false
 	}
-	if m.Executed {
-		i--
-		if m.Executed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(this.PoolCreationFee) != len(that1.PoolCreationFee) {
+		return false
+	}
+	for i := range this.PoolCreationFee {
+		if !this.PoolCreationFee[i].Equal(&that1.PoolCreationFee[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.MsgIndex != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgIndex))
-		i--
-		dAtA[i] = 0x10
+	if !this.SwapFeeRate.Equal(that1.SwapFeeRate) {
+		return false
 	}
-	if m.MsgHeight != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
-		i--
-		dAtA[i] = 0x8
+	if !this.WithdrawFeeRate.Equal(that1.WithdrawFeeRate) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if !this.MaxOrderAmountRatio.Equal(that1.MaxOrderAmountRatio) {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *PoolType) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.UnitBatchHeight != that1.UnitBatchHeight {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	if this.CircuitBreakerEnabled != that1.CircuitBreakerEnabled {
+		return false
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if this.StableSwapAmplification != that1.StableSwapAmplification {
+		return false
 	}
-	if m.MinReserveCoinNum != 0 {
-		n += 1 + sovLiquidity(uint64(m.MinReserveCoinNum))
+	if this.MaxOrderLifespan != that1.MaxOrderLifespan {
+		return false
 	}
-	if m.MaxReserveCoinNum != 0 {
-		n += 1 + sovLiquidity(uint64(m.MaxReserveCoinNum))
+	if this.BatchResultRetention != that1.BatchResultRetention {
+		return false
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if this.PriceAccumulatorRetention != that1.PriceAccumulatorRetention {
+		return false
 	}
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.RewardPlanCreationFee) != len(that1.RewardPlanCreationFee) {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.PoolTypes) > 0 {
-		for _, e := range m.PoolTypes {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	for i := range this.RewardPlanCreationFee {
+		if !this.RewardPlanCreationFee[i].Equal(&that1.RewardPlanCreationFee[i]) {
+			return false
 		}
 	}
-	l = m.MinInitDepositAmount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.InitPoolCoinMintAmount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.MaxReserveCoinAmount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	if len(this.BondDurations) != len(that1.BondDurations) {
+		return false
+	}
+	for i := range this.BondDurations {
+		if this.BondDurations[i] != that1.BondDurations[i] {
+			return false
 		}
 	}
-	l = m.SwapFeeRate.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.WithdrawFeeRate.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.MaxOrderAmountRatio.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.UnitBatchHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.UnitBatchHeight))
+	if !this.ProtocolFeeRate.Equal(that1.ProtocolFeeRate) {
+		return false
 	}
-	if m.CircuitBreakerEnabled {
-		n += 2
+	if this.WithdrawProtocolFeeEnabled != that1.WithdrawProtocolFeeEnabled {
+		return false
 	}
-	return n
-}
-
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.MinPoolFeeRate.Equal(that1.MinPoolFeeRate) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	if !this.MaxPoolFeeRate.Equal(that1.MaxPoolFeeRate) {
+		return false
 	}
-	if m.TypeId != 0 {
-		n += 1 + sovLiquidity(uint64(m.TypeId))
+	if this.DynamicSwapFeeEnabled != that1.DynamicSwapFeeEnabled {
+		return false
 	}
-	if len(m.ReserveCoinDenoms) > 0 {
-		for _, s := range m.ReserveCoinDenoms {
-			l = len(s)
-			n += 1 + l + sovLiquidity(uint64(l))
-		}
+	if this.DynamicSwapFeeLookback != that1.DynamicSwapFeeLookback {
+		return false
 	}
-	l = len(m.ReserveAccountAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if !this.DynamicSwapFeeSensitivity.Equal(that1.DynamicSwapFeeSensitivity) {
+		return false
 	}
-	l = len(m.PoolCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if !this.DynamicSwapFeeDecayRate.Equal(that1.DynamicSwapFeeDecayRate) {
+		return false
 	}
-	return n
-}
-
-func (m *PoolMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.CircuitBreakerAdmins) != len(that1.CircuitBreakerAdmins) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PoolId))
-	}
-	l = m.PoolCoinTotalSupply.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if len(m.ReserveCoins) > 0 {
-		for _, e := range m.ReserveCoins {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	for i := range this.CircuitBreakerAdmins {
+		if this.CircuitBreakerAdmins[i] != that1.CircuitBreakerAdmins[i] {
+			return false
 		}
 	}
-	return n
+	if !this.CircuitBreakerPriceMoveThreshold.Equal(that1.CircuitBreakerPriceMoveThreshold) {
+		return false
+	}
+	if this.CircuitBreakerPriceMoveLookback != that1.CircuitBreakerPriceMoveLookback {
+		return false
+	}
+	if !this.MaxOrderPriceDeviation.Equal(that1.MaxOrderPriceDeviation) {
+		return false
+	}
+	return true
 }
+func (this *Pool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PoolBatch) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Pool)
+	if !ok {
+		that2, ok := that.(Pool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PoolId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Index != 0 {
-		n += 1 + sovLiquidity(uint64(m.Index))
+	if this.Id != that1.Id {
+		return false
 	}
-	if m.BeginHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.BeginHeight))
+	if this.TypeId != that1.TypeId {
+		return false
 	}
-	if m.DepositMsgIndex != 0 {
-		n += 1 + sovLiquidity(uint64(m.DepositMsgIndex))
+	if len(this.ReserveCoinDenoms) != len(that1.ReserveCoinDenoms) {
+		return false
 	}
-	if m.WithdrawMsgIndex != 0 {
-		n += 1 + sovLiquidity(uint64(m.WithdrawMsgIndex))
+	for i := range this.ReserveCoinDenoms {
+		if this.ReserveCoinDenoms[i] != that1.ReserveCoinDenoms[i] {
+			return false
+		}
 	}
-	if m.SwapMsgIndex != 0 {
-		n += 1 + sovLiquidity(uint64(m.SwapMsgIndex))
+	if this.ReserveAccountAddress != that1.ReserveAccountAddress {
+		return false
 	}
-	if m.Executed {
-		n += 2
+	if this.PoolCoinDenom != that1.PoolCoinDenom {
+		return false
 	}
-	return n
+	if len(this.ReserveCoinWeights) != len(that1.ReserveCoinWeights) {
+		return false
+	}
+	for i := range this.ReserveCoinWeights {
+		if this.ReserveCoinWeights[i] != that1.ReserveCoinWeights[i] {
+			return false
+		}
+	}
+	if this.Amplification != that1.Amplification {
+		return false
+	}
+	return true
 }
+func (this *PoolMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DepositMsgState) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PoolMetadata)
+	if !ok {
+		that2, ok := that.(PoolMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.MsgIndex != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgIndex))
+	if this.PoolId != that1.PoolId {
+		return false
 	}
-	if m.Executed {
-		n += 2
+	if !this.PoolCoinTotalSupply.Equal(&that1.PoolCoinTotalSupply) {
+		return false
 	}
-	if m.Succeeded {
-		n += 2
+	if len(this.ReserveCoins) != len(that1.ReserveCoins) {
+		return false
 	}
-	if m.ToBeDeleted {
-		n += 2
+	for i := range this.ReserveCoins {
+		if !this.ReserveCoins[i].Equal(&that1.ReserveCoins[i]) {
+			return false
+		}
 	}
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
+	return true
+}
+func (this *PoolBatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
+
+	that1, ok := that.(*PoolBatch)
+	if !ok {
+		that2, ok := that.(PoolBatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.BeginHeight != that1.BeginHeight {
+		return false
+	}
+	if this.DepositMsgIndex != that1.DepositMsgIndex {
+		return false
+	}
+	if this.WithdrawMsgIndex != that1.WithdrawMsgIndex {
+		return false
+	}
+	if this.SwapMsgIndex != that1.SwapMsgIndex {
+		return false
+	}
+	if this.Executed != that1.Executed {
+		return false
+	}
+	return true
 }
+func (this *Position) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *WithdrawMsgState) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Position)
+	if !ok {
+		that2, ok := that.(Position)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.MsgIndex != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgIndex))
+	if this.Id != that1.Id {
+		return false
 	}
-	if m.Executed {
-		n += 2
+	if this.PoolId != that1.PoolId {
+		return false
 	}
-	if m.Succeeded {
-		n += 2
+	if this.OwnerAddress != that1.OwnerAddress {
+		return false
 	}
-	if m.ToBeDeleted {
-		n += 2
+	if !this.LowerPrice.Equal(that1.LowerPrice) {
+		return false
 	}
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
+	if !this.UpperPrice.Equal(that1.UpperPrice) {
+		return false
 	}
-	return n
+	if !this.Liquidity.Equal(that1.Liquidity) {
+		return false
+	}
+	if !this.FeeGrowthInsideLast.Equal(&that1.FeeGrowthInsideLast) {
+		return false
+	}
+	return true
 }
+func (this *FeeGrowth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SwapMsgState) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*FeeGrowth)
+	if !ok {
+		that2, ok := that.(FeeGrowth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.MsgIndex != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgIndex))
+	if !this.X.Equal(that1.X) {
+		return false
 	}
-	if m.Executed {
-		n += 2
+	if !this.Y.Equal(that1.Y) {
+		return false
 	}
-	if m.Succeeded {
-		n += 2
+	return true
+}
+func (this *PriceTick) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.ToBeDeleted {
-		n += 2
+
+	that1, ok := that.(*PriceTick)
+	if !ok {
+		that2, ok := that.(PriceTick)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.OrderExpiryHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.OrderExpiryHeight))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = m.ExchangedOfferCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.FeeGrowthOutside.Equal(&that1.FeeGrowthOutside) {
+		return false
+	}
+	return true
+}
+func (this *PoolBatchResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolBatchResult)
+	if !ok {
+		that2, ok := that.(PoolBatchResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.BatchIndex != that1.BatchIndex {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.SwapResults) != len(that1.SwapResults) {
+		return false
+	}
+	for i := range this.SwapResults {
+		if !this.SwapResults[i].Equal(&that1.SwapResults[i]) {
+			return false
+		}
+	}
+	if len(this.ReserveCoins) != len(that1.ReserveCoins) {
+		return false
+	}
+	for i := range this.ReserveCoins {
+		if !this.ReserveCoins[i].Equal(&that1.ReserveCoins[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PairSwapResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairSwapResult)
+	if !ok {
+		that2, ok := that.(PairSwapResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomX != that1.DenomX {
+		return false
+	}
+	if this.DenomY != that1.DenomY {
+		return false
+	}
+	if !this.SwapPrice.Equal(that1.SwapPrice) {
+		return false
+	}
+	if !this.XToYVolume.Equal(that1.XToYVolume) {
+		return false
+	}
+	if !this.YToXVolume.Equal(that1.YToXVolume) {
+		return false
+	}
+	if len(this.FeeCoins) != len(that1.FeeCoins) {
+		return false
+	}
+	for i := range this.FeeCoins {
+		if !this.FeeCoins[i].Equal(&that1.FeeCoins[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PriceAccumulator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceAccumulator)
+	if !ok {
+		that2, ok := that.(PriceAccumulator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.BatchIndex != that1.BatchIndex {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if len(this.PairAccumulators) != len(that1.PairAccumulators) {
+		return false
+	}
+	for i := range this.PairAccumulators {
+		if !this.PairAccumulators[i].Equal(&that1.PairAccumulators[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PairPriceAccumulator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairPriceAccumulator)
+	if !ok {
+		that2, ok := that.(PairPriceAccumulator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomX != that1.DenomX {
+		return false
+	}
+	if this.DenomY != that1.DenomY {
+		return false
+	}
+	if !this.LastPrice.Equal(that1.LastPrice) {
+		return false
+	}
+	if !this.CumulativePrice.Equal(that1.CumulativePrice) {
+		return false
+	}
+	return true
+}
+func (this *RewardPlan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardPlan)
+	if !ok {
+		that2, ok := that.(RewardPlan)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.FunderAddress != that1.FunderAddress {
+		return false
+	}
+	if len(this.RewardCoins) != len(that1.RewardCoins) {
+		return false
+	}
+	for i := range this.RewardCoins {
+		if !this.RewardCoins[i].Equal(&that1.RewardCoins[i]) {
+			return false
+		}
+	}
+	if len(this.DistributedCoins) != len(that1.DistributedCoins) {
+		return false
+	}
+	for i := range this.DistributedCoins {
+		if !this.DistributedCoins[i].Equal(&that1.DistributedCoins[i]) {
+			return false
+		}
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	if len(this.TruncatedRewards) != len(that1.TruncatedRewards) {
+		return false
+	}
+	for i := range this.TruncatedRewards {
+		if !this.TruncatedRewards[i].Equal(&that1.TruncatedRewards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RewardAccumulator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardAccumulator)
+	if !ok {
+		that2, ok := that.(RewardAccumulator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.TotalStaked.Equal(that1.TotalStaked) {
+		return false
+	}
+	if len(this.RewardPerShare) != len(that1.RewardPerShare) {
+		return false
+	}
+	for i := range this.RewardPerShare {
+		if !this.RewardPerShare[i].Equal(&that1.RewardPerShare[i]) {
+			return false
+		}
+	}
+	if len(this.TruncatedRewards) != len(that1.TruncatedRewards) {
+		return false
+	}
+	for i := range this.TruncatedRewards {
+		if !this.TruncatedRewards[i].Equal(&that1.TruncatedRewards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Stake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Stake)
+	if !ok {
+		that2, ok := that.(Stake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.StakerAddress != that1.StakerAddress {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if len(this.RewardPerShare) != len(that1.RewardPerShare) {
+		return false
+	}
+	for i := range this.RewardPerShare {
+		if !this.RewardPerShare[i].Equal(&that1.RewardPerShare[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Bond) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Bond)
+	if !ok {
+		that2, ok := that.(Bond)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.OwnerAddress != that1.OwnerAddress {
+		return false
+	}
+	if !this.PoolCoin.Equal(&that1.PoolCoin) {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if !this.UnbondTime.Equal(that1.UnbondTime) {
+		return false
+	}
+	return true
+}
+func (this *CollectedProtocolFees) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CollectedProtocolFees)
+	if !ok {
+		that2, ok := that.(CollectedProtocolFees)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if len(this.SwapFeeCoins) != len(that1.SwapFeeCoins) {
+		return false
+	}
+	for i := range this.SwapFeeCoins {
+		if !this.SwapFeeCoins[i].Equal(&that1.SwapFeeCoins[i]) {
+			return false
+		}
+	}
+	if len(this.WithdrawFeeCoins) != len(that1.WithdrawFeeCoins) {
+		return false
+	}
+	for i := range this.WithdrawFeeCoins {
+		if !this.WithdrawFeeCoins[i].Equal(&that1.WithdrawFeeCoins[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PoolFeeRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolFeeRate)
+	if !ok {
+		that2, ok := that.(PoolFeeRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.SwapFeeRate.Equal(that1.SwapFeeRate) {
+		return false
+	}
+	if !this.WithdrawFeeRate.Equal(that1.WithdrawFeeRate) {
+		return false
+	}
+	return true
+}
+func (this *DynamicSwapFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicSwapFee)
+	if !ok {
+		that2, ok := that.(DynamicSwapFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.BatchIndex != that1.BatchIndex {
+		return false
+	}
+	if !this.Volatility.Equal(that1.Volatility) {
+		return false
+	}
+	if !this.SwapFeeRate.Equal(that1.SwapFeeRate) {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreaker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreaker)
+	if !ok {
+		that2, ok := that.(CircuitBreaker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.MsgType != that1.MsgType {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+func (m *PoolType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxReserveCoinNum != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxReserveCoinNum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinReserveCoinNum != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MinReserveCoinNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxOrderPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxOrderPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	if m.CircuitBreakerPriceMoveLookback != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.CircuitBreakerPriceMoveLookback))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.CircuitBreakerPriceMoveThreshold.Size()
+		i -= size
+		if _, err := m.CircuitBreakerPriceMoveThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if len(m.CircuitBreakerAdmins) > 0 {
+		for iNdEx := len(m.CircuitBreakerAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CircuitBreakerAdmins[iNdEx])
+			copy(dAtA[i:], m.CircuitBreakerAdmins[iNdEx])
+			i = encodeVarintLiquidity(dAtA, i, uint64(len(m.CircuitBreakerAdmins[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	{
+		size := m.DynamicSwapFeeDecayRate.Size()
+		i -= size
+		if _, err := m.DynamicSwapFeeDecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size := m.DynamicSwapFeeSensitivity.Size()
+		i -= size
+		if _, err := m.DynamicSwapFeeSensitivity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.DynamicSwapFeeLookback != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.DynamicSwapFeeLookback))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.DynamicSwapFeeEnabled {
+		i--
+		if m.DynamicSwapFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.MaxPoolFeeRate.Size()
+		i -= size
+		if _, err := m.MaxPoolFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.MinPoolFeeRate.Size()
+		i -= size
+		if _, err := m.MinPoolFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.WithdrawProtocolFeeEnabled {
+		i--
+		if m.WithdrawProtocolFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.ProtocolFeeRate.Size()
+		i -= size
+		if _, err := m.ProtocolFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.BondDurations) > 0 {
+		for iNdEx := len(m.BondDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BondDurations[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BondDurations[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintLiquidity(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RewardPlanCreationFee) > 0 {
+		for iNdEx := len(m.RewardPlanCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPlanCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.PriceAccumulatorRetention != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PriceAccumulatorRetention))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BatchResultRetention != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchResultRetention))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxOrderLifespan != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxOrderLifespan))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.StableSwapAmplification != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.StableSwapAmplification))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CircuitBreakerEnabled {
+		i--
+		if m.CircuitBreakerEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.UnitBatchHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.UnitBatchHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MaxOrderAmountRatio.Size()
+		i -= size
+		if _, err := m.MaxOrderAmountRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.WithdrawFeeRate.Size()
+		i -= size
+		if _, err := m.WithdrawFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SwapFeeRate.Size()
+		i -= size
+		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.PoolCreationFee) > 0 {
+		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.MaxReserveCoinAmount.Size()
+		i -= size
+		if _, err := m.MaxReserveCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InitPoolCoinMintAmount.Size()
+		i -= size
+		if _, err := m.InitPoolCoinMintAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinInitDepositAmount.Size()
+		i -= size
+		if _, err := m.MinInitDepositAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolTypes) > 0 {
+		for iNdEx := len(m.PoolTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ReserveCoinWeights) > 0 {
+		dAtA2 := make([]byte, len(m.ReserveCoinWeights)*10)
+		var j1 int
+		for _, num := range m.ReserveCoinWeights {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PoolCoinDenom) > 0 {
+		i -= len(m.PoolCoinDenom)
+		copy(dAtA[i:], m.PoolCoinDenom)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.PoolCoinDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReserveAccountAddress) > 0 {
+		i -= len(m.ReserveAccountAddress)
+		copy(dAtA[i:], m.ReserveAccountAddress)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ReserveAccountAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReserveCoinDenoms) > 0 {
+		for iNdEx := len(m.ReserveCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReserveCoinDenoms[iNdEx])
+			copy(dAtA[i:], m.ReserveCoinDenoms[iNdEx])
+			i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ReserveCoinDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TypeId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.TypeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReserveCoins) > 0 {
+		for iNdEx := len(m.ReserveCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.PoolCoinTotalSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SwapMsgIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.SwapMsgIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WithdrawMsgIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.WithdrawMsgIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DepositMsgIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.DepositMsgIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BeginHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BeginHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositMsgState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositMsgState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ToBeDeleted {
+		i--
+		if m.ToBeDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawMsgState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawMsgState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ToBeDeleted {
+		i--
+		if m.ToBeDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapMsgState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapMsgState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.ReservedOfferCoinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.RemainingOfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.ExchangedOfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.OrderExpiryHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.OrderExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ToBeDeleted {
+		i--
+		if m.ToBeDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinDemandCoinAmount.Size()
+		i -= size
+		if _, err := m.MinDemandCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DemandCoinDenoms) > 0 {
+		for iNdEx := len(m.DemandCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DemandCoinDenoms[iNdEx])
+			copy(dAtA[i:], m.DemandCoinDenoms[iNdEx])
+			i = encodeVarintLiquidity(dAtA, i, uint64(len(m.DemandCoinDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PoolIds) > 0 {
+		dAtA12 := make([]byte, len(m.PoolIds)*10)
+		var j11 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeGrowthInsideLast.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.UpperPrice.Size()
+		i -= size
+		if _, err := m.UpperPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LowerPrice.Size()
+		i -= size
+		if _, err := m.LowerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeGrowth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeGrowth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeGrowth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Y.Size()
+		i -= size
+		if _, err := m.Y.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.X.Size()
+		i -= size
+		if _, err := m.X.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceTick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceTick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceTick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeGrowthOutside.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolBatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolBatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolBatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReserveCoins) > 0 {
+		for iNdEx := len(m.ReserveCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SwapResults) > 0 {
+		for iNdEx := len(m.SwapResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairSwapResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairSwapResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairSwapResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeCoins) > 0 {
+		for iNdEx := len(m.FeeCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.YToXVolume.Size()
+		i -= size
+		if _, err := m.YToXVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.XToYVolume.Size()
+		i -= size
+		if _, err := m.XToYVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapPrice.Size()
+		i -= size
+		if _, err := m.SwapPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DenomY) > 0 {
+		i -= len(m.DenomY)
+		copy(dAtA[i:], m.DenomY)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.DenomY)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomX) > 0 {
+		i -= len(m.DenomX)
+		copy(dAtA[i:], m.DenomX)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.DenomX)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PairAccumulators) > 0 {
+		for iNdEx := len(m.PairAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintLiquidity(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairPriceAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairPriceAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairPriceAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LastPrice.Size()
+		i -= size
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DenomY) > 0 {
+		i -= len(m.DenomY)
+		copy(dAtA[i:], m.DenomY)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.DenomY)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomX) > 0 {
+		i -= len(m.DenomX)
+		copy(dAtA[i:], m.DenomX)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.DenomX)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TruncatedRewards) > 0 {
+		for iNdEx := len(m.TruncatedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TruncatedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RewardCoins) > 0 {
+		for iNdEx := len(m.RewardCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TruncatedRewards) > 0 {
+		for iNdEx := len(m.TruncatedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TruncatedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RewardPerShare) > 0 {
+		for iNdEx := len(m.RewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TotalStaked.Size()
+		i -= size
+		if _, err := m.TotalStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPerShare) > 0 {
+		for iNdEx := len(m.RewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintLiquidity(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x2a
+	n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintLiquidity(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectedProtocolFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectedProtocolFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectedProtocolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawFeeCoins) > 0 {
+		for iNdEx := len(m.WithdrawFeeCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawFeeCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SwapFeeCoins) > 0 {
+		for iNdEx := len(m.SwapFeeCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFeeCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolFeeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolFeeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolFeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WithdrawFeeRate.Size()
+		i -= size
+		if _, err := m.WithdrawFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SwapFeeRate.Size()
+		i -= size
+		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolFeeRatesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolFeeRatesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolFeeRatesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolFeeRates) > 0 {
+		for iNdEx := len(m.PoolFeeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolFeeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolFeeRatesProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolFeeRatesProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolFeeRatesProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PoolFeeRates) > 0 {
+		for iNdEx := len(m.PoolFeeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolFeeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicSwapFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSwapFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSwapFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SwapFeeRate.Size()
+		i -= size
+		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BatchIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.MinReserveCoinNum != 0 {
+		n += 1 + sovLiquidity(uint64(m.MinReserveCoinNum))
+	}
+	if m.MaxReserveCoinNum != 0 {
+		n += 1 + sovLiquidity(uint64(m.MaxReserveCoinNum))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolTypes) > 0 {
+		for _, e := range m.PoolTypes {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MinInitDepositAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.InitPoolCoinMintAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.MaxReserveCoinAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.SwapFeeRate.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.WithdrawFeeRate.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.MaxOrderAmountRatio.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.UnitBatchHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.UnitBatchHeight))
+	}
+	if m.CircuitBreakerEnabled {
+		n += 2
+	}
+	if m.StableSwapAmplification != 0 {
+		n += 1 + sovLiquidity(uint64(m.StableSwapAmplification))
+	}
+	if m.MaxOrderLifespan != 0 {
+		n += 1 + sovLiquidity(uint64(m.MaxOrderLifespan))
+	}
+	if m.BatchResultRetention != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchResultRetention))
+	}
+	if m.PriceAccumulatorRetention != 0 {
+		n += 1 + sovLiquidity(uint64(m.PriceAccumulatorRetention))
+	}
+	if len(m.RewardPlanCreationFee) > 0 {
+		for _, e := range m.RewardPlanCreationFee {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.BondDurations) > 0 {
+		for _, e := range m.BondDurations {
+			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.ProtocolFeeRate.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	if m.WithdrawProtocolFeeEnabled {
+		n += 3
+	}
+	l = m.MinPoolFeeRate.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	l = m.MaxPoolFeeRate.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	if m.DynamicSwapFeeEnabled {
+		n += 3
+	}
+	if m.DynamicSwapFeeLookback != 0 {
+		n += 2 + sovLiquidity(uint64(m.DynamicSwapFeeLookback))
+	}
+	l = m.DynamicSwapFeeSensitivity.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	l = m.DynamicSwapFeeDecayRate.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	if len(m.CircuitBreakerAdmins) > 0 {
+		for _, s := range m.CircuitBreakerAdmins {
+			l = len(s)
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.CircuitBreakerPriceMoveThreshold.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	if m.CircuitBreakerPriceMoveLookback != 0 {
+		n += 2 + sovLiquidity(uint64(m.CircuitBreakerPriceMoveLookback))
+	}
+	l = m.MaxOrderPriceDeviation.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.TypeId != 0 {
+		n += 1 + sovLiquidity(uint64(m.TypeId))
+	}
+	if len(m.ReserveCoinDenoms) > 0 {
+		for _, s := range m.ReserveCoinDenoms {
+			l = len(s)
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = len(m.ReserveAccountAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.PoolCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.ReserveCoinWeights) > 0 {
+		l = 0
+		for _, e := range m.ReserveCoinWeights {
+			l += sovLiquidity(uint64(e))
+		}
+		n += 1 + sovLiquidity(uint64(l)) + l
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovLiquidity(uint64(m.Amplification))
+	}
+	return n
+}
+
+func (m *PoolMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = m.PoolCoinTotalSupply.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.ReserveCoins) > 0 {
+		for _, e := range m.ReserveCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovLiquidity(uint64(m.Index))
+	}
+	if m.BeginHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.BeginHeight))
+	}
+	if m.DepositMsgIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.DepositMsgIndex))
+	}
+	if m.WithdrawMsgIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.WithdrawMsgIndex))
+	}
+	if m.SwapMsgIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.SwapMsgIndex))
+	}
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *DepositMsgState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgIndex))
+	}
+	if m.Executed {
+		n += 2
+	}
+	if m.Succeeded {
+		n += 2
+	}
+	if m.ToBeDeleted {
+		n += 2
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawMsgState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgIndex))
+	}
+	if m.Executed {
+		n += 2
+	}
+	if m.Succeeded {
+		n += 2
+	}
+	if m.ToBeDeleted {
+		n += 2
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapMsgState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgIndex))
+	}
+	if m.Executed {
+		n += 2
+	}
+	if m.Succeeded {
+		n += 2
+	}
+	if m.ToBeDeleted {
+		n += 2
+	}
+	if m.OrderExpiryHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.OrderExpiryHeight))
+	}
+	l = m.ExchangedOfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
 	l = m.RemainingOfferCoin.Size()
 	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.ReservedOfferCoinFee.Size()
+	l = m.ReservedOfferCoinFee.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovLiquidity(uint64(e))
+		}
+		n += 1 + sovLiquidity(uint64(l)) + l
+	}
+	if len(m.DemandCoinDenoms) > 0 {
+		for _, s := range m.DemandCoinDenoms {
+			l = len(s)
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MinDemandCoinAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.LowerPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.UpperPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.FeeGrowthInsideLast.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *FeeGrowth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.X.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Y.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *PriceTick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.FeeGrowthOutside.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *PoolBatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.BatchIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchIndex))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidity(uint64(m.Height))
+	}
+	if len(m.SwapResults) > 0 {
+		for _, e := range m.SwapResults {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.ReserveCoins) > 0 {
+		for _, e := range m.ReserveCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairSwapResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomX)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.DenomY)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.SwapPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.XToYVolume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.YToXVolume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.FeeCoins) > 0 {
+		for _, e := range m.FeeCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.BatchIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchIndex))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidity(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.PairAccumulators) > 0 {
+		for _, e := range m.PairAccumulators {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairPriceAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomX)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.DenomY)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.LastPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *RewardPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.RewardCoins) > 0 {
+		for _, e := range m.RewardCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.EndHeight))
+	}
+	if len(m.TruncatedRewards) > 0 {
+		for _, e := range m.TruncatedRewards {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = m.TotalStaked.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.RewardPerShare) > 0 {
+		for _, e := range m.RewardPerShare {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.TruncatedRewards) > 0 {
+		for _, e := range m.TruncatedRewards {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Stake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.RewardPerShare) > 0 {
+		for _, e := range m.RewardPerShare {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Bond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondTime)
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *CollectedProtocolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if len(m.SwapFeeCoins) > 0 {
+		for _, e := range m.SwapFeeCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.WithdrawFeeCoins) > 0 {
+		for _, e := range m.WithdrawFeeCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolFeeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = m.SwapFeeRate.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.WithdrawFeeRate.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *PoolFeeRatesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.PoolFeeRates) > 0 {
+		for _, e := range m.PoolFeeRates {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolFeeRatesProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.PoolFeeRates) > 0 {
+		for _, e := range m.PoolFeeRates {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicSwapFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.BatchIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchIndex))
+	}
+	l = m.Volatility.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.SwapFeeRate.Size()
 	n += 1 + l + sovLiquidity(uint64(l))
-	if m.Msg != nil {
-		l = m.Msg.Size()
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *CircuitBreakerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
 		n += 1 + l + sovLiquidity(uint64(l))
 	}
-	return n
-}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CircuitBreakerProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func sovLiquidity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidity(x uint64) (n int) {
+	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReserveCoinNum", wireType)
+			}
+			m.MinReserveCoinNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReserveCoinNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReserveCoinNum", wireType)
+			}
+			m.MaxReserveCoinNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReserveCoinNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolTypes = append(m.PoolTypes, PoolType{})
+			if err := m.PoolTypes[len(m.PoolTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitDepositAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitPoolCoinMintAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitPoolCoinMintAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReserveCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxReserveCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderAmountRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOrderAmountRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitBatchHeight", wireType)
+			}
+			m.UnitBatchHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnitBatchHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CircuitBreakerEnabled = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSwapAmplification", wireType)
+			}
+			m.StableSwapAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableSwapAmplification |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderLifespan", wireType)
+			}
+			m.MaxOrderLifespan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrderLifespan |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchResultRetention", wireType)
+			}
+			m.BatchResultRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchResultRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceAccumulatorRetention", wireType)
+			}
+			m.PriceAccumulatorRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceAccumulatorRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPlanCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPlanCreationFee = append(m.RewardPlanCreationFee, types.Coin{})
+			if err := m.RewardPlanCreationFee[len(m.RewardPlanCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDurations = append(m.BondDurations, time.Duration(0))
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&(m.BondDurations[len(m.BondDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawProtocolFeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawProtocolFeeEnabled = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPoolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPoolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicSwapFeeEnabled = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeLookback", wireType)
+			}
+			m.DynamicSwapFeeLookback = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DynamicSwapFeeLookback |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeSensitivity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicSwapFeeSensitivity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeDecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicSwapFeeDecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerAdmins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerAdmins = append(m.CircuitBreakerAdmins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerPriceMoveThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerPriceMoveThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerPriceMoveLookback", wireType)
+			}
+			m.CircuitBreakerPriceMoveLookback = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerPriceMoveLookback |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOrderPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeId", wireType)
+			}
+			m.TypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveCoinDenoms = append(m.ReserveCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReserveCoinWeights = append(m.ReserveCoinWeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReserveCoinWeights) == 0 {
+					m.ReserveCoinWeights = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLiquidity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReserveCoinWeights = append(m.ReserveCoinWeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinWeights", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinTotalSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoinTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveCoins = append(m.ReserveCoins, types.Coin{})
+			if err := m.ReserveCoins[len(m.ReserveCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginHeight", wireType)
+			}
+			m.BeginHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositMsgIndex", wireType)
+			}
+			m.DepositMsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositMsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawMsgIndex", wireType)
+			}
+			m.WithdrawMsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawMsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapMsgIndex", wireType)
+			}
+			m.SwapMsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapMsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositMsgState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToBeDeleted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgDepositWithinBatch{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawMsgState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToBeDeleted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgWithdrawWithinBatch{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapMsgState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToBeDeleted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderExpiryHeight", wireType)
+			}
+			m.OrderExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedOfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedOfferCoinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservedOfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgSwapWithinBatch{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &SwapRoute{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLiquidity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandCoinDenoms = append(m.DemandCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDemandCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDemandCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideLast", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInsideLast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeGrowth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeGrowth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeGrowth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.X.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Y", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Y.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceTick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceTick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceTick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolBatchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolBatchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
+			}
+			m.BatchIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapResults = append(m.SwapResults, PairSwapResult{})
+			if err := m.SwapResults[len(m.SwapResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveCoins = append(m.ReserveCoins, types.Coin{})
+			if err := m.ReserveCoins[len(m.ReserveCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairSwapResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairSwapResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairSwapResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomX", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomX = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomY = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XToYVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.XToYVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YToXVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YToXVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCoins = append(m.FeeCoins, types.Coin{})
+			if err := m.FeeCoins[len(m.FeeCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
+			}
+			m.BatchIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairAccumulators = append(m.PairAccumulators, PairPriceAccumulator{})
+			if err := m.PairAccumulators[len(m.PairAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairPriceAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairPriceAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairPriceAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomX", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomX = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomY = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovLiquidity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidity(x uint64) (n int) {
-	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *PoolType) Unmarshal(dAtA []byte) error {
+func (m *RewardPlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1670,10 +9238,10 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolType: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardPlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardPlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1690,14 +9258,33 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1725,13 +9312,81 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardCoins = append(m.RewardCoins, types.Coin{})
+			if err := m.RewardCoins[len(m.RewardCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinReserveCoinNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.MinReserveCoinNum = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1741,16 +9396,16 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinReserveCoinNum |= uint32(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReserveCoinNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			m.MaxReserveCoinNum = 0
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1760,16 +9415,16 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxReserveCoinNum |= uint32(b&0x7F) << shift
+				m.EndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatedRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1779,23 +9434,25 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.TruncatedRewards = append(m.TruncatedRewards, types.DecCoin{})
+			if err := m.TruncatedRewards[len(m.TruncatedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1818,7 +9475,7 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *RewardAccumulator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1841,17 +9498,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardAccumulator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1861,29 +9518,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PoolTypes = append(m.PoolTypes, PoolType{})
-			if err := m.PoolTypes[len(m.PoolTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitDepositAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1911,15 +9553,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinInitDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitPoolCoinMintAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1929,31 +9571,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitPoolCoinMintAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardPerShare = append(m.RewardPerShare, types.DecCoin{})
+			if err := m.RewardPerShare[len(m.RewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReserveCoinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatedRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1963,65 +9605,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxReserveCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TruncatedRewards = append(m.TruncatedRewards, types.DecCoin{})
+			if err := m.TruncatedRewards[len(m.TruncatedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2031,29 +9689,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2081,13 +9724,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderAmountRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2115,15 +9756,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxOrderAmountRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnitBatchHeight", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
 			}
-			m.UnitBatchHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2133,31 +9774,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnitBatchHeight |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerEnabled", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.CircuitBreakerEnabled = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerShare = append(m.RewardPerShare, types.DecCoin{})
+			if err := m.RewardPerShare[len(m.RewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2179,7 +9815,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *Bond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2202,10 +9838,10 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2228,10 +9864,10 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
-			m.TypeId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2241,16 +9877,29 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TypeId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2260,29 +9909,30 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveCoinDenoms = append(m.ReserveCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2292,29 +9942,30 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveAccountAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2324,23 +9975,24 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2363,7 +10015,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
+func (m *CollectedProtocolFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2386,10 +10038,10 @@ func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectedProtocolFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectedProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2413,7 +10065,7 @@ func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2440,13 +10092,14 @@ func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolCoinTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SwapFeeCoins = append(m.SwapFeeCoins, types.Coin{})
+			if err := m.SwapFeeCoins[len(m.SwapFeeCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2473,8 +10126,8 @@ func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveCoins = append(m.ReserveCoins, types.Coin{})
-			if err := m.ReserveCoins[len(m.ReserveCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WithdrawFeeCoins = append(m.WithdrawFeeCoins, types.Coin{})
+			if err := m.WithdrawFeeCoins[len(m.WithdrawFeeCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2499,7 +10152,7 @@ func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolBatch) Unmarshal(dAtA []byte) error {
+func (m *PoolFeeRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2522,10 +10175,10 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolFeeRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolFeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2548,10 +10201,10 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
 			}
-			m.Index = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2561,16 +10214,31 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
 			}
-			m.BeginHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2580,35 +10248,81 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BeginHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositMsgIndex", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.DepositMsgIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DepositMsgIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawMsgIndex", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolFeeRatesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolFeeRatesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolFeeRatesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.WithdrawMsgIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2618,16 +10332,29 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawMsgIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapMsgIndex", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.SwapMsgIndex = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2637,16 +10364,29 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SwapMsgIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolFeeRates", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2656,12 +10396,26 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Executed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolFeeRates = append(m.PoolFeeRates, PoolFeeRate{})
+			if err := m.PoolFeeRates[len(m.PoolFeeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2683,7 +10437,7 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
+func (m *PoolFeeRatesProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2706,17 +10460,17 @@ func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositMsgState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolFeeRatesProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolFeeRatesProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.MsgHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2726,16 +10480,29 @@ func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.MsgIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2745,16 +10512,29 @@ func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolFeeRates", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2764,57 +10544,31 @@ func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Executed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.Succeeded = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.PoolFeeRates = append(m.PoolFeeRates, PoolFeeRate{})
+			if err := m.PoolFeeRates[len(m.PoolFeeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ToBeDeleted = bool(v != 0)
-		case 6:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2824,27 +10578,23 @@ func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgDepositWithinBatch{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2867,7 +10617,7 @@ func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
+func (m *DynamicSwapFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2890,17 +10640,17 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawMsgState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicSwapFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicSwapFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.MsgHeight = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2910,16 +10660,16 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
 			}
-			m.MsgIndex = 0
+			m.BatchIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2929,16 +10679,16 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
+				m.BatchIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2948,57 +10698,31 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Executed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.Succeeded = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ToBeDeleted = bool(v != 0)
-		case 6:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3008,25 +10732,23 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgWithdrawWithinBatch{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3051,7 +10773,7 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3074,17 +10796,17 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapMsgState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.MsgHeight = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3094,16 +10816,16 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
 			}
-			m.MsgIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3113,14 +10835,27 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3137,12 +10872,62 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Executed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3152,17 +10937,29 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Succeeded = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3172,17 +10969,29 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ToBeDeleted = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderExpiryHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.OrderExpiryHeight = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3192,16 +11001,81 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderExpiryHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedOfferCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3211,30 +11085,29 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOfferCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3244,28 +11117,27 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservedOfferCoinFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3292,15 +11164,16 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservedOfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3310,27 +11183,23 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgSwapWithinBatch{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // PoolName returns unique name of the pool consists of given reserve coin denoms and type id.
@@ -18,6 +19,56 @@ func (pool Pool) Name() string {
 	return PoolName(pool.ReserveCoinDenoms, pool.TypeId)
 }
 
+// HasReserveCoinDenom returns true if the given denom is one of the pool's reserve coin denoms.
+func (pool Pool) HasReserveCoinDenom(denom string) bool {
+	for _, reserveCoinDenom := range pool.ReserveCoinDenoms {
+		if reserveCoinDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// ReserveCoinWeight returns the weight of the given reserve coin denom of the pool.
+// Every reserve coin of a pool which is not weighted has the same weight of one.
+func (pool Pool) ReserveCoinWeight(denom string) sdk.Dec {
+	if len(pool.ReserveCoinWeights) == 0 {
+		return sdk.OneDec()
+	}
+	for i, reserveCoinDenom := range pool.ReserveCoinDenoms {
+		if reserveCoinDenom == denom {
+			return sdk.NewDec(int64(pool.ReserveCoinWeights[i]))
+		}
+	}
+	return sdk.ZeroDec()
+}
+
+// ValidateReserveCoinWeights validates the reserve coin weights of a weighted pool with the given number of reserve coins.
+func ValidateReserveCoinWeights(weights []uint32, reserveCoinNum int) error {
+	if len(weights) != reserveCoinNum {
+		return sdkerrors.Wrapf(ErrBadReserveCoinWeights, "%d weights are given for %d reserve coins", len(weights), reserveCoinNum)
+	}
+	sum := uint32(0)
+	for _, weight := range weights {
+		if weight == 0 || weight >= TotalReserveCoinWeight {
+			return sdkerrors.Wrapf(ErrBadReserveCoinWeights, "weight must be between 1 and %d: %d", TotalReserveCoinWeight-1, weight)
+		}
+		sum += weight
+	}
+	if sum != TotalReserveCoinWeight {
+		return sdkerrors.Wrapf(ErrBadReserveCoinWeights, "weights must sum up to %d: %d", TotalReserveCoinWeight, sum)
+	}
+	return nil
+}
+
+// ValidateAmplification validates the amplification coefficient of the StableSwap curve of a stable pool.
+func ValidateAmplification(amplification uint32) error {
+	if amplification == 0 || amplification > MaxStableSwapAmplification {
+		return sdkerrors.Wrapf(ErrBadAmplification, "amplification must be between 1 and %d: %d", MaxStableSwapAmplification, amplification)
+	}
+	return nil
+}
+
 // Validate validates Pool.
 func (pool Pool) Validate() error {
 	if pool.Id == 0 {
@@ -32,9 +83,15 @@ func (pool Pool) Validate() error {
 	if uint32(len(pool.ReserveCoinDenoms)) > MaxReserveCoinNum || uint32(len(pool.ReserveCoinDenoms)) < MinReserveCoinNum {
 		return ErrNumOfReserveCoinDenoms
 	}
-	sortedDenomA, sortedDenomB := AlphabeticalDenomPair(pool.ReserveCoinDenoms[0], pool.ReserveCoinDenoms[1])
-	if sortedDenomA != pool.ReserveCoinDenoms[0] || sortedDenomB != pool.ReserveCoinDenoms[1] {
-		return ErrBadOrderingReserveCoinDenoms
+	for i := 1; i < len(pool.ReserveCoinDenoms); i++ {
+		if pool.ReserveCoinDenoms[i-1] >= pool.ReserveCoinDenoms[i] {
+			return ErrBadOrderingReserveCoinDenoms
+		}
+	}
+	if len(pool.ReserveCoinWeights) > 0 {
+		if err := ValidateReserveCoinWeights(pool.ReserveCoinWeights, len(pool.ReserveCoinDenoms)); err != nil {
+			return err
+		}
 	}
 	if pool.ReserveAccountAddress == "" {
 		return ErrEmptyReserveAccountAddress
@@ -188,3 +245,223 @@ func MustUnmarshalSwapMsgState(cdc codec.BinaryCodec, value []byte) SwapMsgState
 	}
 	return msg
 }
+
+// MustMarshalPosition returns the Position bytes. Panics if fails.
+func MustMarshalPosition(cdc codec.BinaryCodec, position Position) []byte {
+	return cdc.MustMarshal(&position)
+}
+
+// UnmarshalPosition returns the Position from bytes.
+func UnmarshalPosition(cdc codec.BinaryCodec, value []byte) (position Position, err error) {
+	err = cdc.Unmarshal(value, &position)
+	return position, err
+}
+
+// MustUnmarshalPosition returns the Position from bytes. Panics if fails.
+func MustUnmarshalPosition(cdc codec.BinaryCodec, value []byte) Position {
+	position, err := UnmarshalPosition(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return position
+}
+
+// MustMarshalPoolBatchResult returns the PoolBatchResult bytes. Panics if fails.
+func MustMarshalPoolBatchResult(cdc codec.BinaryCodec, result PoolBatchResult) []byte {
+	return cdc.MustMarshal(&result)
+}
+
+// UnmarshalPoolBatchResult returns the PoolBatchResult from bytes.
+func UnmarshalPoolBatchResult(cdc codec.BinaryCodec, value []byte) (result PoolBatchResult, err error) {
+	err = cdc.Unmarshal(value, &result)
+	return result, err
+}
+
+// MustUnmarshalPoolBatchResult returns the PoolBatchResult from bytes. Panics if fails.
+func MustUnmarshalPoolBatchResult(cdc codec.BinaryCodec, value []byte) PoolBatchResult {
+	result, err := UnmarshalPoolBatchResult(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// MustMarshalPriceAccumulator returns the PriceAccumulator bytes. Panics if fails.
+func MustMarshalPriceAccumulator(cdc codec.BinaryCodec, accumulator PriceAccumulator) []byte {
+	return cdc.MustMarshal(&accumulator)
+}
+
+// UnmarshalPriceAccumulator returns the PriceAccumulator from bytes.
+func UnmarshalPriceAccumulator(cdc codec.BinaryCodec, value []byte) (accumulator PriceAccumulator, err error) {
+	err = cdc.Unmarshal(value, &accumulator)
+	return accumulator, err
+}
+
+// MustUnmarshalPriceAccumulator returns the PriceAccumulator from bytes. Panics if fails.
+func MustUnmarshalPriceAccumulator(cdc codec.BinaryCodec, value []byte) PriceAccumulator {
+	accumulator, err := UnmarshalPriceAccumulator(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return accumulator
+}
+
+// MustMarshalRewardPlan returns the RewardPlan bytes. Panics if fails.
+func MustMarshalRewardPlan(cdc codec.BinaryCodec, plan RewardPlan) []byte {
+	return cdc.MustMarshal(&plan)
+}
+
+// UnmarshalRewardPlan returns the RewardPlan from bytes.
+func UnmarshalRewardPlan(cdc codec.BinaryCodec, value []byte) (plan RewardPlan, err error) {
+	err = cdc.Unmarshal(value, &plan)
+	return plan, err
+}
+
+// MustUnmarshalRewardPlan returns the RewardPlan from bytes. Panics if fails.
+func MustUnmarshalRewardPlan(cdc codec.BinaryCodec, value []byte) RewardPlan {
+	plan, err := UnmarshalRewardPlan(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return plan
+}
+
+// MustMarshalRewardAccumulator returns the RewardAccumulator bytes. Panics if fails.
+func MustMarshalRewardAccumulator(cdc codec.BinaryCodec, accumulator RewardAccumulator) []byte {
+	return cdc.MustMarshal(&accumulator)
+}
+
+// UnmarshalRewardAccumulator returns the RewardAccumulator from bytes.
+func UnmarshalRewardAccumulator(cdc codec.BinaryCodec, value []byte) (accumulator RewardAccumulator, err error) {
+	err = cdc.Unmarshal(value, &accumulator)
+	return accumulator, err
+}
+
+// MustUnmarshalRewardAccumulator returns the RewardAccumulator from bytes. Panics if fails.
+func MustUnmarshalRewardAccumulator(cdc codec.BinaryCodec, value []byte) RewardAccumulator {
+	accumulator, err := UnmarshalRewardAccumulator(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return accumulator
+}
+
+// MustMarshalStake returns the Stake bytes. Panics if fails.
+func MustMarshalStake(cdc codec.BinaryCodec, stake Stake) []byte {
+	return cdc.MustMarshal(&stake)
+}
+
+// UnmarshalStake returns the Stake from bytes.
+func UnmarshalStake(cdc codec.BinaryCodec, value []byte) (stake Stake, err error) {
+	err = cdc.Unmarshal(value, &stake)
+	return stake, err
+}
+
+// MustUnmarshalStake returns the Stake from bytes. Panics if fails.
+func MustUnmarshalStake(cdc codec.BinaryCodec, value []byte) Stake {
+	stake, err := UnmarshalStake(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return stake
+}
+
+// MustMarshalBond returns the Bond bytes. Panics if fails.
+func MustMarshalBond(cdc codec.BinaryCodec, bond Bond) []byte {
+	return cdc.MustMarshal(&bond)
+}
+
+// UnmarshalBond returns the Bond from bytes.
+func UnmarshalBond(cdc codec.BinaryCodec, value []byte) (bond Bond, err error) {
+	err = cdc.Unmarshal(value, &bond)
+	return bond, err
+}
+
+// MustUnmarshalBond returns the Bond from bytes. Panics if fails.
+func MustUnmarshalBond(cdc codec.BinaryCodec, value []byte) Bond {
+	bond, err := UnmarshalBond(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return bond
+}
+
+// MustMarshalCollectedProtocolFees returns the CollectedProtocolFees bytes. Panics if fails.
+func MustMarshalCollectedProtocolFees(cdc codec.BinaryCodec, fees CollectedProtocolFees) []byte {
+	return cdc.MustMarshal(&fees)
+}
+
+// UnmarshalCollectedProtocolFees returns the CollectedProtocolFees from bytes.
+func UnmarshalCollectedProtocolFees(cdc codec.BinaryCodec, value []byte) (fees CollectedProtocolFees, err error) {
+	err = cdc.Unmarshal(value, &fees)
+	return fees, err
+}
+
+// MustUnmarshalCollectedProtocolFees returns the CollectedProtocolFees from bytes. Panics if fails.
+func MustUnmarshalCollectedProtocolFees(cdc codec.BinaryCodec, value []byte) CollectedProtocolFees {
+	fees, err := UnmarshalCollectedProtocolFees(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return fees
+}
+
+// MustMarshalPoolFeeRate returns the PoolFeeRate bytes. Panics if fails.
+func MustMarshalPoolFeeRate(cdc codec.BinaryCodec, feeRate PoolFeeRate) []byte {
+	return cdc.MustMarshal(&feeRate)
+}
+
+// UnmarshalPoolFeeRate returns the PoolFeeRate from bytes.
+func UnmarshalPoolFeeRate(cdc codec.BinaryCodec, value []byte) (feeRate PoolFeeRate, err error) {
+	err = cdc.Unmarshal(value, &feeRate)
+	return feeRate, err
+}
+
+// MustUnmarshalPoolFeeRate returns the PoolFeeRate from bytes. Panics if fails.
+func MustUnmarshalPoolFeeRate(cdc codec.BinaryCodec, value []byte) PoolFeeRate {
+	feeRate, err := UnmarshalPoolFeeRate(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return feeRate
+}
+
+// MustMarshalDynamicSwapFee returns the DynamicSwapFee bytes. Panics if fails.
+func MustMarshalDynamicSwapFee(cdc codec.BinaryCodec, fee DynamicSwapFee) []byte {
+	return cdc.MustMarshal(&fee)
+}
+
+// UnmarshalDynamicSwapFee returns the DynamicSwapFee from bytes.
+func UnmarshalDynamicSwapFee(cdc codec.BinaryCodec, value []byte) (fee DynamicSwapFee, err error) {
+	err = cdc.Unmarshal(value, &fee)
+	return fee, err
+}
+
+// MustUnmarshalDynamicSwapFee returns the DynamicSwapFee from bytes. Panics if fails.
+func MustUnmarshalDynamicSwapFee(cdc codec.BinaryCodec, value []byte) DynamicSwapFee {
+	fee, err := UnmarshalDynamicSwapFee(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return fee
+}
+
+// MustMarshalCircuitBreaker returns the CircuitBreaker bytes. Panics if fails.
+func MustMarshalCircuitBreaker(cdc codec.BinaryCodec, circuitBreaker CircuitBreaker) []byte {
+	return cdc.MustMarshal(&circuitBreaker)
+}
+
+// UnmarshalCircuitBreaker returns the CircuitBreaker from bytes.
+func UnmarshalCircuitBreaker(cdc codec.BinaryCodec, value []byte) (circuitBreaker CircuitBreaker, err error) {
+	err = cdc.Unmarshal(value, &circuitBreaker)
+	return circuitBreaker, err
+}
+
+// MustUnmarshalCircuitBreaker returns the CircuitBreaker from bytes. Panics if fails.
+func MustUnmarshalCircuitBreaker(cdc codec.BinaryCodec, value []byte) CircuitBreaker {
+	circuitBreaker, err := UnmarshalCircuitBreaker(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return circuitBreaker
+}
@@ -52,9 +52,12 @@ func TestLiquidityPoolBatch(t *testing.T) {
 	pool.TypeId = 1
 	require.Equal(t, types.ErrNumOfReserveCoinDenoms, pool.Validate())
 
-	pool.ReserveCoinDenoms = []string{DenomY, DenomX, DenomX}
+	pool.ReserveCoinDenoms = []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"}
 	require.Equal(t, types.ErrNumOfReserveCoinDenoms, pool.Validate())
 
+	pool.ReserveCoinDenoms = []string{DenomX, DenomY, DenomX}
+	require.Equal(t, types.ErrBadOrderingReserveCoinDenoms, pool.Validate())
+
 	pool.ReserveCoinDenoms = []string{DenomY, DenomX}
 	require.Equal(t, types.ErrBadOrderingReserveCoinDenoms, pool.Validate())
 
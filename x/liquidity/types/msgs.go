@@ -1,7 +1,10 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -9,6 +12,17 @@ var (
 	_ sdk.Msg = (*MsgDepositWithinBatch)(nil)
 	_ sdk.Msg = (*MsgWithdrawWithinBatch)(nil)
 	_ sdk.Msg = (*MsgSwapWithinBatch)(nil)
+	_ sdk.Msg = (*MsgDepositToRange)(nil)
+	_ sdk.Msg = (*MsgWithdrawFromRange)(nil)
+	_ sdk.Msg = (*MsgCancelSwap)(nil)
+	_ sdk.Msg = (*MsgSwapRoute)(nil)
+	_ sdk.Msg = (*MsgCreateRewardPlan)(nil)
+	_ sdk.Msg = (*MsgStake)(nil)
+	_ sdk.Msg = (*MsgUnstake)(nil)
+	_ sdk.Msg = (*MsgClaimRewards)(nil)
+	_ sdk.Msg = (*MsgBond)(nil)
+	_ sdk.Msg = (*MsgBeginUnbond)(nil)
+	_ sdk.Msg = (*MsgSetCircuitBreaker)(nil)
 )
 
 // Message types for the liquidity module
@@ -17,6 +31,17 @@ const (
 	TypeMsgDepositWithinBatch  = "deposit_within_batch"
 	TypeMsgWithdrawWithinBatch = "withdraw_within_batch"
 	TypeMsgSwapWithinBatch     = "swap_within_batch"
+	TypeMsgDepositToRange      = "deposit_to_range"
+	TypeMsgWithdrawFromRange   = "withdraw_from_range"
+	TypeMsgCancelSwap          = "cancel_swap"
+	TypeMsgSwapRoute           = "swap_route"
+	TypeMsgCreateRewardPlan    = "create_reward_plan"
+	TypeMsgStake               = "stake"
+	TypeMsgUnstake             = "unstake"
+	TypeMsgClaimRewards        = "claim_rewards"
+	TypeMsgBond                = "bond"
+	TypeMsgBeginUnbond         = "begin_unbond"
+	TypeMsgSetCircuitBreaker   = "set_circuit_breaker"
 )
 
 // NewMsgCreatePool creates a new MsgCreatePool.
@@ -28,6 +53,16 @@ func NewMsgCreatePool(poolCreator sdk.AccAddress, poolTypeID uint32, depositCoin
 	}
 }
 
+// NewMsgCreateWeightedPool creates a new MsgCreatePool of the weighted pool type.
+func NewMsgCreateWeightedPool(poolCreator sdk.AccAddress, depositCoins sdk.Coins, reserveCoinWeights []uint32) *MsgCreatePool {
+	return &MsgCreatePool{
+		PoolCreatorAddress: poolCreator.String(),
+		PoolTypeId:         WeightedPoolTypeID,
+		DepositCoins:       depositCoins,
+		ReserveCoinWeights: reserveCoinWeights,
+	}
+}
+
 func (msg MsgCreatePool) Route() string { return RouterKey }
 
 func (msg MsgCreatePool) Type() string { return TypeMsgCreatePool }
@@ -45,6 +80,16 @@ func (msg MsgCreatePool) ValidateBasic() error {
 	if n := uint32(len(msg.DepositCoins)); n > MaxReserveCoinNum || n < MinReserveCoinNum {
 		return ErrNumOfReserveCoin
 	}
+	if len(msg.ReserveCoinWeights) > 0 {
+		if err := ValidateReserveCoinWeights(msg.ReserveCoinWeights, len(msg.DepositCoins)); err != nil {
+			return err
+		}
+	}
+	if msg.Amplification != 0 {
+		if err := ValidateAmplification(msg.Amplification); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -91,7 +136,8 @@ func (msg MsgDepositWithinBatch) ValidateBasic() error {
 	if !msg.DepositCoins.IsAllPositive() {
 		return ErrBadDepositCoinsAmount
 	}
-	if n := uint32(len(msg.DepositCoins)); n > MaxReserveCoinNum || n < MinReserveCoinNum {
+	// a subset of the reserve coins can be deposited to the weighted pools
+	if n := uint32(len(msg.DepositCoins)); n > MaxReserveCoinNum || n == 0 {
 		return ErrNumOfReserveCoin
 	}
 	return nil
@@ -140,6 +186,11 @@ func (msg MsgWithdrawWithinBatch) ValidateBasic() error {
 	if !msg.PoolCoin.IsPositive() {
 		return ErrBadPoolCoinAmount
 	}
+	if msg.WithdrawCoinDenom != "" {
+		if err := sdk.ValidateDenom(msg.WithdrawCoinDenom); err != nil {
+			return sdkerrors.Wrap(ErrBadWithdrawCoinDenom, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -204,6 +255,21 @@ func (msg MsgSwapWithinBatch) ValidateBasic() error {
 	if !msg.OfferCoin.Amount.GTE(MinOfferCoinAmount) {
 		return ErrLessThanMinOfferAmount
 	}
+	if !msg.MinDemandCoinAmount.IsNil() && msg.MinDemandCoinAmount.IsNegative() {
+		return ErrBadMinDemandCoinAmount
+	}
+	switch msg.SwapTypeId {
+	case DefaultSwapTypeID:
+		if !msg.DemandCoinAmount.IsNil() && !msg.DemandCoinAmount.IsZero() {
+			return ErrBadDemandCoinAmount
+		}
+	case ExactOutputSwapTypeID:
+		if msg.DemandCoinAmount.IsNil() || !msg.DemandCoinAmount.IsPositive() {
+			return ErrBadDemandCoinAmount
+		}
+	default:
+		return ErrSwapTypeNotExists
+	}
 	return nil
 }
 
@@ -226,3 +292,534 @@ func (msg MsgSwapWithinBatch) GetSwapRequester() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgDepositToRange creates a new MsgDepositToRange.
+func NewMsgDepositToRange(depositor sdk.AccAddress, poolID uint64, lowerPrice, upperPrice sdk.Dec, depositCoins sdk.Coins) *MsgDepositToRange {
+	return &MsgDepositToRange{
+		DepositorAddress: depositor.String(),
+		PoolId:           poolID,
+		LowerPrice:       lowerPrice,
+		UpperPrice:       upperPrice,
+		DepositCoins:     depositCoins,
+	}
+}
+
+func (msg MsgDepositToRange) Route() string { return RouterKey }
+
+func (msg MsgDepositToRange) Type() string { return TypeMsgDepositToRange }
+
+func (msg MsgDepositToRange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DepositorAddress); err != nil {
+		return ErrInvalidDepositorAddr
+	}
+	if err := ValidatePriceRange(msg.LowerPrice, msg.UpperPrice); err != nil {
+		return err
+	}
+	if err := msg.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	if !msg.DepositCoins.IsAllPositive() {
+		return ErrBadDepositCoinsAmount
+	}
+	if n := uint32(len(msg.DepositCoins)); n > ConcentratedPoolType.MaxReserveCoinNum || n < ConcentratedPoolType.MinReserveCoinNum {
+		return ErrNumOfReserveCoin
+	}
+	return nil
+}
+
+func (msg MsgDepositToRange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDepositToRange) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DepositorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgDepositToRange) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DepositorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgWithdrawFromRange creates a new MsgWithdrawFromRange.
+func NewMsgWithdrawFromRange(owner sdk.AccAddress, positionID uint64) *MsgWithdrawFromRange {
+	return &MsgWithdrawFromRange{
+		OwnerAddress: owner.String(),
+		PositionId:   positionID,
+	}
+}
+
+func (msg MsgWithdrawFromRange) Route() string { return RouterKey }
+
+func (msg MsgWithdrawFromRange) Type() string { return TypeMsgWithdrawFromRange }
+
+func (msg MsgWithdrawFromRange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return ErrInvalidWithdrawerAddr
+	}
+	if msg.PositionId == 0 {
+		return ErrPositionNotExists
+	}
+	return nil
+}
+
+func (msg MsgWithdrawFromRange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawFromRange) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgWithdrawFromRange) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCancelSwap creates a new MsgCancelSwap.
+func NewMsgCancelSwap(swapRequester sdk.AccAddress, poolID, msgIndex uint64) *MsgCancelSwap {
+	return &MsgCancelSwap{
+		SwapRequesterAddress: swapRequester.String(),
+		PoolId:               poolID,
+		MsgIndex:             msgIndex,
+	}
+}
+
+func (msg MsgCancelSwap) Route() string { return RouterKey }
+
+func (msg MsgCancelSwap) Type() string { return TypeMsgCancelSwap }
+
+func (msg MsgCancelSwap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress); err != nil {
+		return ErrInvalidSwapRequesterAddr
+	}
+	if msg.MsgIndex == 0 {
+		return ErrBadBatchMsgIndex
+	}
+	return nil
+}
+
+func (msg MsgCancelSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelSwap) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCancelSwap) GetSwapRequester() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSwapRoute creates a new MsgSwapRoute.
+func NewMsgSwapRoute(
+	swapRequester sdk.AccAddress,
+	poolIDs []uint64,
+	offerCoin sdk.Coin,
+	demandCoinDenom string,
+	minDemandCoinAmt sdk.Int,
+	swapFeeRate sdk.Dec,
+) *MsgSwapRoute {
+	return &MsgSwapRoute{
+		SwapRequesterAddress: swapRequester.String(),
+		PoolIds:              poolIDs,
+		OfferCoin:            offerCoin,
+		DemandCoinDenom:      demandCoinDenom,
+		OfferCoinFee:         GetOfferCoinFee(offerCoin, swapFeeRate),
+		MinDemandCoinAmount:  minDemandCoinAmt,
+	}
+}
+
+func (msg MsgSwapRoute) Route() string { return RouterKey }
+
+func (msg MsgSwapRoute) Type() string { return TypeMsgSwapRoute }
+
+func (msg MsgSwapRoute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress); err != nil {
+		return ErrInvalidSwapRequesterAddr
+	}
+	if len(msg.PoolIds) < 2 || len(msg.PoolIds) > MaxSwapRouteLength {
+		return ErrBadSwapRoute
+	}
+	for i := 1; i < len(msg.PoolIds); i++ {
+		if msg.PoolIds[i] == msg.PoolIds[i-1] {
+			return ErrBadSwapRoute
+		}
+	}
+	if err := msg.OfferCoin.Validate(); err != nil {
+		return err
+	}
+	if !msg.OfferCoin.IsPositive() {
+		return ErrBadOfferCoinAmount
+	}
+	if !msg.OfferCoin.Amount.GTE(MinOfferCoinAmount) {
+		return ErrLessThanMinOfferAmount
+	}
+	if err := sdk.ValidateDenom(msg.DemandCoinDenom); err != nil || msg.DemandCoinDenom == msg.OfferCoin.Denom {
+		return ErrNotMatchedReserveCoin
+	}
+	if msg.MinDemandCoinAmount.IsNil() || msg.MinDemandCoinAmount.IsNegative() {
+		return ErrBadSwapRoute
+	}
+	return nil
+}
+
+func (msg MsgSwapRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapRoute) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSwapRoute) GetSwapRequester() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCreateRewardPlan creates a new MsgCreateRewardPlan.
+func NewMsgCreateRewardPlan(funder sdk.AccAddress, poolID uint64, rewardCoins sdk.Coins, duration uint64) *MsgCreateRewardPlan {
+	return &MsgCreateRewardPlan{
+		FunderAddress: funder.String(),
+		PoolId:        poolID,
+		RewardCoins:   rewardCoins,
+		Duration:      duration,
+	}
+}
+
+func (msg MsgCreateRewardPlan) Route() string { return RouterKey }
+
+func (msg MsgCreateRewardPlan) Type() string { return TypeMsgCreateRewardPlan }
+
+func (msg MsgCreateRewardPlan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return ErrInvalidFunderAddr
+	}
+	if msg.PoolId == 0 {
+		return ErrPoolNotExists
+	}
+	if err := msg.RewardCoins.Validate(); err != nil {
+		return err
+	}
+	if msg.RewardCoins.Empty() || msg.Duration == 0 {
+		return ErrBadRewardPlan
+	}
+	return nil
+}
+
+func (msg MsgCreateRewardPlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateRewardPlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateRewardPlan) GetFunder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgStake creates a new MsgStake.
+func NewMsgStake(staker sdk.AccAddress, poolCoin sdk.Coin) *MsgStake {
+	return &MsgStake{
+		StakerAddress: staker.String(),
+		PoolCoin:      poolCoin,
+	}
+}
+
+func (msg MsgStake) Route() string { return RouterKey }
+
+func (msg MsgStake) Type() string { return TypeMsgStake }
+
+func (msg MsgStake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.StakerAddress); err != nil {
+		return ErrInvalidStakerAddr
+	}
+	if err := msg.PoolCoin.Validate(); err != nil {
+		return err
+	}
+	if !msg.PoolCoin.IsPositive() {
+		return ErrBadPoolCoinAmount
+	}
+	return nil
+}
+
+func (msg MsgStake) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStake) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.StakerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgStake) GetStaker() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.StakerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgUnstake creates a new MsgUnstake.
+func NewMsgUnstake(staker sdk.AccAddress, poolCoin sdk.Coin) *MsgUnstake {
+	return &MsgUnstake{
+		StakerAddress: staker.String(),
+		PoolCoin:      poolCoin,
+	}
+}
+
+func (msg MsgUnstake) Route() string { return RouterKey }
+
+func (msg MsgUnstake) Type() string { return TypeMsgUnstake }
+
+func (msg MsgUnstake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.StakerAddress); err != nil {
+		return ErrInvalidStakerAddr
+	}
+	if err := msg.PoolCoin.Validate(); err != nil {
+		return err
+	}
+	if !msg.PoolCoin.IsPositive() {
+		return ErrBadPoolCoinAmount
+	}
+	return nil
+}
+
+func (msg MsgUnstake) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnstake) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.StakerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgUnstake) GetStaker() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.StakerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgClaimRewards creates a new MsgClaimRewards.
+func NewMsgClaimRewards(staker sdk.AccAddress, poolID uint64) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		StakerAddress: staker.String(),
+		PoolId:        poolID,
+	}
+}
+
+func (msg MsgClaimRewards) Route() string { return RouterKey }
+
+func (msg MsgClaimRewards) Type() string { return TypeMsgClaimRewards }
+
+func (msg MsgClaimRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.StakerAddress); err != nil {
+		return ErrInvalidStakerAddr
+	}
+	if msg.PoolId == 0 {
+		return ErrPoolNotExists
+	}
+	return nil
+}
+
+func (msg MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.StakerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgClaimRewards) GetStaker() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.StakerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgBond creates a new MsgBond.
+func NewMsgBond(owner sdk.AccAddress, poolCoin sdk.Coin, duration time.Duration) *MsgBond {
+	return &MsgBond{
+		OwnerAddress: owner.String(),
+		PoolCoin:     poolCoin,
+		Duration:     duration,
+	}
+}
+
+func (msg MsgBond) Route() string { return RouterKey }
+
+func (msg MsgBond) Type() string { return TypeMsgBond }
+
+func (msg MsgBond) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return ErrInvalidBondOwnerAddr
+	}
+	if err := msg.PoolCoin.Validate(); err != nil {
+		return err
+	}
+	if !msg.PoolCoin.IsPositive() {
+		return ErrBadPoolCoinAmount
+	}
+	if msg.Duration <= 0 {
+		return ErrBadBondDuration
+	}
+	return nil
+}
+
+func (msg MsgBond) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgBond) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgBond) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgBeginUnbond creates a new MsgBeginUnbond.
+func NewMsgBeginUnbond(owner sdk.AccAddress, bondID uint64) *MsgBeginUnbond {
+	return &MsgBeginUnbond{
+		OwnerAddress: owner.String(),
+		BondId:       bondID,
+	}
+}
+
+func (msg MsgBeginUnbond) Route() string { return RouterKey }
+
+func (msg MsgBeginUnbond) Type() string { return TypeMsgBeginUnbond }
+
+func (msg MsgBeginUnbond) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return ErrInvalidBondOwnerAddr
+	}
+	if msg.BondId == 0 {
+		return ErrBondNotExists
+	}
+	return nil
+}
+
+func (msg MsgBeginUnbond) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgBeginUnbond) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgBeginUnbond) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSetCircuitBreaker creates a new MsgSetCircuitBreaker.
+func NewMsgSetCircuitBreaker(admin sdk.AccAddress, poolID uint64, msgType string, enabled bool) *MsgSetCircuitBreaker {
+	return &MsgSetCircuitBreaker{
+		AdminAddress: admin.String(),
+		PoolId:       poolID,
+		MsgType:      msgType,
+		Enabled:      enabled,
+	}
+}
+
+func (msg MsgSetCircuitBreaker) Route() string { return RouterKey }
+
+func (msg MsgSetCircuitBreaker) Type() string { return TypeMsgSetCircuitBreaker }
+
+func (msg MsgSetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.AdminAddress); err != nil {
+		return ErrInvalidCircuitBreakerAdminAddr
+	}
+	return msg.GetCircuitBreaker().Validate()
+}
+
+func (msg MsgSetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.AdminAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetCircuitBreaker) GetAdmin() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.AdminAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetCircuitBreaker returns the circuit breaker set by the message.
+func (msg MsgSetCircuitBreaker) GetCircuitBreaker() CircuitBreaker {
+	return NewCircuitBreaker(msg.PoolId, msg.MsgType, msg.Enabled)
+}
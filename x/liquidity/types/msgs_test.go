@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -16,52 +17,104 @@ const (
 	DefaultSwapTypeId = uint32(1)
 	DenomX            = "denomX"
 	DenomY            = "denomY"
+	DenomZ            = "denomZ"
 )
 
 func TestMsgCreatePool(t *testing.T) {
 	poolCreator := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
 
 	cases := []struct {
+		name        string
 		expectedErr string // empty means no error expected
 		msg         *types.MsgCreatePool
 	}{
 		{
+			"ValidStandardPool",
 			"",
 			types.NewMsgCreatePool(poolCreator, DefaultPoolTypeId, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000)))),
 		},
 		{
+			"ZeroPoolTypeID",
 			"invalid index of the pool type",
 			types.NewMsgCreatePool(poolCreator, 0, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000)))),
 		},
 		{
+			"EmptyPoolCreator",
 			"invalid pool creator address",
 			types.NewMsgCreatePool(sdk.AccAddress{}, DefaultPoolTypeId, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000)))),
 		},
 		{
+			"SingleReserveCoin",
 			"invalid number of reserve coin",
 			types.NewMsgCreatePool(poolCreator, DefaultPoolTypeId, sdk.NewCoins(sdk.NewCoin(DenomY, sdk.NewInt(1000)))),
 		},
 		{
+			"NineReserveCoins",
 			"invalid number of reserve coin",
-			types.NewMsgCreatePool(poolCreator, DefaultPoolTypeId, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000)), sdk.NewCoin("denomZ", sdk.NewInt(1000)))),
+			types.NewMsgCreatePool(poolCreator, DefaultPoolTypeId, sdk.NewCoins(
+				sdk.NewCoin("denoma", sdk.NewInt(1000)), sdk.NewCoin("denomb", sdk.NewInt(1000)), sdk.NewCoin("denomc", sdk.NewInt(1000)),
+				sdk.NewCoin("denomd", sdk.NewInt(1000)), sdk.NewCoin("denome", sdk.NewInt(1000)), sdk.NewCoin("denomf", sdk.NewInt(1000)),
+				sdk.NewCoin("denomg", sdk.NewInt(1000)), sdk.NewCoin("denomh", sdk.NewInt(1000)), sdk.NewCoin("denomi", sdk.NewInt(1000)))),
+		},
+		{
+			"ValidWeightedPool",
+			"",
+			types.NewMsgCreateWeightedPool(poolCreator, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000))), []uint32{80, 20}),
+		},
+		{
+			"MissingReserveCoinWeight",
+			"1 weights are given for 2 reserve coins: invalid reserve coin weights",
+			types.NewMsgCreateWeightedPool(poolCreator, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000))), []uint32{100}),
+		},
+		{
+			"ZeroReserveCoinWeight",
+			"weight must be between 1 and 99: 0: invalid reserve coin weights",
+			types.NewMsgCreateWeightedPool(poolCreator, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000))), []uint32{0, 100}),
+		},
+		{
+			"ReserveCoinWeightsNotSumTo100",
+			"weights must sum up to 100: 90: invalid reserve coin weights",
+			types.NewMsgCreateWeightedPool(poolCreator, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000))), []uint32{80, 10}),
+		},
+		{
+			"ValidStablePoolAmplification",
+			"",
+			&types.MsgCreatePool{
+				PoolCreatorAddress: poolCreator.String(),
+				PoolTypeId:         types.StableSwapPoolTypeID,
+				DepositCoins:       sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000))),
+				Amplification:      types.MaxStableSwapAmplification,
+			},
+		},
+		{
+			"TooLargeAmplification",
+			"amplification must be between 1 and 1000000: 1000001: invalid amplification",
+			&types.MsgCreatePool{
+				PoolCreatorAddress: poolCreator.String(),
+				PoolTypeId:         types.StableSwapPoolTypeID,
+				DepositCoins:       sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000))),
+				Amplification:      types.MaxStableSwapAmplification + 1,
+			},
 		},
 	}
 
 	for _, tc := range cases {
-		require.IsType(t, &types.MsgCreatePool{}, tc.msg)
-		require.Equal(t, types.TypeMsgCreatePool, tc.msg.Type())
-		require.Equal(t, types.RouterKey, tc.msg.Route())
-		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+		t.Run(tc.name, func(t *testing.T) {
+			require.IsType(t, &types.MsgCreatePool{}, tc.msg)
+			require.Equal(t, types.TypeMsgCreatePool, tc.msg.Type())
+			require.Equal(t, types.RouterKey, tc.msg.Route())
+			require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
 
-		err := tc.msg.ValidateBasic()
-		if tc.expectedErr == "" {
-			require.Nil(t, err)
-			signers := tc.msg.GetSigners()
-			require.Len(t, signers, 1)
-			require.Equal(t, tc.msg.GetPoolCreator(), signers[0])
-		} else {
-			require.EqualError(t, err, tc.expectedErr)
-		}
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.Nil(t, err)
+				signers := tc.msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, tc.msg.GetPoolCreator(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
 	}
 }
 
@@ -85,7 +138,7 @@ func TestMsgDepositWithinBatch(t *testing.T) {
 			types.NewMsgDepositWithinBatch(sdk.AccAddress{}, DefaultPoolId, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000)))),
 		},
 		{
-			"invalid number of reserve coin",
+			"",
 			types.NewMsgDepositWithinBatch(depositor, DefaultPoolId, sdk.NewCoins(sdk.NewCoin(DenomY, sdk.NewInt(1000)))),
 		},
 	}
@@ -178,6 +231,38 @@ func TestMsgSwapWithinBatch(t *testing.T) {
 			"offer amount should be over 100 micro",
 			types.NewMsgSwapWithinBatch(swapRequester, DefaultPoolId, DefaultSwapTypeId, sdk.NewCoin(DenomX, sdk.NewInt(1)), DenomY, orderPrice, types.DefaultSwapFeeRate),
 		},
+		{
+			"invalid min demand coin amount",
+			func() *types.MsgSwapWithinBatch {
+				msg := types.NewMsgSwapWithinBatch(swapRequester, DefaultPoolId, DefaultSwapTypeId, offerCoin, DenomY, orderPrice, types.DefaultSwapFeeRate)
+				msg.MinDemandCoinAmount = sdk.NewInt(-1)
+				return msg
+			}(),
+		},
+		{
+			"swap type not exists",
+			types.NewMsgSwapWithinBatch(swapRequester, DefaultPoolId, 3, offerCoin, DenomY, orderPrice, types.DefaultSwapFeeRate),
+		},
+		{
+			"",
+			func() *types.MsgSwapWithinBatch {
+				msg := types.NewMsgSwapWithinBatch(swapRequester, DefaultPoolId, types.ExactOutputSwapTypeID, offerCoin, DenomY, orderPrice, types.DefaultSwapFeeRate)
+				msg.DemandCoinAmount = sdk.NewInt(5000)
+				return msg
+			}(),
+		},
+		{
+			"invalid demand coin amount",
+			types.NewMsgSwapWithinBatch(swapRequester, DefaultPoolId, types.ExactOutputSwapTypeID, offerCoin, DenomY, orderPrice, types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid demand coin amount",
+			func() *types.MsgSwapWithinBatch {
+				msg := types.NewMsgSwapWithinBatch(swapRequester, DefaultPoolId, DefaultSwapTypeId, offerCoin, DenomY, orderPrice, types.DefaultSwapFeeRate)
+				msg.DemandCoinAmount = sdk.NewInt(5000)
+				return msg
+			}(),
+		},
 	}
 
 	for _, tc := range cases {
@@ -198,6 +283,397 @@ func TestMsgSwapWithinBatch(t *testing.T) {
 	}
 }
 
+func TestMsgDepositToRange(t *testing.T) {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000)))
+	lowerPrice, upperPrice := sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("1.1")
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgDepositToRange
+	}{
+		{
+			"",
+			types.NewMsgDepositToRange(depositor, DefaultPoolId, lowerPrice, upperPrice, depositCoins),
+		},
+		{
+			"invalid pool depositor address",
+			types.NewMsgDepositToRange(sdk.AccAddress{}, DefaultPoolId, lowerPrice, upperPrice, depositCoins),
+		},
+		{
+			"invalid price range",
+			types.NewMsgDepositToRange(depositor, DefaultPoolId, upperPrice, lowerPrice, depositCoins),
+		},
+		{
+			"invalid price range",
+			types.NewMsgDepositToRange(depositor, DefaultPoolId, sdk.ZeroDec(), upperPrice, depositCoins),
+		},
+		{
+			"invalid number of reserve coin",
+			types.NewMsgDepositToRange(depositor, DefaultPoolId, lowerPrice, upperPrice, depositCoins[:1]),
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgDepositToRange{}, tc.msg)
+		require.Equal(t, types.TypeMsgDepositToRange, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDepositor(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgWithdrawFromRange(t *testing.T) {
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgWithdrawFromRange
+	}{
+		{
+			"",
+			types.NewMsgWithdrawFromRange(owner, 1),
+		},
+		{
+			"invalid pool withdrawer address",
+			types.NewMsgWithdrawFromRange(sdk.AccAddress{}, 1),
+		},
+		{
+			"position not exists",
+			types.NewMsgWithdrawFromRange(owner, 0),
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgWithdrawFromRange{}, tc.msg)
+		require.Equal(t, types.TypeMsgWithdrawFromRange, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetOwner(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCancelSwap(t *testing.T) {
+	swapRequester := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgCancelSwap
+	}{
+		{
+			"",
+			types.NewMsgCancelSwap(swapRequester, DefaultPoolId, 1),
+		},
+		{
+			"invalid pool swap requester address",
+			types.NewMsgCancelSwap(sdk.AccAddress{}, DefaultPoolId, 1),
+		},
+		{
+			"bad msg index of the batch",
+			types.NewMsgCancelSwap(swapRequester, DefaultPoolId, 0),
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgCancelSwap{}, tc.msg)
+		require.Equal(t, types.TypeMsgCancelSwap, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetSwapRequester(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCreateRewardPlan(t *testing.T) {
+	funder := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000000)))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgCreateRewardPlan
+	}{
+		{
+			"",
+			types.NewMsgCreateRewardPlan(funder, DefaultPoolId, rewardCoins, 100),
+		},
+		{
+			"invalid funder address",
+			types.NewMsgCreateRewardPlan(sdk.AccAddress{}, DefaultPoolId, rewardCoins, 100),
+		},
+		{
+			"pool not exists",
+			types.NewMsgCreateRewardPlan(funder, 0, rewardCoins, 100),
+		},
+		{
+			"invalid reward plan",
+			types.NewMsgCreateRewardPlan(funder, DefaultPoolId, sdk.NewCoins(), 100),
+		},
+		{
+			"invalid reward plan",
+			types.NewMsgCreateRewardPlan(funder, DefaultPoolId, rewardCoins, 0),
+		},
+		{
+			"coin 0denomX amount is not positive",
+			types.NewMsgCreateRewardPlan(funder, DefaultPoolId, sdk.Coins{sdk.NewCoin(DenomX, sdk.ZeroInt())}, 100),
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgCreateRewardPlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgCreateRewardPlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFunder(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgStakeAndUnstake(t *testing.T) {
+	staker := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+	poolCoin := sdk.NewCoin("poolcoin", sdk.NewInt(1000))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		staker      sdk.AccAddress
+		poolCoin    sdk.Coin
+	}{
+		{"", staker, poolCoin},
+		{"invalid staker address", sdk.AccAddress{}, poolCoin},
+		{"invalid pool coin amount", staker, sdk.NewCoin("poolcoin", sdk.ZeroInt())},
+		{"invalid denom: -", staker, sdk.Coin{Denom: "-", Amount: sdk.NewInt(1000)}},
+	}
+
+	for _, tc := range cases {
+		stakeMsg := types.NewMsgStake(tc.staker, tc.poolCoin)
+		unstakeMsg := types.NewMsgUnstake(tc.staker, tc.poolCoin)
+		require.Equal(t, types.TypeMsgStake, stakeMsg.Type())
+		require.Equal(t, types.TypeMsgUnstake, unstakeMsg.Type())
+		require.Equal(t, types.RouterKey, stakeMsg.Route())
+		require.Equal(t, types.RouterKey, unstakeMsg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(stakeMsg)), stakeMsg.GetSignBytes())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(unstakeMsg)), unstakeMsg.GetSignBytes())
+
+		for _, msg := range []interface {
+			ValidateBasic() error
+			GetSigners() []sdk.AccAddress
+			GetStaker() sdk.AccAddress
+		}{stakeMsg, unstakeMsg} {
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.Nil(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetStaker(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		}
+	}
+}
+
+func TestMsgClaimRewards(t *testing.T) {
+	staker := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgClaimRewards
+	}{
+		{
+			"",
+			types.NewMsgClaimRewards(staker, DefaultPoolId),
+		},
+		{
+			"invalid staker address",
+			types.NewMsgClaimRewards(sdk.AccAddress{}, DefaultPoolId),
+		},
+		{
+			"pool not exists",
+			types.NewMsgClaimRewards(staker, 0),
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgClaimRewards{}, tc.msg)
+		require.Equal(t, types.TypeMsgClaimRewards, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetStaker(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgBond(t *testing.T) {
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+	poolCoin := sdk.NewInt64Coin("pool", 1000)
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgBond
+	}{
+		{
+			"",
+			types.NewMsgBond(owner, poolCoin, 24*time.Hour),
+		},
+		{
+			"invalid bond owner address",
+			types.NewMsgBond(sdk.AccAddress{}, poolCoin, 24*time.Hour),
+		},
+		{
+			"invalid pool coin amount",
+			types.NewMsgBond(owner, sdk.NewInt64Coin("pool", 0), 24*time.Hour),
+		},
+		{
+			"invalid bond duration",
+			types.NewMsgBond(owner, poolCoin, 0),
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgBond{}, tc.msg)
+		require.Equal(t, types.TypeMsgBond, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetOwner(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgBeginUnbond(t *testing.T) {
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgBeginUnbond
+	}{
+		{
+			"",
+			types.NewMsgBeginUnbond(owner, 1),
+		},
+		{
+			"invalid bond owner address",
+			types.NewMsgBeginUnbond(sdk.AccAddress{}, 1),
+		},
+		{
+			"bond not exists",
+			types.NewMsgBeginUnbond(owner, 0),
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgBeginUnbond{}, tc.msg)
+		require.Equal(t, types.TypeMsgBeginUnbond, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetOwner(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetCircuitBreaker(t *testing.T) {
+	admin := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgSetCircuitBreaker
+	}{
+		{
+			"",
+			types.NewMsgSetCircuitBreaker(admin, 1, types.TypeMsgSwapWithinBatch, true),
+		},
+		{
+			"",
+			types.NewMsgSetCircuitBreaker(admin, 0, "", false),
+		},
+		{
+			"invalid circuit breaker admin address",
+			types.NewMsgSetCircuitBreaker(sdk.AccAddress{}, 1, types.TypeMsgSwapWithinBatch, true),
+		},
+		{
+			"unknown message type withdraw_within_batch: invalid circuit breaker",
+			types.NewMsgSetCircuitBreaker(admin, 1, types.TypeMsgWithdrawWithinBatch, true),
+		},
+		{
+			"create_pool applies to all pools: invalid circuit breaker",
+			types.NewMsgSetCircuitBreaker(admin, 1, types.TypeMsgCreatePool, true),
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgSetCircuitBreaker{}, tc.msg)
+		require.Equal(t, types.TypeMsgSetCircuitBreaker, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetAdmin(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestMsgPanics(t *testing.T) {
 	emptyMsgCreatePool := types.MsgCreatePool{}
 	emptyMsgDeposit := types.MsgDepositWithinBatch{}
@@ -324,14 +800,10 @@ func TestMsgValidateBasic(t *testing.T) {
 			{
 				types.MsgDepositWithinBatch{
 					DepositorAddress: validAddr,
-					DepositCoins:     sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(int64(types.MinReserveCoinNum)-1))),
-				},
-				types.ErrNumOfReserveCoin.Error(),
-			},
-			{
-				types.MsgDepositWithinBatch{
-					DepositorAddress: validAddr,
-					DepositCoins:     sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(int64(types.MaxReserveCoinNum)+1))),
+					DepositCoins: sdk.NewCoins(
+						sdk.NewCoin("denoma", sdk.NewInt(1000)), sdk.NewCoin("denomb", sdk.NewInt(1000)), sdk.NewCoin("denomc", sdk.NewInt(1000)),
+						sdk.NewCoin("denomd", sdk.NewInt(1000)), sdk.NewCoin("denome", sdk.NewInt(1000)), sdk.NewCoin("denomf", sdk.NewInt(1000)),
+						sdk.NewCoin("denomg", sdk.NewInt(1000)), sdk.NewCoin("denomh", sdk.NewInt(1000)), sdk.NewCoin("denomi", sdk.NewInt(1000))),
 				},
 				types.ErrNumOfReserveCoin.Error(),
 			},
@@ -361,6 +833,10 @@ func TestMsgValidateBasic(t *testing.T) {
 				types.MsgWithdrawWithinBatch{WithdrawerAddress: validAddr, PoolCoin: zeroCoin},
 				types.ErrBadPoolCoinAmount.Error(),
 			},
+			{
+				types.MsgWithdrawWithinBatch{WithdrawerAddress: validAddr, PoolCoin: sdk.NewInt64Coin(DenomX, 1000), WithdrawCoinDenom: "?"},
+				"invalid denom: ?: invalid withdraw coin denom",
+			},
 		} {
 			err := tc.msg.ValidateBasic()
 			require.EqualError(t, err, tc.errMsg)
@@ -404,3 +880,67 @@ func TestMsgValidateBasic(t *testing.T) {
 		}
 	})
 }
+
+func TestMsgSwapRoute(t *testing.T) {
+	swapRequester := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(10000))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgSwapRoute
+	}{
+		{
+			"",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2}, offerCoin, DenomZ, sdk.NewInt(9000), types.DefaultSwapFeeRate),
+		},
+		{
+			"",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2, 1}, offerCoin, DenomZ, sdk.ZeroInt(), types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid pool swap requester address",
+			types.NewMsgSwapRoute(sdk.AccAddress{}, []uint64{1, 2}, offerCoin, DenomZ, sdk.ZeroInt(), types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid swap route",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1}, offerCoin, DenomZ, sdk.ZeroInt(), types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid swap route",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 1}, offerCoin, DenomZ, sdk.ZeroInt(), types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid swap route",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2, 3, 4, 5, 6}, offerCoin, DenomZ, sdk.ZeroInt(), types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid swap route",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2}, offerCoin, DenomZ, sdk.NewInt(-1), types.DefaultSwapFeeRate),
+		},
+		{
+			"offer amount should be over 100 micro",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2}, sdk.NewCoin(DenomX, sdk.NewInt(1)), DenomZ, sdk.ZeroInt(), types.DefaultSwapFeeRate),
+		},
+		{
+			"does not match the reserve coin of the pool",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2}, offerCoin, DenomX, sdk.ZeroInt(), types.DefaultSwapFeeRate),
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgSwapRoute{}, tc.msg)
+		require.Equal(t, types.TypeMsgSwapRoute, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetSwapRequester(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
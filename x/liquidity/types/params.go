@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -16,53 +17,165 @@ const (
 	MinReserveCoinNum uint32 = 2
 
 	// MaxReserveCoinNum is the maximum number of reserve coins in each liquidity pool.
-	MaxReserveCoinNum uint32 = 2
+	MaxReserveCoinNum uint32 = 8
 
 	// DefaultUnitBatchHeight is the default number of blocks in one batch. This param is used for scalability.
 	DefaultUnitBatchHeight uint32 = 1
 
-	// DefaultPoolTypeID is the default pool type id. The only supported pool type id is 1.
+	// DefaultPoolTypeID is the default pool type id of the standard liquidity pool with two reserve coins.
 	DefaultPoolTypeID uint32 = 1
 
-	// DefaultSwapTypeID is the default swap type id. The only supported swap type (instant swap) id is 1.
+	// MultiAssetPoolTypeID is the pool type id of the multi-asset liquidity pool with three to eight reserve coins.
+	MultiAssetPoolTypeID uint32 = 2
+
+	// WeightedPoolTypeID is the pool type id of the weighted liquidity pool with the reserve coin weights set by the pool creator.
+	WeightedPoolTypeID uint32 = 3
+
+	// TotalReserveCoinWeight is the sum of the reserve coin weights of a weighted liquidity pool.
+	TotalReserveCoinWeight uint32 = 100
+
+	// StableSwapPoolTypeID is the pool type id of the stable liquidity pool with the StableSwap curve.
+	StableSwapPoolTypeID uint32 = 4
+
+	// ConcentratedPoolTypeID is the pool type id of the concentrated liquidity pool with the liquidity provided within price ranges.
+	ConcentratedPoolTypeID uint32 = 5
+
+	// DefaultSwapTypeID is the default swap type id of the instant swap, which offers the exact offer coin amount.
 	DefaultSwapTypeID uint32 = 1
 
+	// ExactOutputSwapTypeID is the swap type id of the instant swap which receives the exact demand coin amount,
+	// offering the offer coin up to its amount.
+	ExactOutputSwapTypeID uint32 = 2
+
 	// DefaultCircuitBreakerEnabled is the default circuit breaker status. This param is used for a contingency plan.
 	DefaultCircuitBreakerEnabled = false
+
+	// DefaultStableSwapAmplification is the default amplification coefficient of the StableSwap curve.
+	DefaultStableSwapAmplification uint32 = 100
+
+	// MaxStableSwapAmplification is the maximum amplification coefficient of the StableSwap curve.
+	MaxStableSwapAmplification uint32 = 1000000
+
+	// DefaultMaxOrderLifespan is the default maximum lifespan in blocks of the swap orders.
+	DefaultMaxOrderLifespan uint32 = 100
+
+	// DefaultBatchResultRetention is the default number of the latest executed batches of each pool whose results are kept.
+	DefaultBatchResultRetention uint32 = 100
+
+	// DefaultPriceAccumulatorRetention is the default number of the latest executed batches of each pool whose price
+	// accumulators are kept.
+	DefaultPriceAccumulatorRetention uint32 = 1000
+
+	// DefaultWithdrawProtocolFeeEnabled is the default status of collecting the protocol fee from the withdraw fees.
+	DefaultWithdrawProtocolFeeEnabled = false
+
+	// DefaultDynamicSwapFeeEnabled is the default status of the swap fee rates raised by the price volatility.
+	DefaultDynamicSwapFeeEnabled = false
+
+	// DefaultDynamicSwapFeeLookback is the default number of the latest batch results of each pool used for the price volatility.
+	DefaultDynamicSwapFeeLookback uint32 = 10
+
+	// DefaultCircuitBreakerPriceMoveLookback is the default number of the previous batch results of each pool used for the reference prices.
+	DefaultCircuitBreakerPriceMoveLookback uint32 = 10
 )
 
 // Parameter store keys
 var (
-	KeyPoolTypes              = []byte("PoolTypes")
-	KeyMinInitDepositAmount   = []byte("MinInitDepositAmount")
-	KeyInitPoolCoinMintAmount = []byte("InitPoolCoinMintAmount")
-	KeyMaxReserveCoinAmount   = []byte("MaxReserveCoinAmount")
-	KeySwapFeeRate            = []byte("SwapFeeRate")
-	KeyPoolCreationFee        = []byte("PoolCreationFee")
-	KeyUnitBatchHeight        = []byte("UnitBatchHeight")
-	KeyWithdrawFeeRate        = []byte("WithdrawFeeRate")
-	KeyMaxOrderAmountRatio    = []byte("MaxOrderAmountRatio")
-	KeyCircuitBreakerEnabled  = []byte("CircuitBreakerEnabled")
+	KeyPoolTypes                        = []byte("PoolTypes")
+	KeyMinInitDepositAmount             = []byte("MinInitDepositAmount")
+	KeyInitPoolCoinMintAmount           = []byte("InitPoolCoinMintAmount")
+	KeyMaxReserveCoinAmount             = []byte("MaxReserveCoinAmount")
+	KeySwapFeeRate                      = []byte("SwapFeeRate")
+	KeyPoolCreationFee                  = []byte("PoolCreationFee")
+	KeyUnitBatchHeight                  = []byte("UnitBatchHeight")
+	KeyWithdrawFeeRate                  = []byte("WithdrawFeeRate")
+	KeyMaxOrderAmountRatio              = []byte("MaxOrderAmountRatio")
+	KeyCircuitBreakerEnabled            = []byte("CircuitBreakerEnabled")
+	KeyStableSwapAmplification          = []byte("StableSwapAmplification")
+	KeyMaxOrderLifespan                 = []byte("MaxOrderLifespan")
+	KeyBatchResultRetention             = []byte("BatchResultRetention")
+	KeyPriceAccumulatorRetention        = []byte("PriceAccumulatorRetention")
+	KeyRewardPlanCreationFee            = []byte("RewardPlanCreationFee")
+	KeyBondDurations                    = []byte("BondDurations")
+	KeyProtocolFeeRate                  = []byte("ProtocolFeeRate")
+	KeyWithdrawProtocolFeeEnabled       = []byte("WithdrawProtocolFeeEnabled")
+	KeyMinPoolFeeRate                   = []byte("MinPoolFeeRate")
+	KeyMaxPoolFeeRate                   = []byte("MaxPoolFeeRate")
+	KeyDynamicSwapFeeEnabled            = []byte("DynamicSwapFeeEnabled")
+	KeyDynamicSwapFeeLookback           = []byte("DynamicSwapFeeLookback")
+	KeyDynamicSwapFeeSensitivity        = []byte("DynamicSwapFeeSensitivity")
+	KeyDynamicSwapFeeDecayRate          = []byte("DynamicSwapFeeDecayRate")
+	KeyCircuitBreakerAdmins             = []byte("CircuitBreakerAdmins")
+	KeyCircuitBreakerPriceMoveThreshold = []byte("CircuitBreakerPriceMoveThreshold")
+	KeyCircuitBreakerPriceMoveLookback  = []byte("CircuitBreakerPriceMoveLookback")
+	KeyMaxOrderPriceDeviation           = []byte("MaxOrderPriceDeviation")
 )
 
 var (
-	DefaultMinInitDepositAmount   = sdk.NewInt(1000000)
-	DefaultInitPoolCoinMintAmount = sdk.NewInt(1000000)
-	DefaultMaxReserveCoinAmount   = sdk.ZeroInt()
-	DefaultSwapFeeRate            = sdk.NewDecWithPrec(3, 3) // "0.003000000000000000"
-	DefaultWithdrawFeeRate        = sdk.ZeroDec()
-	DefaultMaxOrderAmountRatio    = sdk.NewDecWithPrec(1, 1) // "0.100000000000000000"
-	DefaultPoolCreationFee        = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(40000000)))
-	DefaultPoolType               = PoolType{
-		Id:                1,
+	DefaultMinInitDepositAmount             = sdk.NewInt(1000000)
+	DefaultInitPoolCoinMintAmount           = sdk.NewInt(1000000)
+	DefaultMaxReserveCoinAmount             = sdk.ZeroInt()
+	DefaultSwapFeeRate                      = sdk.NewDecWithPrec(3, 3) // "0.003000000000000000"
+	DefaultWithdrawFeeRate                  = sdk.ZeroDec()
+	DefaultMaxOrderAmountRatio              = sdk.NewDecWithPrec(1, 1) // "0.100000000000000000"
+	DefaultPoolCreationFee                  = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(40000000)))
+	DefaultRewardPlanCreationFee            = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000000)))
+	DefaultBondDurations                    = []time.Duration{24 * time.Hour, 7 * 24 * time.Hour, 14 * 24 * time.Hour}
+	DefaultProtocolFeeRate                  = sdk.ZeroDec()
+	DefaultMinPoolFeeRate                   = sdk.ZeroDec()
+	DefaultMaxPoolFeeRate                   = sdk.NewDecWithPrec(1, 1) // "0.100000000000000000"
+	DefaultDynamicSwapFeeSensitivity        = sdk.NewDec(10)
+	DefaultDynamicSwapFeeDecayRate          = sdk.NewDecWithPrec(1, 1) // "0.100000000000000000"
+	DefaultCircuitBreakerAdmins             []string
+	DefaultCircuitBreakerPriceMoveThreshold = sdk.ZeroDec()
+	DefaultMaxOrderPriceDeviation           = sdk.ZeroDec()
+	DefaultPoolType                         = PoolType{
+		Id:                DefaultPoolTypeID,
 		Name:              "StandardLiquidityPool",
+		MinReserveCoinNum: 2,
+		MaxReserveCoinNum: 2,
+		Description:       "Standard liquidity pool with pool price function X/Y, ESPM constraint, and two kinds of reserve coins",
+	}
+	MultiAssetPoolType = PoolType{
+		Id:                MultiAssetPoolTypeID,
+		Name:              "MultiAssetLiquidityPool",
+		MinReserveCoinNum: 3,
+		MaxReserveCoinNum: MaxReserveCoinNum,
+		Description:       "Multi-asset liquidity pool with pool price function X/Y for each pair of reserve coins, ESPM constraint, and three to eight kinds of reserve coins",
+	}
+	WeightedPoolType = PoolType{
+		Id:                WeightedPoolTypeID,
+		Name:              "WeightedLiquidityPool",
 		MinReserveCoinNum: MinReserveCoinNum,
 		MaxReserveCoinNum: MaxReserveCoinNum,
-		Description:       "Standard liquidity pool with pool price function X/Y, ESPM constraint, and two kinds of reserve coins",
+		Description:       "Weighted liquidity pool with pool price function (X/Wx)/(Y/Wy) for each pair of reserve coins, ESPM constraint, and two to eight kinds of reserve coins",
 	}
-	DefaultPoolTypes = []PoolType{DefaultPoolType}
+	StableSwapPoolType = PoolType{
+		Id:                StableSwapPoolTypeID,
+		Name:              "StableSwapLiquidityPool",
+		MinReserveCoinNum: 2,
+		MaxReserveCoinNum: 2,
+		Description:       "Stable liquidity pool with pool price function of the StableSwap curve, ESPM constraint, and two kinds of pegged reserve coins",
+	}
+	ConcentratedPoolType = PoolType{
+		Id:                ConcentratedPoolTypeID,
+		Name:              "ConcentratedLiquidityPool",
+		MinReserveCoinNum: 2,
+		MaxReserveCoinNum: 2,
+		Description:       "Concentrated liquidity pool with the liquidity of positions active within their price ranges, ESPM constraint, and two kinds of reserve coins",
+	}
+	DefaultPoolTypes = []PoolType{DefaultPoolType, MultiAssetPoolType, WeightedPoolType, StableSwapPoolType, ConcentratedPoolType}
 
 	MinOfferCoinAmount = sdk.NewInt(100)
+
+	// MinPositionPrice and MaxPositionPrice are the bounds of the price ranges of the concentrated liquidity pool.
+	MinPositionPrice = sdk.NewDecWithPrec(1, 12)
+	MaxPositionPrice = sdk.NewDec(1000000000000)
+
+	// MaxSwapRouteLength is the maximum number of pools of a swap route, and SwapRouteOrderPriceSlippage is the ratio
+	// by which the order price of each hop of the swap route is set beyond the pool price.
+	MaxSwapRouteLength          = 5
+	SwapRouteOrderPriceSlippage = sdk.NewDecWithPrec(1, 1)
 )
 
 var _ paramstypes.ParamSet = (*Params)(nil)
@@ -75,16 +188,34 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns the default liquidity module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolTypes:              DefaultPoolTypes,
-		MinInitDepositAmount:   DefaultMinInitDepositAmount,
-		InitPoolCoinMintAmount: DefaultInitPoolCoinMintAmount,
-		MaxReserveCoinAmount:   DefaultMaxReserveCoinAmount,
-		PoolCreationFee:        DefaultPoolCreationFee,
-		SwapFeeRate:            DefaultSwapFeeRate,
-		WithdrawFeeRate:        DefaultWithdrawFeeRate,
-		MaxOrderAmountRatio:    DefaultMaxOrderAmountRatio,
-		UnitBatchHeight:        DefaultUnitBatchHeight,
-		CircuitBreakerEnabled:  DefaultCircuitBreakerEnabled,
+		PoolTypes:                        DefaultPoolTypes,
+		MinInitDepositAmount:             DefaultMinInitDepositAmount,
+		InitPoolCoinMintAmount:           DefaultInitPoolCoinMintAmount,
+		MaxReserveCoinAmount:             DefaultMaxReserveCoinAmount,
+		PoolCreationFee:                  DefaultPoolCreationFee,
+		SwapFeeRate:                      DefaultSwapFeeRate,
+		WithdrawFeeRate:                  DefaultWithdrawFeeRate,
+		MaxOrderAmountRatio:              DefaultMaxOrderAmountRatio,
+		UnitBatchHeight:                  DefaultUnitBatchHeight,
+		CircuitBreakerEnabled:            DefaultCircuitBreakerEnabled,
+		StableSwapAmplification:          DefaultStableSwapAmplification,
+		MaxOrderLifespan:                 DefaultMaxOrderLifespan,
+		BatchResultRetention:             DefaultBatchResultRetention,
+		PriceAccumulatorRetention:        DefaultPriceAccumulatorRetention,
+		RewardPlanCreationFee:            DefaultRewardPlanCreationFee,
+		BondDurations:                    DefaultBondDurations,
+		ProtocolFeeRate:                  DefaultProtocolFeeRate,
+		WithdrawProtocolFeeEnabled:       DefaultWithdrawProtocolFeeEnabled,
+		MinPoolFeeRate:                   DefaultMinPoolFeeRate,
+		MaxPoolFeeRate:                   DefaultMaxPoolFeeRate,
+		DynamicSwapFeeEnabled:            DefaultDynamicSwapFeeEnabled,
+		DynamicSwapFeeLookback:           DefaultDynamicSwapFeeLookback,
+		DynamicSwapFeeSensitivity:        DefaultDynamicSwapFeeSensitivity,
+		DynamicSwapFeeDecayRate:          DefaultDynamicSwapFeeDecayRate,
+		CircuitBreakerAdmins:             DefaultCircuitBreakerAdmins,
+		CircuitBreakerPriceMoveThreshold: DefaultCircuitBreakerPriceMoveThreshold,
+		CircuitBreakerPriceMoveLookback:  DefaultCircuitBreakerPriceMoveLookback,
+		MaxOrderPriceDeviation:           DefaultMaxOrderPriceDeviation,
 	}
 }
 
@@ -101,6 +232,24 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyMaxOrderAmountRatio, &p.MaxOrderAmountRatio, validateMaxOrderAmountRatio),
 		paramstypes.NewParamSetPair(KeyUnitBatchHeight, &p.UnitBatchHeight, validateUnitBatchHeight),
 		paramstypes.NewParamSetPair(KeyCircuitBreakerEnabled, &p.CircuitBreakerEnabled, validateCircuitBreakerEnabled),
+		paramstypes.NewParamSetPair(KeyStableSwapAmplification, &p.StableSwapAmplification, validateStableSwapAmplification),
+		paramstypes.NewParamSetPair(KeyMaxOrderLifespan, &p.MaxOrderLifespan, validateMaxOrderLifespan),
+		paramstypes.NewParamSetPair(KeyBatchResultRetention, &p.BatchResultRetention, validateBatchResultRetention),
+		paramstypes.NewParamSetPair(KeyPriceAccumulatorRetention, &p.PriceAccumulatorRetention, validatePriceAccumulatorRetention),
+		paramstypes.NewParamSetPair(KeyRewardPlanCreationFee, &p.RewardPlanCreationFee, validateRewardPlanCreationFee),
+		paramstypes.NewParamSetPair(KeyBondDurations, &p.BondDurations, validateBondDurations),
+		paramstypes.NewParamSetPair(KeyProtocolFeeRate, &p.ProtocolFeeRate, validateProtocolFeeRate),
+		paramstypes.NewParamSetPair(KeyWithdrawProtocolFeeEnabled, &p.WithdrawProtocolFeeEnabled, validateWithdrawProtocolFeeEnabled),
+		paramstypes.NewParamSetPair(KeyMinPoolFeeRate, &p.MinPoolFeeRate, validateMinPoolFeeRate),
+		paramstypes.NewParamSetPair(KeyMaxPoolFeeRate, &p.MaxPoolFeeRate, validateMaxPoolFeeRate),
+		paramstypes.NewParamSetPair(KeyDynamicSwapFeeEnabled, &p.DynamicSwapFeeEnabled, validateDynamicSwapFeeEnabled),
+		paramstypes.NewParamSetPair(KeyDynamicSwapFeeLookback, &p.DynamicSwapFeeLookback, validateDynamicSwapFeeLookback),
+		paramstypes.NewParamSetPair(KeyDynamicSwapFeeSensitivity, &p.DynamicSwapFeeSensitivity, validateDynamicSwapFeeSensitivity),
+		paramstypes.NewParamSetPair(KeyDynamicSwapFeeDecayRate, &p.DynamicSwapFeeDecayRate, validateDynamicSwapFeeDecayRate),
+		paramstypes.NewParamSetPair(KeyCircuitBreakerAdmins, &p.CircuitBreakerAdmins, validateCircuitBreakerAdmins),
+		paramstypes.NewParamSetPair(KeyCircuitBreakerPriceMoveThreshold, &p.CircuitBreakerPriceMoveThreshold, validateCircuitBreakerPriceMoveThreshold),
+		paramstypes.NewParamSetPair(KeyCircuitBreakerPriceMoveLookback, &p.CircuitBreakerPriceMoveLookback, validateCircuitBreakerPriceMoveLookback),
+		paramstypes.NewParamSetPair(KeyMaxOrderPriceDeviation, &p.MaxOrderPriceDeviation, validateMaxOrderPriceDeviation),
 	}
 }
 
@@ -126,11 +275,40 @@ func (p Params) Validate() error {
 		{p.MaxOrderAmountRatio, validateMaxOrderAmountRatio},
 		{p.UnitBatchHeight, validateUnitBatchHeight},
 		{p.CircuitBreakerEnabled, validateCircuitBreakerEnabled},
+		{p.StableSwapAmplification, validateStableSwapAmplification},
+		{p.MaxOrderLifespan, validateMaxOrderLifespan},
+		{p.BatchResultRetention, validateBatchResultRetention},
+		{p.PriceAccumulatorRetention, validatePriceAccumulatorRetention},
+		{p.RewardPlanCreationFee, validateRewardPlanCreationFee},
+		{p.BondDurations, validateBondDurations},
+		{p.ProtocolFeeRate, validateProtocolFeeRate},
+		{p.WithdrawProtocolFeeEnabled, validateWithdrawProtocolFeeEnabled},
+		{p.MinPoolFeeRate, validateMinPoolFeeRate},
+		{p.MaxPoolFeeRate, validateMaxPoolFeeRate},
+		{p.DynamicSwapFeeEnabled, validateDynamicSwapFeeEnabled},
+		{p.DynamicSwapFeeLookback, validateDynamicSwapFeeLookback},
+		{p.DynamicSwapFeeSensitivity, validateDynamicSwapFeeSensitivity},
+		{p.DynamicSwapFeeDecayRate, validateDynamicSwapFeeDecayRate},
+		{p.CircuitBreakerAdmins, validateCircuitBreakerAdmins},
+		{p.CircuitBreakerPriceMoveThreshold, validateCircuitBreakerPriceMoveThreshold},
+		{p.CircuitBreakerPriceMoveLookback, validateCircuitBreakerPriceMoveLookback},
+		{p.MaxOrderPriceDeviation, validateMaxOrderPriceDeviation},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
 		}
 	}
+
+	if p.MinPoolFeeRate.GT(p.MaxPoolFeeRate) {
+		return fmt.Errorf("min pool fee rate must not exceed the max pool fee rate: %s > %s", p.MinPoolFeeRate, p.MaxPoolFeeRate)
+	}
+	// the lookbacks are taken from the batch results kept within the batch result retention
+	if p.DynamicSwapFeeLookback > p.BatchResultRetention {
+		return fmt.Errorf("dynamic swap fee lookback must not exceed the batch result retention: %d > %d", p.DynamicSwapFeeLookback, p.BatchResultRetention)
+	}
+	if p.CircuitBreakerPriceMoveLookback > p.BatchResultRetention {
+		return fmt.Errorf("circuit breaker price move lookback must not exceed the batch result retention: %d > %d", p.CircuitBreakerPriceMoveLookback, p.BatchResultRetention)
+	}
 	return nil
 }
 
@@ -151,10 +329,10 @@ func validatePoolTypes(i interface{}) error {
 		if p.MaxReserveCoinNum > MaxReserveCoinNum || MinReserveCoinNum > p.MinReserveCoinNum {
 			return fmt.Errorf("min, max reserve coin num value of pool types are out of bounds")
 		}
-	}
-
-	if len(v) > 1 || !v[0].Equal(DefaultPoolType) {
-		return fmt.Errorf("the only supported pool type is 1")
+		// a pool type is supported only with the pool curve registered for it
+		if poolType, found := GetRegisteredPoolType(p.Id); !found || !p.Equal(poolType) {
+			return fmt.Errorf("unsupported pool type: %d", p.Id)
+		}
 	}
 
 	return nil
@@ -316,3 +494,291 @@ func validateCircuitBreakerEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateStableSwapAmplification(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("stable swap amplification must be positive: %d", v)
+	}
+
+	if v > MaxStableSwapAmplification {
+		return fmt.Errorf("stable swap amplification too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxOrderLifespan(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBatchResultRetention(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 2 {
+		return fmt.Errorf("batch result retention must be at least 2: %d", v)
+	}
+
+	return nil
+}
+
+func validatePriceAccumulatorRetention(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRewardPlanCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	if v.Empty() {
+		return fmt.Errorf("reward plan creation fee must not be empty")
+	}
+
+	return nil
+}
+
+func validateBondDurations(i interface{}) error {
+	v, ok := i.([]time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	durationMap := make(map[time.Duration]bool)
+	for _, duration := range v {
+		if duration <= 0 {
+			return fmt.Errorf("bond duration must be positive: %s", duration)
+		}
+		if durationMap[duration] {
+			return fmt.Errorf("duplicate bond duration: %s", duration)
+		}
+		durationMap[duration] = true
+	}
+
+	return nil
+}
+
+func validateProtocolFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("protocol fee rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("protocol fee rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("protocol fee rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateWithdrawProtocolFeeEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMinPoolFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min pool fee rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("min pool fee rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min pool fee rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxPoolFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max pool fee rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max pool fee rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max pool fee rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateDynamicSwapFeeEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDynamicSwapFeeLookback(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 2 {
+		return fmt.Errorf("dynamic swap fee lookback must be at least 2: %d", v)
+	}
+
+	return nil
+}
+
+func validateDynamicSwapFeeSensitivity(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("dynamic swap fee sensitivity must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("dynamic swap fee sensitivity must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDynamicSwapFeeDecayRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("dynamic swap fee decay rate must not be nil")
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("dynamic swap fee decay rate must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("dynamic swap fee decay rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerAdmins(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	adminMap := make(map[string]bool)
+	for _, admin := range v {
+		if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+			return fmt.Errorf("invalid circuit breaker admin address %s: %w", admin, err)
+		}
+		if adminMap[admin] {
+			return fmt.Errorf("duplicate circuit breaker admin address: %s", admin)
+		}
+		adminMap[admin] = true
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerPriceMoveThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("circuit breaker price move threshold must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("circuit breaker price move threshold must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerPriceMoveLookback(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("circuit breaker price move lookback must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxOrderPriceDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max order price deviation must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max order price deviation must not be negative: %s", v)
+	}
+
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max order price deviation must be less than 1: %s", v)
+	}
+
+	return nil
+}
@@ -19,6 +19,24 @@ func TestValidateAuxFunctions(t *testing.T) {
 		validateMaxOrderAmountRatio,
 		validateUnitBatchHeight,
 		validateCircuitBreakerEnabled,
+		validateStableSwapAmplification,
+		validateMaxOrderLifespan,
+		validateBatchResultRetention,
+		validatePriceAccumulatorRetention,
+		validateRewardPlanCreationFee,
+		validateBondDurations,
+		validateProtocolFeeRate,
+		validateWithdrawProtocolFeeEnabled,
+		validateMinPoolFeeRate,
+		validateMaxPoolFeeRate,
+		validateDynamicSwapFeeEnabled,
+		validateDynamicSwapFeeLookback,
+		validateDynamicSwapFeeSensitivity,
+		validateDynamicSwapFeeDecayRate,
+		validateCircuitBreakerAdmins,
+		validateCircuitBreakerPriceMoveThreshold,
+		validateCircuitBreakerPriceMoveLookback,
+		validateMaxOrderPriceDeviation,
 	} {
 		err := v(badType{})
 		require.EqualError(t, err, "invalid parameter type: types.badType")
@@ -3,10 +3,12 @@ package types_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/tendermint/liquidity/app"
 	"github.com/tendermint/liquidity/x/liquidity/types"
@@ -26,6 +28,31 @@ func TestParams(t *testing.T) {
   max_reserve_coin_num: 2
   description: Standard liquidity pool with pool price function X/Y, ESPM constraint,
     and two kinds of reserve coins
+- id: 2
+  name: MultiAssetLiquidityPool
+  min_reserve_coin_num: 3
+  max_reserve_coin_num: 8
+  description: Multi-asset liquidity pool with pool price function X/Y for each pair
+    of reserve coins, ESPM constraint, and three to eight kinds of reserve coins
+- id: 3
+  name: WeightedLiquidityPool
+  min_reserve_coin_num: 2
+  max_reserve_coin_num: 8
+  description: Weighted liquidity pool with pool price function (X/Wx)/(Y/Wy) for
+    each pair of reserve coins, ESPM constraint, and two to eight kinds of reserve
+    coins
+- id: 4
+  name: StableSwapLiquidityPool
+  min_reserve_coin_num: 2
+  max_reserve_coin_num: 2
+  description: Stable liquidity pool with pool price function of the StableSwap curve,
+    ESPM constraint, and two kinds of pegged reserve coins
+- id: 5
+  name: ConcentratedLiquidityPool
+  min_reserve_coin_num: 2
+  max_reserve_coin_num: 2
+  description: Concentrated liquidity pool with the liquidity of positions active
+    within their price ranges, ESPM constraint, and two kinds of reserve coins
 min_init_deposit_amount: "1000000"
 init_pool_coin_mint_amount: "1000000"
 max_reserve_coin_amount: "0"
@@ -37,6 +64,29 @@ withdraw_fee_rate: "0.000000000000000000"
 max_order_amount_ratio: "0.100000000000000000"
 unit_batch_height: 1
 circuit_breaker_enabled: false
+stable_swap_amplification: 100
+max_order_lifespan: 100
+batch_result_retention: 100
+price_accumulator_retention: 1000
+reward_plan_creation_fee:
+- denom: stake
+  amount: "10000000"
+bond_durations:
+- 24h0m0s
+- 168h0m0s
+- 336h0m0s
+protocol_fee_rate: "0.000000000000000000"
+withdraw_protocol_fee_enabled: false
+min_pool_fee_rate: "0.000000000000000000"
+max_pool_fee_rate: "0.100000000000000000"
+dynamic_swap_fee_enabled: false
+dynamic_swap_fee_lookback: 10
+dynamic_swap_fee_sensitivity: "10.000000000000000000"
+dynamic_swap_fee_decay_rate: "0.100000000000000000"
+circuit_breaker_admins: []
+circuit_breaker_price_move_threshold: "0.000000000000000000"
+circuit_breaker_price_move_lookback: 10
+max_order_price_deviation: "0.000000000000000000"
 `
 	require.Equal(t, paramsStr, defaultParams.String())
 }
@@ -70,7 +120,16 @@ func TestParams_Validate(t *testing.T) {
 				poolType.Name = "CustomPoolType"
 				params.PoolTypes = []types.PoolType{poolType}
 			},
-			"the only supported pool type is 1",
+			"unsupported pool type: 1",
+		},
+		{
+			"BadReserveCoinNumOfPoolType",
+			func(params *types.Params) {
+				poolType := types.MultiAssetPoolType
+				poolType.MaxReserveCoinNum = types.MaxReserveCoinNum + 1
+				params.PoolTypes = []types.PoolType{types.DefaultPoolType, poolType}
+			},
+			"min, max reserve coin num value of pool types are out of bounds",
 		},
 		{
 			"NilMinInitDepositAmount",
@@ -191,6 +250,140 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"pool creation fee must not be empty",
 		},
+		{
+			"EmptyRewardPlanCreationFee",
+			func(params *types.Params) {
+				params.RewardPlanCreationFee = sdk.NewCoins()
+			},
+			"reward plan creation fee must not be empty",
+		},
+		{
+			"NonPositiveBondDuration",
+			func(params *types.Params) {
+				params.BondDurations = []time.Duration{time.Hour, 0}
+			},
+			"bond duration must be positive: 0s",
+		},
+		{
+			"DuplicateBondDuration",
+			func(params *types.Params) {
+				params.BondDurations = []time.Duration{time.Hour, time.Hour}
+			},
+			"duplicate bond duration: 1h0m0s",
+		},
+		{
+			"NilProtocolFeeRate",
+			func(params *types.Params) {
+				params.ProtocolFeeRate = sdk.Dec{}
+			},
+			"protocol fee rate must not be nil",
+		},
+		{
+			"NegativeProtocolFeeRate",
+			func(params *types.Params) {
+				params.ProtocolFeeRate = sdk.NewDec(-1)
+			},
+			"protocol fee rate must not be negative: -1.000000000000000000",
+		},
+		{
+			"TooLargeProtocolFeeRate",
+			func(params *types.Params) {
+				params.ProtocolFeeRate = sdk.NewDec(2)
+			},
+			"protocol fee rate too large: 2.000000000000000000",
+		},
+		{
+			"NegativeMinPoolFeeRate",
+			func(params *types.Params) {
+				params.MinPoolFeeRate = sdk.NewDec(-1)
+			},
+			"min pool fee rate must not be negative: -1.000000000000000000",
+		},
+		{
+			"TooLargeMaxPoolFeeRate",
+			func(params *types.Params) {
+				params.MaxPoolFeeRate = sdk.NewDec(2)
+			},
+			"max pool fee rate too large: 2.000000000000000000",
+		},
+		{
+			"TooShortBatchResultRetention",
+			func(params *types.Params) {
+				params.BatchResultRetention = 1
+			},
+			"batch result retention must be at least 2: 1",
+		},
+		{
+			"TooShortDynamicSwapFeeLookback",
+			func(params *types.Params) {
+				params.DynamicSwapFeeLookback = 1
+			},
+			"dynamic swap fee lookback must be at least 2: 1",
+		},
+		{
+			"NegativeDynamicSwapFeeSensitivity",
+			func(params *types.Params) {
+				params.DynamicSwapFeeSensitivity = sdk.NewDec(-1)
+			},
+			"dynamic swap fee sensitivity must not be negative: -1.000000000000000000",
+		},
+		{
+			"ZeroDynamicSwapFeeDecayRate",
+			func(params *types.Params) {
+				params.DynamicSwapFeeDecayRate = sdk.ZeroDec()
+			},
+			"dynamic swap fee decay rate must be positive: 0.000000000000000000",
+		},
+		{
+			"TooLargeDynamicSwapFeeDecayRate",
+			func(params *types.Params) {
+				params.DynamicSwapFeeDecayRate = sdk.NewDec(2)
+			},
+			"dynamic swap fee decay rate too large: 2.000000000000000000",
+		},
+		{
+			"InvalidCircuitBreakerAdmin",
+			func(params *types.Params) {
+				params.CircuitBreakerAdmins = []string{"xx"}
+			},
+			"invalid circuit breaker admin address xx: decoding bech32 failed: invalid bech32 string length 2",
+		},
+		{
+			"DuplicateCircuitBreakerAdmin",
+			func(params *types.Params) {
+				admin := sdk.AccAddress(crypto.AddressHash([]byte("admin"))).String()
+				params.CircuitBreakerAdmins = []string{admin, admin}
+			},
+			"duplicate circuit breaker admin address: " + sdk.AccAddress(crypto.AddressHash([]byte("admin"))).String(),
+		},
+		{
+			"NegativeCircuitBreakerPriceMoveThreshold",
+			func(params *types.Params) {
+				params.CircuitBreakerPriceMoveThreshold = sdk.NewDec(-1)
+			},
+			"circuit breaker price move threshold must not be negative: -1.000000000000000000",
+		},
+		{
+			"ZeroCircuitBreakerPriceMoveLookback",
+			func(params *types.Params) {
+				params.CircuitBreakerPriceMoveLookback = 0
+			},
+			"circuit breaker price move lookback must be positive: 0",
+		},
+		{
+			"NegativeMaxOrderPriceDeviation",
+			func(params *types.Params) {
+				params.MaxOrderPriceDeviation = sdk.NewDec(-1)
+			},
+			"max order price deviation must not be negative: -1.000000000000000000",
+		},
+		{
+			"TooLargeMaxOrderPriceDeviation",
+			func(params *types.Params) {
+				params.MaxOrderPriceDeviation = sdk.OneDec()
+			},
+			"max order price deviation must be less than 1: 1.000000000000000000",
+		},
 		{
 			"InvalidPoolCreationFeeDenom",
 			func(params *types.Params) {
@@ -222,6 +415,20 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"unit batch height must be positive: 0",
 		},
+		{
+			"NonPositiveStableSwapAmplification",
+			func(params *types.Params) {
+				params.StableSwapAmplification = 0
+			},
+			"stable swap amplification must be positive: 0",
+		},
+		{
+			"TooLargeStableSwapAmplification",
+			func(params *types.Params) {
+				params.StableSwapAmplification = types.MaxStableSwapAmplification + 1
+			},
+			"stable swap amplification too large: 1000001",
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -241,3 +448,30 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidateLookbacks(t *testing.T) {
+	// the lookbacks can not exceed the batch result retention, which is validated only with the whole params
+	params := types.DefaultParams()
+	params.BatchResultRetention = 5
+	params.DynamicSwapFeeLookback = 5
+	params.CircuitBreakerPriceMoveLookback = 5
+	require.NoError(t, params.Validate())
+
+	params.DynamicSwapFeeLookback = 6
+	require.EqualError(t, params.Validate(), "dynamic swap fee lookback must not exceed the batch result retention: 6 > 5")
+
+	params.DynamicSwapFeeLookback = 5
+	params.CircuitBreakerPriceMoveLookback = 6
+	require.EqualError(t, params.Validate(), "circuit breaker price move lookback must not exceed the batch result retention: 6 > 5")
+}
+
+func TestParams_ValidatePoolFeeRateBounds(t *testing.T) {
+	// the min pool fee rate can not exceed the max pool fee rate, which is validated only with the whole params
+	params := types.DefaultParams()
+	params.MinPoolFeeRate = params.MaxPoolFeeRate
+	require.NoError(t, params.Validate())
+
+	params.MinPoolFeeRate = sdk.NewDecWithPrec(2, 1)
+	params.MaxPoolFeeRate = sdk.NewDecWithPrec(1, 1)
+	require.EqualError(t, params.Validate(), "min pool fee rate must not exceed the max pool fee rate: 0.200000000000000000 > 0.100000000000000000")
+}
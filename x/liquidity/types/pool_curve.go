@@ -0,0 +1,465 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PoolCurve is the pool price function of a pool type. It owns the pool price and the swap curve of each pair of
+// reserve coins, the pool coin minting of deposits, and the reserve coin payout of withdrawals, so a new pool type
+// can be added by registering its PoolCurve with RegisterPoolCurve.
+type PoolCurve interface {
+	// InitPool sets the fields of a new pool specific to the pool type which are not given on the pool creation.
+	InitPool(pool *Pool, params Params)
+	// ValidatePool validates the fields of the pool specific to the pool type, such as the reserve coin weights.
+	ValidatePool(pool Pool) error
+	// PoolPrice returns the pool price, the price of Y in X, of the pair of reserve coins with the reserves x and y.
+	PoolPrice(pool Pool, params Params, denomX, denomY string, x, y sdk.Dec) sdk.Dec
+	// SwapCurve returns the swap curve and the reserves with which the swap orders of the pair of reserve coins
+	// are matched in the batch.
+	SwapCurve(pool Pool, params Params, denomX, denomY string, x, y sdk.Dec) (curve SwapCurve, curveX, curveY sdk.Dec)
+	// ValidateDepositCoins validates the denoms of the coins deposited to the pool.
+	ValidateDepositCoins(pool Pool, depositCoins sdk.Coins) error
+	// ValidateWithdrawCoinDenom validates the denom of the reserve coin in which the whole pool coin is withdrawn, empty
+	// for withdrawing every reserve coin in proportion.
+	ValidateWithdrawCoinDenom(pool Pool, withdrawCoinDenom string) error
+	// DepositMint returns the amount of pool coin minted for the deposit coins, and the accepted deposit coins.
+	DepositMint(pool Pool, params Params, reserveCoins sdk.Coins, poolCoinTotalSupply sdk.Int, depositCoins sdk.Coins,
+		feeRate PoolFeeRate) (mintAmt sdk.Int, acceptedCoins sdk.Coins, err error)
+	// WithdrawPayout returns the reserve coins paid out for the withdrawn pool coin amount, and the withdraw fee
+	// coins left in the pool.
+	WithdrawPayout(pool Pool, params Params, reserveCoins sdk.Coins, poolCoinTotalSupply, poolCoinAmt sdk.Int, withdrawCoinDenom string,
+		feeRate PoolFeeRate) (withdrawCoins, withdrawFeeCoins sdk.Coins, err error)
+	// ValidateInitialDeposit validates the deposit coins with which the pool is created.
+	ValidateInitialDeposit(pool Pool, depositCoins sdk.Coins) error
+	// HasPoolCoin returns true if the liquidity of the pool is provided with the pool coin, which is minted for the
+	// deposits and can be staked to the reward plans of the pool.
+	HasPoolCoin() bool
+	// InitPoolState initializes the pool state kept besides the reserves with the deposit coins of the pool creator,
+	// after the new pool is stored.
+	InitPoolState(ctx sdk.Context, k PoolStateKeeper, pool Pool, poolCreator sdk.AccAddress, depositCoins sdk.Coins) error
+	// IsDepleted returns true if the pool has no liquidity left to be swapped with.
+	IsDepleted(ctx sdk.Context, k PoolStateKeeper, pool Pool, reserveCoins sdk.Coins, poolCoinTotalSupply sdk.Int) bool
+	// MaxOrderReserve returns the reserve of which an offer coin amount of a swap order can be MaxOrderAmountRatio
+	// at most.
+	MaxOrderReserve(pool Pool, reserveCoins sdk.Coins, offerCoinDenom, demandCoinDenom string, orderPrice sdk.Dec) sdk.Dec
+	// CurrentSwapCurve returns the swap curve and the reserves with which the swap orders of the pair of reserve coins
+	// are matched in the batch at the current pool state.
+	CurrentSwapCurve(ctx sdk.Context, k PoolStateKeeper, pool Pool, params Params, denomX, denomY string, x, y sdk.Dec) (curve SwapCurve, curveX, curveY sdk.Dec)
+	// PriceAfterSwap returns the pool price of the pair of reserve coins after the pool provided the pool deltas
+	// to the swap orders matched with the curve returned by CurrentSwapCurve.
+	PriceAfterSwap(pool Pool, params Params, denomX, denomY string, x, y sdk.Dec, curve SwapCurve, result BatchResult,
+		poolXDelta, poolYDelta sdk.Dec) sdk.Dec
+	// AfterSwap updates the pool state kept besides the reserves with the pool price after the swap orders of the batch
+	// were settled.
+	AfterSwap(ctx sdk.Context, k PoolStateKeeper, pool Pool, lastPrice sdk.Dec)
+}
+
+var (
+	_ PoolCurve = ConstantProductPoolCurve{}
+	_ PoolCurve = WeightedPoolCurve{}
+	_ PoolCurve = StableSwapPoolCurve{}
+	_ PoolCurve = ConcentratedPoolCurve{}
+)
+
+// registeredPoolType is the pool type and its PoolCurve registered with RegisterPoolCurve.
+type registeredPoolType struct {
+	poolType PoolType
+	curve    PoolCurve
+}
+
+var poolCurves = map[uint32]registeredPoolType{}
+
+func init() {
+	RegisterPoolCurve(DefaultPoolType, ConstantProductPoolCurve{})
+	RegisterPoolCurve(MultiAssetPoolType, ConstantProductPoolCurve{})
+	RegisterPoolCurve(WeightedPoolType, WeightedPoolCurve{})
+	RegisterPoolCurve(StableSwapPoolType, StableSwapPoolCurve{})
+	RegisterPoolCurve(ConcentratedPoolType, ConcentratedPoolCurve{})
+}
+
+// RegisterPoolCurve registers the PoolCurve of the pool type. The pool type is supported once it is also added to
+// the PoolTypes param. It panics if a PoolCurve is already registered for the pool type id.
+func RegisterPoolCurve(poolType PoolType, curve PoolCurve) {
+	if _, found := poolCurves[poolType.Id]; found {
+		panic(fmt.Sprintf("pool curve already registered for pool type: %d", poolType.Id))
+	}
+	poolCurves[poolType.Id] = registeredPoolType{poolType: poolType, curve: curve}
+}
+
+// GetPoolCurve returns the PoolCurve registered for the pool type id.
+func GetPoolCurve(poolTypeID uint32) (PoolCurve, bool) {
+	r, found := poolCurves[poolTypeID]
+	return r.curve, found
+}
+
+// GetRegisteredPoolType returns the pool type registered with its PoolCurve for the pool type id.
+func GetRegisteredPoolType(poolTypeID uint32) (PoolType, bool) {
+	r, found := poolCurves[poolTypeID]
+	return r.poolType, found
+}
+
+// ReservePoolCurve implements the parts of PoolCurve shared by the pool types whose liquidity is provided with the
+// pool coin, in which the pool state is the reserves and the pool coin supply. It is meant to be embedded.
+type ReservePoolCurve struct{}
+
+// ValidateInitialDeposit implements PoolCurve.
+func (ReservePoolCurve) ValidateInitialDeposit(Pool, sdk.Coins) error {
+	return nil
+}
+
+// HasPoolCoin implements PoolCurve.
+func (ReservePoolCurve) HasPoolCoin() bool {
+	return true
+}
+
+// InitPoolState implements PoolCurve. The deposit coins of the pool creator are minted the pool coin on the pool
+// creation, so no other pool state is kept.
+func (ReservePoolCurve) InitPoolState(sdk.Context, PoolStateKeeper, Pool, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+// IsDepleted implements PoolCurve. The pool is depleted when the pool coin supply or any reserve coin is empty.
+func (ReservePoolCurve) IsDepleted(_ sdk.Context, _ PoolStateKeeper, pool Pool, reserveCoins sdk.Coins, poolCoinTotalSupply sdk.Int) bool {
+	if !poolCoinTotalSupply.IsPositive() {
+		return true
+	}
+	for _, denom := range pool.ReserveCoinDenoms {
+		if reserveCoins.AmountOf(denom).IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxOrderReserve implements PoolCurve. It is the reserve of the offer coin.
+func (ReservePoolCurve) MaxOrderReserve(_ Pool, reserveCoins sdk.Coins, offerCoinDenom, _ string, _ sdk.Dec) sdk.Dec {
+	return reserveCoins.AmountOf(offerCoinDenom).ToDec()
+}
+
+// AfterSwap implements PoolCurve. The pool price is derived from the reserves, so no other pool state is kept.
+func (ReservePoolCurve) AfterSwap(sdk.Context, PoolStateKeeper, Pool, sdk.Dec) {}
+
+// ProportionalPoolCurve implements the parts of PoolCurve shared by the built-in pool types, in which deposits and
+// withdrawals are proportional across every reserve coin of the pool. It is meant to be embedded.
+type ProportionalPoolCurve struct {
+	ReservePoolCurve
+}
+
+// InitPool implements PoolCurve.
+func (ProportionalPoolCurve) InitPool(*Pool, Params) {}
+
+// ValidatePool implements PoolCurve. The reserve coin weights and the amplification are not allowed.
+func (ProportionalPoolCurve) ValidatePool(pool Pool) error {
+	if len(pool.ReserveCoinWeights) > 0 {
+		return ErrBadReserveCoinWeights
+	}
+	if pool.Amplification != 0 {
+		return ErrBadAmplification
+	}
+	return nil
+}
+
+// ValidateDepositCoins implements PoolCurve. Every reserve coin of the pool must be deposited.
+func (ProportionalPoolCurve) ValidateDepositCoins(pool Pool, depositCoins sdk.Coins) error {
+	if depositCoins.Len() != len(pool.ReserveCoinDenoms) {
+		return ErrNumOfReserveCoin
+	}
+	depositCoinDenoms := make([]string, depositCoins.Len())
+	for i, coin := range depositCoins {
+		depositCoinDenoms[i] = coin.Denom
+	}
+	for i, denom := range SortDenoms(depositCoinDenoms) {
+		if denom != pool.ReserveCoinDenoms[i] {
+			return ErrNotMatchedReserveCoin
+		}
+	}
+	return nil
+}
+
+// ValidateWithdrawCoinDenom implements PoolCurve. Every reserve coin is withdrawn in proportion.
+func (ProportionalPoolCurve) ValidateWithdrawCoinDenom(_ Pool, withdrawCoinDenom string) error {
+	if withdrawCoinDenom != "" {
+		return sdkerrors.Wrap(ErrBadWithdrawCoinDenom, "every reserve coin of the pool type is withdrawn in proportion")
+	}
+	return nil
+}
+
+// DepositMint implements PoolCurve. The minting amount is bounded by the reserve coin with the smallest deposit
+// ratio, and the deposit coins over that ratio are not accepted. The reserve coins and the deposit coins must be
+// sorted and have the same denoms.
+func (ProportionalPoolCurve) DepositMint(_ Pool, _ Params, reserveCoins sdk.Coins, poolCoinTotalSupply sdk.Int, depositCoins sdk.Coins, _ PoolFeeRate) (sdk.Int, sdk.Coins, error) {
+	totalSupply := poolCoinTotalSupply.ToDec()
+	for _, depositCoin := range depositCoins {
+		if err := CheckOverflowWithDec(totalSupply, depositCoin.Amount.ToDec()); err != nil {
+			return sdk.Int{}, nil, err
+		}
+	}
+
+	var poolCoinMintAmt sdk.Dec
+	for i, depositCoin := range depositCoins {
+		mintAmt := totalSupply.MulTruncate(depositCoin.Amount.ToDec()).QuoTruncate(reserveCoins[i].Amount.ToDec())
+		if i == 0 || mintAmt.LT(poolCoinMintAmt) {
+			poolCoinMintAmt = mintAmt
+		}
+	}
+	mintRate := poolCoinMintAmt.TruncateDec().QuoTruncate(totalSupply)
+	acceptedCoins := make(sdk.Coins, 0, len(reserveCoins))
+	for i, reserveCoin := range reserveCoins {
+		acceptedCoins = append(acceptedCoins, sdk.NewCoin(depositCoins[i].Denom, reserveCoin.Amount.ToDec().Mul(mintRate).TruncateInt()))
+	}
+	return poolCoinMintAmt.TruncateInt(), sdk.NewCoins(acceptedCoins...), nil
+}
+
+// WithdrawPayout implements PoolCurve. Every reserve coin is paid out in proportion to the withdrawn pool coin amount,
+// and all reserve coins are paid out without the withdraw fee when the whole pool coin supply is withdrawn.
+func (ProportionalPoolCurve) WithdrawPayout(_ Pool, _ Params, reserveCoins sdk.Coins, poolCoinTotalSupply, poolCoinAmt sdk.Int, _ string, feeRate PoolFeeRate) (sdk.Coins, sdk.Coins, error) {
+	return proportionalWithdrawPayout(reserveCoins, poolCoinTotalSupply, poolCoinAmt, feeRate.WithdrawFeeRate)
+}
+
+// proportionalWithdrawPayout returns the reserve coins paid out in proportion to the withdrawn pool coin amount, and the
+// withdraw fee coins left in the pool.
+func proportionalWithdrawPayout(reserveCoins sdk.Coins, poolCoinTotalSupply, poolCoinAmt sdk.Int, withdrawFeeRate sdk.Dec) (sdk.Coins, sdk.Coins, error) {
+	withdrawCoins := sdk.NewCoins()
+	withdrawFeeCoins := sdk.NewCoins()
+
+	// Case for withdrawing all reserve coins
+	if poolCoinAmt.Equal(poolCoinTotalSupply) {
+		return reserveCoins, withdrawFeeCoins, nil
+	}
+
+	withdrawProportion := sdk.OneDec().Sub(withdrawFeeRate)
+	// Calculate withdraw amount of respective reserve coin considering fees and pool coin's totally supply
+	for _, reserveCoin := range reserveCoins {
+		if err := CheckOverflow(reserveCoin.Amount, poolCoinAmt); err != nil {
+			return nil, nil, err
+		}
+		if err := CheckOverflow(reserveCoin.Amount.Mul(poolCoinAmt).ToDec().TruncateInt(), poolCoinTotalSupply); err != nil {
+			return nil, nil, err
+		}
+		// WithdrawAmount = ReserveAmount * PoolCoinAmount * WithdrawFeeProportion / TotalSupply
+		withdrawAmtWithFee := reserveCoin.Amount.Mul(poolCoinAmt).ToDec().TruncateInt().Quo(poolCoinTotalSupply)
+		withdrawAmt := reserveCoin.Amount.Mul(poolCoinAmt).ToDec().MulTruncate(withdrawProportion).TruncateInt().Quo(poolCoinTotalSupply)
+		withdrawCoins = append(withdrawCoins, sdk.NewCoin(reserveCoin.Denom, withdrawAmt))
+		withdrawFeeCoins = append(withdrawFeeCoins, sdk.NewCoin(reserveCoin.Denom, withdrawAmtWithFee.Sub(withdrawAmt)))
+	}
+	return withdrawCoins, withdrawFeeCoins, nil
+}
+
+// ConstantProductPoolCurve is the PoolCurve of the standard and the multi-asset pool types with the pool price X/Y.
+type ConstantProductPoolCurve struct {
+	ProportionalPoolCurve
+}
+
+// PoolPrice implements PoolCurve.
+func (ConstantProductPoolCurve) PoolPrice(_ Pool, _ Params, _, _ string, x, y sdk.Dec) sdk.Dec {
+	return x.Quo(y)
+}
+
+// SwapCurve implements PoolCurve.
+func (ConstantProductPoolCurve) SwapCurve(_ Pool, _ Params, _, _ string, x, y sdk.Dec) (SwapCurve, sdk.Dec, sdk.Dec) {
+	return ConstantProductCurve{}, x, y
+}
+
+// CurrentSwapCurve implements PoolCurve.
+func (c ConstantProductPoolCurve) CurrentSwapCurve(_ sdk.Context, _ PoolStateKeeper, pool Pool, params Params, denomX, denomY string, x, y sdk.Dec) (SwapCurve, sdk.Dec, sdk.Dec) {
+	return c.SwapCurve(pool, params, denomX, denomY, x, y)
+}
+
+// PriceAfterSwap implements PoolCurve. It is the pool price of the reserves with the pool deltas.
+func (c ConstantProductPoolCurve) PriceAfterSwap(pool Pool, params Params, denomX, denomY string, x, y sdk.Dec, _ SwapCurve, _ BatchResult, poolXDelta, poolYDelta sdk.Dec) sdk.Dec {
+	return c.PoolPrice(pool, params, denomX, denomY, x.Add(poolXDelta), y.Add(poolYDelta))
+}
+
+// WeightedPoolCurve is the PoolCurve of the weighted pool type with the pool price (X/Wx)/(Y/Wy). The deposits and
+// the withdrawals keep the weighted invariant V = Π(B^W) per pool coin, so any subset of the reserve coins can be
+// deposited and the whole pool coin can be withdrawn in a single reserve coin. The part of a deposit or a withdrawal
+// which is not proportional to the reserves is a swap with the pool in effect, and is charged the swap fee.
+type WeightedPoolCurve struct {
+	ReservePoolCurve
+}
+
+// InitPool implements PoolCurve.
+func (WeightedPoolCurve) InitPool(*Pool, Params) {}
+
+// ValidatePool implements PoolCurve. The reserve coin weights are required, and the amplification is not allowed.
+func (WeightedPoolCurve) ValidatePool(pool Pool) error {
+	if pool.Amplification != 0 {
+		return ErrBadAmplification
+	}
+	return ValidateReserveCoinWeights(pool.ReserveCoinWeights, len(pool.ReserveCoinDenoms))
+}
+
+// ValidateDepositCoins implements PoolCurve. Any reserve coins of the pool can be deposited.
+func (WeightedPoolCurve) ValidateDepositCoins(pool Pool, depositCoins sdk.Coins) error {
+	if depositCoins.Empty() || depositCoins.Len() > len(pool.ReserveCoinDenoms) {
+		return ErrNumOfReserveCoin
+	}
+	for _, coin := range depositCoins {
+		if !pool.HasReserveCoinDenom(coin.Denom) {
+			return ErrNotMatchedReserveCoin
+		}
+	}
+	return nil
+}
+
+// ValidateWithdrawCoinDenom implements PoolCurve. The withdraw coin denom must be a reserve coin of the pool if given.
+func (WeightedPoolCurve) ValidateWithdrawCoinDenom(pool Pool, withdrawCoinDenom string) error {
+	if withdrawCoinDenom != "" && !pool.HasReserveCoinDenom(withdrawCoinDenom) {
+		return ErrNotMatchedReserveCoin
+	}
+	return nil
+}
+
+// DepositMint implements PoolCurve. All the deposit coins are accepted and the pool coin is minted for the growth of
+// the weighted invariant, as in Balancer's exact tokens in join. The part of each deposit coin over the average
+// weighted deposit ratio is charged the swap fee, and can not exceed MaxOrderAmountRatio of its reserve as a swap
+// order can not.
+func (WeightedPoolCurve) DepositMint(pool Pool, params Params, reserveCoins sdk.Coins, poolCoinTotalSupply sdk.Int, depositCoins sdk.Coins, feeRate PoolFeeRate) (sdk.Int, sdk.Coins, error) {
+	totalSupply := poolCoinTotalSupply.ToDec()
+	depositRatios := make([]sdk.Dec, len(pool.ReserveCoinDenoms))
+	averageDepositRatio := sdk.ZeroDec()
+	for i, denom := range pool.ReserveCoinDenoms {
+		reserveAmt, depositAmt := reserveCoins.AmountOf(denom).ToDec(), depositCoins.AmountOf(denom).ToDec()
+		if !reserveAmt.IsPositive() {
+			return sdk.Int{}, nil, ErrDepletedPool
+		}
+		if err := CheckOverflowWithDec(totalSupply, reserveAmt.Add(depositAmt)); err != nil {
+			return sdk.Int{}, nil, err
+		}
+		depositRatios[i] = reserveAmt.Add(depositAmt).Quo(reserveAmt)
+		averageDepositRatio = averageDepositRatio.Add(depositRatios[i].MulInt64(int64(pool.ReserveCoinWeights[i])))
+	}
+	averageDepositRatio = averageDepositRatio.QuoInt64(int64(TotalReserveCoinWeight))
+
+	invariantRatio := sdk.OneDec()
+	for i, denom := range pool.ReserveCoinDenoms {
+		reserveAmt, depositAmt := reserveCoins.AmountOf(denom).ToDec(), depositCoins.AmountOf(denom).ToDec()
+		if depositRatios[i].GT(averageDepositRatio) {
+			// the part over the average deposit ratio is swapped into the other reserve coins in effect
+			taxableAmt := depositAmt.Sub(reserveAmt.Mul(averageDepositRatio.Sub(sdk.OneDec())))
+			if taxableAmt.GT(reserveAmt.MulTruncate(params.MaxOrderAmountRatio)) {
+				return sdk.Int{}, nil, ErrExceededMaxOrderable
+			}
+			depositAmt = depositAmt.Sub(taxableAmt.Mul(feeRate.SwapFeeRate))
+		}
+		ratio, err := WeightedPower(reserveAmt.Add(depositAmt).Quo(reserveAmt), pool.ReserveCoinWeights[i], TotalReserveCoinWeight)
+		if err != nil {
+			return sdk.Int{}, nil, err
+		}
+		invariantRatio = invariantRatio.Mul(ratio)
+	}
+	if invariantRatio.LTE(sdk.OneDec()) {
+		return sdk.ZeroInt(), depositCoins, nil
+	}
+	return totalSupply.MulTruncate(invariantRatio.Sub(sdk.OneDec())).TruncateInt(), depositCoins, nil
+}
+
+// WithdrawPayout implements PoolCurve. Every reserve coin is paid out in proportion without the withdraw coin denom.
+// With the withdraw coin denom, only that reserve coin is paid out for the decrease of the weighted invariant, as in
+// Balancer's exact pool coin in exit for a single token, and the part over its proportional payout is charged the swap
+// fee and can not exceed MaxOrderAmountRatio of its reserve. The whole pool coin supply can not be withdrawn in a
+// single reserve coin.
+func (WeightedPoolCurve) WithdrawPayout(pool Pool, params Params, reserveCoins sdk.Coins, poolCoinTotalSupply, poolCoinAmt sdk.Int, withdrawCoinDenom string, feeRate PoolFeeRate) (sdk.Coins, sdk.Coins, error) {
+	if withdrawCoinDenom == "" {
+		return proportionalWithdrawPayout(reserveCoins, poolCoinTotalSupply, poolCoinAmt, feeRate.WithdrawFeeRate)
+	}
+	if poolCoinAmt.GTE(poolCoinTotalSupply) {
+		return nil, nil, sdkerrors.Wrap(ErrBadPoolCoinAmount, "the whole pool coin supply can not be withdrawn in a single reserve coin")
+	}
+
+	var weight uint32
+	for i, denom := range pool.ReserveCoinDenoms {
+		if denom == withdrawCoinDenom {
+			weight = pool.ReserveCoinWeights[i]
+		}
+	}
+	reserveAmt := reserveCoins.AmountOf(withdrawCoinDenom).ToDec()
+	if weight == 0 || !reserveAmt.IsPositive() {
+		return nil, nil, ErrNotMatchedReserveCoin
+	}
+
+	// the reserve coin is paid out until the weighted invariant decreases in proportion to the pool coin supply
+	invariantRatio := poolCoinTotalSupply.Sub(poolCoinAmt).ToDec().Quo(poolCoinTotalSupply.ToDec())
+	balanceRatio, err := WeightedPower(invariantRatio, TotalReserveCoinWeight, weight)
+	if err != nil {
+		return nil, nil, err
+	}
+	payoutAmt := reserveAmt.Mul(sdk.OneDec().Sub(balanceRatio))
+
+	// the part over the share of the reserve coin weight is swapped from the other reserve coins in effect
+	taxableAmt := payoutAmt.MulInt64(int64(TotalReserveCoinWeight - weight)).QuoInt64(int64(TotalReserveCoinWeight))
+	if taxableAmt.GT(reserveAmt.MulTruncate(params.MaxOrderAmountRatio)) {
+		return nil, nil, ErrExceededMaxOrderable
+	}
+	payoutAmt = payoutAmt.Sub(taxableAmt.Mul(feeRate.SwapFeeRate))
+
+	withdrawAmt := payoutAmt.MulTruncate(sdk.OneDec().Sub(feeRate.WithdrawFeeRate)).TruncateInt()
+	withdrawFeeAmt := payoutAmt.TruncateInt().Sub(withdrawAmt)
+	return sdk.NewCoins(sdk.NewCoin(withdrawCoinDenom, withdrawAmt)), sdk.NewCoins(sdk.NewCoin(withdrawCoinDenom, withdrawFeeAmt)), nil
+}
+
+// PoolPrice implements PoolCurve.
+func (WeightedPoolCurve) PoolPrice(pool Pool, _ Params, denomX, denomY string, x, y sdk.Dec) sdk.Dec {
+	return WeightedPoolPrice(x, y, pool.ReserveCoinWeight(denomX), pool.ReserveCoinWeight(denomY))
+}
+
+// SwapCurve implements PoolCurve. The swap orders are matched with the virtual reserves whose pool price X'/Y'
+// equals the weighted pool price.
+func (WeightedPoolCurve) SwapCurve(pool Pool, _ Params, denomX, denomY string, x, y sdk.Dec) (SwapCurve, sdk.Dec, sdk.Dec) {
+	curveX, curveY := WeightedReserves(x, y, pool.ReserveCoinWeight(denomX), pool.ReserveCoinWeight(denomY))
+	return ConstantProductCurve{}, curveX, curveY
+}
+
+// CurrentSwapCurve implements PoolCurve.
+func (c WeightedPoolCurve) CurrentSwapCurve(_ sdk.Context, _ PoolStateKeeper, pool Pool, params Params, denomX, denomY string, x, y sdk.Dec) (SwapCurve, sdk.Dec, sdk.Dec) {
+	return c.SwapCurve(pool, params, denomX, denomY, x, y)
+}
+
+// PriceAfterSwap implements PoolCurve. It is the pool price of the reserves with the pool deltas.
+func (c WeightedPoolCurve) PriceAfterSwap(pool Pool, params Params, denomX, denomY string, x, y sdk.Dec, _ SwapCurve, _ BatchResult, poolXDelta, poolYDelta sdk.Dec) sdk.Dec {
+	return c.PoolPrice(pool, params, denomX, denomY, x.Add(poolXDelta), y.Add(poolYDelta))
+}
+
+// StableSwapPoolCurve is the PoolCurve of the stable pool type with the pool price of the StableSwap curve.
+type StableSwapPoolCurve struct {
+	ProportionalPoolCurve
+}
+
+// InitPool implements PoolCurve. The amplification of the pool is the StableSwapAmplification param if not given, and
+// is kept by the pool after the param changes.
+func (StableSwapPoolCurve) InitPool(pool *Pool, params Params) {
+	if pool.Amplification == 0 {
+		pool.Amplification = params.StableSwapAmplification
+	}
+}
+
+// ValidatePool implements PoolCurve. The amplification is required, and the reserve coin weights are not allowed.
+func (StableSwapPoolCurve) ValidatePool(pool Pool) error {
+	if len(pool.ReserveCoinWeights) > 0 {
+		return ErrBadReserveCoinWeights
+	}
+	return ValidateAmplification(pool.Amplification)
+}
+
+// PoolPrice implements PoolCurve.
+func (StableSwapPoolCurve) PoolPrice(pool Pool, _ Params, _, _ string, x, y sdk.Dec) sdk.Dec {
+	return NewStableSwapCurve(pool.Amplification).Price(x, y)
+}
+
+// SwapCurve implements PoolCurve.
+func (StableSwapPoolCurve) SwapCurve(pool Pool, _ Params, _, _ string, x, y sdk.Dec) (SwapCurve, sdk.Dec, sdk.Dec) {
+	return NewStableSwapCurve(pool.Amplification), x, y
+}
+
+// CurrentSwapCurve implements PoolCurve.
+func (c StableSwapPoolCurve) CurrentSwapCurve(_ sdk.Context, _ PoolStateKeeper, pool Pool, params Params, denomX, denomY string, x, y sdk.Dec) (SwapCurve, sdk.Dec, sdk.Dec) {
+	return c.SwapCurve(pool, params, denomX, denomY, x, y)
+}
+
+// PriceAfterSwap implements PoolCurve. It is the pool price of the reserves with the pool deltas.
+func (c StableSwapPoolCurve) PriceAfterSwap(pool Pool, params Params, denomX, denomY string, x, y sdk.Dec, _ SwapCurve, _ BatchResult, poolXDelta, poolYDelta sdk.Dec) sdk.Dec {
+	return c.PoolPrice(pool, params, denomX, denomY, x.Add(poolXDelta), y.Add(poolYDelta))
+}
@@ -0,0 +1,194 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+type customPoolCurve struct {
+	types.ConstantProductPoolCurve
+}
+
+var customPoolType = types.PoolType{
+	Id:                6,
+	Name:              "CustomLiquidityPool",
+	MinReserveCoinNum: 2,
+	MaxReserveCoinNum: 2,
+	Description:       "Custom liquidity pool",
+}
+
+func TestRegisterPoolCurve(t *testing.T) {
+	for _, poolType := range types.DefaultPoolTypes {
+		_, found := types.GetPoolCurve(poolType.Id)
+		require.True(t, found)
+		require.Panics(t, func() { types.RegisterPoolCurve(poolType, types.ConstantProductPoolCurve{}) })
+	}
+
+	params := types.DefaultParams()
+	params.PoolTypes = append(append([]types.PoolType{}, types.DefaultPoolTypes...), customPoolType)
+	require.EqualError(t, params.Validate(), "unsupported pool type: 6")
+
+	if _, found := types.GetPoolCurve(customPoolType.Id); !found {
+		types.RegisterPoolCurve(customPoolType, customPoolCurve{})
+	}
+	curve, found := types.GetPoolCurve(customPoolType.Id)
+	require.True(t, found)
+	require.Equal(t, customPoolCurve{}, curve)
+	require.NoError(t, params.Validate())
+
+	// the registered pool type must not be altered in the params
+	params.PoolTypes[len(params.PoolTypes)-1].Name = "AlteredLiquidityPool"
+	require.EqualError(t, params.Validate(), "unsupported pool type: 6")
+}
+
+func TestProportionalPoolCurve(t *testing.T) {
+	curve := types.ProportionalPoolCurve{}
+	pool := types.Pool{ReserveCoinDenoms: []string{"denomx", "denomy"}}
+	params := types.DefaultParams()
+	feeRate := types.PoolFeeRate{SwapFeeRate: params.SwapFeeRate, WithdrawFeeRate: sdk.NewDecWithPrec(1, 2)}
+	reserveCoins := sdk.NewCoins(sdk.NewInt64Coin("denomx", 1000000), sdk.NewInt64Coin("denomy", 2000000))
+	poolCoinTotalSupply := sdk.NewInt(1000000)
+
+	// the deposit coins over the smallest deposit ratio are not accepted
+	mintAmt, acceptedCoins, err := curve.DepositMint(pool, params, reserveCoins, poolCoinTotalSupply,
+		sdk.NewCoins(sdk.NewInt64Coin("denomx", 100000), sdk.NewInt64Coin("denomy", 100000)), feeRate)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(50000), mintAmt)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomx", 50000), sdk.NewInt64Coin("denomy", 100000)), acceptedCoins)
+
+	withdrawCoins, withdrawFeeCoins, err := curve.WithdrawPayout(pool, params, reserveCoins, poolCoinTotalSupply, sdk.NewInt(100000), "", feeRate)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomx", 99000), sdk.NewInt64Coin("denomy", 198000)), withdrawCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomx", 1000), sdk.NewInt64Coin("denomy", 2000)), withdrawFeeCoins)
+
+	// all reserve coins are paid out without the withdraw fee for the whole pool coin supply
+	withdrawCoins, withdrawFeeCoins, err = curve.WithdrawPayout(pool, params, reserveCoins, poolCoinTotalSupply, poolCoinTotalSupply, "", feeRate)
+	require.NoError(t, err)
+	require.Equal(t, reserveCoins, withdrawCoins)
+	require.True(t, withdrawFeeCoins.IsZero())
+
+	require.NoError(t, curve.ValidatePool(types.Pool{ReserveCoinDenoms: []string{"denomx", "denomy"}}))
+	require.ErrorIs(t, curve.ValidatePool(types.Pool{ReserveCoinDenoms: []string{"denomx", "denomy"}, ReserveCoinWeights: []uint32{50, 50}}),
+		types.ErrBadReserveCoinWeights)
+
+	// every reserve coin must be deposited and withdrawn
+	require.NoError(t, curve.ValidateDepositCoins(pool, reserveCoins))
+	require.ErrorIs(t, curve.ValidateDepositCoins(pool, sdk.NewCoins(sdk.NewInt64Coin("denomx", 1000))), types.ErrNumOfReserveCoin)
+	require.ErrorIs(t, curve.ValidateDepositCoins(pool, sdk.NewCoins(sdk.NewInt64Coin("denomx", 1000), sdk.NewInt64Coin("denomz", 1000))),
+		types.ErrNotMatchedReserveCoin)
+	require.NoError(t, curve.ValidateWithdrawCoinDenom(pool, ""))
+	require.ErrorIs(t, curve.ValidateWithdrawCoinDenom(pool, "denomx"), types.ErrBadWithdrawCoinDenom)
+}
+
+func TestWeightedPoolCurve(t *testing.T) {
+	curve := types.WeightedPoolCurve{}
+	pool := types.Pool{ReserveCoinDenoms: []string{"denomx", "denomy"}, ReserveCoinWeights: []uint32{80, 20}}
+	params := types.DefaultParams()
+	feeRate := types.PoolFeeRate{SwapFeeRate: params.SwapFeeRate, WithdrawFeeRate: sdk.ZeroDec()}
+	reserveCoins := sdk.NewCoins(sdk.NewInt64Coin("denomx", 800000), sdk.NewInt64Coin("denomy", 200000))
+	poolCoinTotalSupply := sdk.NewInt(1000000)
+
+	// any subset of the reserve coins can be deposited, and a single reserve coin can be withdrawn
+	require.NoError(t, curve.ValidateDepositCoins(pool, sdk.NewCoins(sdk.NewInt64Coin("denomx", 1000))))
+	require.ErrorIs(t, curve.ValidateDepositCoins(pool, sdk.NewCoins(sdk.NewInt64Coin("denomz", 1000))), types.ErrNotMatchedReserveCoin)
+	require.NoError(t, curve.ValidateWithdrawCoinDenom(pool, "denomy"))
+	require.ErrorIs(t, curve.ValidateWithdrawCoinDenom(pool, "denomz"), types.ErrNotMatchedReserveCoin)
+
+	// the deposit in proportion to the reserves is not charged the swap fee
+	mintAmt, acceptedCoins, err := curve.DepositMint(pool, params, reserveCoins, poolCoinTotalSupply,
+		sdk.NewCoins(sdk.NewInt64Coin("denomx", 8000), sdk.NewInt64Coin("denomy", 2000)), feeRate)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomx", 8000), sdk.NewInt64Coin("denomy", 2000)), acceptedCoins)
+	require.True(t, mintAmt.Sub(sdk.NewInt(10000)).Abs().LTE(sdk.OneInt()), mintAmt.String())
+
+	// the single-sided deposit of the same value mints less for the swap fee on the part over the weighted ratio
+	singleMintAmt, acceptedCoins, err := curve.DepositMint(pool, params, reserveCoins, poolCoinTotalSupply,
+		sdk.NewCoins(sdk.NewInt64Coin("denomx", 10000)), feeRate)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomx", 10000)), acceptedCoins)
+	require.True(t, singleMintAmt.LT(mintAmt))
+	require.Equal(t, sdk.NewInt(9981), singleMintAmt)
+
+	// the single-sided deposit over the max order amount ratio is rejected
+	_, _, err = curve.DepositMint(pool, params, reserveCoins, poolCoinTotalSupply,
+		sdk.NewCoins(sdk.NewInt64Coin("denomy", 200000)), feeRate)
+	require.ErrorIs(t, err, types.ErrExceededMaxOrderable)
+
+	// the whole pool coin is withdrawn in a single reserve coin for the decrease of the weighted invariant
+	withdrawCoins, withdrawFeeCoins, err := curve.WithdrawPayout(pool, params, reserveCoins, poolCoinTotalSupply, sdk.NewInt(10000), "denomy", feeRate)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomy", 9778)), withdrawCoins)
+	require.True(t, withdrawFeeCoins.IsZero())
+
+	// every reserve coin is withdrawn in proportion without the withdraw coin denom
+	withdrawCoins, _, err = curve.WithdrawPayout(pool, params, reserveCoins, poolCoinTotalSupply, sdk.NewInt(10000), "", feeRate)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomx", 8000), sdk.NewInt64Coin("denomy", 2000)), withdrawCoins)
+
+	// the whole pool coin supply can not be withdrawn in a single reserve coin
+	_, _, err = curve.WithdrawPayout(pool, params, reserveCoins, poolCoinTotalSupply, poolCoinTotalSupply, "denomx", feeRate)
+	require.ErrorIs(t, err, types.ErrBadPoolCoinAmount)
+}
+
+func TestPoolCurvePoolPrice(t *testing.T) {
+	params := types.DefaultParams()
+	x, y := sdk.NewDec(800000), sdk.NewDec(200000)
+
+	curve, _ := types.GetPoolCurve(types.DefaultPoolTypeID)
+	require.Equal(t, sdk.NewDec(4), curve.PoolPrice(types.Pool{}, params, "denomx", "denomy", x, y))
+
+	weightedPool := types.Pool{
+		TypeId:             types.WeightedPoolTypeID,
+		ReserveCoinDenoms:  []string{"denomx", "denomy"},
+		ReserveCoinWeights: []uint32{80, 20},
+	}
+	curve, _ = types.GetPoolCurve(types.WeightedPoolTypeID)
+	require.NoError(t, curve.ValidatePool(weightedPool))
+	require.Equal(t, sdk.OneDec(), curve.PoolPrice(weightedPool, params, "denomx", "denomy", x, y))
+	swapCurve, curveX, curveY := curve.SwapCurve(weightedPool, params, "denomx", "denomy", x, y)
+	require.Equal(t, sdk.OneDec(), swapCurve.Price(curveX, curveY))
+
+	// the stable pool keeps the amplification of the param at its creation
+	stablePool := types.Pool{TypeId: types.StableSwapPoolTypeID, ReserveCoinDenoms: []string{"denomx", "denomy"}}
+	curve, _ = types.GetPoolCurve(types.StableSwapPoolTypeID)
+	require.ErrorIs(t, curve.ValidatePool(stablePool), types.ErrBadAmplification)
+	curve.InitPool(&stablePool, params)
+	require.Equal(t, params.StableSwapAmplification, stablePool.Amplification)
+	require.NoError(t, curve.ValidatePool(stablePool))
+	price := curve.PoolPrice(stablePool, params, "denomx", "denomy", x, y)
+	require.True(t, price.GT(sdk.OneDec()))
+	require.True(t, price.LT(sdk.NewDec(4)))
+
+	// the pool price of the stable pool follows its own amplification rather than the param
+	params.StableSwapAmplification = 1
+	require.Equal(t, price, curve.PoolPrice(stablePool, params, "denomx", "denomy", x, y))
+	stablePool.Amplification = 1
+	require.True(t, curve.PoolPrice(stablePool, params, "denomx", "denomy", x, y).GT(price))
+
+	// the other pool types do not allow the amplification
+	weightedPool.Amplification = 100
+	require.ErrorIs(t, types.WeightedPoolCurve{}.ValidatePool(weightedPool), types.ErrBadAmplification)
+	require.ErrorIs(t, types.ConstantProductPoolCurve{}.ValidatePool(types.Pool{Amplification: 100}), types.ErrBadAmplification)
+}
+
+func TestConcentratedPoolCurve(t *testing.T) {
+	curve, found := types.GetPoolCurve(types.ConcentratedPoolTypeID)
+	require.True(t, found)
+	require.False(t, curve.HasPoolCoin())
+	constantProductCurve, _ := types.GetPoolCurve(types.DefaultPoolTypeID)
+	require.True(t, constantProductCurve.HasPoolCoin())
+
+	pool := types.Pool{ReserveCoinDenoms: []string{"denomx", "denomy"}}
+	require.NoError(t, curve.ValidateInitialDeposit(pool, sdk.NewCoins(sdk.NewInt64Coin("denomx", 1000), sdk.NewInt64Coin("denomy", 2000))))
+	require.ErrorIs(t, curve.ValidateInitialDeposit(pool, sdk.NewCoins(sdk.NewInt64Coin("denomx", 1), sdk.NewInt64Coin("denomy", 1e18))), types.ErrBadPriceRange)
+
+	// the swap orders are limited by the reserve of the demand coin valued at the order price
+	reserveCoins := sdk.NewCoins(sdk.NewInt64Coin("denomy", 1000))
+	require.Equal(t, sdk.NewDec(2000), curve.MaxOrderReserve(pool, reserveCoins, "denomx", "denomy", sdk.NewDec(2)))
+	require.Equal(t, sdk.ZeroDec(), curve.MaxOrderReserve(pool, reserveCoins, "denomy", "denomx", sdk.NewDec(2)))
+	require.Equal(t, sdk.ZeroDec(), constantProductCurve.MaxOrderReserve(pool, reserveCoins, "denomx", "denomy", sdk.NewDec(2)))
+}
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypePoolFeeRates defines the type for a PoolFeeRatesProposal
+	ProposalTypePoolFeeRates = "PoolFeeRates"
+	// ProposalTypeCircuitBreaker defines the type for a CircuitBreakerProposal
+	ProposalTypeCircuitBreaker = "CircuitBreaker"
+)
+
+var (
+	_ govtypes.Content = &PoolFeeRatesProposal{}
+	_ govtypes.Content = &CircuitBreakerProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypePoolFeeRates)
+	govtypes.RegisterProposalType(ProposalTypeCircuitBreaker)
+}
+
+// NewPoolFeeRate returns a new PoolFeeRate of the pool.
+func NewPoolFeeRate(poolID uint64, swapFeeRate, withdrawFeeRate sdk.Dec) PoolFeeRate {
+	return PoolFeeRate{
+		PoolId:          poolID,
+		SwapFeeRate:     swapFeeRate,
+		WithdrawFeeRate: withdrawFeeRate,
+	}
+}
+
+// Validate validates that the fee rates of PoolFeeRate are between zero and one. The bounds of the params are
+// checked when the fee rates are set.
+func (feeRate PoolFeeRate) Validate() error {
+	if feeRate.PoolId == 0 {
+		return ErrPoolNotExists
+	}
+	for _, rate := range []sdk.Dec{feeRate.SwapFeeRate, feeRate.WithdrawFeeRate} {
+		if rate.IsNil() || rate.IsNegative() || rate.GT(sdk.OneDec()) {
+			return ErrBadPoolFeeRate
+		}
+	}
+	return nil
+}
+
+// IsWithinBounds returns whether both fee rates of PoolFeeRate are within the bounds of the params.
+func (feeRate PoolFeeRate) IsWithinBounds(params Params) bool {
+	for _, rate := range []sdk.Dec{feeRate.SwapFeeRate, feeRate.WithdrawFeeRate} {
+		if rate.LT(params.MinPoolFeeRate) || rate.GT(params.MaxPoolFeeRate) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewPoolFeeRatesProposal creates a new PoolFeeRatesProposal.
+func NewPoolFeeRatesProposal(title, description string, poolFeeRates []PoolFeeRate) *PoolFeeRatesProposal {
+	return &PoolFeeRatesProposal{
+		Title:        title,
+		Description:  description,
+		PoolFeeRates: poolFeeRates,
+	}
+}
+
+// GetTitle returns the title of a pool fee rates proposal.
+func (p *PoolFeeRatesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a pool fee rates proposal.
+func (p *PoolFeeRatesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a pool fee rates proposal.
+func (p *PoolFeeRatesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a pool fee rates proposal.
+func (p *PoolFeeRatesProposal) ProposalType() string { return ProposalTypePoolFeeRates }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *PoolFeeRatesProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.PoolFeeRates) == 0 {
+		return ErrBadPoolFeeRate
+	}
+	poolIDs := make(map[uint64]bool)
+	for _, feeRate := range p.PoolFeeRates {
+		if err := feeRate.Validate(); err != nil {
+			return err
+		}
+		if poolIDs[feeRate.PoolId] {
+			return ErrBadPoolFeeRate
+		}
+		poolIDs[feeRate.PoolId] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p PoolFeeRatesProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Pool Fee Rates Proposal:
+  Title:          %s
+  Description:    %s
+  Pool Fee Rates:
+`, p.Title, p.Description))
+	for _, feeRate := range p.PoolFeeRates {
+		b.WriteString(fmt.Sprintf("    Pool %d: swap fee rate %s, withdraw fee rate %s\n",
+			feeRate.PoolId, feeRate.SwapFeeRate, feeRate.WithdrawFeeRate))
+	}
+	return b.String()
+}
+
+// NewCircuitBreakerProposal creates a new CircuitBreakerProposal.
+func NewCircuitBreakerProposal(title, description string, circuitBreakers []CircuitBreaker) *CircuitBreakerProposal {
+	return &CircuitBreakerProposal{
+		Title:           title,
+		Description:     description,
+		CircuitBreakers: circuitBreakers,
+	}
+}
+
+// GetTitle returns the title of a circuit breaker proposal.
+func (p *CircuitBreakerProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a circuit breaker proposal.
+func (p *CircuitBreakerProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a circuit breaker proposal.
+func (p *CircuitBreakerProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a circuit breaker proposal.
+func (p *CircuitBreakerProposal) ProposalType() string { return ProposalTypeCircuitBreaker }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CircuitBreakerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.CircuitBreakers) == 0 {
+		return ErrBadCircuitBreaker
+	}
+	return ValidateCircuitBreakers(p.CircuitBreakers)
+}
+
+// String implements the Stringer interface.
+func (p CircuitBreakerProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Circuit Breaker Proposal:
+  Title:            %s
+  Description:      %s
+  Circuit Breakers:
+`, p.Title, p.Description))
+	for _, cb := range p.CircuitBreakers {
+		b.WriteString(fmt.Sprintf("    Pool %d, message type %q: enabled %t\n", cb.PoolId, cb.MsgType, cb.Enabled))
+	}
+	return b.String()
+}
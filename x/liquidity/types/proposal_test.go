@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestPoolFeeRatesProposal_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name        string
+		malleate    func(proposal *types.PoolFeeRatesProposal)
+		expectedErr string
+	}{
+		{"Valid", func(proposal *types.PoolFeeRatesProposal) {}, ""},
+		{"EmptyTitle", func(proposal *types.PoolFeeRatesProposal) { proposal.Title = "" }, "proposal title cannot be blank: invalid proposal content"},
+		{"NoPoolFeeRate", func(proposal *types.PoolFeeRatesProposal) { proposal.PoolFeeRates = nil }, "invalid pool fee rate"},
+		{"ZeroPoolId", func(proposal *types.PoolFeeRatesProposal) { proposal.PoolFeeRates[0].PoolId = 0 }, "pool not exists"},
+		{"NegativeSwapFeeRate", func(proposal *types.PoolFeeRatesProposal) {
+			proposal.PoolFeeRates[0].SwapFeeRate = sdk.NewDec(-1)
+		}, "invalid pool fee rate"},
+		{"DuplicatePoolId", func(proposal *types.PoolFeeRatesProposal) { proposal.PoolFeeRates[1].PoolId = 1 }, "invalid pool fee rate"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := types.NewPoolFeeRatesProposal("title", "description", []types.PoolFeeRate{
+				types.NewPoolFeeRate(1, sdk.NewDecWithPrec(5, 4), sdk.ZeroDec()),
+				types.NewPoolFeeRate(2, sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 3)),
+			})
+			tc.malleate(proposal)
+			err := proposal.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, types.RouterKey, proposal.ProposalRoute())
+				require.Equal(t, types.ProposalTypePoolFeeRates, proposal.ProposalType())
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPoolFeeRate_IsWithinBounds(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinPoolFeeRate = sdk.NewDecWithPrec(1, 4)
+	require.True(t, types.NewPoolFeeRate(1, sdk.NewDecWithPrec(5, 4), sdk.NewDecWithPrec(1, 1)).IsWithinBounds(params))
+	require.False(t, types.NewPoolFeeRate(1, sdk.ZeroDec(), sdk.NewDecWithPrec(1, 3)).IsWithinBounds(params))
+	require.False(t, types.NewPoolFeeRate(1, sdk.NewDecWithPrec(5, 4), sdk.NewDecWithPrec(2, 1)).IsWithinBounds(params))
+}
+
+func TestCircuitBreakerProposal_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name        string
+		malleate    func(proposal *types.CircuitBreakerProposal)
+		expectedErr string
+	}{
+		{"Valid", func(proposal *types.CircuitBreakerProposal) {}, ""},
+		{"EmptyTitle", func(proposal *types.CircuitBreakerProposal) { proposal.Title = "" }, "proposal title cannot be blank: invalid proposal content"},
+		{"NoCircuitBreaker", func(proposal *types.CircuitBreakerProposal) { proposal.CircuitBreakers = nil }, "invalid circuit breaker"},
+		{"UnknownMsgType", func(proposal *types.CircuitBreakerProposal) {
+			proposal.CircuitBreakers[0].MsgType = "unknown"
+		}, "unknown message type unknown: invalid circuit breaker"},
+		{"DuplicateCircuitBreaker", func(proposal *types.CircuitBreakerProposal) {
+			proposal.CircuitBreakers[1] = proposal.CircuitBreakers[0]
+			proposal.CircuitBreakers[1].Enabled = false
+		}, "duplicate circuit breaker of pool 1 and message type \"swap_within_batch\": invalid circuit breaker"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := types.NewCircuitBreakerProposal("title", "description", []types.CircuitBreaker{
+				types.NewCircuitBreaker(1, types.TypeMsgSwapWithinBatch, true),
+				types.NewCircuitBreaker(0, "", false),
+			})
+			tc.malleate(proposal)
+			err := proposal.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, types.RouterKey, proposal.ProposalRoute())
+				require.Equal(t, types.ProposalTypeCircuitBreaker, proposal.ProposalType())
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
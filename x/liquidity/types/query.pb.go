@@ -6,24 +6,30 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -213,6 +219,133 @@ func (m *QueryLiquidityPoolByReserveAccRequest) GetReserveAcc() string {
 	return ""
 }
 
+// the request type for the QueryLiquidityPoolByDenoms RPC method. Requestable specified pair of reserve coin denoms and optional type_id.
+type QueryLiquidityPoolByDenomsRequest struct {
+	// denoms of the pair of reserve coins in any order
+	DenomA string `protobuf:"bytes,1,opt,name=denom_a,json=denomA,proto3" json:"denom_a,omitempty"`
+	DenomB string `protobuf:"bytes,2,opt,name=denom_b,json=denomB,proto3" json:"denom_b,omitempty"`
+	// id of the pool type, required only when several pools of different types have the pair
+	TypeId uint32 `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+}
+
+func (m *QueryLiquidityPoolByDenomsRequest) Reset()         { *m = QueryLiquidityPoolByDenomsRequest{} }
+func (m *QueryLiquidityPoolByDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityPoolByDenomsRequest) ProtoMessage()    {}
+func (*QueryLiquidityPoolByDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{4}
+}
+func (m *QueryLiquidityPoolByDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityPoolByDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityPoolByDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityPoolByDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityPoolByDenomsRequest.Merge(m, src)
+}
+func (m *QueryLiquidityPoolByDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityPoolByDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityPoolByDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityPoolByDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityPoolByDenomsRequest) GetDenomA() string {
+	if m != nil {
+		return m.DenomA
+	}
+	return ""
+}
+
+func (m *QueryLiquidityPoolByDenomsRequest) GetDenomB() string {
+	if m != nil {
+		return m.DenomB
+	}
+	return ""
+}
+
+func (m *QueryLiquidityPoolByDenomsRequest) GetTypeId() uint32 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+// the request type for the QueryLiquidityPoolsByDenom RPC method. Requestable specified reserve coin denom, optional type_id and pagination.
+type QueryLiquidityPoolsByDenomRequest struct {
+	// denom of the reserve coin
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// id of the pool type to filter the pools, all types if 0
+	TypeId uint32 `protobuf:"varint,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLiquidityPoolsByDenomRequest) Reset()         { *m = QueryLiquidityPoolsByDenomRequest{} }
+func (m *QueryLiquidityPoolsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityPoolsByDenomRequest) ProtoMessage()    {}
+func (*QueryLiquidityPoolsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{5}
+}
+func (m *QueryLiquidityPoolsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityPoolsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityPoolsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityPoolsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityPoolsByDenomRequest.Merge(m, src)
+}
+func (m *QueryLiquidityPoolsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityPoolsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityPoolsByDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityPoolsByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityPoolsByDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryLiquidityPoolsByDenomRequest) GetTypeId() uint32 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *QueryLiquidityPoolsByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // the request type for the QueryLiquidityPoolBatch RPC method. requestable including specified pool_id.
 type QueryLiquidityPoolBatchRequest struct {
 	// id of the target pool for query
@@ -223,7 +356,7 @@ func (m *QueryLiquidityPoolBatchRequest) Reset()         { *m = QueryLiquidityPo
 func (m *QueryLiquidityPoolBatchRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidityPoolBatchRequest) ProtoMessage()    {}
 func (*QueryLiquidityPoolBatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{4}
+	return fileDescriptor_f8c9321d314a3b1d, []int{6}
 }
 func (m *QueryLiquidityPoolBatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +401,7 @@ func (m *QueryLiquidityPoolBatchResponse) Reset()         { *m = QueryLiquidityP
 func (m *QueryLiquidityPoolBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidityPoolBatchResponse) ProtoMessage()    {}
 func (*QueryLiquidityPoolBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{5}
+	return fileDescriptor_f8c9321d314a3b1d, []int{7}
 }
 func (m *QueryLiquidityPoolBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,13 +441,17 @@ func (m *QueryLiquidityPoolBatchResponse) GetBatch() PoolBatch {
 type QueryLiquidityPoolsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// id of the pool type to filter the pools, all types if 0
+	TypeId uint32 `protobuf:"varint,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	// reserve coin denom to filter the pools, all pools if empty
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryLiquidityPoolsRequest) Reset()         { *m = QueryLiquidityPoolsRequest{} }
 func (m *QueryLiquidityPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidityPoolsRequest) ProtoMessage()    {}
 func (*QueryLiquidityPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{6}
+	return fileDescriptor_f8c9321d314a3b1d, []int{8}
 }
 func (m *QueryLiquidityPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,6 +487,20 @@ func (m *QueryLiquidityPoolsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryLiquidityPoolsRequest) GetTypeId() uint32 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *QueryLiquidityPoolsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // the response type for the QueryLiquidityPoolsResponse RPC method. This includes a list of all existing liquidity pools and paging results that contain next_key and total count.
 type QueryLiquidityPoolsResponse struct {
 	Pools []Pool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
@@ -361,7 +512,7 @@ func (m *QueryLiquidityPoolsResponse) Reset()         { *m = QueryLiquidityPools
 func (m *QueryLiquidityPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidityPoolsResponse) ProtoMessage()    {}
 func (*QueryLiquidityPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{7}
+	return fileDescriptor_f8c9321d314a3b1d, []int{9}
 }
 func (m *QueryLiquidityPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,7 +563,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{8}
+	return fileDescriptor_f8c9321d314a3b1d, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,7 +602,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{9}
+	return fileDescriptor_f8c9321d314a3b1d, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +650,7 @@ func (m *QueryPoolBatchSwapMsgsRequest) Reset()         { *m = QueryPoolBatchSwa
 func (m *QueryPoolBatchSwapMsgsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchSwapMsgsRequest) ProtoMessage()    {}
 func (*QueryPoolBatchSwapMsgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{10}
+	return fileDescriptor_f8c9321d314a3b1d, []int{12}
 }
 func (m *QueryPoolBatchSwapMsgsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +705,7 @@ func (m *QueryPoolBatchSwapMsgRequest) Reset()         { *m = QueryPoolBatchSwap
 func (m *QueryPoolBatchSwapMsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchSwapMsgRequest) ProtoMessage()    {}
 func (*QueryPoolBatchSwapMsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{11}
+	return fileDescriptor_f8c9321d314a3b1d, []int{13}
 }
 func (m *QueryPoolBatchSwapMsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +759,7 @@ func (m *QueryPoolBatchSwapMsgsResponse) Reset()         { *m = QueryPoolBatchSw
 func (m *QueryPoolBatchSwapMsgsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchSwapMsgsResponse) ProtoMessage()    {}
 func (*QueryPoolBatchSwapMsgsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{12}
+	return fileDescriptor_f8c9321d314a3b1d, []int{14}
 }
 func (m *QueryPoolBatchSwapMsgsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +811,7 @@ func (m *QueryPoolBatchSwapMsgResponse) Reset()         { *m = QueryPoolBatchSwa
 func (m *QueryPoolBatchSwapMsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchSwapMsgResponse) ProtoMessage()    {}
 func (*QueryPoolBatchSwapMsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{13}
+	return fileDescriptor_f8c9321d314a3b1d, []int{15}
 }
 func (m *QueryPoolBatchSwapMsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +859,7 @@ func (m *QueryPoolBatchDepositMsgsRequest) Reset()         { *m = QueryPoolBatch
 func (m *QueryPoolBatchDepositMsgsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchDepositMsgsRequest) ProtoMessage()    {}
 func (*QueryPoolBatchDepositMsgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{14}
+	return fileDescriptor_f8c9321d314a3b1d, []int{16}
 }
 func (m *QueryPoolBatchDepositMsgsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,7 +914,7 @@ func (m *QueryPoolBatchDepositMsgRequest) Reset()         { *m = QueryPoolBatchD
 func (m *QueryPoolBatchDepositMsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchDepositMsgRequest) ProtoMessage()    {}
 func (*QueryPoolBatchDepositMsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{15}
+	return fileDescriptor_f8c9321d314a3b1d, []int{17}
 }
 func (m *QueryPoolBatchDepositMsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +968,7 @@ func (m *QueryPoolBatchDepositMsgsResponse) Reset()         { *m = QueryPoolBatc
 func (m *QueryPoolBatchDepositMsgsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchDepositMsgsResponse) ProtoMessage()    {}
 func (*QueryPoolBatchDepositMsgsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{16}
+	return fileDescriptor_f8c9321d314a3b1d, []int{18}
 }
 func (m *QueryPoolBatchDepositMsgsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,7 +1020,7 @@ func (m *QueryPoolBatchDepositMsgResponse) Reset()         { *m = QueryPoolBatch
 func (m *QueryPoolBatchDepositMsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchDepositMsgResponse) ProtoMessage()    {}
 func (*QueryPoolBatchDepositMsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{17}
+	return fileDescriptor_f8c9321d314a3b1d, []int{19}
 }
 func (m *QueryPoolBatchDepositMsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,7 +1068,7 @@ func (m *QueryPoolBatchWithdrawMsgsRequest) Reset()         { *m = QueryPoolBatc
 func (m *QueryPoolBatchWithdrawMsgsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchWithdrawMsgsRequest) ProtoMessage()    {}
 func (*QueryPoolBatchWithdrawMsgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{18}
+	return fileDescriptor_f8c9321d314a3b1d, []int{20}
 }
 func (m *QueryPoolBatchWithdrawMsgsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1123,7 @@ func (m *QueryPoolBatchWithdrawMsgRequest) Reset()         { *m = QueryPoolBatch
 func (m *QueryPoolBatchWithdrawMsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchWithdrawMsgRequest) ProtoMessage()    {}
 func (*QueryPoolBatchWithdrawMsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{19}
+	return fileDescriptor_f8c9321d314a3b1d, []int{21}
 }
 func (m *QueryPoolBatchWithdrawMsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1177,7 @@ func (m *QueryPoolBatchWithdrawMsgsResponse) Reset()         { *m = QueryPoolBat
 func (m *QueryPoolBatchWithdrawMsgsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchWithdrawMsgsResponse) ProtoMessage()    {}
 func (*QueryPoolBatchWithdrawMsgsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{20}
+	return fileDescriptor_f8c9321d314a3b1d, []int{22}
 }
 func (m *QueryPoolBatchWithdrawMsgsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1078,7 +1229,7 @@ func (m *QueryPoolBatchWithdrawMsgResponse) Reset()         { *m = QueryPoolBatc
 func (m *QueryPoolBatchWithdrawMsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchWithdrawMsgResponse) ProtoMessage()    {}
 func (*QueryPoolBatchWithdrawMsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{21}
+	return fileDescriptor_f8c9321d314a3b1d, []int{23}
 }
 func (m *QueryPoolBatchWithdrawMsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,1775 +1265,12666 @@ func (m *QueryPoolBatchWithdrawMsgResponse) GetWithdraw() WithdrawMsgState {
 	return WithdrawMsgState{}
 }
 
-func init() {
-	proto.RegisterType((*QueryLiquidityPoolRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolRequest")
-	proto.RegisterType((*QueryLiquidityPoolResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolResponse")
-	proto.RegisterType((*QueryLiquidityPoolByPoolCoinDenomRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolByPoolCoinDenomRequest")
-	proto.RegisterType((*QueryLiquidityPoolByReserveAccRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolByReserveAccRequest")
-	proto.RegisterType((*QueryLiquidityPoolBatchRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolBatchRequest")
-	proto.RegisterType((*QueryLiquidityPoolBatchResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolBatchResponse")
-	proto.RegisterType((*QueryLiquidityPoolsRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsRequest")
-	proto.RegisterType((*QueryLiquidityPoolsResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.liquidity.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.liquidity.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryPoolBatchSwapMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgsRequest")
-	proto.RegisterType((*QueryPoolBatchSwapMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgRequest")
-	proto.RegisterType((*QueryPoolBatchSwapMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgsResponse")
-	proto.RegisterType((*QueryPoolBatchSwapMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgResponse")
-	proto.RegisterType((*QueryPoolBatchDepositMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgsRequest")
-	proto.RegisterType((*QueryPoolBatchDepositMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgRequest")
-	proto.RegisterType((*QueryPoolBatchDepositMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgsResponse")
-	proto.RegisterType((*QueryPoolBatchDepositMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgResponse")
-	proto.RegisterType((*QueryPoolBatchWithdrawMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgsRequest")
-	proto.RegisterType((*QueryPoolBatchWithdrawMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgRequest")
-	proto.RegisterType((*QueryPoolBatchWithdrawMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgsResponse")
-	proto.RegisterType((*QueryPoolBatchWithdrawMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgResponse")
+// the request type for the QueryLiquidityPoolPositions RPC method. Requestable including specified pool_id and pagination offset, limit, key.
+type QueryLiquidityPoolPositionsRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("tendermint/liquidity/v1beta1/query.proto", fileDescriptor_f8c9321d314a3b1d)
+func (m *QueryLiquidityPoolPositionsRequest) Reset()         { *m = QueryLiquidityPoolPositionsRequest{} }
+func (m *QueryLiquidityPoolPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityPoolPositionsRequest) ProtoMessage()    {}
+func (*QueryLiquidityPoolPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{24}
 }
-
-var fileDescriptor_f8c9321d314a3b1d = []byte{
-	// 1974 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x9a, 0x5f, 0x6c, 0xe4, 0x46,
-	0x19, 0xc0, 0xcf, 0x39, 0xef, 0xf6, 0x32, 0xa7, 0x42, 0x99, 0x5e, 0x45, 0xeb, 0xa6, 0x9b, 0xa9,
-	0x05, 0xbd, 0xa3, 0x24, 0x76, 0x92, 0xbb, 0x53, 0x73, 0x9b, 0xcb, 0x95, 0x4d, 0xd2, 0x94, 0x1c,
-	0xb4, 0x1c, 0x7b, 0x85, 0xc2, 0x15, 0x14, 0xbc, 0xf6, 0xdc, 0xc6, 0xe0, 0x9d, 0x71, 0x3c, 0xb3,
-	0xf9, 0x43, 0x88, 0x74, 0xfc, 0x91, 0x4a, 0x5f, 0x4a, 0xb4, 0x08, 0x84, 0x90, 0x38, 0x81, 0x10,
-	0xa5, 0xa8, 0x45, 0x08, 0x04, 0x6f, 0x45, 0xa8, 0x20, 0x68, 0x79, 0x40, 0x2a, 0xea, 0x0b, 0x2f,
-	0x20, 0xb8, 0xe3, 0x85, 0x27, 0xc4, 0x2b, 0x4f, 0xc8, 0xf6, 0x78, 0xd7, 0xbb, 0xeb, 0xdd, 0x8d,
-	0x9d, 0xd0, 0x80, 0xba, 0x2f, 0x77, 0x59, 0x7b, 0xbe, 0x6f, 0xbe, 0x3f, 0xbf, 0x6f, 0xbe, 0xf1,
-	0xd8, 0xe0, 0x0c, 0xc7, 0xc4, 0xc2, 0x5e, 0xcd, 0x26, 0x5c, 0x77, 0xec, 0xf5, 0xba, 0x6d, 0xd9,
-	0x7c, 0x5b, 0xdf, 0x98, 0xae, 0x60, 0x6e, 0x4c, 0xeb, 0xeb, 0x75, 0xec, 0x6d, 0x6b, 0xae, 0x47,
-	0x39, 0x85, 0x63, 0xad, 0x91, 0x5a, 0x73, 0xa4, 0x26, 0x46, 0x2a, 0xa7, 0xaa, 0xb4, 0x4a, 0x83,
-	0x81, 0xba, 0xff, 0x57, 0x28, 0xa3, 0x4c, 0xf4, 0xd5, 0xde, 0xd2, 0x12, 0x8e, 0x1e, 0xab, 0x52,
-	0x5a, 0x75, 0xb0, 0x6e, 0xb8, 0xb6, 0x6e, 0x10, 0x42, 0xb9, 0xc1, 0x6d, 0x4a, 0x98, 0xb8, 0xfb,
-	0x80, 0x49, 0x59, 0x8d, 0xb2, 0xd5, 0x70, 0x12, 0xd7, 0xa8, 0xda, 0x24, 0xb8, 0x2f, 0x6e, 0x87,
-	0xff, 0x99, 0x93, 0x55, 0x4c, 0x26, 0xa9, 0x8b, 0x89, 0xe1, 0xda, 0x1b, 0x33, 0x3a, 0x75, 0x03,
-	0x15, 0xdd, 0xea, 0xd4, 0x73, 0xe0, 0xbe, 0x8f, 0xfa, 0xde, 0x7d, 0x38, 0x32, 0xe2, 0x0a, 0xa5,
-	0x4e, 0x19, 0xaf, 0xd7, 0x31, 0xe3, 0xf0, 0xdd, 0xe0, 0x0e, 0x97, 0x52, 0x67, 0xd5, 0xb6, 0xee,
-	0x95, 0x90, 0x74, 0x46, 0x2e, 0xe7, 0xfd, 0x9f, 0x2b, 0x96, 0x7a, 0x0d, 0x28, 0x49, 0x52, 0xcc,
-	0xa5, 0x84, 0x61, 0x78, 0x11, 0xc8, 0xfe, 0xb8, 0x40, 0xe6, 0xe4, 0x8c, 0xaa, 0xf5, 0x8b, 0x98,
-	0xe6, 0x4b, 0x2e, 0xc8, 0xaf, 0xff, 0x65, 0xfc, 0x58, 0x39, 0x90, 0x52, 0xcb, 0xe0, 0x4c, 0xb7,
-	0xee, 0x85, 0xe0, 0xdf, 0x45, 0x6a, 0x93, 0x25, 0x4c, 0x68, 0x2d, 0x32, 0xf0, 0x21, 0xf0, 0xce,
-	0xc0, 0x40, 0x93, 0xda, 0x64, 0xd5, 0xf2, 0xef, 0x04, 0x93, 0x8e, 0x96, 0xef, 0x74, 0xe3, 0xc3,
-	0xd5, 0x0f, 0x82, 0xf7, 0x26, 0xe9, 0x2c, 0x63, 0x86, 0xbd, 0x0d, 0x5c, 0x32, 0xcd, 0x48, 0xe1,
-	0x38, 0x38, 0xe9, 0x85, 0x17, 0x57, 0x0d, 0xd3, 0x14, 0xca, 0x80, 0xd7, 0x1c, 0xa7, 0x5e, 0x00,
-	0x85, 0x04, 0x4d, 0x06, 0x37, 0xd7, 0x06, 0x06, 0xed, 0x3a, 0x18, 0xef, 0x29, 0x2a, 0x22, 0xb7,
-	0x08, 0x72, 0x15, 0xff, 0x82, 0x08, 0xdd, 0xe9, 0x7d, 0x84, 0xce, 0x1f, 0x2e, 0xe2, 0x17, 0xca,
-	0xaa, 0x56, 0x52, 0x72, 0x58, 0x64, 0xde, 0x32, 0x00, 0x2d, 0x68, 0xc4, 0x3c, 0x0f, 0x69, 0x21,
-	0x54, 0x5a, 0xc5, 0x60, 0x58, 0x0b, 0x69, 0x6f, 0x4e, 0x62, 0x54, 0xb1, 0x90, 0x2d, 0xc7, 0x24,
-	0xd5, 0x17, 0x24, 0x70, 0x7f, 0xe2, 0x34, 0xc2, 0x95, 0x4b, 0x20, 0xe7, 0xfb, 0xcd, 0xee, 0x95,
-	0xd0, 0xf1, 0x54, 0x14, 0x84, 0x62, 0xf0, 0xf1, 0x36, 0x3b, 0x47, 0x44, 0x3c, 0x06, 0xd9, 0x19,
-	0x4e, 0xde, 0x66, 0xe8, 0x29, 0x00, 0x03, 0x3b, 0xaf, 0x18, 0x9e, 0x51, 0x8b, 0xc2, 0xa0, 0x7e,
-	0x12, 0xdc, 0xdd, 0x76, 0x55, 0x58, 0xbd, 0x00, 0xf2, 0x6e, 0x70, 0x45, 0x44, 0xe6, 0x3d, 0x03,
-	0xcc, 0x0e, 0xc6, 0x0a, 0xc3, 0x85, 0xa4, 0x7a, 0x43, 0x02, 0x0f, 0x84, 0xba, 0xa3, 0xfc, 0x5c,
-	0xdd, 0x34, 0xdc, 0x27, 0x58, 0x95, 0x0d, 0x42, 0xa4, 0x23, 0x39, 0x23, 0x99, 0x93, 0xf3, 0x14,
-	0x18, 0x4b, 0xb4, 0x60, 0xa0, 0x01, 0xf7, 0x83, 0xd1, 0x1a, 0xab, 0xae, 0xda, 0xc4, 0xc2, 0x5b,
-	0xc1, 0xfc, 0x72, 0xf9, 0x44, 0x8d, 0x55, 0x57, 0xfc, 0xdf, 0xea, 0x4f, 0x25, 0x01, 0x7f, 0x82,
-	0x63, 0x22, 0x7e, 0xcb, 0x20, 0xc7, 0x36, 0x0d, 0x37, 0xca, 0xfa, 0xc3, 0xfd, 0xc3, 0x27, 0xc4,
-	0xaf, 0x72, 0x83, 0xe3, 0x28, 0xfb, 0x81, 0xf8, 0xe1, 0x65, 0x1f, 0xf7, 0xc8, 0x45, 0xd3, 0xe2,
-	0x25, 0x20, 0xfb, 0x53, 0x8a, 0x7c, 0xa7, 0x37, 0x38, 0x90, 0x56, 0xbf, 0x2c, 0x01, 0xd4, 0x3e,
-	0xcf, 0x12, 0x76, 0x29, 0xb3, 0xf9, 0x5b, 0x9a, 0xf6, 0xa7, 0xc5, 0x0a, 0x93, 0x60, 0xc4, 0xc1,
-	0x32, 0xff, 0x4b, 0x09, 0x3c, 0xd8, 0xc7, 0x3d, 0x11, 0xca, 0x8f, 0x80, 0x13, 0x56, 0x78, 0x39,
-	0xca, 0xff, 0x64, 0xff, 0x70, 0xb6, 0x94, 0xc4, 0x23, 0xda, 0x54, 0x72, 0x78, 0x14, 0xac, 0xf7,
-	0xce, 0x4e, 0xd3, 0xfa, 0x27, 0xc0, 0x1d, 0x62, 0x62, 0xc1, 0x42, 0x26, 0xe3, 0x23, 0x1d, 0xea,
-	0x57, 0xba, 0x42, 0xf6, 0xb4, 0xcd, 0xd7, 0x2c, 0xcf, 0xd8, 0x7c, 0x4b, 0x91, 0xf8, 0x44, 0xa7,
-	0xe7, 0x31, 0x2b, 0x0e, 0xc6, 0xc4, 0xab, 0x12, 0x50, 0xfb, 0x39, 0x28, 0xc2, 0x5a, 0x06, 0xa3,
-	0x9b, 0xe2, 0x7a, 0x44, 0x85, 0xd6, 0x3f, 0xb0, 0x31, 0x35, 0xf1, 0xc8, 0xb6, 0xd4, 0x1c, 0x1e,
-	0x17, 0xf5, 0x3e, 0x39, 0x6a, 0x7a, 0x70, 0x05, 0x9c, 0x88, 0xa6, 0x16, 0x64, 0x64, 0x73, 0xa0,
-	0xa9, 0x65, 0xe6, 0xc6, 0x12, 0xc8, 0x05, 0xf3, 0xc2, 0x3d, 0x19, 0xbc, 0xa3, 0xbd, 0x81, 0xc2,
-	0xd9, 0xfe, 0xca, 0x7b, 0xb7, 0x76, 0xe5, 0x42, 0x06, 0xc9, 0xd0, 0x47, 0xf5, 0xab, 0xc7, 0x1b,
-	0xa5, 0x3f, 0x8f, 0x28, 0xf3, 0x65, 0xcc, 0xeb, 0x1e, 0x61, 0xc8, 0x40, 0x8e, 0xcd, 0x38, 0xa2,
-	0xd7, 0x91, 0xe1, 0x38, 0xa8, 0xa9, 0x0b, 0x05, 0xbd, 0x19, 0xf9, 0x8e, 0xa0, 0x56, 0x18, 0x91,
-	0x87, 0x59, 0xdd, 0xe1, 0x9a, 0xca, 0xc0, 0xe4, 0xb2, 0x4d, 0x2c, 0x44, 0xeb, 0x1c, 0xd5, 0xa8,
-	0x87, 0x91, 0x51, 0xf1, 0xff, 0xe4, 0x6b, 0x18, 0x05, 0x09, 0x41, 0x06, 0xb1, 0x10, 0xf6, 0x3c,
-	0xea, 0x21, 0x93, 0x5a, 0x98, 0xc1, 0x85, 0x35, 0xce, 0x5d, 0x56, 0xd4, 0xf5, 0xaa, 0xcd, 0xd7,
-	0xea, 0x15, 0xcd, 0xa4, 0x35, 0x3d, 0x71, 0xaf, 0x5c, 0x71, 0x68, 0x45, 0xb7, 0xf0, 0x06, 0x76,
-	0xa8, 0xab, 0x5b, 0xd4, 0xd4, 0x4d, 0xc7, 0xc6, 0x84, 0x6b, 0x35, 0xeb, 0xf2, 0x0b, 0x12, 0x38,
-	0x7e, 0x7e, 0x6a, 0x0a, 0xde, 0x94, 0xc0, 0x3d, 0x2b, 0x84, 0x63, 0x8f, 0x18, 0x0e, 0xba, 0xea,
-	0xef, 0xd7, 0x3c, 0xf4, 0x98, 0x3f, 0x97, 0x5f, 0x8a, 0x77, 0x19, 0xae, 0xeb, 0xd8, 0x66, 0x60,
-	0xae, 0xfe, 0x59, 0x46, 0x09, 0x74, 0x77, 0x54, 0xdf, 0x06, 0xb5, 0x38, 0x33, 0xa1, 0xd6, 0x30,
-	0x63, 0x46, 0x15, 0xab, 0x45, 0xd5, 0x73, 0xcd, 0xd0, 0xc0, 0x62, 0x60, 0x21, 0x9a, 0x47, 0x4f,
-	0x52, 0xbe, 0x4c, 0xeb, 0xc4, 0x42, 0x16, 0x66, 0x26, 0x9a, 0x47, 0x4f, 0xad, 0x61, 0xdf, 0x31,
-	0x0f, 0x23, 0x42, 0x45, 0x38, 0x5c, 0x7f, 0x7f, 0x48, 0xb8, 0x56, 0x44, 0x9f, 0xc3, 0xdb, 0x88,
-	0x50, 0x8e, 0xae, 0xfb, 0x12, 0xea, 0x84, 0x6a, 0x61, 0x6e, 0xd8, 0x0e, 0x53, 0x8b, 0xcf, 0x7c,
-	0x7a, 0xf7, 0x4b, 0x6f, 0xfe, 0xfd, 0xeb, 0x23, 0x0f, 0xc2, 0x71, 0x3d, 0xe4, 0x34, 0xe1, 0x41,
-	0x20, 0xdc, 0xf8, 0xbc, 0x9a, 0x03, 0x77, 0xb6, 0x65, 0x09, 0x3e, 0x92, 0x36, 0xaf, 0x11, 0x10,
-	0xb3, 0xe9, 0x05, 0x05, 0x0f, 0xaf, 0xc8, 0x8d, 0xd2, 0xb3, 0xb2, 0x32, 0x17, 0xf1, 0xe0, 0xa7,
-	0xb0, 0x9d, 0x02, 0xc4, 0xd7, 0x0c, 0x8e, 0x4c, 0xea, 0x79, 0x81, 0x8c, 0xc5, 0x10, 0xa7, 0xc1,
-	0x30, 0xb1, 0x94, 0x1c, 0x21, 0x0d, 0xe7, 0x42, 0x1a, 0x4e, 0x2e, 0x18, 0x16, 0x8a, 0xf6, 0x7b,
-	0xcf, 0x27, 0x31, 0xf0, 0xf9, 0x88, 0x81, 0xb3, 0x71, 0x06, 0xf8, 0xb6, 0x8b, 0x51, 0xcd, 0x66,
-	0x35, 0x7f, 0x41, 0x98, 0x40, 0xc1, 0xae, 0x0e, 0x73, 0xec, 0x15, 0x23, 0xd7, 0x26, 0x22, 0x44,
-	0x18, 0xf7, 0x4c, 0x4a, 0x36, 0xfc, 0x6d, 0x20, 0xc3, 0x1f, 0xb3, 0x09, 0x2f, 0xfa, 0xa3, 0x99,
-	0x4d, 0xaa, 0xe8, 0xe1, 0x22, 0xb2, 0xc9, 0x86, 0xe1, 0xd8, 0x16, 0x62, 0xdb, 0x84, 0x1b, 0x5b,
-	0x1d, 0x34, 0x5c, 0xfe, 0x91, 0xc0, 0xf6, 0x7b, 0x3d, 0xb1, 0x7d, 0x36, 0xc9, 0x64, 0x96, 0x11,
-	0xdb, 0x8e, 0xe4, 0x9d, 0x45, 0x16, 0xc5, 0x8c, 0x9c, 0xe6, 0x08, 0x6f, 0xd9, 0x8c, 0xef, 0x83,
-	0xdc, 0xf7, 0xc3, 0xf7, 0x0d, 0x20, 0x57, 0xdf, 0x11, 0xf1, 0xd9, 0x85, 0xbf, 0xc8, 0x83, 0xb1,
-	0x7e, 0xcf, 0x6f, 0x70, 0x39, 0x2d, 0x99, 0xc9, 0x0f, 0x80, 0x07, 0x20, 0xbc, 0x91, 0x6b, 0x94,
-	0x7e, 0x2b, 0x2b, 0x8b, 0x2b, 0x1c, 0x79, 0xbd, 0x21, 0x6f, 0xf1, 0xed, 0x27, 0x35, 0x4e, 0x78,
-	0xeb, 0x91, 0xf3, 0x88, 0x48, 0xff, 0x79, 0x40, 0xfa, 0x39, 0xf8, 0xb2, 0x04, 0x46, 0x9f, 0xa4,
-	0x1c, 0x05, 0xe9, 0x56, 0x6f, 0x26, 0x41, 0xf3, 0x9c, 0x14, 0x51, 0x73, 0xfe, 0x40, 0xd4, 0x84,
-	0xeb, 0x7e, 0x18, 0x17, 0x9b, 0xa0, 0xc0, 0x7b, 0xb4, 0xb5, 0x95, 0x86, 0xa5, 0xcb, 0x7f, 0x14,
-	0xdc, 0xff, 0xbe, 0x27, 0xf7, 0x3f, 0x49, 0x72, 0xe1, 0xdb, 0x52, 0x46, 0xf0, 0x33, 0x26, 0x35,
-	0x75, 0x7d, 0x2c, 0xc2, 0xd2, 0xa0, 0xfa, 0xe8, 0x98, 0x42, 0xd4, 0x4b, 0xeb, 0xc2, 0x2e, 0xbc,
-	0x99, 0x07, 0xf7, 0xf5, 0x3c, 0xa3, 0x80, 0x8b, 0xe9, 0x8b, 0xa6, 0xeb, 0x84, 0xe3, 0x00, 0x15,
-	0xf3, 0xc5, 0x5c, 0xa3, 0xf4, 0x4a, 0xb6, 0x8a, 0x11, 0x07, 0x28, 0xc8, 0x30, 0x4d, 0x5a, 0x27,
-	0x47, 0xb5, 0x53, 0x78, 0x49, 0x54, 0xcc, 0xf7, 0xdb, 0x2a, 0xe6, 0x1b, 0x49, 0xb8, 0xdd, 0xc8,
-	0x5a, 0x31, 0x09, 0xde, 0x22, 0xc3, 0xb2, 0x3c, 0xcc, 0x98, 0x5f, 0x29, 0x36, 0x0b, 0x28, 0x0a,
-	0x1a, 0xc3, 0xff, 0x69, 0xa1, 0x74, 0x7a, 0x97, 0xb6, 0x50, 0xe6, 0xe0, 0x85, 0x41, 0x85, 0x12,
-	0x3b, 0x82, 0xd3, 0x77, 0x62, 0x3f, 0x76, 0xe1, 0xdf, 0x72, 0x00, 0x76, 0x9f, 0x9f, 0xc1, 0x8b,
-	0xa9, 0x2b, 0x23, 0x76, 0x62, 0xa7, 0xcc, 0x67, 0x94, 0x16, 0x75, 0xf1, 0x07, 0xb9, 0x51, 0x6a,
-	0xc8, 0xca, 0x72, 0x7c, 0xaf, 0x64, 0xd6, 0x3d, 0x0f, 0x13, 0x8e, 0x82, 0x13, 0x39, 0x7f, 0x1b,
-	0x1d, 0x2d, 0x31, 0xc3, 0x6d, 0xd3, 0xdb, 0x6b, 0xdb, 0x34, 0x0d, 0xf5, 0x7d, 0x6f, 0x9b, 0xf4,
-	0x80, 0x16, 0xf8, 0xef, 0x1c, 0x78, 0x57, 0xd7, 0x09, 0x1b, 0x9c, 0xdb, 0x07, 0xa4, 0xbd, 0x0e,
-	0x1c, 0x95, 0x8b, 0xd9, 0x84, 0x05, 0xe0, 0xff, 0x90, 0x1b, 0xa5, 0x17, 0x65, 0xe5, 0x53, 0xc9,
-	0x0f, 0x87, 0x6c, 0xd3, 0x70, 0x91, 0x88, 0x29, 0x43, 0x36, 0x19, 0xc0, 0xff, 0xff, 0xdc, 0xb3,
-	0xe3, 0x10, 0xfb, 0xff, 0x02, 0xf6, 0x8f, 0xc0, 0xf3, 0x29, 0xb1, 0xd7, 0xc3, 0x83, 0xdf, 0xef,
-	0xe4, 0xc1, 0x5d, 0x9d, 0x24, 0xc2, 0x62, 0x06, 0x7c, 0x23, 0xf4, 0xe7, 0x32, 0xc9, 0x0a, 0xf2,
-	0xbf, 0x96, 0x6b, 0x94, 0x7e, 0x2d, 0x2b, 0x1f, 0x8f, 0x2f, 0xed, 0x71, 0xde, 0x7b, 0xae, 0xe6,
-	0xcd, 0x63, 0xb3, 0xa8, 0x20, 0x7c, 0x67, 0x4f, 0xb3, 0xf6, 0xba, 0x38, 0x1a, 0xe6, 0x5f, 0x14,
-	0xcc, 0x7f, 0xb7, 0x83, 0xf9, 0xbd, 0x24, 0x80, 0xbe, 0x90, 0x92, 0xf9, 0xa6, 0xdf, 0x87, 0x42,
-	0xfd, 0x6b, 0x82, 0xfa, 0x5f, 0xf5, 0xa4, 0xfe, 0x07, 0x49, 0x46, 0xef, 0x49, 0x3b, 0xaa, 0x47,
-	0x29, 0x57, 0x8b, 0x31, 0xfc, 0x63, 0x8a, 0xd3, 0xef, 0x8b, 0x6a, 0xac, 0x8a, 0xaa, 0xf6, 0x06,
-	0x26, 0xb1, 0xc4, 0x4e, 0xb7, 0x17, 0x05, 0xa2, 0x1e, 0xb2, 0xb0, 0x83, 0x39, 0xee, 0xda, 0xd8,
-	0xed, 0xee, 0xfb, 0x09, 0x21, 0xb1, 0x26, 0xf4, 0x9d, 0xe6, 0xa4, 0xbb, 0xf0, 0xb9, 0x3c, 0x38,
-	0x95, 0x74, 0x08, 0x0f, 0x2f, 0xa5, 0xe1, 0xbc, 0xfb, 0xe5, 0x84, 0xf2, 0x68, 0x66, 0x79, 0x51,
-	0x2b, 0xff, 0x94, 0x1b, 0xa5, 0x97, 0x64, 0x65, 0x35, 0xb9, 0x4b, 0x88, 0x63, 0xf1, 0x61, 0xa3,
-	0x18, 0x36, 0x8a, 0xb6, 0x46, 0x51, 0x84, 0xb3, 0x69, 0x8b, 0xa2, 0xf9, 0x7a, 0xe8, 0xc7, 0x79,
-	0x70, 0x77, 0x02, 0x92, 0x70, 0x3e, 0x1b, 0xca, 0x51, 0x25, 0x5c, 0xca, 0x2a, 0x2e, 0x0a, 0xe1,
-	0x9b, 0xb9, 0x46, 0xe9, 0x77, 0xb2, 0x72, 0x2d, 0xde, 0x34, 0x3a, 0xf0, 0x3f, 0x58, 0xdf, 0xd0,
-	0x86, 0x8d, 0xe3, 0x6d, 0xd5, 0x38, 0x96, 0xe1, 0x52, 0xd6, 0x1a, 0x69, 0xeb, 0x1d, 0xcf, 0xe7,
-	0xc1, 0x3d, 0x89, 0x2f, 0xeb, 0x60, 0xaa, 0xc5, 0x3f, 0xe1, 0x3d, 0xa6, 0xf2, 0x81, 0xec, 0x0a,
-	0x44, 0xd5, 0xfc, 0x4b, 0x6e, 0x94, 0x5e, 0x96, 0x95, 0xcf, 0x24, 0xb7, 0x8f, 0xe8, 0xd5, 0xd9,
-	0xb0, 0x7f, 0x0c, 0xfb, 0x47, 0xda, 0xd3, 0xa4, 0xce, 0xda, 0x68, 0xbd, 0x47, 0xfe, 0x59, 0x7c,
-	0x33, 0x15, 0xa3, 0x32, 0xdd, 0x66, 0xaa, 0xfb, 0x8d, 0xba, 0xf2, 0x68, 0x66, 0x79, 0x51, 0x0d,
-	0xdf, 0xca, 0x35, 0x4a, 0xaf, 0xc9, 0xca, 0x33, 0xf1, 0x1e, 0xd2, 0x59, 0x03, 0xc3, 0x26, 0x32,
-	0x6c, 0x22, 0xfb, 0x6f, 0x22, 0x8f, 0xc3, 0xc7, 0x32, 0x17, 0x4a, 0x5b, 0x17, 0xf9, 0xcd, 0x08,
-	0xc8, 0x87, 0xdf, 0xbd, 0xc1, 0xa9, 0xfd, 0x60, 0x1e, 0xff, 0xec, 0x4e, 0x99, 0x4e, 0x21, 0x21,
-	0x4a, 0xe1, 0x4d, 0xa9, 0x51, 0xfa, 0xa1, 0xa4, 0xe8, 0xcd, 0xc6, 0xe0, 0x38, 0xad, 0x9c, 0xb3,
-	0x68, 0xc9, 0x6f, 0x2d, 0x24, 0x35, 0x6a, 0xd5, 0x1d, 0xac, 0xa9, 0x1c, 0x14, 0x7a, 0xe1, 0x1d,
-	0x7e, 0xa8, 0x07, 0xcb, 0x99, 0x78, 0xde, 0x8a, 0xdd, 0x60, 0x2e, 0x36, 0xf5, 0xa9, 0xd9, 0xd5,
-	0x50, 0xa1, 0x56, 0xb3, 0x82, 0x50, 0xab, 0x10, 0xf5, 0x09, 0x75, 0xf8, 0xc9, 0xe0, 0x87, 0x5e,
-	0xbf, 0x55, 0x90, 0xde, 0xb8, 0x55, 0x90, 0xfe, 0x7a, 0xab, 0x20, 0xed, 0xdd, 0x2e, 0x1c, 0x7b,
-	0xe3, 0x76, 0xe1, 0xd8, 0x9f, 0x6e, 0x17, 0x8e, 0x5d, 0x9b, 0x1e, 0x64, 0x4d, 0xdc, 0x00, 0xbf,
-	0x16, 0x58, 0x25, 0x1f, 0x7c, 0xcb, 0x7b, 0xf6, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x07, 0x44,
-	0xfe, 0xe5, 0xc6, 0x2c, 0x00, 0x00,
+func (m *QueryLiquidityPoolPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityPoolPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityPoolPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityPoolPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityPoolPositionsRequest.Merge(m, src)
+}
+func (m *QueryLiquidityPoolPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityPoolPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityPoolPositionsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryLiquidityPoolPositionsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Get existing liquidity pools.
-	LiquidityPools(ctx context.Context, in *QueryLiquidityPoolsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error)
-	// Get specific liquidity pool.
-	LiquidityPool(ctx context.Context, in *QueryLiquidityPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
-	// Get specific liquidity pool corresponding to the pool_coin_denom.
-	LiquidityPoolByPoolCoinDenom(ctx context.Context, in *QueryLiquidityPoolByPoolCoinDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
-	// Get specific liquidity pool corresponding to the reserve account.
-	LiquidityPoolByReserveAcc(ctx context.Context, in *QueryLiquidityPoolByReserveAccRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
-	// Get the pool's current batch.
-	LiquidityPoolBatch(ctx context.Context, in *QueryLiquidityPoolBatchRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolBatchResponse, error)
-	// Get all swap messages in the pool's current batch.
-	PoolBatchSwapMsgs(ctx context.Context, in *QueryPoolBatchSwapMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgsResponse, error)
-	// Get a specific swap message in the pool's current batch.
-	PoolBatchSwapMsg(ctx context.Context, in *QueryPoolBatchSwapMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgResponse, error)
-	// Get all deposit messages in the pool's current batch.
-	PoolBatchDepositMsgs(ctx context.Context, in *QueryPoolBatchDepositMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgsResponse, error)
-	// Get a specific deposit message in the pool's current batch.
-	PoolBatchDepositMsg(ctx context.Context, in *QueryPoolBatchDepositMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgResponse, error)
-	// Get all withdraw messages in the pool's current batch.
-	PoolBatchWithdrawMsgs(ctx context.Context, in *QueryPoolBatchWithdrawMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgsResponse, error)
-	// Get a specific withdraw message in the pool's current batch.
-	PoolBatchWithdrawMsg(ctx context.Context, in *QueryPoolBatchWithdrawMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgResponse, error)
-	// Get all parameters of the liquidity module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryLiquidityPoolPositionsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryLiquidityPoolPositionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// the response type for the QueryLiquidityPoolPositions RPC method. This includes a list of all positions of the pool and paging results that contain next_key and total count.
+type QueryLiquidityPoolPositionsResponse struct {
+	Positions []Position `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) LiquidityPools(ctx context.Context, in *QueryLiquidityPoolsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error) {
-	out := new(QueryLiquidityPoolsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityPoolPositionsResponse) Reset()         { *m = QueryLiquidityPoolPositionsResponse{} }
+func (m *QueryLiquidityPoolPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityPoolPositionsResponse) ProtoMessage()    {}
+func (*QueryLiquidityPoolPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{25}
+}
+func (m *QueryLiquidityPoolPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityPoolPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityPoolPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLiquidityPoolPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityPoolPositionsResponse.Merge(m, src)
+}
+func (m *QueryLiquidityPoolPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityPoolPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityPoolPositionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LiquidityPool(ctx context.Context, in *QueryLiquidityPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
-	out := new(QueryLiquidityPoolResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidityPoolPositionsResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityPoolPositionsResponse) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LiquidityPoolByPoolCoinDenom(ctx context.Context, in *QueryLiquidityPoolByPoolCoinDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
-	out := new(QueryLiquidityPoolResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByPoolCoinDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityPoolPositionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LiquidityPoolByReserveAcc(ctx context.Context, in *QueryLiquidityPoolByReserveAccRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
-	out := new(QueryLiquidityPoolResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByReserveAcc", in, out, opts...)
-	if err != nil {
-		return nil, err
+// the request type for the QuerySimulateSwap RPC method. Requestable with the fields of the swap order to be simulated.
+type QuerySimulateSwapRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// id of swap type, 1 for the exact-input swap and 2 for the exact-output swap
+	SwapTypeId uint32 `protobuf:"varint,2,opt,name=swap_type_id,json=swapTypeId,proto3" json:"swap_type_id,omitempty"`
+	// offer coin of the swap order such as 1000000uatom, the maximum offer coin for the exact-output swap
+	OfferCoin string `protobuf:"bytes,3,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin,omitempty"`
+	// denom of the demand coin of the swap order
+	DemandCoinDenom string `protobuf:"bytes,4,opt,name=demand_coin_denom,json=demandCoinDenom,proto3" json:"demand_coin_denom,omitempty"`
+	// limit order price of the swap order
+	OrderPrice string `protobuf:"bytes,5,opt,name=order_price,json=orderPrice,proto3" json:"order_price,omitempty"`
+	// exact amount of demand coin to be received by the exact-output swap
+	DemandCoinAmount string `protobuf:"bytes,6,opt,name=demand_coin_amount,json=demandCoinAmount,proto3" json:"demand_coin_amount,omitempty"`
+}
+
+func (m *QuerySimulateSwapRequest) Reset()         { *m = QuerySimulateSwapRequest{} }
+func (m *QuerySimulateSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapRequest) ProtoMessage()    {}
+func (*QuerySimulateSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{26}
+}
+func (m *QuerySimulateSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulateSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapRequest.Merge(m, src)
+}
+func (m *QuerySimulateSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) LiquidityPoolBatch(ctx context.Context, in *QueryLiquidityPoolBatchRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolBatchResponse, error) {
-	out := new(QueryLiquidityPoolBatchResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateSwapRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) PoolBatchSwapMsgs(ctx context.Context, in *QueryPoolBatchSwapMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgsResponse, error) {
-	out := new(QueryPoolBatchSwapMsgsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsgs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateSwapRequest) GetSwapTypeId() uint32 {
+	if m != nil {
+		return m.SwapTypeId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) PoolBatchSwapMsg(ctx context.Context, in *QueryPoolBatchSwapMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgResponse, error) {
-	out := new(QueryPoolBatchSwapMsgResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsg", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateSwapRequest) GetOfferCoin() string {
+	if m != nil {
+		return m.OfferCoin
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PoolBatchDepositMsgs(ctx context.Context, in *QueryPoolBatchDepositMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgsResponse, error) {
-	out := new(QueryPoolBatchDepositMsgsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsgs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateSwapRequest) GetDemandCoinDenom() string {
+	if m != nil {
+		return m.DemandCoinDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PoolBatchDepositMsg(ctx context.Context, in *QueryPoolBatchDepositMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgResponse, error) {
-	out := new(QueryPoolBatchDepositMsgResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsg", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateSwapRequest) GetOrderPrice() string {
+	if m != nil {
+		return m.OrderPrice
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PoolBatchWithdrawMsgs(ctx context.Context, in *QueryPoolBatchWithdrawMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgsResponse, error) {
-	out := new(QueryPoolBatchWithdrawMsgsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsgs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateSwapRequest) GetDemandCoinAmount() string {
+	if m != nil {
+		return m.DemandCoinAmount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PoolBatchWithdrawMsg(ctx context.Context, in *QueryPoolBatchWithdrawMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgResponse, error) {
-	out := new(QueryPoolBatchWithdrawMsgResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsg", in, out, opts...)
-	if err != nil {
-		return nil, err
+// the response type for the QuerySimulateSwap RPC method. This includes the expected outcome of the swap order in the batch.
+type QuerySimulateSwapResponse struct {
+	// expected swap price of the batch
+	SwapPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_price,json=swapPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_price"`
+	// expected direction of the pool price, increasing, decreasing or staying
+	PriceDirection string `protobuf:"bytes,2,opt,name=price_direction,json=priceDirection,proto3" json:"price_direction,omitempty"`
+	// whether the swap order is expected to be matched
+	Matched bool `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	// offer coin expected to be transacted
+	TransactedCoin types.Coin `protobuf:"bytes,4,opt,name=transacted_coin,json=transactedCoin,proto3" json:"transacted_coin"`
+	// offer coin fee expected to be paid for the transacted offer coin
+	OfferCoinFee types.Coin `protobuf:"bytes,5,opt,name=offer_coin_fee,json=offerCoinFee,proto3" json:"offer_coin_fee"`
+	// demand coin expected to be received after the swap fee
+	ExchangedDemandCoin types.Coin `protobuf:"bytes,6,opt,name=exchanged_demand_coin,json=exchangedDemandCoin,proto3" json:"exchanged_demand_coin"`
+	// swap fee expected to be paid in the demand coin
+	ExchangedCoinFee types.Coin `protobuf:"bytes,7,opt,name=exchanged_coin_fee,json=exchangedCoinFee,proto3" json:"exchanged_coin_fee"`
+}
+
+func (m *QuerySimulateSwapResponse) Reset()         { *m = QuerySimulateSwapResponse{} }
+func (m *QuerySimulateSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapResponse) ProtoMessage()    {}
+func (*QuerySimulateSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{27}
+}
+func (m *QuerySimulateSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulateSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapResponse.Merge(m, src)
+}
+func (m *QuerySimulateSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateSwapResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapResponse) GetPriceDirection() string {
+	if m != nil {
+		return m.PriceDirection
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Get existing liquidity pools.
-	LiquidityPools(context.Context, *QueryLiquidityPoolsRequest) (*QueryLiquidityPoolsResponse, error)
-	// Get specific liquidity pool.
-	LiquidityPool(context.Context, *QueryLiquidityPoolRequest) (*QueryLiquidityPoolResponse, error)
-	// Get specific liquidity pool corresponding to the pool_coin_denom.
-	LiquidityPoolByPoolCoinDenom(context.Context, *QueryLiquidityPoolByPoolCoinDenomRequest) (*QueryLiquidityPoolResponse, error)
-	// Get specific liquidity pool corresponding to the reserve account.
-	LiquidityPoolByReserveAcc(context.Context, *QueryLiquidityPoolByReserveAccRequest) (*QueryLiquidityPoolResponse, error)
-	// Get the pool's current batch.
-	LiquidityPoolBatch(context.Context, *QueryLiquidityPoolBatchRequest) (*QueryLiquidityPoolBatchResponse, error)
-	// Get all swap messages in the pool's current batch.
-	PoolBatchSwapMsgs(context.Context, *QueryPoolBatchSwapMsgsRequest) (*QueryPoolBatchSwapMsgsResponse, error)
-	// Get a specific swap message in the pool's current batch.
-	PoolBatchSwapMsg(context.Context, *QueryPoolBatchSwapMsgRequest) (*QueryPoolBatchSwapMsgResponse, error)
-	// Get all deposit messages in the pool's current batch.
-	PoolBatchDepositMsgs(context.Context, *QueryPoolBatchDepositMsgsRequest) (*QueryPoolBatchDepositMsgsResponse, error)
-	// Get a specific deposit message in the pool's current batch.
-	PoolBatchDepositMsg(context.Context, *QueryPoolBatchDepositMsgRequest) (*QueryPoolBatchDepositMsgResponse, error)
-	// Get all withdraw messages in the pool's current batch.
-	PoolBatchWithdrawMsgs(context.Context, *QueryPoolBatchWithdrawMsgsRequest) (*QueryPoolBatchWithdrawMsgsResponse, error)
-	// Get a specific withdraw message in the pool's current batch.
-	PoolBatchWithdrawMsg(context.Context, *QueryPoolBatchWithdrawMsgRequest) (*QueryPoolBatchWithdrawMsgResponse, error)
-	// Get all parameters of the liquidity module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QuerySimulateSwapResponse) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySimulateSwapResponse) GetTransactedCoin() types.Coin {
+	if m != nil {
+		return m.TransactedCoin
+	}
+	return types.Coin{}
 }
 
-func (*UnimplementedQueryServer) LiquidityPools(ctx context.Context, req *QueryLiquidityPoolsRequest) (*QueryLiquidityPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPools not implemented")
+func (m *QuerySimulateSwapResponse) GetOfferCoinFee() types.Coin {
+	if m != nil {
+		return m.OfferCoinFee
+	}
+	return types.Coin{}
 }
-func (*UnimplementedQueryServer) LiquidityPool(ctx context.Context, req *QueryLiquidityPoolRequest) (*QueryLiquidityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPool not implemented")
+
+func (m *QuerySimulateSwapResponse) GetExchangedDemandCoin() types.Coin {
+	if m != nil {
+		return m.ExchangedDemandCoin
+	}
+	return types.Coin{}
 }
-func (*UnimplementedQueryServer) LiquidityPoolByPoolCoinDenom(ctx context.Context, req *QueryLiquidityPoolByPoolCoinDenomRequest) (*QueryLiquidityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolByPoolCoinDenom not implemented")
+
+func (m *QuerySimulateSwapResponse) GetExchangedCoinFee() types.Coin {
+	if m != nil {
+		return m.ExchangedCoinFee
+	}
+	return types.Coin{}
 }
-func (*UnimplementedQueryServer) LiquidityPoolByReserveAcc(ctx context.Context, req *QueryLiquidityPoolByReserveAccRequest) (*QueryLiquidityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolByReserveAcc not implemented")
+
+// the request type for the QueryEstimateDeposit RPC method. Requestable including specified pool_id and deposit_coins.
+type QueryEstimateDepositRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// coins to be deposited to the pool such as 1000000uatom,50000000uusd
+	DepositCoins string `protobuf:"bytes,2,opt,name=deposit_coins,json=depositCoins,proto3" json:"deposit_coins,omitempty"`
 }
-func (*UnimplementedQueryServer) LiquidityPoolBatch(ctx context.Context, req *QueryLiquidityPoolBatchRequest) (*QueryLiquidityPoolBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolBatch not implemented")
+
+func (m *QueryEstimateDepositRequest) Reset()         { *m = QueryEstimateDepositRequest{} }
+func (m *QueryEstimateDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateDepositRequest) ProtoMessage()    {}
+func (*QueryEstimateDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{28}
 }
-func (*UnimplementedQueryServer) PoolBatchSwapMsgs(ctx context.Context, req *QueryPoolBatchSwapMsgsRequest) (*QueryPoolBatchSwapMsgsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchSwapMsgs not implemented")
+func (m *QueryEstimateDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) PoolBatchSwapMsg(ctx context.Context, req *QueryPoolBatchSwapMsgRequest) (*QueryPoolBatchSwapMsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchSwapMsg not implemented")
+func (m *QueryEstimateDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PoolBatchDepositMsgs(ctx context.Context, req *QueryPoolBatchDepositMsgsRequest) (*QueryPoolBatchDepositMsgsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchDepositMsgs not implemented")
+func (m *QueryEstimateDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateDepositRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) PoolBatchDepositMsg(ctx context.Context, req *QueryPoolBatchDepositMsgRequest) (*QueryPoolBatchDepositMsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchDepositMsg not implemented")
+func (m *QueryEstimateDepositRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PoolBatchWithdrawMsgs(ctx context.Context, req *QueryPoolBatchWithdrawMsgsRequest) (*QueryPoolBatchWithdrawMsgsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchWithdrawMsgs not implemented")
+func (m *QueryEstimateDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateDepositRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PoolBatchWithdrawMsg(ctx context.Context, req *QueryPoolBatchWithdrawMsgRequest) (*QueryPoolBatchWithdrawMsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchWithdrawMsg not implemented")
+
+var xxx_messageInfo_QueryEstimateDepositRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateDepositRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+func (m *QueryEstimateDepositRequest) GetDepositCoins() string {
+	if m != nil {
+		return m.DepositCoins
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// the response type for the QueryEstimateDeposit RPC method. This includes the expected outcome of the deposit.
+type QueryEstimateDepositResponse struct {
+	// deposit coins expected to be accepted by the pool
+	AcceptedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=accepted_coins,json=acceptedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accepted_coins"`
+	// deposit coins expected to be refunded
+	RefundedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=refunded_coins,json=refundedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_coins"`
+	// pool coin expected to be minted
+	PoolCoin types.Coin `protobuf:"bytes,3,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin"`
 }
 
-func _Query_LiquidityPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPools(ctx, req.(*QueryLiquidityPoolsRequest))
+func (m *QueryEstimateDepositResponse) Reset()         { *m = QueryEstimateDepositResponse{} }
+func (m *QueryEstimateDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateDepositResponse) ProtoMessage()    {}
+func (*QueryEstimateDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{29}
+}
+func (m *QueryEstimateDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEstimateDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateDepositResponse.Merge(m, src)
+}
+func (m *QueryEstimateDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateDepositResponse.DiscardUnknown(m)
 }
 
-func _Query_LiquidityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPool(ctx, req.(*QueryLiquidityPoolRequest))
+var xxx_messageInfo_QueryEstimateDepositResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateDepositResponse) GetAcceptedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AcceptedCoins
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LiquidityPoolByPoolCoinDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityPoolByPoolCoinDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPoolByPoolCoinDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByPoolCoinDenom",
+func (m *QueryEstimateDepositResponse) GetRefundedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedCoins
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPoolByPoolCoinDenom(ctx, req.(*QueryLiquidityPoolByPoolCoinDenomRequest))
+	return nil
+}
+
+func (m *QueryEstimateDepositResponse) GetPoolCoin() types.Coin {
+	if m != nil {
+		return m.PoolCoin
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_LiquidityPoolByReserveAcc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityPoolByReserveAccRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPoolByReserveAcc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByReserveAcc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPoolByReserveAcc(ctx, req.(*QueryLiquidityPoolByReserveAccRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// the request type for the QueryEstimateWithdraw RPC method. Requestable including specified pool_id and pool_coin_amount.
+type QueryEstimateWithdrawRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// amount of the pool coin to be withdrawn
+	PoolCoinAmount string `protobuf:"bytes,2,opt,name=pool_coin_amount,json=poolCoinAmount,proto3" json:"pool_coin_amount,omitempty"`
+	// denom of the reserve coin in which the whole pool coin is withdrawn, only for the weighted pool type
+	WithdrawCoinDenom string `protobuf:"bytes,3,opt,name=withdraw_coin_denom,json=withdrawCoinDenom,proto3" json:"withdraw_coin_denom,omitempty"`
 }
 
-func _Query_LiquidityPoolBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityPoolBatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPoolBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPoolBatch(ctx, req.(*QueryLiquidityPoolBatchRequest))
+func (m *QueryEstimateWithdrawRequest) Reset()         { *m = QueryEstimateWithdrawRequest{} }
+func (m *QueryEstimateWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateWithdrawRequest) ProtoMessage()    {}
+func (*QueryEstimateWithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{30}
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateWithdrawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateWithdrawRequest.Merge(m, src)
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateWithdrawRequest.DiscardUnknown(m)
 }
 
-func _Query_PoolBatchSwapMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchSwapMsgsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchSwapMsgs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsgs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchSwapMsgs(ctx, req.(*QueryPoolBatchSwapMsgsRequest))
+var xxx_messageInfo_QueryEstimateWithdrawRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateWithdrawRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PoolBatchSwapMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchSwapMsgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchSwapMsg(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsg",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchSwapMsg(ctx, req.(*QueryPoolBatchSwapMsgRequest))
+func (m *QueryEstimateWithdrawRequest) GetPoolCoinAmount() string {
+	if m != nil {
+		return m.PoolCoinAmount
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PoolBatchDepositMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchDepositMsgsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchDepositMsgs(ctx, in)
+func (m *QueryEstimateWithdrawRequest) GetWithdrawCoinDenom() string {
+	if m != nil {
+		return m.WithdrawCoinDenom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsgs",
+	return ""
+}
+
+// the response type for the QueryEstimateWithdraw RPC method. This includes the expected outcome of the withdrawal.
+type QueryEstimateWithdrawResponse struct {
+	// pool coin expected to be burned
+	PoolCoin types.Coin `protobuf:"bytes,1,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin"`
+	// reserve coins expected to be paid out after the withdraw fee
+	WithdrawCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=withdraw_coins,json=withdrawCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdraw_coins"`
+	// withdraw fee coins expected to be left in the pool
+	WithdrawFeeCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdraw_fee_coins,json=withdrawFeeCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdraw_fee_coins"`
+}
+
+func (m *QueryEstimateWithdrawResponse) Reset()         { *m = QueryEstimateWithdrawResponse{} }
+func (m *QueryEstimateWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateWithdrawResponse) ProtoMessage()    {}
+func (*QueryEstimateWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{31}
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchDepositMsgs(ctx, req.(*QueryPoolBatchDepositMsgsRequest))
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateWithdrawResponse.Merge(m, src)
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateWithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateWithdrawResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateWithdrawResponse) GetPoolCoin() types.Coin {
+	if m != nil {
+		return m.PoolCoin
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_PoolBatchDepositMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchDepositMsgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryEstimateWithdrawResponse) GetWithdrawCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawCoins
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchDepositMsg(ctx, in)
+	return nil
+}
+
+func (m *QueryEstimateWithdrawResponse) GetWithdrawFeeCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawFeeCoins
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsg",
+	return nil
+}
+
+// the request type for the QueryPoolBatchResults RPC method. Requestable including specified pool_id and pagination offset, limit, key.
+type QueryPoolBatchResultsRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolBatchResultsRequest) Reset()         { *m = QueryPoolBatchResultsRequest{} }
+func (m *QueryPoolBatchResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolBatchResultsRequest) ProtoMessage()    {}
+func (*QueryPoolBatchResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{32}
+}
+func (m *QueryPoolBatchResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolBatchResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolBatchResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchDepositMsg(ctx, req.(*QueryPoolBatchDepositMsgRequest))
+}
+func (m *QueryPoolBatchResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolBatchResultsRequest.Merge(m, src)
+}
+func (m *QueryPoolBatchResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolBatchResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolBatchResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolBatchResultsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolBatchResultsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PoolBatchWithdrawMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchWithdrawMsgsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryPoolBatchResultsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchWithdrawMsgs(ctx, in)
+	return nil
+}
+
+// the response type for the QueryPoolBatchResults RPC method. This includes a list of the results of the latest executed batches of the pool in ascending order of the batch index and paging results that contain next_key and total count.
+type QueryPoolBatchResultsResponse struct {
+	BatchResults []PoolBatchResult `protobuf:"bytes,1,rep,name=batch_results,json=batchResults,proto3" json:"batch_results"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolBatchResultsResponse) Reset()         { *m = QueryPoolBatchResultsResponse{} }
+func (m *QueryPoolBatchResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolBatchResultsResponse) ProtoMessage()    {}
+func (*QueryPoolBatchResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{33}
+}
+func (m *QueryPoolBatchResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolBatchResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolBatchResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsgs",
+}
+func (m *QueryPoolBatchResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolBatchResultsResponse.Merge(m, src)
+}
+func (m *QueryPoolBatchResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolBatchResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolBatchResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolBatchResultsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolBatchResultsResponse) GetBatchResults() []PoolBatchResult {
+	if m != nil {
+		return m.BatchResults
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchWithdrawMsgs(ctx, req.(*QueryPoolBatchWithdrawMsgsRequest))
+	return nil
+}
+
+func (m *QueryPoolBatchResultsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PoolBatchWithdrawMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchWithdrawMsgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// the request type for the QueryTimeWeightedAveragePrice RPC method. Requestable including specified pool_id, the pair
+// of reserve coins, and the window.
+type QueryTimeWeightedAveragePriceRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// denoms of the pair of reserve coins, optional for the pool with two reserve coins
+	DenomX string `protobuf:"bytes,2,opt,name=denom_x,json=denomX,proto3" json:"denom_x,omitempty"`
+	DenomY string `protobuf:"bytes,3,opt,name=denom_y,json=denomY,proto3" json:"denom_y,omitempty"`
+	// start time of the window in RFC3339 format
+	StartTime string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end time of the window in RFC3339 format, the current block time if empty
+	EndTime string `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *QueryTimeWeightedAveragePriceRequest) Reset()         { *m = QueryTimeWeightedAveragePriceRequest{} }
+func (m *QueryTimeWeightedAveragePriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeWeightedAveragePriceRequest) ProtoMessage()    {}
+func (*QueryTimeWeightedAveragePriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{34}
+}
+func (m *QueryTimeWeightedAveragePriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeWeightedAveragePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeWeightedAveragePriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchWithdrawMsg(ctx, in)
+}
+func (m *QueryTimeWeightedAveragePriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeWeightedAveragePriceRequest.Merge(m, src)
+}
+func (m *QueryTimeWeightedAveragePriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeWeightedAveragePriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeWeightedAveragePriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimeWeightedAveragePriceRequest proto.InternalMessageInfo
+
+func (m *QueryTimeWeightedAveragePriceRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsg",
+	return 0
+}
+
+func (m *QueryTimeWeightedAveragePriceRequest) GetDenomX() string {
+	if m != nil {
+		return m.DenomX
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchWithdrawMsg(ctx, req.(*QueryPoolBatchWithdrawMsgRequest))
+	return ""
+}
+
+func (m *QueryTimeWeightedAveragePriceRequest) GetDenomY() string {
+	if m != nil {
+		return m.DenomY
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/Params",
+func (m *QueryTimeWeightedAveragePriceRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return ""
+}
+
+func (m *QueryTimeWeightedAveragePriceRequest) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tendermint.liquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LiquidityPools",
-			Handler:    _Query_LiquidityPools_Handler,
-		},
-		{
-			MethodName: "LiquidityPool",
-			Handler:    _Query_LiquidityPool_Handler,
-		},
-		{
-			MethodName: "LiquidityPoolByPoolCoinDenom",
-			Handler:    _Query_LiquidityPoolByPoolCoinDenom_Handler,
-		},
-		{
-			MethodName: "LiquidityPoolByReserveAcc",
-			Handler:    _Query_LiquidityPoolByReserveAcc_Handler,
-		},
-		{
-			MethodName: "LiquidityPoolBatch",
-			Handler:    _Query_LiquidityPoolBatch_Handler,
-		},
-		{
-			MethodName: "PoolBatchSwapMsgs",
-			Handler:    _Query_PoolBatchSwapMsgs_Handler,
-		},
-		{
-			MethodName: "PoolBatchSwapMsg",
-			Handler:    _Query_PoolBatchSwapMsg_Handler,
-		},
-		{
-			MethodName: "PoolBatchDepositMsgs",
-			Handler:    _Query_PoolBatchDepositMsgs_Handler,
-		},
-		{
-			MethodName: "PoolBatchDepositMsg",
-			Handler:    _Query_PoolBatchDepositMsg_Handler,
-		},
-		{
-			MethodName: "PoolBatchWithdrawMsgs",
-			Handler:    _Query_PoolBatchWithdrawMsgs_Handler,
-		},
-		{
-			MethodName: "PoolBatchWithdrawMsg",
-			Handler:    _Query_PoolBatchWithdrawMsg_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tendermint/liquidity/v1beta1/query.proto",
+// the response type for the QueryTimeWeightedAveragePrice RPC method. This includes the time-weighted average price
+// of the pair of reserve coins sorted alphabetically.
+type QueryTimeWeightedAveragePriceResponse struct {
+	// denom of the reserve coin X of the pair
+	DenomX string `protobuf:"bytes,1,opt,name=denom_x,json=denomX,proto3" json:"denom_x,omitempty"`
+	// denom of the reserve coin Y of the pair
+	DenomY string `protobuf:"bytes,2,opt,name=denom_y,json=denomY,proto3" json:"denom_y,omitempty"`
+	// time-weighted average pool price of the pair over the window, the exchange ratio of X/Y
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
 }
 
-func (m *QueryLiquidityPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTimeWeightedAveragePriceResponse) Reset()         { *m = QueryTimeWeightedAveragePriceResponse{} }
+func (m *QueryTimeWeightedAveragePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeWeightedAveragePriceResponse) ProtoMessage()    {}
+func (*QueryTimeWeightedAveragePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{35}
+}
+func (m *QueryTimeWeightedAveragePriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeWeightedAveragePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeWeightedAveragePriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryLiquidityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTimeWeightedAveragePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeWeightedAveragePriceResponse.Merge(m, src)
+}
+func (m *QueryTimeWeightedAveragePriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeWeightedAveragePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeWeightedAveragePriceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryTimeWeightedAveragePriceResponse proto.InternalMessageInfo
+
+func (m *QueryTimeWeightedAveragePriceResponse) GetDenomX() string {
+	if m != nil {
+		return m.DenomX
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTimeWeightedAveragePriceResponse) GetDenomY() string {
+	if m != nil {
+		return m.DenomY
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLiquidityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the request type for the QuerySwapMsgsByRequester RPC method. Requestable including specified swap_requester_address and pagination.
+type QuerySwapMsgsByRequesterRequest struct {
+	// bech32-encoded address of the swap requester
+	SwapRequesterAddress string `protobuf:"bytes,1,opt,name=swap_requester_address,json=swapRequesterAddress,proto3" json:"swap_requester_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLiquidityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySwapMsgsByRequesterRequest) Reset()         { *m = QuerySwapMsgsByRequesterRequest{} }
+func (m *QuerySwapMsgsByRequesterRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapMsgsByRequesterRequest) ProtoMessage()    {}
+func (*QuerySwapMsgsByRequesterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{36}
+}
+func (m *QuerySwapMsgsByRequesterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapMsgsByRequesterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapMsgsByRequesterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySwapMsgsByRequesterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapMsgsByRequesterRequest.Merge(m, src)
 }
-
-func (m *QueryLiquidityPoolByPoolCoinDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySwapMsgsByRequesterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapMsgsByRequesterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapMsgsByRequesterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidityPoolByPoolCoinDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolCoinDenom) > 0 {
-		i -= len(m.PoolCoinDenom)
-		copy(dAtA[i:], m.PoolCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolCoinDenom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuerySwapMsgsByRequesterRequest proto.InternalMessageInfo
+
+func (m *QuerySwapMsgsByRequesterRequest) GetSwapRequesterAddress() string {
+	if m != nil {
+		return m.SwapRequesterAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryLiquidityPoolByReserveAccRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySwapMsgsByRequesterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLiquidityPoolByReserveAccRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the response type for the QuerySwapMsgsByRequester RPC method. This includes the swap messages of the swap requester in the current batches ordered by pool id and msg index.
+type QuerySwapMsgsByRequesterResponse struct {
+	Swaps []SwapMsgState `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLiquidityPoolByReserveAccRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReserveAcc) > 0 {
-		i -= len(m.ReserveAcc)
-		copy(dAtA[i:], m.ReserveAcc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReserveAcc)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySwapMsgsByRequesterResponse) Reset()         { *m = QuerySwapMsgsByRequesterResponse{} }
+func (m *QuerySwapMsgsByRequesterResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapMsgsByRequesterResponse) ProtoMessage()    {}
+func (*QuerySwapMsgsByRequesterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{37}
 }
-
-func (m *QueryLiquidityPoolBatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySwapMsgsByRequesterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapMsgsByRequesterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapMsgsByRequesterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryLiquidityPoolBatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySwapMsgsByRequesterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapMsgsByRequesterResponse.Merge(m, src)
+}
+func (m *QuerySwapMsgsByRequesterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapMsgsByRequesterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapMsgsByRequesterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidityPoolBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QuerySwapMsgsByRequesterResponse proto.InternalMessageInfo
+
+func (m *QuerySwapMsgsByRequesterResponse) GetSwaps() []SwapMsgState {
+	if m != nil {
+		return m.Swaps
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryLiquidityPoolBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySwapMsgsByRequesterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLiquidityPoolBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the request type for the QueryDepositMsgsByDepositor RPC method. Requestable including specified depositor_address and pagination.
+type QueryDepositMsgsByDepositorRequest struct {
+	// bech32-encoded address of the depositor
+	DepositorAddress string `protobuf:"bytes,1,opt,name=depositor_address,json=depositorAddress,proto3" json:"depositor_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLiquidityPoolBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDepositMsgsByDepositorRequest) Reset()         { *m = QueryDepositMsgsByDepositorRequest{} }
+func (m *QueryDepositMsgsByDepositorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositMsgsByDepositorRequest) ProtoMessage()    {}
+func (*QueryDepositMsgsByDepositorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{38}
+}
+func (m *QueryDepositMsgsByDepositorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositMsgsByDepositorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositMsgsByDepositorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLiquidityPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositMsgsByDepositorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositMsgsByDepositorRequest.Merge(m, src)
 }
-
-func (m *QueryLiquidityPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositMsgsByDepositorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositMsgsByDepositorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositMsgsByDepositorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidityPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryDepositMsgsByDepositorRequest proto.InternalMessageInfo
+
+func (m *QueryDepositMsgsByDepositorRequest) GetDepositorAddress() string {
+	if m != nil {
+		return m.DepositorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryLiquidityPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositMsgsByDepositorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLiquidityPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the response type for the QueryDepositMsgsByDepositor RPC method. This includes the deposit messages of the depositor in the current batches ordered by pool id and msg index.
+type QueryDepositMsgsByDepositorResponse struct {
+	Deposits []DepositMsgState `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLiquidityPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDepositMsgsByDepositorResponse) Reset()         { *m = QueryDepositMsgsByDepositorResponse{} }
+func (m *QueryDepositMsgsByDepositorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositMsgsByDepositorResponse) ProtoMessage()    {}
+func (*QueryDepositMsgsByDepositorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{39}
+}
+func (m *QueryDepositMsgsByDepositorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositMsgsByDepositorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositMsgsByDepositorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositMsgsByDepositorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositMsgsByDepositorResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositMsgsByDepositorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositMsgsByDepositorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositMsgsByDepositorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryDepositMsgsByDepositorResponse proto.InternalMessageInfo
+
+func (m *QueryDepositMsgsByDepositorResponse) GetDeposits() []DepositMsgState {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositMsgsByDepositorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the request type for the QueryWithdrawMsgsByWithdrawer RPC method. Requestable including specified withdrawer_address and pagination.
+type QueryWithdrawMsgsByWithdrawerRequest struct {
+	// bech32-encoded address of the withdrawer
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryWithdrawMsgsByWithdrawerRequest) Reset()         { *m = QueryWithdrawMsgsByWithdrawerRequest{} }
+func (m *QueryWithdrawMsgsByWithdrawerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawMsgsByWithdrawerRequest) ProtoMessage()    {}
+func (*QueryWithdrawMsgsByWithdrawerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{40}
+}
+func (m *QueryWithdrawMsgsByWithdrawerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawMsgsByWithdrawerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawMsgsByWithdrawerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryWithdrawMsgsByWithdrawerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawMsgsByWithdrawerRequest.Merge(m, src)
+}
+func (m *QueryWithdrawMsgsByWithdrawerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawMsgsByWithdrawerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawMsgsByWithdrawerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolBatchSwapMsgsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWithdrawMsgsByWithdrawerRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawMsgsByWithdrawerRequest) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPoolBatchSwapMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWithdrawMsgsByWithdrawerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryPoolBatchSwapMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// the response type for the QueryWithdrawMsgsByWithdrawer RPC method. This includes the withdraw messages of the withdrawer in the current batches ordered by pool id and msg index.
+type QueryWithdrawMsgsByWithdrawerResponse struct {
+	Withdraws []WithdrawMsgState `protobuf:"bytes,1,rep,name=withdraws,proto3" json:"withdraws"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWithdrawMsgsByWithdrawerResponse) Reset()         { *m = QueryWithdrawMsgsByWithdrawerResponse{} }
+func (m *QueryWithdrawMsgsByWithdrawerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawMsgsByWithdrawerResponse) ProtoMessage()    {}
+func (*QueryWithdrawMsgsByWithdrawerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{41}
+}
+func (m *QueryWithdrawMsgsByWithdrawerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawMsgsByWithdrawerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawMsgsByWithdrawerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryWithdrawMsgsByWithdrawerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawMsgsByWithdrawerResponse.Merge(m, src)
+}
+func (m *QueryWithdrawMsgsByWithdrawerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawMsgsByWithdrawerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawMsgsByWithdrawerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawMsgsByWithdrawerResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawMsgsByWithdrawerResponse) GetWithdraws() []WithdrawMsgState {
+	if m != nil {
+		return m.Withdraws
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPoolBatchSwapMsgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWithdrawMsgsByWithdrawerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolBatchSwapMsgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the request type for the QueryLiquidityPositions RPC method. Requestable including specified address.
+type QueryLiquidityPositionsRequest struct {
+	// bech32-encoded address of the pool coin holder
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryPoolBatchSwapMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MsgIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryLiquidityPositionsRequest) Reset()         { *m = QueryLiquidityPositionsRequest{} }
+func (m *QueryLiquidityPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityPositionsRequest) ProtoMessage()    {}
+func (*QueryLiquidityPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{42}
+}
+func (m *QueryLiquidityPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLiquidityPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityPositionsRequest.Merge(m, src)
+}
+func (m *QueryLiquidityPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityPositionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolBatchSwapMsgsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidityPositionsRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityPositionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPoolBatchSwapMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the response type for the QueryLiquidityPositions RPC method. This includes the liquidity positions of the address in ascending order of the pool id.
+type QueryLiquidityPositionsResponse struct {
+	Positions []LiquidityPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
 }
 
-func (m *QueryPoolBatchSwapMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Swaps) > 0 {
-		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryLiquidityPositionsResponse) Reset()         { *m = QueryLiquidityPositionsResponse{} }
+func (m *QueryLiquidityPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityPositionsResponse) ProtoMessage()    {}
+func (*QueryLiquidityPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{43}
+}
+func (m *QueryLiquidityPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLiquidityPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityPositionsResponse.Merge(m, src)
+}
+func (m *QueryLiquidityPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityPositionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolBatchSwapMsgResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidityPositionsResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityPositionsResponse) GetPositions() []LiquidityPosition {
+	if m != nil {
+		return m.Positions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolBatchSwapMsgResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LiquidityPosition defines the pool coin of a liquidity pool held by an account, valued at the current reserves of the pool.
+type LiquidityPosition struct {
+	// id of the liquidity pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// pool coin held by the account
+	PoolCoin types.Coin `protobuf:"bytes,2,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin"`
+	// pool coin escrowed in the pending withdraw messages of the account
+	EscrowedPoolCoin types.Coin `protobuf:"bytes,3,opt,name=escrowed_pool_coin,json=escrowedPoolCoin,proto3" json:"escrowed_pool_coin"`
+	// total supply of the pool coin
+	PoolCoinTotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=pool_coin_total_supply,json=poolCoinTotalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_coin_total_supply"`
+	// share of the held and escrowed pool coins in the pool coin total supply
+	Share github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+	// reserve coins withdrawable for the held and escrowed pool coins after the withdraw fee
+	WithdrawCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=withdraw_coins,json=withdrawCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdraw_coins"`
+	// withdraw fee coins left in the pool for the withdrawal
+	WithdrawFeeCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=withdraw_fee_coins,json=withdrawFeeCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdraw_fee_coins"`
+}
+
+func (m *LiquidityPosition) Reset()         { *m = LiquidityPosition{} }
+func (m *LiquidityPosition) String() string { return proto.CompactTextString(m) }
+func (*LiquidityPosition) ProtoMessage()    {}
+func (*LiquidityPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{44}
+}
+func (m *LiquidityPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryPoolBatchSwapMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
+func (m *LiquidityPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *LiquidityPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityPosition.Merge(m, src)
+}
+func (m *LiquidityPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityPosition.DiscardUnknown(m)
 }
 
-func (m *QueryPoolBatchDepositMsgsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LiquidityPosition proto.InternalMessageInfo
+
+func (m *LiquidityPosition) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPoolBatchDepositMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LiquidityPosition) GetPoolCoin() types.Coin {
+	if m != nil {
+		return m.PoolCoin
+	}
+	return types.Coin{}
 }
 
-func (m *QueryPoolBatchDepositMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *LiquidityPosition) GetEscrowedPoolCoin() types.Coin {
+	if m != nil {
+		return m.EscrowedPoolCoin
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return types.Coin{}
+}
+
+func (m *LiquidityPosition) GetWithdrawCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawCoins
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPoolBatchDepositMsgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LiquidityPosition) GetWithdrawFeeCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawFeeCoins
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolBatchDepositMsgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the request type for the QueryRewardPlans RPC method. Requestable including optional pool_id and pagination.
+type QueryRewardPlansRequest struct {
+	// id of the target pool for query, all pools if zero
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPoolBatchDepositMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MsgIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryRewardPlansRequest) Reset()         { *m = QueryRewardPlansRequest{} }
+func (m *QueryRewardPlansRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPlansRequest) ProtoMessage()    {}
+func (*QueryRewardPlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{45}
+}
+func (m *QueryRewardPlansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPlansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryRewardPlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPlansRequest.Merge(m, src)
+}
+func (m *QueryRewardPlansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPlansRequest proto.InternalMessageInfo
+
+func (m *QueryRewardPlansRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryPoolBatchDepositMsgsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardPlansRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolBatchDepositMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the response type for the QueryRewardPlans RPC method. This includes a list of the reward plans and paging results that contain next_key and total count.
+type QueryRewardPlansResponse struct {
+	Plans []RewardPlan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPoolBatchDepositMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRewardPlansResponse) Reset()         { *m = QueryRewardPlansResponse{} }
+func (m *QueryRewardPlansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPlansResponse) ProtoMessage()    {}
+func (*QueryRewardPlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{46}
+}
+func (m *QueryRewardPlansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPlansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryRewardPlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPlansResponse.Merge(m, src)
+}
+func (m *QueryRewardPlansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPlansResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPlansResponse) GetPlans() []RewardPlan {
+	if m != nil {
+		return m.Plans
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPoolBatchDepositMsgResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardPlansResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolBatchDepositMsgResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the request type for the QueryRewardPlan RPC method. Requestable including specified plan_id.
+type QueryRewardPlanRequest struct {
+	// id of the target reward plan for query
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 }
 
-func (m *QueryPoolBatchDepositMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardPlanRequest) Reset()         { *m = QueryRewardPlanRequest{} }
+func (m *QueryRewardPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPlanRequest) ProtoMessage()    {}
+func (*QueryRewardPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{47}
+}
+func (m *QueryRewardPlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPlanRequest.Merge(m, src)
+}
+func (m *QueryRewardPlanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPlanRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolBatchWithdrawMsgsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardPlanRequest proto.InternalMessageInfo
+
+func (m *QueryRewardPlanRequest) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPoolBatchWithdrawMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the response type for the QueryRewardPlan RPC method. This includes the reward plan corresponding to the plan_id.
+type QueryRewardPlanResponse struct {
+	Plan RewardPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
 }
 
-func (m *QueryPoolBatchWithdrawMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRewardPlanResponse) Reset()         { *m = QueryRewardPlanResponse{} }
+func (m *QueryRewardPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPlanResponse) ProtoMessage()    {}
+func (*QueryRewardPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{48}
+}
+func (m *QueryRewardPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPlanResponse.Merge(m, src)
+}
+func (m *QueryRewardPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPlanResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolBatchWithdrawMsgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardPlanResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPlanResponse) GetPlan() RewardPlan {
+	if m != nil {
+		return m.Plan
 	}
-	return dAtA[:n], nil
+	return RewardPlan{}
 }
 
-func (m *QueryPoolBatchWithdrawMsgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the request type for the QueryRewardAccumulator RPC method. Requestable including specified pool_id.
+type QueryRewardAccumulatorRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *QueryPoolBatchWithdrawMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MsgIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryRewardAccumulatorRequest) Reset()         { *m = QueryRewardAccumulatorRequest{} }
+func (m *QueryRewardAccumulatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardAccumulatorRequest) ProtoMessage()    {}
+func (*QueryRewardAccumulatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{49}
+}
+func (m *QueryRewardAccumulatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardAccumulatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardAccumulatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardAccumulatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardAccumulatorRequest.Merge(m, src)
+}
+func (m *QueryRewardAccumulatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardAccumulatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardAccumulatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolBatchWithdrawMsgsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardAccumulatorRequest proto.InternalMessageInfo
+
+func (m *QueryRewardAccumulatorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPoolBatchWithdrawMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the response type for the QueryRewardAccumulator RPC method. This includes the reward accumulator of the pool.
+type QueryRewardAccumulatorResponse struct {
+	Accumulator RewardAccumulator `protobuf:"bytes,1,opt,name=accumulator,proto3" json:"accumulator"`
 }
 
-func (m *QueryPoolBatchWithdrawMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Withdraws) > 0 {
-		for iNdEx := len(m.Withdraws) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Withdraws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRewardAccumulatorResponse) Reset()         { *m = QueryRewardAccumulatorResponse{} }
+func (m *QueryRewardAccumulatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardAccumulatorResponse) ProtoMessage()    {}
+func (*QueryRewardAccumulatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{50}
+}
+func (m *QueryRewardAccumulatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardAccumulatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardAccumulatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardAccumulatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardAccumulatorResponse.Merge(m, src)
+}
+func (m *QueryRewardAccumulatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardAccumulatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardAccumulatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolBatchWithdrawMsgResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardAccumulatorResponse proto.InternalMessageInfo
+
+func (m *QueryRewardAccumulatorResponse) GetAccumulator() RewardAccumulator {
+	if m != nil {
+		return m.Accumulator
 	}
-	return dAtA[:n], nil
+	return RewardAccumulator{}
 }
 
-func (m *QueryPoolBatchWithdrawMsgResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the request type for the QueryStakes RPC method. Requestable including specified staker_address and pagination.
+type QueryStakesRequest struct {
+	// bech32-encoded address of the staker
+	StakerAddress string `protobuf:"bytes,1,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPoolBatchWithdrawMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Withdraw.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryStakesRequest) Reset()         { *m = QueryStakesRequest{} }
+func (m *QueryStakesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakesRequest) ProtoMessage()    {}
+func (*QueryStakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{51}
+}
+func (m *QueryStakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryStakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakesRequest.Merge(m, src)
+}
+func (m *QueryStakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakesRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryLiquidityPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+var xxx_messageInfo_QueryStakesRequest proto.InternalMessageInfo
+
+func (m *QueryStakesRequest) GetStakerAddress() string {
+	if m != nil {
+		return m.StakerAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryLiquidityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStakesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PoolCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// the response type for the QueryStakes RPC method. This includes the stakes of the staker with the pending rewards and paging results that contain next_key and total count.
+type QueryStakesResponse struct {
+	Stakes []StakeWithRewards `protobuf:"bytes,1,rep,name=stakes,proto3" json:"stakes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLiquidityPoolByReserveAccRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ReserveAcc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStakesResponse) Reset()         { *m = QueryStakesResponse{} }
+func (m *QueryStakesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakesResponse) ProtoMessage()    {}
+func (*QueryStakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{52}
+}
+func (m *QueryStakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryStakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakesResponse.Merge(m, src)
+}
+func (m *QueryStakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidityPoolBatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+var xxx_messageInfo_QueryStakesResponse proto.InternalMessageInfo
+
+func (m *QueryStakesResponse) GetStakes() []StakeWithRewards {
+	if m != nil {
+		return m.Stakes
 	}
-	return n
+	return nil
 }
 
-func (m *QueryLiquidityPoolBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStakesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Batch.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryLiquidityPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// the request type for the QueryBonds RPC method. Requestable including specified owner_address.
+type QueryBondsRequest struct {
+	// bech32-encoded address of the bond owner
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLiquidityPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBondsRequest) Reset()         { *m = QueryBondsRequest{} }
+func (m *QueryBondsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBondsRequest) ProtoMessage()    {}
+func (*QueryBondsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{53}
+}
+func (m *QueryBondsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBondsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBondsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryBondsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBondsRequest.Merge(m, src)
+}
+func (m *QueryBondsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBondsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBondsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBondsRequest proto.InternalMessageInfo
+
+func (m *QueryBondsRequest) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryPoolBatchSwapMsgsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBondsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryPoolBatchSwapMsgRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.MsgIndex != 0 {
-		n += 1 + sovQuery(uint64(m.MsgIndex))
-	}
-	return n
+// the response type for the QueryBonds RPC method. This includes the bonds of the owner and paging results that contain next_key and total count.
+type QueryBondsResponse struct {
+	Bonds []Bond `protobuf:"bytes,1,rep,name=bonds,proto3" json:"bonds"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPoolBatchSwapMsgsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Swaps) > 0 {
-		for _, e := range m.Swaps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBondsResponse) Reset()         { *m = QueryBondsResponse{} }
+func (m *QueryBondsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBondsResponse) ProtoMessage()    {}
+func (*QueryBondsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{54}
+}
+func (m *QueryBondsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBondsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBondsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryBondsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBondsResponse.Merge(m, src)
+}
+func (m *QueryBondsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBondsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBondsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolBatchSwapMsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBondsResponse proto.InternalMessageInfo
+
+func (m *QueryBondsResponse) GetBonds() []Bond {
+	if m != nil {
+		return m.Bonds
 	}
-	var l int
-	_ = l
-	l = m.Swap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryPoolBatchDepositMsgsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBondsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryPoolBatchDepositMsgRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.MsgIndex != 0 {
-		n += 1 + sovQuery(uint64(m.MsgIndex))
-	}
-	return n
+// the request type for the QueryBondedPoolCoins RPC method. Requestable including specified owner_address and min_duration.
+type QueryBondedPoolCoinsRequest struct {
+	// bech32-encoded address of the bond owner
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// minimum bond duration of the bonds to count
+	MinDuration time.Duration `protobuf:"bytes,2,opt,name=min_duration,json=minDuration,proto3,stdduration" json:"min_duration"`
 }
 
-func (m *QueryPoolBatchDepositMsgsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBondedPoolCoinsRequest) Reset()         { *m = QueryBondedPoolCoinsRequest{} }
+func (m *QueryBondedPoolCoinsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBondedPoolCoinsRequest) ProtoMessage()    {}
+func (*QueryBondedPoolCoinsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{55}
+}
+func (m *QueryBondedPoolCoinsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBondedPoolCoinsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBondedPoolCoinsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryBondedPoolCoinsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBondedPoolCoinsRequest.Merge(m, src)
+}
+func (m *QueryBondedPoolCoinsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBondedPoolCoinsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBondedPoolCoinsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBondedPoolCoinsRequest proto.InternalMessageInfo
+
+func (m *QueryBondedPoolCoinsRequest) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryPoolBatchDepositMsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBondedPoolCoinsRequest) GetMinDuration() time.Duration {
+	if m != nil {
+		return m.MinDuration
 	}
-	var l int
-	_ = l
-	l = m.Deposit.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryPoolBatchWithdrawMsgsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// the response type for the QueryBondedPoolCoins RPC method. This includes the pool coins bonded by the owner.
+type QueryBondedPoolCoinsResponse struct {
+	PoolCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_coins,json=poolCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_coins"`
+}
+
+func (m *QueryBondedPoolCoinsResponse) Reset()         { *m = QueryBondedPoolCoinsResponse{} }
+func (m *QueryBondedPoolCoinsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBondedPoolCoinsResponse) ProtoMessage()    {}
+func (*QueryBondedPoolCoinsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{56}
+}
+func (m *QueryBondedPoolCoinsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBondedPoolCoinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBondedPoolCoinsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+}
+func (m *QueryBondedPoolCoinsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBondedPoolCoinsResponse.Merge(m, src)
+}
+func (m *QueryBondedPoolCoinsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBondedPoolCoinsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBondedPoolCoinsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBondedPoolCoinsResponse proto.InternalMessageInfo
+
+func (m *QueryBondedPoolCoinsResponse) GetPoolCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PoolCoins
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// the request type for the QueryCollectedProtocolFees RPC method. Requestable including specified pool_id.
+type QueryCollectedProtocolFeesRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryCollectedProtocolFeesRequest) Reset()         { *m = QueryCollectedProtocolFeesRequest{} }
+func (m *QueryCollectedProtocolFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedProtocolFeesRequest) ProtoMessage()    {}
+func (*QueryCollectedProtocolFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{57}
+}
+func (m *QueryCollectedProtocolFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollectedProtocolFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectedProtocolFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollectedProtocolFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedProtocolFeesRequest.Merge(m, src)
+}
+func (m *QueryCollectedProtocolFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectedProtocolFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedProtocolFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollectedProtocolFeesRequest proto.InternalMessageInfo
+
+func (m *QueryCollectedProtocolFeesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// the response type for the QueryCollectedProtocolFees RPC method. This includes the protocol fees collected from the pool.
+type QueryCollectedProtocolFeesResponse struct {
+	CollectedProtocolFees CollectedProtocolFees `protobuf:"bytes,1,opt,name=collected_protocol_fees,json=collectedProtocolFees,proto3" json:"collected_protocol_fees"`
+}
+
+func (m *QueryCollectedProtocolFeesResponse) Reset()         { *m = QueryCollectedProtocolFeesResponse{} }
+func (m *QueryCollectedProtocolFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedProtocolFeesResponse) ProtoMessage()    {}
+func (*QueryCollectedProtocolFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{58}
+}
+func (m *QueryCollectedProtocolFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollectedProtocolFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectedProtocolFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollectedProtocolFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedProtocolFeesResponse.Merge(m, src)
+}
+func (m *QueryCollectedProtocolFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectedProtocolFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedProtocolFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollectedProtocolFeesResponse proto.InternalMessageInfo
+
+func (m *QueryCollectedProtocolFeesResponse) GetCollectedProtocolFees() CollectedProtocolFees {
+	if m != nil {
+		return m.CollectedProtocolFees
+	}
+	return CollectedProtocolFees{}
+}
+
+// the request type for the QueryPoolFeeRate RPC method. Requestable including specified pool_id.
+type QueryPoolFeeRateRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryPoolFeeRateRequest) Reset()         { *m = QueryPoolFeeRateRequest{} }
+func (m *QueryPoolFeeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolFeeRateRequest) ProtoMessage()    {}
+func (*QueryPoolFeeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{59}
+}
+func (m *QueryPoolFeeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolFeeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolFeeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolFeeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolFeeRateRequest.Merge(m, src)
+}
+func (m *QueryPoolFeeRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolFeeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolFeeRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolFeeRateRequest proto.InternalMessageInfo
+
+func (m *QueryPoolFeeRateRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// the response type for the QueryPoolFeeRate RPC method. This includes the fee rates applied to the pool.
+type QueryPoolFeeRateResponse struct {
+	PoolFeeRate PoolFeeRate `protobuf:"bytes,1,opt,name=pool_fee_rate,json=poolFeeRate,proto3" json:"pool_fee_rate"`
+	// dynamic swap fee of the pool, with zero pool_id if the dynamic swap fee is disabled or not updated yet
+	DynamicSwapFee DynamicSwapFee `protobuf:"bytes,2,opt,name=dynamic_swap_fee,json=dynamicSwapFee,proto3" json:"dynamic_swap_fee"`
+}
+
+func (m *QueryPoolFeeRateResponse) Reset()         { *m = QueryPoolFeeRateResponse{} }
+func (m *QueryPoolFeeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolFeeRateResponse) ProtoMessage()    {}
+func (*QueryPoolFeeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{60}
+}
+func (m *QueryPoolFeeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolFeeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolFeeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolFeeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolFeeRateResponse.Merge(m, src)
+}
+func (m *QueryPoolFeeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolFeeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolFeeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolFeeRateResponse proto.InternalMessageInfo
+
+func (m *QueryPoolFeeRateResponse) GetPoolFeeRate() PoolFeeRate {
+	if m != nil {
+		return m.PoolFeeRate
+	}
+	return PoolFeeRate{}
+}
+
+func (m *QueryPoolFeeRateResponse) GetDynamicSwapFee() DynamicSwapFee {
+	if m != nil {
+		return m.DynamicSwapFee
+	}
+	return DynamicSwapFee{}
+}
+
+// the request type for the QueryCircuitBreakers RPC method. Requestable including optional pool_id and pagination.
+type QueryCircuitBreakersRequest struct {
+	// id of the target pool for query, all pools if zero
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCircuitBreakersRequest) Reset()         { *m = QueryCircuitBreakersRequest{} }
+func (m *QueryCircuitBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{61}
+}
+func (m *QueryCircuitBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakersRequest proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryCircuitBreakersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// the response type for the QueryCircuitBreakers RPC method. This includes a list of the enabled circuit breakers and paging results that contain next_key and total count.
+type QueryCircuitBreakersResponse struct {
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,1,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCircuitBreakersResponse) Reset()         { *m = QueryCircuitBreakersResponse{} }
+func (m *QueryCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{62}
+}
+func (m *QueryCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakersResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakersResponse) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+func (m *QueryCircuitBreakersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// StakeWithRewards defines the pool coin stake with its pending rewards to be claimed.
+type StakeWithRewards struct {
+	Stake Stake `protobuf:"bytes,1,opt,name=stake,proto3" json:"stake"`
+	// reward coins distributed to the stake and not claimed yet
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *StakeWithRewards) Reset()         { *m = StakeWithRewards{} }
+func (m *StakeWithRewards) String() string { return proto.CompactTextString(m) }
+func (*StakeWithRewards) ProtoMessage()    {}
+func (*StakeWithRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{63}
+}
+func (m *StakeWithRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeWithRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeWithRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakeWithRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeWithRewards.Merge(m, src)
+}
+func (m *StakeWithRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeWithRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeWithRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeWithRewards proto.InternalMessageInfo
+
+func (m *StakeWithRewards) GetStake() Stake {
+	if m != nil {
+		return m.Stake
+	}
+	return Stake{}
+}
+
+func (m *StakeWithRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryLiquidityPoolRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolRequest")
+	proto.RegisterType((*QueryLiquidityPoolResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolResponse")
+	proto.RegisterType((*QueryLiquidityPoolByPoolCoinDenomRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolByPoolCoinDenomRequest")
+	proto.RegisterType((*QueryLiquidityPoolByReserveAccRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolByReserveAccRequest")
+	proto.RegisterType((*QueryLiquidityPoolByDenomsRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolByDenomsRequest")
+	proto.RegisterType((*QueryLiquidityPoolsByDenomRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsByDenomRequest")
+	proto.RegisterType((*QueryLiquidityPoolBatchRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolBatchRequest")
+	proto.RegisterType((*QueryLiquidityPoolBatchResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolBatchResponse")
+	proto.RegisterType((*QueryLiquidityPoolsRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsRequest")
+	proto.RegisterType((*QueryLiquidityPoolsResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.liquidity.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.liquidity.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPoolBatchSwapMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgsRequest")
+	proto.RegisterType((*QueryPoolBatchSwapMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgRequest")
+	proto.RegisterType((*QueryPoolBatchSwapMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgsResponse")
+	proto.RegisterType((*QueryPoolBatchSwapMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgResponse")
+	proto.RegisterType((*QueryPoolBatchDepositMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgsRequest")
+	proto.RegisterType((*QueryPoolBatchDepositMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgRequest")
+	proto.RegisterType((*QueryPoolBatchDepositMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgsResponse")
+	proto.RegisterType((*QueryPoolBatchDepositMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgResponse")
+	proto.RegisterType((*QueryPoolBatchWithdrawMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgsRequest")
+	proto.RegisterType((*QueryPoolBatchWithdrawMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgRequest")
+	proto.RegisterType((*QueryPoolBatchWithdrawMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgsResponse")
+	proto.RegisterType((*QueryPoolBatchWithdrawMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgResponse")
+	proto.RegisterType((*QueryLiquidityPoolPositionsRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolPositionsRequest")
+	proto.RegisterType((*QueryLiquidityPoolPositionsResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolPositionsResponse")
+	proto.RegisterType((*QuerySimulateSwapRequest)(nil), "tendermint.liquidity.v1beta1.QuerySimulateSwapRequest")
+	proto.RegisterType((*QuerySimulateSwapResponse)(nil), "tendermint.liquidity.v1beta1.QuerySimulateSwapResponse")
+	proto.RegisterType((*QueryEstimateDepositRequest)(nil), "tendermint.liquidity.v1beta1.QueryEstimateDepositRequest")
+	proto.RegisterType((*QueryEstimateDepositResponse)(nil), "tendermint.liquidity.v1beta1.QueryEstimateDepositResponse")
+	proto.RegisterType((*QueryEstimateWithdrawRequest)(nil), "tendermint.liquidity.v1beta1.QueryEstimateWithdrawRequest")
+	proto.RegisterType((*QueryEstimateWithdrawResponse)(nil), "tendermint.liquidity.v1beta1.QueryEstimateWithdrawResponse")
+	proto.RegisterType((*QueryPoolBatchResultsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchResultsRequest")
+	proto.RegisterType((*QueryPoolBatchResultsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchResultsResponse")
+	proto.RegisterType((*QueryTimeWeightedAveragePriceRequest)(nil), "tendermint.liquidity.v1beta1.QueryTimeWeightedAveragePriceRequest")
+	proto.RegisterType((*QueryTimeWeightedAveragePriceResponse)(nil), "tendermint.liquidity.v1beta1.QueryTimeWeightedAveragePriceResponse")
+	proto.RegisterType((*QuerySwapMsgsByRequesterRequest)(nil), "tendermint.liquidity.v1beta1.QuerySwapMsgsByRequesterRequest")
+	proto.RegisterType((*QuerySwapMsgsByRequesterResponse)(nil), "tendermint.liquidity.v1beta1.QuerySwapMsgsByRequesterResponse")
+	proto.RegisterType((*QueryDepositMsgsByDepositorRequest)(nil), "tendermint.liquidity.v1beta1.QueryDepositMsgsByDepositorRequest")
+	proto.RegisterType((*QueryDepositMsgsByDepositorResponse)(nil), "tendermint.liquidity.v1beta1.QueryDepositMsgsByDepositorResponse")
+	proto.RegisterType((*QueryWithdrawMsgsByWithdrawerRequest)(nil), "tendermint.liquidity.v1beta1.QueryWithdrawMsgsByWithdrawerRequest")
+	proto.RegisterType((*QueryWithdrawMsgsByWithdrawerResponse)(nil), "tendermint.liquidity.v1beta1.QueryWithdrawMsgsByWithdrawerResponse")
+	proto.RegisterType((*QueryLiquidityPositionsRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPositionsRequest")
+	proto.RegisterType((*QueryLiquidityPositionsResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPositionsResponse")
+	proto.RegisterType((*LiquidityPosition)(nil), "tendermint.liquidity.v1beta1.LiquidityPosition")
+	proto.RegisterType((*QueryRewardPlansRequest)(nil), "tendermint.liquidity.v1beta1.QueryRewardPlansRequest")
+	proto.RegisterType((*QueryRewardPlansResponse)(nil), "tendermint.liquidity.v1beta1.QueryRewardPlansResponse")
+	proto.RegisterType((*QueryRewardPlanRequest)(nil), "tendermint.liquidity.v1beta1.QueryRewardPlanRequest")
+	proto.RegisterType((*QueryRewardPlanResponse)(nil), "tendermint.liquidity.v1beta1.QueryRewardPlanResponse")
+	proto.RegisterType((*QueryRewardAccumulatorRequest)(nil), "tendermint.liquidity.v1beta1.QueryRewardAccumulatorRequest")
+	proto.RegisterType((*QueryRewardAccumulatorResponse)(nil), "tendermint.liquidity.v1beta1.QueryRewardAccumulatorResponse")
+	proto.RegisterType((*QueryStakesRequest)(nil), "tendermint.liquidity.v1beta1.QueryStakesRequest")
+	proto.RegisterType((*QueryStakesResponse)(nil), "tendermint.liquidity.v1beta1.QueryStakesResponse")
+	proto.RegisterType((*QueryBondsRequest)(nil), "tendermint.liquidity.v1beta1.QueryBondsRequest")
+	proto.RegisterType((*QueryBondsResponse)(nil), "tendermint.liquidity.v1beta1.QueryBondsResponse")
+	proto.RegisterType((*QueryBondedPoolCoinsRequest)(nil), "tendermint.liquidity.v1beta1.QueryBondedPoolCoinsRequest")
+	proto.RegisterType((*QueryBondedPoolCoinsResponse)(nil), "tendermint.liquidity.v1beta1.QueryBondedPoolCoinsResponse")
+	proto.RegisterType((*QueryCollectedProtocolFeesRequest)(nil), "tendermint.liquidity.v1beta1.QueryCollectedProtocolFeesRequest")
+	proto.RegisterType((*QueryCollectedProtocolFeesResponse)(nil), "tendermint.liquidity.v1beta1.QueryCollectedProtocolFeesResponse")
+	proto.RegisterType((*QueryPoolFeeRateRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolFeeRateRequest")
+	proto.RegisterType((*QueryPoolFeeRateResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolFeeRateResponse")
+	proto.RegisterType((*QueryCircuitBreakersRequest)(nil), "tendermint.liquidity.v1beta1.QueryCircuitBreakersRequest")
+	proto.RegisterType((*QueryCircuitBreakersResponse)(nil), "tendermint.liquidity.v1beta1.QueryCircuitBreakersResponse")
+	proto.RegisterType((*StakeWithRewards)(nil), "tendermint.liquidity.v1beta1.StakeWithRewards")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/liquidity/v1beta1/query.proto", fileDescriptor_f8c9321d314a3b1d)
+}
+
+var fileDescriptor_f8c9321d314a3b1d = []byte{
+	// 5341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x7d, 0x90, 0x1c, 0xc5,
+	0x75, 0x67, 0x6f, 0x3f, 0xa4, 0x6b, 0x7d, 0x9d, 0x5a, 0x08, 0x4e, 0x63, 0x71, 0x6a, 0x0f, 0x36,
+	0xc8, 0xf8, 0xb4, 0x2b, 0x9d, 0x24, 0x10, 0x27, 0x09, 0xbc, 0x27, 0x71, 0xb6, 0x88, 0x71, 0x94,
+	0x15, 0x09, 0x18, 0x4c, 0x36, 0xb3, 0x33, 0x7d, 0x7b, 0x63, 0x76, 0x67, 0x96, 0xe9, 0xd9, 0x3b,
+	0x9d, 0xcf, 0xe7, 0x60, 0x13, 0x0a, 0x1c, 0x2a, 0x36, 0x5e, 0x92, 0xd8, 0xa1, 0x0a, 0x08, 0x21,
+	0x60, 0x3b, 0xe0, 0x4a, 0xc5, 0x26, 0x2e, 0xc7, 0xc1, 0xa6, 0xc0, 0x04, 0x93, 0x04, 0xdb, 0x38,
+	0x94, 0xab, 0x52, 0x49, 0xc5, 0x4e, 0xc0, 0x8e, 0x2b, 0x7f, 0xb9, 0xe2, 0xbf, 0x52, 0xce, 0x1f,
+	0x49, 0xf5, 0xd7, 0x7c, 0xec, 0xce, 0xee, 0xce, 0xec, 0xad, 0xbe, 0xe0, 0xfe, 0x91, 0xb4, 0x3d,
+	0xfd, 0xba, 0x5f, 0xbf, 0xf7, 0x7b, 0xfd, 0x5e, 0x7f, 0x3d, 0x81, 0xdd, 0x2e, 0xb6, 0x0c, 0xec,
+	0xd4, 0x4d, 0xcb, 0x2d, 0xd4, 0xcc, 0xbb, 0x9a, 0xa6, 0x61, 0xba, 0x4b, 0x85, 0x85, 0x7d, 0x15,
+	0xec, 0x6a, 0xfb, 0x0a, 0x77, 0x35, 0xb1, 0xb3, 0x94, 0x6f, 0x38, 0xb6, 0x6b, 0xc3, 0x9d, 0x7e,
+	0xcd, 0xbc, 0x57, 0x33, 0x2f, 0x6a, 0x2a, 0x17, 0x57, 0xed, 0xaa, 0xcd, 0x2a, 0x16, 0xe8, 0xbf,
+	0x38, 0x8d, 0x32, 0xd9, 0xb3, 0x75, 0xbf, 0x15, 0x5e, 0x7b, 0x67, 0xd5, 0xb6, 0xab, 0x35, 0x5c,
+	0xd0, 0x1a, 0x66, 0x41, 0xb3, 0x2c, 0xdb, 0xd5, 0x5c, 0xd3, 0xb6, 0x88, 0xf8, 0x7a, 0x99, 0x6e,
+	0x93, 0xba, 0x4d, 0xca, 0xbc, 0x93, 0x86, 0x56, 0x35, 0x2d, 0xf6, 0x5d, 0x7c, 0xbe, 0x34, 0xf4,
+	0x59, 0xb7, 0x4d, 0xf9, 0x61, 0x42, 0xb4, 0xca, 0x7e, 0x55, 0x9a, 0x73, 0x05, 0xa3, 0xe9, 0x04,
+	0x09, 0xf9, 0x5f, 0xfa, 0x9e, 0x2a, 0xb6, 0xf6, 0xd8, 0x0d, 0x6c, 0x69, 0x0d, 0x73, 0x61, 0xaa,
+	0x60, 0x37, 0x58, 0xdf, 0x9d, 0x7c, 0xa8, 0x07, 0xc0, 0x8e, 0xdf, 0xa0, 0x62, 0xf9, 0xa0, 0xe4,
+	0xfe, 0xa4, 0x6d, 0xd7, 0x4a, 0xf8, 0xae, 0x26, 0x26, 0x2e, 0xbc, 0x14, 0xac, 0x6b, 0xd8, 0x76,
+	0xad, 0x6c, 0x1a, 0xe3, 0x29, 0x94, 0xda, 0x9d, 0x29, 0xe5, 0xe8, 0xcf, 0x13, 0x86, 0x7a, 0x1b,
+	0x50, 0xa2, 0xa8, 0x48, 0xc3, 0xb6, 0x08, 0x86, 0x47, 0x40, 0x86, 0xd6, 0x63, 0x34, 0x1b, 0xa6,
+	0xd4, 0x7c, 0x2f, 0x51, 0xe7, 0x29, 0xe5, 0x4c, 0xe6, 0x95, 0x1f, 0xef, 0xba, 0xa8, 0xc4, 0xa8,
+	0xd4, 0x12, 0xd8, 0xdd, 0xd9, 0xf6, 0x0c, 0xfb, 0xf3, 0x98, 0x6d, 0x5a, 0xc7, 0xb1, 0x65, 0xd7,
+	0x25, 0x83, 0x57, 0x80, 0x2d, 0x8c, 0x41, 0x2a, 0xa0, 0xb2, 0x41, 0xbf, 0xb0, 0x4e, 0x47, 0x4b,
+	0x9b, 0x1a, 0xc1, 0xea, 0xea, 0x07, 0xc0, 0xbb, 0xa3, 0xda, 0x2c, 0x61, 0x82, 0x9d, 0x05, 0x5c,
+	0xd4, 0x75, 0xd9, 0xe0, 0x2e, 0xb0, 0xc1, 0xe1, 0x85, 0x65, 0x4d, 0xd7, 0x45, 0x63, 0xc0, 0xf1,
+	0xea, 0xa9, 0x16, 0x78, 0x67, 0x54, 0x4b, 0xac, 0x1b, 0x12, 0x90, 0x1b, 0x63, 0xa6, 0xac, 0x89,
+	0x16, 0x72, 0xec, 0x67, 0xd1, 0xff, 0x50, 0x19, 0x1f, 0x09, 0x7c, 0x98, 0xa1, 0x1f, 0xdc, 0xa5,
+	0x06, 0xa6, 0x92, 0x4e, 0xa3, 0xd4, 0xee, 0x4d, 0xa5, 0x1c, 0xfd, 0x79, 0xc2, 0x50, 0x1f, 0x4e,
+	0x45, 0x75, 0x48, 0x44, 0x8f, 0xb2, 0xc3, 0x8b, 0x41, 0x36, 0x38, 0x7a, 0xfe, 0x23, 0xd8, 0xe8,
+	0x48, 0xb0, 0x51, 0x38, 0x0b, 0x80, 0x8f, 0x38, 0xd6, 0xe1, 0x86, 0xa9, 0x2b, 0xf2, 0x1c, 0x72,
+	0xf9, 0x8a, 0x46, 0x70, 0x9e, 0x9b, 0x8a, 0xa7, 0x23, 0xad, 0x8a, 0x45, 0x57, 0xa5, 0x00, 0xa5,
+	0x7a, 0x2d, 0x98, 0x88, 0x10, 0x86, 0xe6, 0xea, 0xf3, 0x7d, 0x11, 0x34, 0x07, 0x76, 0x75, 0x25,
+	0x15, 0x30, 0x3a, 0x06, 0xb2, 0x15, 0x5a, 0x20, 0x70, 0x74, 0x65, 0x0c, 0x1c, 0xd1, 0xea, 0x02,
+	0x4c, 0x9c, 0x56, 0x7d, 0x28, 0x15, 0x05, 0x55, 0x4f, 0x53, 0x61, 0x49, 0xa4, 0x06, 0x95, 0x44,
+	0x77, 0x51, 0x7b, 0x9a, 0x49, 0x07, 0x34, 0xa3, 0x3e, 0x95, 0x02, 0xef, 0x88, 0xe4, 0x4a, 0x0c,
+	0xfd, 0x3a, 0x90, 0xa5, 0x72, 0x22, 0xe3, 0x29, 0x94, 0x4e, 0x64, 0x42, 0x9c, 0x0c, 0xbe, 0x3f,
+	0x34, 0xac, 0x11, 0x21, 0xbf, 0x7e, 0xc3, 0xe2, 0x9d, 0x87, 0x34, 0x7c, 0x31, 0x80, 0x8c, 0xcf,
+	0x93, 0x9a, 0xa3, 0x79, 0xf8, 0x56, 0x3f, 0x0c, 0xb6, 0x85, 0x4a, 0x05, 0xd7, 0x33, 0x20, 0xd7,
+	0x60, 0x25, 0x42, 0x90, 0xef, 0xea, 0xc3, 0x36, 0xab, 0x2b, 0x18, 0x17, 0x94, 0xea, 0xdd, 0x29,
+	0x70, 0x19, 0x6f, 0x5b, 0xea, 0xf3, 0xd4, 0xa2, 0xd6, 0xb8, 0x89, 0x54, 0x49, 0x3f, 0x48, 0xc1,
+	0xd9, 0x88, 0x41, 0x0f, 0x82, 0xea, 0x9b, 0xc1, 0xce, 0x48, 0x0e, 0xfa, 0x32, 0xf0, 0x0e, 0x30,
+	0x5a, 0x27, 0xd5, 0xb2, 0x69, 0x19, 0xf8, 0x34, 0xeb, 0x3f, 0x53, 0x5a, 0x5f, 0x27, 0xd5, 0x13,
+	0xf4, 0xb7, 0xfa, 0x57, 0x29, 0x30, 0x11, 0xd9, 0xac, 0x2f, 0xbf, 0x59, 0x90, 0x25, 0x8b, 0x5a,
+	0x43, 0x6a, 0xfd, 0xaa, 0xde, 0xe2, 0x13, 0xe4, 0xa7, 0x5c, 0xcd, 0xc5, 0x52, 0xfb, 0x8c, 0x7c,
+	0x78, 0xda, 0xc7, 0x5d, 0x74, 0xe1, 0x71, 0x7c, 0x1c, 0x64, 0x68, 0x97, 0x42, 0xdf, 0xc9, 0x19,
+	0x66, 0xd4, 0xea, 0x3d, 0x29, 0x80, 0xc2, 0xfd, 0x1c, 0xc7, 0x0d, 0x9b, 0x98, 0xee, 0x59, 0x55,
+	0xfb, 0x2d, 0x60, 0x57, 0x37, 0x26, 0x56, 0xa7, 0xf9, 0x6f, 0xc9, 0x29, 0x3c, 0x7a, 0x78, 0x42,
+	0x94, 0xbf, 0x0e, 0xd6, 0x1b, 0xbc, 0x58, 0xea, 0x7f, 0x4f, 0x6f, 0x71, 0xfa, 0x8d, 0x04, 0x25,
+	0xea, 0x35, 0x32, 0x3c, 0x14, 0xdc, 0xd5, 0x5d, 0x3b, 0x1e, 0xf7, 0x37, 0x51, 0xc7, 0xc6, 0x4a,
+	0x05, 0x16, 0x06, 0x62, 0x5e, 0xb6, 0xa1, 0xfe, 0x5e, 0x87, 0xc8, 0x6e, 0x31, 0xdd, 0x79, 0xc3,
+	0xd1, 0x16, 0xcf, 0x2a, 0x24, 0x6e, 0x05, 0xa8, 0x2b, 0x17, 0xab, 0xc3, 0xc4, 0x0b, 0x29, 0xa0,
+	0xf6, 0x1a, 0xa0, 0x10, 0x6b, 0x09, 0x8c, 0x2e, 0x8a, 0x72, 0x89, 0x8a, 0x7c, 0x6f, 0xc1, 0x06,
+	0x9a, 0x09, 0x4a, 0xd6, 0x6f, 0x66, 0x78, 0xb8, 0x68, 0xf6, 0xd0, 0x91, 0x37, 0x82, 0x93, 0x60,
+	0xbd, 0xec, 0x5a, 0x20, 0x63, 0xb0, 0x01, 0x78, 0xad, 0xa8, 0xf7, 0x4a, 0xd1, 0x85, 0x7c, 0xe7,
+	0x49, 0x8a, 0x1b, 0xd3, 0xb6, 0xce, 0x1e, 0x38, 0xbe, 0x99, 0x02, 0x97, 0xf7, 0xe4, 0x43, 0x48,
+	0xe0, 0x46, 0x30, 0xda, 0x90, 0x85, 0x42, 0x87, 0x57, 0xf4, 0xf3, 0xe7, 0xbc, 0xba, 0xd4, 0x9d,
+	0x47, 0x3e, 0x3c, 0xdd, 0xfd, 0x22, 0x05, 0xc6, 0x19, 0xf3, 0xa7, 0xcc, 0x7a, 0xb3, 0xa6, 0xb9,
+	0x98, 0x4e, 0xce, 0x7d, 0x45, 0x87, 0xc0, 0x46, 0x3a, 0x61, 0x97, 0xc3, 0xa1, 0x0e, 0xa0, 0x65,
+	0x37, 0xf3, 0x70, 0xe7, 0x32, 0x00, 0xec, 0xb9, 0x39, 0xec, 0xb0, 0x88, 0x5c, 0xc4, 0x3c, 0xa3,
+	0xac, 0x84, 0x06, 0xe3, 0xf0, 0x2a, 0xb0, 0xd5, 0xc0, 0x75, 0xcd, 0x32, 0x82, 0x11, 0x7b, 0x86,
+	0xd5, 0xda, 0xc2, 0x3f, 0x78, 0x31, 0x3b, 0x0d, 0xc5, 0x6d, 0xc7, 0xc0, 0x4e, 0xb9, 0xe1, 0x98,
+	0x3a, 0x1e, 0xcf, 0xb2, 0x5a, 0x80, 0x15, 0x9d, 0xa4, 0x25, 0x70, 0x12, 0xc0, 0x60, 0x63, 0x5a,
+	0xdd, 0x6e, 0x5a, 0xee, 0x78, 0x8e, 0xd5, 0x1b, 0xf3, 0x5b, 0x2b, 0xb2, 0x72, 0xf5, 0x8d, 0x34,
+	0xd8, 0x11, 0x31, 0x62, 0x6f, 0xfe, 0x62, 0xa3, 0x10, 0x7d, 0xb1, 0x28, 0x7a, 0x26, 0x4f, 0xa5,
+	0xff, 0x2f, 0x3f, 0xde, 0x75, 0x45, 0xd5, 0x74, 0xe7, 0x9b, 0x95, 0xbc, 0x6e, 0xd7, 0x0b, 0x5c,
+	0xd4, 0xe2, 0xaf, 0x3d, 0xc4, 0xb8, 0xb3, 0x40, 0x65, 0x41, 0xf2, 0xc7, 0xb1, 0x5e, 0x1a, 0xa5,
+	0x2d, 0x70, 0xd6, 0xae, 0x04, 0x5b, 0x58, 0x4b, 0x65, 0xc3, 0x74, 0xb0, 0xee, 0x29, 0x6b, 0xb4,
+	0xb4, 0x99, 0x15, 0x1f, 0x97, 0xa5, 0x70, 0x1c, 0xac, 0xab, 0x53, 0xd3, 0xc1, 0x3c, 0xee, 0x5f,
+	0x5f, 0x92, 0x3f, 0xe1, 0x07, 0xc0, 0x16, 0xd7, 0xd1, 0x2c, 0xa2, 0xe9, 0x2e, 0xe6, 0x23, 0x64,
+	0x82, 0xda, 0x30, 0xb5, 0x23, 0xa4, 0x6f, 0xa9, 0x69, 0x3a, 0x52, 0x81, 0x97, 0xcd, 0x3e, 0x1d,
+	0x13, 0xfa, 0x0d, 0x60, 0xb3, 0xaf, 0x93, 0xf2, 0x1c, 0xe6, 0xb2, 0x8c, 0xd1, 0xd0, 0x46, 0x4f,
+	0x71, 0xb3, 0x18, 0xc3, 0x53, 0x60, 0x3b, 0x3e, 0xad, 0xcf, 0x6b, 0x56, 0x15, 0x1b, 0xe5, 0x80,
+	0xe0, 0xc7, 0x73, 0xf1, 0x5a, 0xdb, 0xe6, 0x51, 0x1f, 0xf7, 0x74, 0x03, 0x6f, 0x02, 0xd0, 0x6f,
+	0xd4, 0xe3, 0x6f, 0x5d, 0xbc, 0x16, 0xc7, 0x3c, 0x52, 0xc1, 0xa3, 0x7a, 0xbb, 0x08, 0xab, 0x6f,
+	0x20, 0xae, 0x59, 0xd7, 0x5c, 0x2c, 0xdc, 0x4c, 0x5f, 0x60, 0x5f, 0x0e, 0x36, 0x09, 0xd7, 0xc3,
+	0x98, 0x20, 0x42, 0x5b, 0x1b, 0x45, 0x21, 0x6d, 0x9e, 0xa8, 0x2f, 0x8d, 0x80, 0x9d, 0xd1, 0xad,
+	0x0b, 0x10, 0x39, 0x60, 0xb3, 0xa6, 0xeb, 0xb8, 0x21, 0x15, 0x26, 0xcd, 0xbd, 0xc7, 0x40, 0xf6,
+	0xd2, 0x81, 0xfc, 0xc5, 0x4f, 0x76, 0xed, 0x8e, 0x81, 0x31, 0xc6, 0x45, 0x69, 0x93, 0xec, 0x82,
+	0xfd, 0xa4, 0x7d, 0x3a, 0x78, 0xae, 0x69, 0x19, 0x5e, 0x9f, 0x23, 0x67, 0xa0, 0x4f, 0xd9, 0x05,
+	0xef, 0xf3, 0x08, 0x18, 0xf5, 0x56, 0xdd, 0x62, 0xf5, 0xd8, 0x57, 0x57, 0xeb, 0xe5, 0x82, 0x5c,
+	0xfd, 0x5c, 0xaa, 0x4d, 0x8c, 0x72, 0xc6, 0xef, 0xab, 0xa5, 0xdd, 0x60, 0xcc, 0x5f, 0xed, 0x0b,
+	0x73, 0x97, 0x66, 0x25, 0x5a, 0xe7, 0xc6, 0x0e, 0xf3, 0x60, 0x9b, 0xf4, 0x17, 0xc1, 0x99, 0x86,
+	0xcf, 0x47, 0x5b, 0xe5, 0x27, 0x7f, 0x7f, 0xe0, 0xd5, 0x11, 0x70, 0x59, 0x17, 0x9e, 0xbc, 0x3d,
+	0x8d, 0xc0, 0x98, 0x53, 0x09, 0xc7, 0x4c, 0xb5, 0x14, 0xe2, 0xe7, 0xcc, 0x68, 0x29, 0x38, 0x2e,
+	0x02, 0x97, 0x00, 0xf4, 0xfa, 0x9c, 0xc3, 0x58, 0xf4, 0x9b, 0x1e, 0x7e, 0xbf, 0x63, 0xb2, 0x9b,
+	0x59, 0x8c, 0xb9, 0xa5, 0xfc, 0x6e, 0xfb, 0x0a, 0xaa, 0x84, 0x49, 0xb3, 0xe6, 0x9e, 0x3d, 0xdf,
+	0xfc, 0x62, 0xc7, 0x2a, 0xd2, 0xe3, 0x40, 0xe8, 0xf3, 0x56, 0xb0, 0x89, 0x6d, 0x10, 0x94, 0x1d,
+	0xfe, 0x21, 0x5e, 0xcc, 0xdd, 0xd6, 0x9c, 0x9c, 0x27, 0x2b, 0x81, 0x1e, 0x86, 0xe7, 0xa3, 0x9f,
+	0x4e, 0x81, 0x77, 0xb1, 0x41, 0xdc, 0x6c, 0xd6, 0xf1, 0x2d, 0xd8, 0xac, 0xce, 0xbb, 0xd8, 0x28,
+	0x2e, 0x60, 0x47, 0xab, 0x62, 0xe6, 0x66, 0xfa, 0x8a, 0xd3, 0xdb, 0x6e, 0x3a, 0x1d, 0xda, 0x6e,
+	0xba, 0xd5, 0xff, 0xb0, 0x34, 0x9e, 0x0e, 0x7c, 0xf8, 0x30, 0xf5, 0xdf, 0xc4, 0xd5, 0x1c, 0xb7,
+	0xec, 0x9a, 0x75, 0x2c, 0x3c, 0xf3, 0x28, 0x2b, 0xa1, 0x4c, 0xc0, 0x1d, 0x60, 0x3d, 0xb6, 0x0c,
+	0xfe, 0x91, 0x3b, 0xe4, 0x75, 0xd8, 0x32, 0xe8, 0x27, 0xf5, 0xb1, 0x94, 0xd8, 0x63, 0xeb, 0xce,
+	0xad, 0x10, 0x7d, 0x80, 0xab, 0x54, 0x37, 0xae, 0x46, 0x42, 0x5c, 0x1d, 0x07, 0x59, 0xee, 0x98,
+	0xd3, 0x03, 0x39, 0x66, 0x4e, 0x4c, 0x39, 0xe4, 0x2b, 0x3c, 0xb9, 0xf0, 0x9e, 0x59, 0x12, 0x12,
+	0xc4, 0x8e, 0x14, 0xe5, 0x01, 0x70, 0x09, 0x8b, 0x03, 0x1c, 0xf9, 0xa1, 0xac, 0x19, 0x86, 0x83,
+	0x09, 0x11, 0xac, 0x5e, 0x4c, 0xfc, 0x38, 0x09, 0x3b, 0x45, 0xfe, 0x6d, 0x68, 0xb0, 0xfd, 0x9a,
+	0x5c, 0x08, 0x47, 0x72, 0x78, 0xbe, 0xee, 0x12, 0xfc, 0x89, 0x0c, 0xc8, 0x03, 0xcb, 0xda, 0x19,
+	0xf9, 0xc3, 0xf6, 0x44, 0xfb, 0x5e, 0x1a, 0xfb, 0x89, 0xb2, 0x36, 0xa9, 0x8e, 0x79, 0x1f, 0x86,
+	0x2d, 0xd1, 0xe7, 0x65, 0x90, 0xde, 0x8d, 0xb7, 0xf3, 0x7e, 0xf5, 0xfd, 0x88, 0x9c, 0x05, 0x82,
+	0x0b, 0xc4, 0x19, 0xef, 0x97, 0x0f, 0xdd, 0x3d, 0xfe, 0x7c, 0xdf, 0x01, 0xdb, 0xad, 0xfe, 0x97,
+	0x61, 0x4b, 0xf8, 0x25, 0x69, 0xf7, 0xdd, 0xf9, 0xbb, 0x10, 0x16, 0xb3, 0xd3, 0x9d, 0x5b, 0xd9,
+	0x6d, 0x0b, 0xca, 0x71, 0xb0, 0x2e, 0x2c, 0x54, 0xf9, 0x53, 0x5d, 0x00, 0xbb, 0xba, 0xd2, 0x8a,
+	0xb1, 0x9f, 0xea, 0x5c, 0x04, 0x16, 0x7a, 0x8f, 0xbd, 0xa3, 0xb1, 0x8e, 0xd5, 0xa0, 0xfa, 0x7a,
+	0x06, 0x6c, 0xed, 0xa8, 0xd6, 0xdd, 0x1b, 0x84, 0x42, 0x98, 0x91, 0xa4, 0x21, 0x0c, 0x8d, 0xd4,
+	0x89, 0xee, 0xd8, 0x8b, 0xd8, 0x28, 0x27, 0x8e, 0xfe, 0xc6, 0x24, 0xa9, 0x3c, 0xc5, 0x81, 0x3a,
+	0xb8, 0xc4, 0x8f, 0xe5, 0x5c, 0xdb, 0xd5, 0x6a, 0x65, 0xd2, 0x6c, 0x34, 0x6a, 0x4b, 0xe3, 0x99,
+	0xc4, 0x73, 0xfc, 0x09, 0xcb, 0x2d, 0x6d, 0x93, 0x8c, 0xde, 0x4c, 0xdb, 0x3a, 0xc5, 0x9a, 0xa2,
+	0x7e, 0x83, 0xcc, 0x6b, 0x8e, 0xf0, 0x55, 0xc9, 0xfd, 0x06, 0x23, 0x8e, 0x08, 0xde, 0x72, 0xe7,
+	0x28, 0x78, 0x5b, 0x77, 0x36, 0x82, 0xb7, 0x8f, 0x81, 0x4b, 0x19, 0x9a, 0x4b, 0x78, 0x51, 0x73,
+	0x8c, 0x93, 0x35, 0xed, 0x2c, 0xee, 0xa9, 0x7c, 0x59, 0x6e, 0x4b, 0x84, 0x3a, 0xf7, 0x36, 0x9b,
+	0xb3, 0x8d, 0x9a, 0xe6, 0xd9, 0xcf, 0xee, 0xde, 0xf6, 0xe3, 0xb7, 0xe0, 0x1d, 0x8d, 0x50, 0xe2,
+	0xe1, 0xcd, 0x18, 0xfb, 0xc0, 0x25, 0x6d, 0xac, 0x06, 0xc5, 0x54, 0xd3, 0xac, 0xa0, 0x98, 0x6a,
+	0x9a, 0x75, 0xc2, 0x50, 0xef, 0xe8, 0x10, 0x6d, 0xe0, 0xec, 0x24, 0x43, 0x2b, 0x89, 0xa5, 0x45,
+	0xd2, 0xb1, 0x31, 0x5a, 0xf5, 0x90, 0x08, 0x7a, 0xf9, 0xe7, 0xa2, 0xae, 0x37, 0xd9, 0x4e, 0x87,
+	0xed, 0x04, 0x19, 0x8b, 0x3c, 0x8d, 0x5b, 0x02, 0x13, 0xdd, 0x28, 0x05, 0x7f, 0xb7, 0x80, 0x0d,
+	0x9a, 0x5f, 0x2c, 0xd8, 0x2c, 0xc4, 0x61, 0x33, 0xd0, 0x9a, 0xe0, 0x36, 0xd8, 0x12, 0xdd, 0xfc,
+	0xe7, 0x47, 0x4c, 0xa7, 0x5c, 0xed, 0x4e, 0xec, 0x41, 0xed, 0xdd, 0x60, 0x33, 0xa1, 0x05, 0xed,
+	0x9e, 0x6c, 0x13, 0x2f, 0x1d, 0xb6, 0x17, 0x7b, 0x26, 0x05, 0xb6, 0x85, 0xb8, 0x10, 0xc3, 0xfe,
+	0x20, 0xc8, 0xb1, 0x0e, 0x63, 0x3a, 0x2c, 0x46, 0x4d, 0xbd, 0x16, 0x1f, 0xba, 0x77, 0xb8, 0xc5,
+	0xdb, 0x18, 0x1e, 0xf6, 0xee, 0x4e, 0x81, 0xad, 0x8c, 0xdd, 0x19, 0xdb, 0x32, 0x3c, 0x99, 0x5d,
+	0x0e, 0x36, 0xd9, 0x8b, 0x56, 0x87, 0xc8, 0x36, 0xb2, 0xc2, 0x61, 0x4b, 0xec, 0x11, 0xa9, 0x37,
+	0xc1, 0x82, 0x7f, 0x72, 0x59, 0xa1, 0x05, 0xf1, 0x4e, 0x2e, 0x29, 0xad, 0x77, 0x5e, 0x4b, 0xc9,
+	0x86, 0x27, 0xa2, 0xdf, 0x97, 0x47, 0xac, 0xb4, 0x0f, 0xdf, 0xf1, 0x24, 0x15, 0xd6, 0xc6, 0x3a,
+	0xdd, 0x3d, 0x68, 0x3a, 0x41, 0x7e, 0x76, 0xe4, 0xf9, 0x25, 0x8c, 0xbc, 0xbc, 0x84, 0x91, 0x3f,
+	0x2e, 0x2a, 0xcc, 0xac, 0xa7, 0x63, 0xf9, 0xc2, 0x4f, 0x76, 0xa5, 0x4a, 0x1b, 0xea, 0xa6, 0x25,
+	0x8b, 0x29, 0x33, 0x3b, 0xa3, 0x99, 0x11, 0x62, 0xfb, 0x28, 0x00, 0x9e, 0x3b, 0x3c, 0x23, 0xdb,
+	0x46, 0xa3, 0xd2, 0x3f, 0x12, 0xf5, 0x88, 0xd8, 0xb7, 0x3f, 0x66, 0xd7, 0x6a, 0x58, 0x77, 0xb1,
+	0x71, 0x92, 0x0e, 0x43, 0xb7, 0x6b, 0xb3, 0x18, 0xf7, 0x9d, 0xea, 0xd5, 0xcf, 0xcb, 0x68, 0xbf,
+	0x0b, 0xb9, 0x18, 0xd0, 0x5d, 0xe0, 0x52, 0x5d, 0x56, 0xe0, 0xb7, 0x58, 0x74, 0xbb, 0x46, 0x5d,
+	0x99, 0x3c, 0x1c, 0xde, 0xdf, 0x1b, 0x19, 0x91, 0xad, 0x0b, 0xa8, 0x6c, 0xd7, 0xa3, 0x3e, 0xaa,
+	0x53, 0x62, 0x76, 0xa5, 0xd2, 0x9d, 0xc5, 0xb8, 0xa4, 0xb9, 0x7d, 0x57, 0xc8, 0xea, 0xf7, 0xa4,
+	0xc3, 0x09, 0x11, 0x79, 0x41, 0x1b, 0xbb, 0x46, 0xc2, 0x1c, 0xb0, 0xa3, 0xb9, 0x58, 0x70, 0xfe,
+	0x9e, 0xfe, 0x7b, 0x04, 0xa2, 0x25, 0x39, 0xdf, 0x35, 0xfc, 0x22, 0xf8, 0x11, 0x30, 0x66, 0x2c,
+	0x59, 0x5a, 0xdd, 0xd4, 0xcb, 0x6c, 0xa5, 0x49, 0xf7, 0x3b, 0x39, 0xac, 0x26, 0xfb, 0xac, 0x38,
+	0x38, 0x15, 0x5d, 0xd0, 0xcd, 0x62, 0xd9, 0xf4, 0x66, 0x23, 0x54, 0xaa, 0x7e, 0x42, 0x80, 0xfe,
+	0x98, 0xe9, 0xe8, 0x4d, 0xd3, 0x9d, 0x71, 0x30, 0x9d, 0x2d, 0xcf, 0x9e, 0x03, 0x7f, 0x59, 0x02,
+	0xbd, 0x83, 0x01, 0x21, 0xd3, 0x3b, 0xc0, 0x98, 0xce, 0x3f, 0x95, 0x2b, 0xe2, 0x9b, 0x80, 0x7b,
+	0x9f, 0xe1, 0x87, 0x1b, 0x14, 0xc3, 0xdf, 0xa2, 0x87, 0xbb, 0x19, 0xde, 0xf4, 0xf1, 0xcd, 0x14,
+	0x18, 0x6b, 0x9f, 0xcd, 0xe1, 0xf5, 0x20, 0xcb, 0x66, 0x72, 0x01, 0x84, 0xcb, 0x63, 0x38, 0x03,
+	0x6f, 0xcd, 0x4d, 0x7f, 0x40, 0x0c, 0xd6, 0x39, 0xbc, 0xad, 0x33, 0xb1, 0x01, 0x28, 0xdb, 0x9e,
+	0xfa, 0xf6, 0xb3, 0x29, 0x90, 0x65, 0x6a, 0x80, 0x0f, 0x66, 0xc0, 0xe6, 0xf0, 0x25, 0x13, 0x78,
+	0xa8, 0x37, 0xdb, 0xdd, 0x6f, 0xcb, 0x28, 0xd7, 0x0e, 0x40, 0xc9, 0x65, 0xab, 0xde, 0x9f, 0x6e,
+	0x15, 0xff, 0x6d, 0x44, 0x39, 0x5a, 0xc2, 0x6e, 0xd3, 0xb1, 0x08, 0xd2, 0x50, 0xcd, 0x24, 0x2e,
+	0xb2, 0xe7, 0x90, 0x56, 0xab, 0x21, 0xaf, 0x2d, 0x44, 0xf1, 0x48, 0x10, 0x0d, 0x4a, 0x91, 0xaf,
+	0x0d, 0xc4, 0x37, 0xe9, 0xf2, 0x2a, 0x01, 0x7b, 0x66, 0x4d, 0xcb, 0x40, 0x76, 0xd3, 0x45, 0x75,
+	0xdb, 0xc1, 0x48, 0xab, 0xd0, 0x7f, 0xba, 0xf3, 0x18, 0x31, 0xbd, 0x22, 0xcd, 0x32, 0x10, 0x76,
+	0x1c, 0xdb, 0x41, 0xba, 0x6d, 0x60, 0x02, 0x67, 0xe6, 0x5d, 0xb7, 0x41, 0xa6, 0x0b, 0x85, 0x80,
+	0x10, 0x23, 0x6f, 0xf1, 0x55, 0x6a, 0x76, 0xa5, 0x60, 0xe0, 0x05, 0x5c, 0xb3, 0x1b, 0x05, 0xc3,
+	0xd6, 0x0b, 0x7a, 0xcd, 0xc4, 0x96, 0x9b, 0xaf, 0x1b, 0x37, 0x3e, 0x95, 0x02, 0xe9, 0x83, 0x7b,
+	0xf7, 0xc2, 0x47, 0x53, 0x60, 0xfb, 0x09, 0xcb, 0xc5, 0x8e, 0xa5, 0xd5, 0xd0, 0x29, 0x7a, 0x21,
+	0xcc, 0x41, 0x37, 0xd0, 0xbe, 0xe8, 0x71, 0xf5, 0x98, 0xd6, 0x68, 0xd4, 0x4c, 0x9d, 0xb1, 0x5b,
+	0xf8, 0x28, 0xb1, 0x2d, 0xd8, 0x58, 0x56, 0x29, 0x0f, 0xea, 0xf4, 0xd4, 0xa4, 0x5a, 0xc7, 0x84,
+	0x68, 0x55, 0xac, 0x4e, 0xab, 0x4e, 0x43, 0xe7, 0x0c, 0x4e, 0x33, 0x0e, 0xd1, 0x51, 0xf4, 0x21,
+	0xdb, 0x9d, 0xb5, 0x9b, 0x96, 0x81, 0x0c, 0x4c, 0x74, 0x74, 0x14, 0xdd, 0x3c, 0x8f, 0xe9, 0xc0,
+	0x1c, 0x8c, 0x2c, 0x5b, 0x88, 0xa3, 0xe1, 0x60, 0x42, 0x99, 0x99, 0x46, 0x77, 0xe2, 0x25, 0x64,
+	0xd9, 0x2e, 0x9a, 0xa3, 0x14, 0xea, 0xa4, 0x6a, 0x60, 0x57, 0x33, 0x6b, 0x44, 0x9d, 0xbe, 0xfd,
+	0x8e, 0x95, 0x4f, 0xbd, 0xfe, 0xd3, 0x87, 0x46, 0xde, 0x09, 0x77, 0x49, 0x94, 0x74, 0x5e, 0x51,
+	0x64, 0xad, 0xc1, 0x17, 0xb2, 0x60, 0x53, 0x48, 0x4b, 0xf0, 0x9a, 0xa4, 0x7a, 0x95, 0x80, 0x38,
+	0x94, 0x9c, 0x50, 0xe0, 0xe1, 0xb9, 0x4c, 0xab, 0x78, 0x5f, 0x46, 0x39, 0x2c, 0xf1, 0x40, 0x55,
+	0x18, 0x46, 0x01, 0x72, 0xe7, 0x35, 0x17, 0xe9, 0xb6, 0xe3, 0x30, 0x1a, 0x83, 0x20, 0xd7, 0x66,
+	0xd5, 0xc4, 0x0c, 0x76, 0x0e, 0xd1, 0x70, 0x80, 0xa3, 0x61, 0xc3, 0x8c, 0x66, 0x20, 0x79, 0x27,
+	0xea, 0x33, 0x51, 0x18, 0xf8, 0x98, 0xc4, 0xc0, 0xfe, 0x20, 0x06, 0xa8, 0xcd, 0xa2, 0xba, 0x49,
+	0xd8, 0x51, 0xdf, 0x24, 0x62, 0x37, 0x9f, 0xb0, 0x8b, 0x9d, 0x69, 0x39, 0xb4, 0x49, 0x09, 0x11,
+	0xe2, 0x3a, 0xba, 0x6d, 0x2d, 0xd0, 0xab, 0x52, 0x04, 0xff, 0xa6, 0x69, 0xb9, 0xd3, 0xb4, 0x36,
+	0x31, 0xad, 0x2a, 0xba, 0x6a, 0x1a, 0x99, 0xd6, 0x82, 0x56, 0x33, 0x0d, 0x44, 0x96, 0x2c, 0x57,
+	0x3b, 0xdd, 0x86, 0x86, 0x1b, 0xbf, 0x2c, 0x60, 0xfb, 0x78, 0x57, 0xd8, 0xde, 0x17, 0xc5, 0x32,
+	0x19, 0x10, 0xb6, 0x6d, 0xca, 0xdb, 0x8f, 0x0c, 0x1b, 0x13, 0xeb, 0x4a, 0x17, 0xe1, 0xd3, 0x26,
+	0x71, 0x63, 0x20, 0xf7, 0xbd, 0xf0, 0x3d, 0x7d, 0x90, 0x5b, 0x58, 0x16, 0xf2, 0x59, 0x81, 0x7f,
+	0x9d, 0x03, 0x3b, 0x7b, 0x5d, 0x10, 0x85, 0xb3, 0x49, 0x91, 0x19, 0x7d, 0xc3, 0x74, 0x15, 0x08,
+	0x6f, 0x65, 0x5b, 0xc5, 0xbf, 0xcb, 0x28, 0xc7, 0x4e, 0xb8, 0xc8, 0xe9, 0x0e, 0x72, 0x1f, 0xdf,
+	0x54, 0xa9, 0x41, 0x84, 0xfb, 0xe7, 0x56, 0xe7, 0x08, 0xe9, 0xcf, 0x32, 0xa4, 0x1f, 0x80, 0xcf,
+	0xa4, 0xc0, 0xe8, 0x87, 0x6c, 0x17, 0x31, 0x75, 0xab, 0x8f, 0x46, 0x81, 0xe6, 0xd3, 0x29, 0x89,
+	0x9a, 0x83, 0xab, 0x42, 0x0d, 0x9f, 0xf7, 0xb9, 0x5c, 0x4c, 0x0b, 0xb1, 0xd1, 0xa3, 0xd3, 0xa7,
+	0x93, 0x60, 0xe9, 0xc6, 0x1f, 0x0a, 0xdc, 0xff, 0x7d, 0x57, 0xdc, 0xff, 0x65, 0xd4, 0x10, 0x1e,
+	0x4e, 0x0d, 0x08, 0xfc, 0x01, 0x95, 0x9a, 0xd8, 0x3e, 0x8e, 0xc1, 0x62, 0x3f, 0xfb, 0x68, 0xeb,
+	0xa2, 0xb0, 0xdc, 0x56, 0xb0, 0x02, 0x1f, 0xcd, 0x81, 0x1d, 0x5d, 0x2f, 0x41, 0xc3, 0x63, 0xc9,
+	0x8d, 0xa6, 0xe3, 0x0a, 0xf5, 0x2a, 0x2c, 0xe6, 0x93, 0xd9, 0x56, 0xf1, 0xb9, 0xc1, 0x2c, 0x46,
+	0xdc, 0xd0, 0x46, 0x9a, 0xae, 0xdb, 0x4d, 0xeb, 0x5c, 0x45, 0x0a, 0x4f, 0x0b, 0x8b, 0x79, 0x22,
+	0x64, 0x31, 0x7f, 0x14, 0x05, 0xb7, 0xbb, 0x07, 0xb5, 0x98, 0x88, 0xd1, 0x22, 0xb1, 0x84, 0xa5,
+	0x96, 0x62, 0x12, 0x86, 0x22, 0xe6, 0x18, 0x2e, 0x50, 0x43, 0x69, 0x1f, 0x5d, 0x52, 0x43, 0x39,
+	0x0c, 0xaf, 0xed, 0x67, 0x28, 0x81, 0x3b, 0xfe, 0x85, 0xe5, 0xc0, 0x8f, 0x15, 0x78, 0xdf, 0x3a,
+	0xb0, 0x3d, 0xf2, 0x6e, 0x3f, 0xbc, 0x3e, 0xb9, 0x71, 0x84, 0x5e, 0x05, 0xac, 0xc2, 0x30, 0x7e,
+	0x99, 0x6d, 0x15, 0x9f, 0xc9, 0x2a, 0x7f, 0x98, 0xea, 0x6d, 0x19, 0x6c, 0xf6, 0xa4, 0xe5, 0x0d,
+	0xcd, 0x74, 0x68, 0x68, 0x2d, 0x25, 0xe9, 0x4f, 0xa6, 0x04, 0x99, 0x16, 0xd2, 0xac, 0x25, 0xc4,
+	0xee, 0x51, 0x4d, 0xd2, 0x4a, 0x72, 0x6e, 0x42, 0x2c, 0x2c, 0xa9, 0x9a, 0x0b, 0xd8, 0x42, 0x95,
+	0x25, 0x24, 0xee, 0x78, 0xa1, 0xc5, 0x79, 0x6c, 0x21, 0x82, 0xe9, 0x41, 0x6e, 0x4d, 0x84, 0xa3,
+	0xf3, 0xda, 0x02, 0xf6, 0xfa, 0x39, 0x47, 0xa6, 0xf6, 0x7d, 0x11, 0x86, 0xbd, 0xdc, 0x16, 0x86,
+	0x7d, 0x3d, 0x0a, 0xb2, 0x8f, 0xa7, 0x22, 0xe3, 0xb0, 0x4e, 0xc8, 0x9e, 0xe0, 0x11, 0x55, 0xd1,
+	0xa9, 0x36, 0xeb, 0xd8, 0x72, 0x25, 0x72, 0xa7, 0x3a, 0x16, 0x28, 0x9e, 0x08, 0xa2, 0x44, 0xdc,
+	0xd4, 0x5c, 0xbb, 0xce, 0x46, 0xdd, 0x6c, 0x12, 0x63, 0xd2, 0x13, 0x65, 0xbd, 0x49, 0x5c, 0x54,
+	0x11, 0x32, 0x6e, 0xb7, 0xc6, 0x57, 0xc5, 0xdc, 0xf1, 0x52, 0x68, 0xee, 0xe8, 0x33, 0x9c, 0x83,
+	0xab, 0xb6, 0x40, 0x0f, 0x33, 0xfd, 0x07, 0x92, 0xd8, 0x10, 0x8f, 0xc0, 0xe9, 0x7e, 0x86, 0xc8,
+	0x3b, 0x2a, 0x2c, 0x8b, 0xe7, 0x32, 0x2b, 0xf2, 0x5f, 0x95, 0x15, 0xf8, 0x42, 0xa6, 0xcd, 0x12,
+	0xe5, 0xa3, 0x97, 0xe4, 0x96, 0xd8, 0xf6, 0x5c, 0x66, 0x35, 0xeb, 0xd8, 0xa7, 0xd3, 0xad, 0xe2,
+	0x2f, 0x47, 0x94, 0xdf, 0x0e, 0x58, 0xa2, 0xbf, 0x94, 0xed, 0x94, 0x2f, 0x43, 0x0a, 0x9b, 0xdb,
+	0x22, 0x45, 0x7c, 0x7e, 0x2d, 0x74, 0x1f, 0x16, 0x10, 0x6c, 0x85, 0x20, 0xd8, 0x7b, 0x71, 0x7b,
+	0xf0, 0x6c, 0x2e, 0x6e, 0x0b, 0x70, 0x4f, 0x2c, 0x40, 0x09, 0x14, 0xad, 0xc0, 0xff, 0xc8, 0x02,
+	0xd8, 0xf9, 0xc2, 0x08, 0x1e, 0x49, 0x3c, 0x95, 0x07, 0xde, 0x34, 0x29, 0x47, 0x07, 0xa4, 0x16,
+	0x08, 0xfa, 0x5e, 0xa6, 0x55, 0x6c, 0x65, 0x94, 0xd9, 0xe0, 0xca, 0x57, 0x6f, 0x3a, 0x0e, 0x9d,
+	0x6f, 0xd8, 0x45, 0xa2, 0xf0, 0xa4, 0xbc, 0xb6, 0x08, 0x7e, 0x3b, 0x2d, 0x82, 0xf7, 0xc1, 0x42,
+	0xec, 0x45, 0x70, 0x81, 0xa1, 0x05, 0xfe, 0x2a, 0x0b, 0xb6, 0x76, 0xbc, 0x29, 0x82, 0x87, 0x63,
+	0x80, 0xb4, 0xdb, 0x13, 0x2b, 0xe5, 0xc8, 0x60, 0xc4, 0x02, 0xe0, 0xff, 0x95, 0x69, 0x15, 0xbf,
+	0x94, 0x51, 0x3e, 0x12, 0xbd, 0xd5, 0x47, 0x37, 0xbd, 0x91, 0x90, 0x29, 0x8b, 0x46, 0x7a, 0xe3,
+	0xff, 0xbc, 0xdb, 0x09, 0x5c, 0x83, 0xfd, 0x19, 0x80, 0xfd, 0x35, 0xf0, 0x60, 0x42, 0xd8, 0x17,
+	0xf8, 0x25, 0xb6, 0x47, 0x72, 0x60, 0xac, 0x1d, 0x89, 0x70, 0x7a, 0x00, 0xf8, 0x4a, 0xe8, 0x1f,
+	0x1e, 0x88, 0x56, 0x20, 0xff, 0xb3, 0xd9, 0x56, 0xf1, 0xc5, 0x8c, 0xf2, 0x5b, 0xc1, 0xa9, 0x3d,
+	0x88, 0xf7, 0xae, 0xb3, 0xb9, 0xf7, 0x50, 0x48, 0x1a, 0x04, 0x1d, 0xec, 0x95, 0x24, 0x6c, 0x17,
+	0xe7, 0x06, 0xf3, 0x5f, 0x12, 0x98, 0xff, 0xd3, 0x36, 0xcc, 0x3f, 0x18, 0x05, 0xa0, 0x8f, 0x27,
+	0xc4, 0xbc, 0x37, 0xee, 0xa1, 0xa0, 0xfe, 0xbb, 0x02, 0xf5, 0xcf, 0x77, 0x45, 0xfd, 0x93, 0x51,
+	0x4c, 0x3f, 0x98, 0x5a, 0x56, 0x1d, 0xdb, 0x76, 0xd5, 0xe9, 0x00, 0xfc, 0x03, 0x0d, 0x27, 0x8f,
+	0xb1, 0xeb, 0xa4, 0x2a, 0x16, 0x52, 0xbe, 0x62, 0xf7, 0x85, 0x8d, 0x02, 0xd9, 0x0e, 0x32, 0x70,
+	0x0d, 0xbb, 0xb8, 0x63, 0x99, 0xbe, 0x12, 0x7b, 0xbf, 0x27, 0xd2, 0x26, 0x0a, 0xcb, 0x5e, 0xa7,
+	0x2b, 0xf0, 0xd3, 0x39, 0x70, 0x71, 0xd4, 0xb3, 0x43, 0x78, 0x5d, 0x12, 0x9c, 0x77, 0x3e, 0xc7,
+	0x54, 0xae, 0x1f, 0x98, 0x5e, 0xd8, 0xca, 0x2f, 0x32, 0xad, 0xe2, 0xd3, 0x19, 0xa5, 0x1c, 0xed,
+	0x25, 0xc4, 0x3d, 0xca, 0x35, 0x47, 0xb1, 0xe6, 0x28, 0x42, 0x8e, 0x62, 0x1a, 0x1e, 0x4a, 0x6a,
+	0x14, 0xde, 0x95, 0xdc, 0xaf, 0xe4, 0xc0, 0xb6, 0x08, 0x48, 0xc2, 0xa3, 0x83, 0x41, 0x59, 0x5a,
+	0xc2, 0x75, 0x83, 0x92, 0x0b, 0x43, 0xf8, 0xe3, 0x6c, 0xab, 0xf8, 0x72, 0x46, 0xb9, 0x2d, 0xe8,
+	0x34, 0xda, 0xe0, 0xbf, 0x3a, 0xbf, 0x91, 0x5f, 0x73, 0x1c, 0x6f, 0x2b, 0xc7, 0x31, 0x0b, 0x8f,
+	0x0f, 0x6a, 0x23, 0x21, 0xdf, 0xf1, 0x99, 0x1c, 0xd8, 0x1e, 0xf9, 0x3c, 0x19, 0x26, 0x9a, 0xfc,
+	0x23, 0x5e, 0x6e, 0x2b, 0xef, 0x1b, 0xbc, 0x01, 0x61, 0x35, 0xff, 0x9d, 0x69, 0x15, 0x9f, 0xc9,
+	0x28, 0xbf, 0x13, 0xed, 0x3e, 0xe4, 0xa5, 0xd6, 0x35, 0xff, 0xb1, 0xe6, 0x3f, 0x92, 0x9e, 0x0d,
+	0xb4, 0xdb, 0x86, 0xff, 0xd8, 0xe0, 0xab, 0xc1, 0x60, 0x2a, 0x80, 0xca, 0x64, 0xc1, 0x54, 0x67,
+	0x0e, 0x01, 0xe5, 0xfa, 0x81, 0xe9, 0x85, 0x35, 0x7c, 0x21, 0xdb, 0x2a, 0x7e, 0x37, 0xa3, 0xdc,
+	0x1e, 0xf4, 0x21, 0xed, 0x36, 0xb0, 0xe6, 0x44, 0xd6, 0x9c, 0x48, 0x7c, 0x27, 0xf2, 0x7e, 0x78,
+	0xc3, 0xc0, 0x86, 0x12, 0xf2, 0x22, 0xf7, 0xe6, 0xc0, 0x25, 0xd1, 0x19, 0x12, 0xe0, 0xfb, 0x92,
+	0x6e, 0xa4, 0xb6, 0xbf, 0xc9, 0x51, 0x8a, 0xab, 0x68, 0x41, 0x98, 0xce, 0xcf, 0x32, 0xad, 0xe2,
+	0x53, 0x81, 0xf0, 0x2b, 0xec, 0x48, 0xbc, 0xc7, 0x36, 0xd2, 0x57, 0xe8, 0xb6, 0xa5, 0x63, 0xcb,
+	0x75, 0x34, 0x17, 0x1b, 0xd1, 0xb7, 0x17, 0xd6, 0x5c, 0xc8, 0x5b, 0xdb, 0x85, 0x1c, 0x84, 0xfb,
+	0xe3, 0x5b, 0x86, 0x9f, 0xba, 0xe3, 0x95, 0x1c, 0xd8, 0x18, 0x4c, 0x3d, 0x01, 0xaf, 0x8e, 0x81,
+	0xdd, 0x88, 0xec, 0x1c, 0xca, 0x35, 0x89, 0xe9, 0x04, 0xd2, 0x5f, 0xce, 0xb6, 0x8a, 0xf7, 0x64,
+	0x95, 0xaf, 0xa7, 0x82, 0x5e, 0x02, 0x9f, 0x6e, 0xb0, 0x9b, 0xd4, 0x7c, 0x9f, 0x8a, 0x3d, 0x94,
+	0x9d, 0xe4, 0x7f, 0x21, 0x2f, 0x77, 0xc5, 0x24, 0xf2, 0x33, 0x4a, 0x20, 0xfe, 0xee, 0x9e, 0x41,
+	0x96, 0xde, 0xe6, 0x96, 0x76, 0xc1, 0xc8, 0xd9, 0xa1, 0x32, 0x12, 0x19, 0x2c, 0xfc, 0xa3, 0x45,
+	0xe9, 0x48, 0xc4, 0xf9, 0x17, 0x61, 0xc4, 0x61, 0xa2, 0xfe, 0x01, 0xda, 0x9a, 0x19, 0xbd, 0xb5,
+	0xcc, 0xe8, 0x5a, 0x78, 0x4d, 0x7c, 0x33, 0x22, 0x02, 0xcf, 0xec, 0xca, 0x3c, 0x7c, 0x22, 0x07,
+	0xb6, 0xb4, 0xe5, 0xe0, 0x80, 0x71, 0x8e, 0x74, 0xa3, 0xb3, 0x82, 0x28, 0xd3, 0x83, 0x90, 0x06,
+	0x02, 0xaf, 0x7f, 0xca, 0x28, 0xf7, 0x86, 0x6c, 0x4a, 0x66, 0xe8, 0x60, 0x07, 0xbd, 0x64, 0x52,
+	0x9c, 0xfd, 0xf2, 0x0c, 0x1a, 0xbc, 0xcc, 0xb3, 0x00, 0xff, 0xae, 0x1b, 0xed, 0x1d, 0x1b, 0x68,
+	0x8e, 0x79, 0x66, 0xd6, 0x89, 0x3c, 0x36, 0xe6, 0x14, 0x81, 0x73, 0x3f, 0xa4, 0xb9, 0x91, 0x76,
+	0xb5, 0x66, 0x22, 0x6f, 0x2d, 0x13, 0x89, 0x71, 0x7f, 0xc2, 0x37, 0x11, 0x2c, 0x10, 0x5a, 0x16,
+	0xe8, 0x81, 0x7f, 0x9e, 0x03, 0x63, 0xed, 0xe9, 0x4c, 0x60, 0x12, 0xac, 0xb7, 0xe5, 0x65, 0x51,
+	0x0e, 0x0f, 0x44, 0x1b, 0xd8, 0xe5, 0xfa, 0x41, 0x46, 0xf9, 0x54, 0xc8, 0x50, 0x82, 0x17, 0x22,
+	0x08, 0x6a, 0x68, 0x26, 0x47, 0xae, 0x34, 0x0e, 0x6f, 0x05, 0x33, 0x87, 0x65, 0x1d, 0x6a, 0x1e,
+	0xb2, 0x58, 0xda, 0x87, 0x6f, 0x43, 0x73, 0x8e, 0x5d, 0x5f, 0xb3, 0x92, 0xb7, 0x99, 0x95, 0x1c,
+	0x85, 0x87, 0x07, 0xb0, 0x12, 0x09, 0x22, 0xf8, 0xd9, 0xe0, 0x09, 0xa2, 0xcc, 0xe1, 0x92, 0xe8,
+	0x04, 0x31, 0x9c, 0xdc, 0x46, 0x39, 0x3c, 0x10, 0x6d, 0xe0, 0x0a, 0xec, 0xb7, 0x33, 0x8a, 0x53,
+	0x8a, 0xbc, 0x5b, 0x24, 0x72, 0xd5, 0xc8, 0x9f, 0xd4, 0x23, 0x12, 0x2a, 0x28, 0xac, 0x37, 0xa9,
+	0xef, 0x60, 0x31, 0x93, 0x1f, 0x92, 0x31, 0x79, 0xde, 0x89, 0x1b, 0xae, 0x8c, 0xad, 0x88, 0x4b,
+	0xc1, 0xbe, 0xb6, 0x4a, 0x59, 0x0b, 0xaf, 0xa2, 0xd6, 0xef, 0x32, 0x13, 0x12, 0x7d, 0x5b, 0x31,
+	0xde, 0x2d, 0x89, 0x0f, 0x9c, 0x89, 0x81, 0xee, 0x3e, 0xf9, 0x8a, 0x94, 0x63, 0xab, 0x6a, 0x23,
+	0x70, 0xd6, 0xfe, 0xa3, 0x8c, 0x72, 0x7f, 0xc8, 0xa1, 0xb8, 0x66, 0x1d, 0xef, 0x59, 0x14, 0x64,
+	0x48, 0xe3, 0x74, 0x5c, 0x84, 0x7c, 0x4d, 0x63, 0xcf, 0x75, 0xbd, 0x20, 0x4b, 0x90, 0x4d, 0x95,
+	0xc9, 0x1d, 0x8f, 0x65, 0xd8, 0x8b, 0x48, 0xa7, 0x05, 0xd4, 0xae, 0x96, 0x38, 0x11, 0x6b, 0x21,
+	0xf0, 0x76, 0x9d, 0xac, 0xad, 0x4f, 0xde, 0xba, 0x06, 0xb4, 0x17, 0xe6, 0xe3, 0x1b, 0x90, 0x4b,
+	0x97, 0x25, 0x0f, 0x67, 0xc1, 0xb6, 0x88, 0xc4, 0x4d, 0xb1, 0xce, 0x17, 0xbb, 0xa7, 0xa4, 0x52,
+	0xae, 0x1b, 0x94, 0x5c, 0x18, 0xca, 0x3d, 0x99, 0x56, 0xf1, 0xf9, 0xb4, 0xd2, 0x8c, 0x76, 0x29,
+	0xe1, 0xeb, 0x58, 0xc1, 0x42, 0x2f, 0x05, 0x56, 0xe4, 0xd2, 0x1c, 0x13, 0x7f, 0x8b, 0xec, 0xbc,
+	0x7b, 0xb1, 0xf9, 0x9a, 0x30, 0x8a, 0x57, 0xda, 0x8c, 0xe2, 0x1b, 0x51, 0x08, 0x7b, 0x62, 0x95,
+	0x97, 0xc3, 0x3d, 0xd8, 0x47, 0xe6, 0x0d, 0x9b, 0x46, 0x06, 0xd6, 0x6d, 0xf6, 0xbc, 0xa1, 0x82,
+	0xf5, 0xf9, 0xfd, 0x53, 0x68, 0x4e, 0x33, 0x6b, 0x74, 0xdb, 0x55, 0xd2, 0x89, 0x62, 0xe2, 0x3a,
+	0xb4, 0x52, 0x0d, 0x5b, 0x55, 0x77, 0x1e, 0x4d, 0x25, 0x3e, 0xfc, 0x16, 0x77, 0x3f, 0xa2, 0xb9,
+	0x58, 0xa1, 0xe0, 0xbc, 0x24, 0x3a, 0x07, 0x56, 0xac, 0x6d, 0xd8, 0x9e, 0xa9, 0xbd, 0x62, 0x6d,
+	0xc3, 0xf6, 0x4e, 0xc0, 0xa5, 0xfe, 0x2a, 0xdd, 0x2a, 0x7e, 0x23, 0xad, 0x90, 0x68, 0x94, 0x76,
+	0x5c, 0x07, 0x09, 0x97, 0xdb, 0x17, 0x20, 0x46, 0xff, 0x51, 0x60, 0xf4, 0x3b, 0x6d, 0x18, 0xfd,
+	0x5a, 0x14, 0x46, 0x1f, 0x1d, 0x12, 0x46, 0x3b, 0x12, 0xb0, 0x0d, 0x13, 0x9e, 0x87, 0xe0, 0xd5,
+	0xdd, 0xe1, 0xe9, 0x9f, 0x30, 0x77, 0xf0, 0xb0, 0x02, 0x9f, 0xcc, 0x82, 0xf1, 0x6e, 0xe9, 0xc3,
+	0x62, 0x45, 0x1c, 0x7d, 0x72, 0xa3, 0xc5, 0x8a, 0x38, 0xfa, 0xe5, 0x2f, 0x53, 0xff, 0x2f, 0xdd,
+	0x2a, 0xfe, 0x6d, 0xd7, 0x89, 0xb4, 0xf3, 0xc8, 0xb9, 0xed, 0xc3, 0x85, 0x38, 0x91, 0xbe, 0x2a,
+	0x40, 0xfa, 0x52, 0x1b, 0x48, 0x9f, 0x8d, 0x02, 0xe9, 0x63, 0x43, 0x02, 0x69, 0x67, 0x16, 0xbb,
+	0xb3, 0x36, 0x89, 0x06, 0x8e, 0xb0, 0x3a, 0xb9, 0x58, 0x81, 0x3f, 0x0f, 0x3f, 0x27, 0x90, 0x5b,
+	0xfb, 0x09, 0x9f, 0x13, 0xb4, 0x9d, 0x61, 0x1d, 0x1d, 0x90, 0x5a, 0xa0, 0xf2, 0xf5, 0x4c, 0xab,
+	0xf8, 0x60, 0x46, 0xf9, 0x61, 0x28, 0x0e, 0xf6, 0xb6, 0x44, 0xda, 0xc0, 0x35, 0x8f, 0x6b, 0x5e,
+	0x38, 0x2b, 0x46, 0xc5, 0x71, 0x23, 0xd2, 0xb3, 0x51, 0x84, 0x9a, 0x2e, 0x41, 0x0d, 0xcc, 0x5f,
+	0xe4, 0x75, 0x80, 0x7a, 0xd2, 0xdb, 0xd5, 0x37, 0x1d, 0xc4, 0xd2, 0xa3, 0x85, 0x56, 0x9b, 0xb4,
+	0x4b, 0xc4, 0xb2, 0xb9, 0x21, 0x9e, 0xcd, 0xcd, 0xdb, 0xcb, 0x09, 0x47, 0xda, 0xb2, 0x5d, 0xad,
+	0x52, 0xc3, 0xe7, 0xd9, 0x3e, 0xcd, 0x4b, 0x02, 0xf2, 0xcf, 0xb5, 0x41, 0xfe, 0x99, 0x28, 0xc8,
+	0x7f, 0x7e, 0x48, 0x90, 0xf7, 0x1f, 0x70, 0x0e, 0x13, 0xea, 0x3d, 0xd7, 0x80, 0x5e, 0x49, 0xd9,
+	0x3b, 0xa3, 0x2a, 0x2c, 0x7b, 0x48, 0x7f, 0x2e, 0x0d, 0x36, 0x04, 0x72, 0xb0, 0xc1, 0x83, 0x31,
+	0x40, 0xda, 0x99, 0x30, 0x4e, 0xb9, 0x3a, 0x29, 0x99, 0x00, 0xf5, 0xb7, 0x46, 0x5a, 0xc5, 0x07,
+	0x46, 0x94, 0x87, 0x52, 0xdd, 0xf6, 0x41, 0x28, 0x09, 0x62, 0x09, 0xdd, 0xd0, 0xe2, 0xbc, 0xa9,
+	0xcf, 0xf3, 0x07, 0x79, 0x96, 0xed, 0x22, 0xcc, 0x76, 0xd9, 0x4d, 0x0b, 0x69, 0x44, 0x17, 0x28,
+	0xe6, 0xc7, 0x52, 0x12, 0xa7, 0x35, 0xcd, 0x42, 0x74, 0x4d, 0x13, 0xc4, 0xad, 0x39, 0x27, 0xd7,
+	0x3a, 0xc8, 0x24, 0xfc, 0xf0, 0x7c, 0xf2, 0xbc, 0x9a, 0x89, 0x99, 0x3e, 0x77, 0xc3, 0x2b, 0xba,
+	0xeb, 0x93, 0xcb, 0xa4, 0xcc, 0x93, 0xdc, 0xfd, 0x41, 0x06, 0x00, 0x5f, 0xae, 0xf0, 0x40, 0x22,
+	0x35, 0x48, 0xe5, 0x1d, 0x4c, 0x48, 0x25, 0x74, 0xf7, 0xb9, 0x74, 0xab, 0xf8, 0xa3, 0x11, 0xe5,
+	0x60, 0x78, 0xa3, 0xd7, 0x53, 0x58, 0x97, 0xc7, 0xf1, 0x3c, 0x5f, 0xde, 0xdb, 0x23, 0xc3, 0x4b,
+	0x50, 0x1a, 0xc9, 0x17, 0xa6, 0x53, 0x70, 0x6f, 0x3c, 0x14, 0x14, 0x96, 0x85, 0x54, 0x57, 0xe0,
+	0xff, 0x64, 0xc0, 0xd6, 0x8e, 0x6c, 0x7c, 0xb1, 0xde, 0x08, 0x75, 0xcb, 0x25, 0xa8, 0x1c, 0x19,
+	0x8c, 0x58, 0x80, 0xe4, 0xe7, 0xe9, 0x56, 0xf1, 0xb1, 0xb4, 0xf2, 0xf1, 0xd0, 0xe6, 0x0d, 0xf7,
+	0x1b, 0x34, 0x51, 0x92, 0x11, 0x70, 0x27, 0x41, 0x3b, 0x95, 0xde, 0x44, 0x34, 0x68, 0x2e, 0x78,
+	0xd0, 0xe2, 0x7e, 0xc5, 0x30, 0xe9, 0xb4, 0x58, 0x61, 0xbb, 0xa1, 0x0d, 0xec, 0xa0, 0xa6, 0x65,
+	0xfa, 0x2b, 0xdd, 0xb6, 0x86, 0xcf, 0x11, 0xd6, 0x2e, 0xb4, 0x7d, 0x90, 0xeb, 0xe0, 0x91, 0xf8,
+	0xfb, 0x20, 0x02, 0x7e, 0x81, 0x3d, 0x32, 0xf8, 0xbf, 0x19, 0x90, 0xe3, 0x49, 0x15, 0xe1, 0xde,
+	0x38, 0x3b, 0x19, 0xc1, 0x2c, 0x90, 0xca, 0xbe, 0x04, 0x14, 0x02, 0x59, 0x0f, 0x64, 0x5a, 0xc5,
+	0xef, 0xa7, 0x95, 0xfb, 0xbb, 0xb8, 0x0e, 0x1f, 0x57, 0x0c, 0x0f, 0x24, 0x84, 0x0e, 0xa7, 0x97,
+	0xdf, 0xa0, 0x84, 0x66, 0xe0, 0x56, 0x83, 0x8c, 0x91, 0xf8, 0x98, 0xc9, 0xe4, 0xda, 0xf2, 0x52,
+	0x0a, 0xf1, 0xcc, 0x44, 0x33, 0xfb, 0xe1, 0xbe, 0x1e, 0xbb, 0x1f, 0x4c, 0x99, 0x85, 0xe5, 0x70,
+	0xce, 0xd0, 0x15, 0xf8, 0x83, 0x0c, 0xc8, 0xb2, 0x04, 0x95, 0xb0, 0x10, 0x03, 0x4a, 0xc1, 0x6c,
+	0x9a, 0xca, 0xde, 0xf8, 0x04, 0x02, 0x7a, 0x6f, 0xa4, 0x5b, 0xc5, 0x2f, 0xa6, 0x15, 0x3d, 0x1a,
+	0x79, 0x2c, 0xbf, 0xa5, 0xfc, 0xc1, 0xb2, 0x4c, 0xf6, 0x40, 0x1b, 0xad, 0xcc, 0xa2, 0x94, 0xf3,
+	0x0a, 0x54, 0xff, 0x20, 0x40, 0xf5, 0x62, 0x1b, 0xa8, 0xbe, 0x1a, 0x05, 0xaa, 0x47, 0x86, 0x04,
+	0x2a, 0x2e, 0xaa, 0x33, 0x83, 0xa9, 0x9e, 0xcf, 0x6d, 0x99, 0xc2, 0x0a, 0xcb, 0xa1, 0x2c, 0xa1,
+	0x2b, 0xf0, 0x81, 0x2c, 0xd8, 0xd2, 0x96, 0xc5, 0x33, 0xd6, 0xe5, 0x93, 0xe8, 0x34, 0xa4, 0xca,
+	0xf4, 0x20, 0xa4, 0x02, 0x6f, 0x77, 0x67, 0x5a, 0xc5, 0xbf, 0x09, 0x6c, 0x48, 0x74, 0xae, 0xfc,
+	0xba, 0xe0, 0x8e, 0x4e, 0xf8, 0x4d, 0xab, 0xc2, 0x43, 0xb0, 0x49, 0xb4, 0x38, 0x6f, 0x13, 0x5e,
+	0x0f, 0xc9, 0x4c, 0xa7, 0xc8, 0x24, 0x48, 0x73, 0x51, 0x0d, 0x6b, 0x84, 0x83, 0xaa, 0x6e, 0x5a,
+	0x66, 0xbd, 0x59, 0xf7, 0x2a, 0xac, 0x21, 0x70, 0x38, 0x08, 0xec, 0x79, 0x7a, 0x5d, 0x61, 0x8a,
+	0xf7, 0xd3, 0xad, 0x77, 0xa2, 0xf1, 0x93, 0x59, 0xb0, 0x3d, 0x32, 0x55, 0x6a, 0xac, 0x37, 0x1a,
+	0xbd, 0x32, 0xc0, 0x2a, 0xef, 0x1b, 0xbc, 0x01, 0x81, 0xcf, 0x9f, 0xa6, 0x5b, 0xc5, 0xc7, 0xd3,
+	0xca, 0x27, 0x3a, 0x83, 0x3c, 0x99, 0x10, 0x96, 0x5f, 0x21, 0xf4, 0xd2, 0xb9, 0xfa, 0x17, 0x38,
+	0xd8, 0x09, 0x04, 0xfb, 0x18, 0x75, 0x19, 0x84, 0x74, 0x04, 0x85, 0x84, 0xaa, 0x4d, 0xac, 0x25,
+	0x74, 0xbb, 0x5e, 0x6f, 0x5a, 0x32, 0xe4, 0x59, 0x0b, 0xf3, 0x86, 0x7f, 0x5e, 0x1c, 0xca, 0xe7,
+	0x0b, 0xff, 0x2c, 0x0b, 0x36, 0x04, 0x92, 0xde, 0xc6, 0xda, 0x2b, 0xe8, 0xcc, 0xd1, 0xab, 0x5c,
+	0x9d, 0x94, 0x4c, 0xa0, 0xec, 0x89, 0x4c, 0xab, 0xf8, 0xb3, 0xb4, 0xf2, 0x42, 0xaa, 0xe3, 0xd5,
+	0x35, 0xbd, 0x36, 0xe4, 0x68, 0x2e, 0x8e, 0xc4, 0x90, 0xf8, 0x42, 0x15, 0x80, 0x8d, 0xe0, 0xed,
+	0xba, 0x49, 0xb1, 0xa3, 0xa0, 0x39, 0x3c, 0xc3, 0x8f, 0xac, 0x4b, 0x10, 0xc1, 0x2e, 0xbb, 0x80,
+	0x24, 0x6b, 0xd2, 0x9d, 0xb4, 0x2a, 0x3d, 0x23, 0xb6, 0x34, 0x4b, 0xe7, 0xad, 0x8b, 0x1d, 0xde,
+	0x0a, 0x15, 0xb3, 0x8f, 0x52, 0xf6, 0x5f, 0xdb, 0x22, 0xdb, 0x09, 0xb5, 0xe7, 0x15, 0xbb, 0xf3,
+	0xd8, 0x59, 0x34, 0x09, 0x5e, 0x43, 0x6a, 0x2c, 0xa4, 0x1e, 0x80, 0x53, 0xf1, 0x91, 0x2a, 0x53,
+	0x37, 0xc3, 0xff, 0x4c, 0x83, 0x2d, 0x6d, 0x39, 0x89, 0x63, 0xb9, 0xed, 0xe8, 0x44, 0xca, 0xca,
+	0xf4, 0x20, 0xa4, 0x02, 0xb0, 0xff, 0x3a, 0xd2, 0x2a, 0x3e, 0x39, 0xa2, 0x7c, 0xa5, 0xcb, 0x0a,
+	0x05, 0x5b, 0x74, 0x57, 0xd4, 0x40, 0x22, 0xb5, 0x31, 0x92, 0x69, 0x92, 0x63, 0xac, 0x4d, 0x24,
+	0xce, 0xbd, 0x17, 0x3f, 0x4b, 0x0d, 0x3c, 0xd9, 0x31, 0x57, 0xfa, 0xdb, 0xc1, 0x17, 0xc6, 0xb6,
+	0xd7, 0x24, 0xbc, 0xaa, 0xbb, 0xc2, 0xdb, 0x93, 0x49, 0xc3, 0xef, 0x8c, 0x80, 0x1c, 0xff, 0x9f,
+	0xa5, 0x63, 0xad, 0x37, 0x43, 0xff, 0xb1, 0xb5, 0xb2, 0x2f, 0x01, 0x85, 0xdc, 0x7f, 0x4f, 0xb5,
+	0x8a, 0x5f, 0x4c, 0x29, 0x05, 0x4f, 0x99, 0x54, 0xba, 0xf2, 0x0a, 0x05, 0xe9, 0xcc, 0x0a, 0x55,
+	0xb7, 0x8d, 0x66, 0x0d, 0xe7, 0x55, 0x17, 0x4c, 0x74, 0x13, 0x67, 0x83, 0xb3, 0x5f, 0x1a, 0x48,
+	0x7e, 0xa7, 0x03, 0x1f, 0x48, 0x03, 0xeb, 0x85, 0xbd, 0x87, 0xca, 0xbc, 0x41, 0x29, 0x4f, 0x15,
+	0xa2, 0x1e, 0x06, 0xc4, 0xaa, 0xce, 0xfc, 0xda, 0x2b, 0x6f, 0x4c, 0xa4, 0x5e, 0x7b, 0x63, 0x22,
+	0xf5, 0xef, 0x6f, 0x4c, 0xa4, 0x1e, 0x7c, 0x73, 0xe2, 0xa2, 0xd7, 0xde, 0x9c, 0xb8, 0xe8, 0x9f,
+	0xdf, 0x9c, 0xb8, 0xe8, 0xb6, 0x7d, 0xfd, 0xb8, 0x09, 0x32, 0x40, 0x01, 0x48, 0x2a, 0x39, 0xe6,
+	0x2f, 0xf6, 0xff, 0xff, 0x00, 0x4b, 0x81, 0x3f, 0x57, 0x9e, 0x81, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Get existing liquidity pools.
+	LiquidityPools(ctx context.Context, in *QueryLiquidityPoolsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error)
+	// Get specific liquidity pool.
+	LiquidityPool(ctx context.Context, in *QueryLiquidityPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
+	// Get specific liquidity pool corresponding to the pool_coin_denom.
+	LiquidityPoolByPoolCoinDenom(ctx context.Context, in *QueryLiquidityPoolByPoolCoinDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
+	// Get specific liquidity pool corresponding to the reserve account.
+	LiquidityPoolByReserveAcc(ctx context.Context, in *QueryLiquidityPoolByReserveAccRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
+	// Get specific liquidity pool corresponding to the pair of reserve coin denoms.
+	LiquidityPoolByDenoms(ctx context.Context, in *QueryLiquidityPoolByDenomsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
+	// Get all liquidity pools having the reserve coin denom.
+	LiquidityPoolsByDenom(ctx context.Context, in *QueryLiquidityPoolsByDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error)
+	// Get the pool's current batch.
+	LiquidityPoolBatch(ctx context.Context, in *QueryLiquidityPoolBatchRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolBatchResponse, error)
+	// Get all swap messages in the pool's current batch.
+	PoolBatchSwapMsgs(ctx context.Context, in *QueryPoolBatchSwapMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgsResponse, error)
+	// Get a specific swap message in the pool's current batch.
+	PoolBatchSwapMsg(ctx context.Context, in *QueryPoolBatchSwapMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgResponse, error)
+	// Get all deposit messages in the pool's current batch.
+	PoolBatchDepositMsgs(ctx context.Context, in *QueryPoolBatchDepositMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgsResponse, error)
+	// Get a specific deposit message in the pool's current batch.
+	PoolBatchDepositMsg(ctx context.Context, in *QueryPoolBatchDepositMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgResponse, error)
+	// Get all withdraw messages in the pool's current batch.
+	PoolBatchWithdrawMsgs(ctx context.Context, in *QueryPoolBatchWithdrawMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgsResponse, error)
+	// Get a specific withdraw message in the pool's current batch.
+	PoolBatchWithdrawMsg(ctx context.Context, in *QueryPoolBatchWithdrawMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgResponse, error)
+	// Get all positions of the concentrated liquidity pool.
+	LiquidityPoolPositions(ctx context.Context, in *QueryLiquidityPoolPositionsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolPositionsResponse, error)
+	// Simulate a swap order as if it were matched with the swap orders in the current batch of the pool.
+	SimulateSwap(ctx context.Context, in *QuerySimulateSwapRequest, opts ...grpc.CallOption) (*QuerySimulateSwapResponse, error)
+	// Estimate the outcome of depositing coins to the liquidity pool at the current reserves.
+	EstimateDeposit(ctx context.Context, in *QueryEstimateDepositRequest, opts ...grpc.CallOption) (*QueryEstimateDepositResponse, error)
+	// Estimate the outcome of withdrawing pool coin from the liquidity pool at the current reserves.
+	EstimateWithdraw(ctx context.Context, in *QueryEstimateWithdrawRequest, opts ...grpc.CallOption) (*QueryEstimateWithdrawResponse, error)
+	// Get the results of the latest executed batches of the liquidity pool.
+	PoolBatchResults(ctx context.Context, in *QueryPoolBatchResultsRequest, opts ...grpc.CallOption) (*QueryPoolBatchResultsResponse, error)
+	// Get the time-weighted average price of a pair of reserve coins of the liquidity pool.
+	TimeWeightedAveragePrice(ctx context.Context, in *QueryTimeWeightedAveragePriceRequest, opts ...grpc.CallOption) (*QueryTimeWeightedAveragePriceResponse, error)
+	// Get all swap messages requested by the address in the current batches of all liquidity pools.
+	SwapMsgsByRequester(ctx context.Context, in *QuerySwapMsgsByRequesterRequest, opts ...grpc.CallOption) (*QuerySwapMsgsByRequesterResponse, error)
+	// Get all deposit messages requested by the address in the current batches of all liquidity pools.
+	DepositMsgsByDepositor(ctx context.Context, in *QueryDepositMsgsByDepositorRequest, opts ...grpc.CallOption) (*QueryDepositMsgsByDepositorResponse, error)
+	// Get all withdraw messages requested by the address in the current batches of all liquidity pools.
+	WithdrawMsgsByWithdrawer(ctx context.Context, in *QueryWithdrawMsgsByWithdrawerRequest, opts ...grpc.CallOption) (*QueryWithdrawMsgsByWithdrawerResponse, error)
+	// Get the liquidity positions of the pool coins held by the address and escrowed in its pending withdraw messages.
+	LiquidityPositions(ctx context.Context, in *QueryLiquidityPositionsRequest, opts ...grpc.CallOption) (*QueryLiquidityPositionsResponse, error)
+	// Get the reward plans of all liquidity pools or of the liquidity pool.
+	RewardPlans(ctx context.Context, in *QueryRewardPlansRequest, opts ...grpc.CallOption) (*QueryRewardPlansResponse, error)
+	// Get the reward plan.
+	RewardPlan(ctx context.Context, in *QueryRewardPlanRequest, opts ...grpc.CallOption) (*QueryRewardPlanResponse, error)
+	// Get the reward accumulator of the pool coin staked to the liquidity pool.
+	RewardAccumulator(ctx context.Context, in *QueryRewardAccumulatorRequest, opts ...grpc.CallOption) (*QueryRewardAccumulatorResponse, error)
+	// Get the pool coin stakes of the address with their pending rewards.
+	Stakes(ctx context.Context, in *QueryStakesRequest, opts ...grpc.CallOption) (*QueryStakesResponse, error)
+	// Get the bonds of the owner.
+	Bonds(ctx context.Context, in *QueryBondsRequest, opts ...grpc.CallOption) (*QueryBondsResponse, error)
+	// Get the pool coins bonded by the owner for at least the minimum duration.
+	BondedPoolCoins(ctx context.Context, in *QueryBondedPoolCoinsRequest, opts ...grpc.CallOption) (*QueryBondedPoolCoinsResponse, error)
+	// Get the protocol fees collected from the fees of the liquidity pool.
+	CollectedProtocolFees(ctx context.Context, in *QueryCollectedProtocolFeesRequest, opts ...grpc.CallOption) (*QueryCollectedProtocolFeesResponse, error)
+	// Get the swap fee rate and the withdraw fee rate applied to the liquidity pool.
+	PoolFeeRate(ctx context.Context, in *QueryPoolFeeRateRequest, opts ...grpc.CallOption) (*QueryPoolFeeRateResponse, error)
+	// Get the enabled circuit breakers with pagination.
+	CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error)
+	// Get all parameters of the liquidity module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) LiquidityPools(ctx context.Context, in *QueryLiquidityPoolsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error) {
+	out := new(QueryLiquidityPoolsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityPool(ctx context.Context, in *QueryLiquidityPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
+	out := new(QueryLiquidityPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityPoolByPoolCoinDenom(ctx context.Context, in *QueryLiquidityPoolByPoolCoinDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
+	out := new(QueryLiquidityPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByPoolCoinDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityPoolByReserveAcc(ctx context.Context, in *QueryLiquidityPoolByReserveAccRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
+	out := new(QueryLiquidityPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByReserveAcc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityPoolByDenoms(ctx context.Context, in *QueryLiquidityPoolByDenomsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
+	out := new(QueryLiquidityPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityPoolsByDenom(ctx context.Context, in *QueryLiquidityPoolsByDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error) {
+	out := new(QueryLiquidityPoolsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityPoolBatch(ctx context.Context, in *QueryLiquidityPoolBatchRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolBatchResponse, error) {
+	out := new(QueryLiquidityPoolBatchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolBatchSwapMsgs(ctx context.Context, in *QueryPoolBatchSwapMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgsResponse, error) {
+	out := new(QueryPoolBatchSwapMsgsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolBatchSwapMsg(ctx context.Context, in *QueryPoolBatchSwapMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgResponse, error) {
+	out := new(QueryPoolBatchSwapMsgResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolBatchDepositMsgs(ctx context.Context, in *QueryPoolBatchDepositMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgsResponse, error) {
+	out := new(QueryPoolBatchDepositMsgsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolBatchDepositMsg(ctx context.Context, in *QueryPoolBatchDepositMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgResponse, error) {
+	out := new(QueryPoolBatchDepositMsgResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolBatchWithdrawMsgs(ctx context.Context, in *QueryPoolBatchWithdrawMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgsResponse, error) {
+	out := new(QueryPoolBatchWithdrawMsgsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolBatchWithdrawMsg(ctx context.Context, in *QueryPoolBatchWithdrawMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgResponse, error) {
+	out := new(QueryPoolBatchWithdrawMsgResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityPoolPositions(ctx context.Context, in *QueryLiquidityPoolPositionsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolPositionsResponse, error) {
+	out := new(QueryLiquidityPoolPositionsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSwap(ctx context.Context, in *QuerySimulateSwapRequest, opts ...grpc.CallOption) (*QuerySimulateSwapResponse, error) {
+	out := new(QuerySimulateSwapResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/SimulateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateDeposit(ctx context.Context, in *QueryEstimateDepositRequest, opts ...grpc.CallOption) (*QueryEstimateDepositResponse, error) {
+	out := new(QueryEstimateDepositResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/EstimateDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateWithdraw(ctx context.Context, in *QueryEstimateWithdrawRequest, opts ...grpc.CallOption) (*QueryEstimateWithdrawResponse, error) {
+	out := new(QueryEstimateWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/EstimateWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolBatchResults(ctx context.Context, in *QueryPoolBatchResultsRequest, opts ...grpc.CallOption) (*QueryPoolBatchResultsResponse, error) {
+	out := new(QueryPoolBatchResultsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimeWeightedAveragePrice(ctx context.Context, in *QueryTimeWeightedAveragePriceRequest, opts ...grpc.CallOption) (*QueryTimeWeightedAveragePriceResponse, error) {
+	out := new(QueryTimeWeightedAveragePriceResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/TimeWeightedAveragePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapMsgsByRequester(ctx context.Context, in *QuerySwapMsgsByRequesterRequest, opts ...grpc.CallOption) (*QuerySwapMsgsByRequesterResponse, error) {
+	out := new(QuerySwapMsgsByRequesterResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/SwapMsgsByRequester", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositMsgsByDepositor(ctx context.Context, in *QueryDepositMsgsByDepositorRequest, opts ...grpc.CallOption) (*QueryDepositMsgsByDepositorResponse, error) {
+	out := new(QueryDepositMsgsByDepositorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/DepositMsgsByDepositor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawMsgsByWithdrawer(ctx context.Context, in *QueryWithdrawMsgsByWithdrawerRequest, opts ...grpc.CallOption) (*QueryWithdrawMsgsByWithdrawerResponse, error) {
+	out := new(QueryWithdrawMsgsByWithdrawerResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/WithdrawMsgsByWithdrawer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityPositions(ctx context.Context, in *QueryLiquidityPositionsRequest, opts ...grpc.CallOption) (*QueryLiquidityPositionsResponse, error) {
+	out := new(QueryLiquidityPositionsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPlans(ctx context.Context, in *QueryRewardPlansRequest, opts ...grpc.CallOption) (*QueryRewardPlansResponse, error) {
+	out := new(QueryRewardPlansResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/RewardPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPlan(ctx context.Context, in *QueryRewardPlanRequest, opts ...grpc.CallOption) (*QueryRewardPlanResponse, error) {
+	out := new(QueryRewardPlanResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/RewardPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardAccumulator(ctx context.Context, in *QueryRewardAccumulatorRequest, opts ...grpc.CallOption) (*QueryRewardAccumulatorResponse, error) {
+	out := new(QueryRewardAccumulatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/RewardAccumulator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stakes(ctx context.Context, in *QueryStakesRequest, opts ...grpc.CallOption) (*QueryStakesResponse, error) {
+	out := new(QueryStakesResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/Stakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Bonds(ctx context.Context, in *QueryBondsRequest, opts ...grpc.CallOption) (*QueryBondsResponse, error) {
+	out := new(QueryBondsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/Bonds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BondedPoolCoins(ctx context.Context, in *QueryBondedPoolCoinsRequest, opts ...grpc.CallOption) (*QueryBondedPoolCoinsResponse, error) {
+	out := new(QueryBondedPoolCoinsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/BondedPoolCoins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CollectedProtocolFees(ctx context.Context, in *QueryCollectedProtocolFeesRequest, opts ...grpc.CallOption) (*QueryCollectedProtocolFeesResponse, error) {
+	out := new(QueryCollectedProtocolFeesResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/CollectedProtocolFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolFeeRate(ctx context.Context, in *QueryPoolFeeRateRequest, opts ...grpc.CallOption) (*QueryPoolFeeRateResponse, error) {
+	out := new(QueryPoolFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolFeeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error) {
+	out := new(QueryCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/CircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Get existing liquidity pools.
+	LiquidityPools(context.Context, *QueryLiquidityPoolsRequest) (*QueryLiquidityPoolsResponse, error)
+	// Get specific liquidity pool.
+	LiquidityPool(context.Context, *QueryLiquidityPoolRequest) (*QueryLiquidityPoolResponse, error)
+	// Get specific liquidity pool corresponding to the pool_coin_denom.
+	LiquidityPoolByPoolCoinDenom(context.Context, *QueryLiquidityPoolByPoolCoinDenomRequest) (*QueryLiquidityPoolResponse, error)
+	// Get specific liquidity pool corresponding to the reserve account.
+	LiquidityPoolByReserveAcc(context.Context, *QueryLiquidityPoolByReserveAccRequest) (*QueryLiquidityPoolResponse, error)
+	// Get specific liquidity pool corresponding to the pair of reserve coin denoms.
+	LiquidityPoolByDenoms(context.Context, *QueryLiquidityPoolByDenomsRequest) (*QueryLiquidityPoolResponse, error)
+	// Get all liquidity pools having the reserve coin denom.
+	LiquidityPoolsByDenom(context.Context, *QueryLiquidityPoolsByDenomRequest) (*QueryLiquidityPoolsResponse, error)
+	// Get the pool's current batch.
+	LiquidityPoolBatch(context.Context, *QueryLiquidityPoolBatchRequest) (*QueryLiquidityPoolBatchResponse, error)
+	// Get all swap messages in the pool's current batch.
+	PoolBatchSwapMsgs(context.Context, *QueryPoolBatchSwapMsgsRequest) (*QueryPoolBatchSwapMsgsResponse, error)
+	// Get a specific swap message in the pool's current batch.
+	PoolBatchSwapMsg(context.Context, *QueryPoolBatchSwapMsgRequest) (*QueryPoolBatchSwapMsgResponse, error)
+	// Get all deposit messages in the pool's current batch.
+	PoolBatchDepositMsgs(context.Context, *QueryPoolBatchDepositMsgsRequest) (*QueryPoolBatchDepositMsgsResponse, error)
+	// Get a specific deposit message in the pool's current batch.
+	PoolBatchDepositMsg(context.Context, *QueryPoolBatchDepositMsgRequest) (*QueryPoolBatchDepositMsgResponse, error)
+	// Get all withdraw messages in the pool's current batch.
+	PoolBatchWithdrawMsgs(context.Context, *QueryPoolBatchWithdrawMsgsRequest) (*QueryPoolBatchWithdrawMsgsResponse, error)
+	// Get a specific withdraw message in the pool's current batch.
+	PoolBatchWithdrawMsg(context.Context, *QueryPoolBatchWithdrawMsgRequest) (*QueryPoolBatchWithdrawMsgResponse, error)
+	// Get all positions of the concentrated liquidity pool.
+	LiquidityPoolPositions(context.Context, *QueryLiquidityPoolPositionsRequest) (*QueryLiquidityPoolPositionsResponse, error)
+	// Simulate a swap order as if it were matched with the swap orders in the current batch of the pool.
+	SimulateSwap(context.Context, *QuerySimulateSwapRequest) (*QuerySimulateSwapResponse, error)
+	// Estimate the outcome of depositing coins to the liquidity pool at the current reserves.
+	EstimateDeposit(context.Context, *QueryEstimateDepositRequest) (*QueryEstimateDepositResponse, error)
+	// Estimate the outcome of withdrawing pool coin from the liquidity pool at the current reserves.
+	EstimateWithdraw(context.Context, *QueryEstimateWithdrawRequest) (*QueryEstimateWithdrawResponse, error)
+	// Get the results of the latest executed batches of the liquidity pool.
+	PoolBatchResults(context.Context, *QueryPoolBatchResultsRequest) (*QueryPoolBatchResultsResponse, error)
+	// Get the time-weighted average price of a pair of reserve coins of the liquidity pool.
+	TimeWeightedAveragePrice(context.Context, *QueryTimeWeightedAveragePriceRequest) (*QueryTimeWeightedAveragePriceResponse, error)
+	// Get all swap messages requested by the address in the current batches of all liquidity pools.
+	SwapMsgsByRequester(context.Context, *QuerySwapMsgsByRequesterRequest) (*QuerySwapMsgsByRequesterResponse, error)
+	// Get all deposit messages requested by the address in the current batches of all liquidity pools.
+	DepositMsgsByDepositor(context.Context, *QueryDepositMsgsByDepositorRequest) (*QueryDepositMsgsByDepositorResponse, error)
+	// Get all withdraw messages requested by the address in the current batches of all liquidity pools.
+	WithdrawMsgsByWithdrawer(context.Context, *QueryWithdrawMsgsByWithdrawerRequest) (*QueryWithdrawMsgsByWithdrawerResponse, error)
+	// Get the liquidity positions of the pool coins held by the address and escrowed in its pending withdraw messages.
+	LiquidityPositions(context.Context, *QueryLiquidityPositionsRequest) (*QueryLiquidityPositionsResponse, error)
+	// Get the reward plans of all liquidity pools or of the liquidity pool.
+	RewardPlans(context.Context, *QueryRewardPlansRequest) (*QueryRewardPlansResponse, error)
+	// Get the reward plan.
+	RewardPlan(context.Context, *QueryRewardPlanRequest) (*QueryRewardPlanResponse, error)
+	// Get the reward accumulator of the pool coin staked to the liquidity pool.
+	RewardAccumulator(context.Context, *QueryRewardAccumulatorRequest) (*QueryRewardAccumulatorResponse, error)
+	// Get the pool coin stakes of the address with their pending rewards.
+	Stakes(context.Context, *QueryStakesRequest) (*QueryStakesResponse, error)
+	// Get the bonds of the owner.
+	Bonds(context.Context, *QueryBondsRequest) (*QueryBondsResponse, error)
+	// Get the pool coins bonded by the owner for at least the minimum duration.
+	BondedPoolCoins(context.Context, *QueryBondedPoolCoinsRequest) (*QueryBondedPoolCoinsResponse, error)
+	// Get the protocol fees collected from the fees of the liquidity pool.
+	CollectedProtocolFees(context.Context, *QueryCollectedProtocolFeesRequest) (*QueryCollectedProtocolFeesResponse, error)
+	// Get the swap fee rate and the withdraw fee rate applied to the liquidity pool.
+	PoolFeeRate(context.Context, *QueryPoolFeeRateRequest) (*QueryPoolFeeRateResponse, error)
+	// Get the enabled circuit breakers with pagination.
+	CircuitBreakers(context.Context, *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error)
+	// Get all parameters of the liquidity module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) LiquidityPools(ctx context.Context, req *QueryLiquidityPoolsRequest) (*QueryLiquidityPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPools not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPool(ctx context.Context, req *QueryLiquidityPoolRequest) (*QueryLiquidityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPool not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolByPoolCoinDenom(ctx context.Context, req *QueryLiquidityPoolByPoolCoinDenomRequest) (*QueryLiquidityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolByPoolCoinDenom not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolByReserveAcc(ctx context.Context, req *QueryLiquidityPoolByReserveAccRequest) (*QueryLiquidityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolByReserveAcc not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolByDenoms(ctx context.Context, req *QueryLiquidityPoolByDenomsRequest) (*QueryLiquidityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolByDenoms not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolsByDenom(ctx context.Context, req *QueryLiquidityPoolsByDenomRequest) (*QueryLiquidityPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolBatch(ctx context.Context, req *QueryLiquidityPoolBatchRequest) (*QueryLiquidityPoolBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolBatch not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchSwapMsgs(ctx context.Context, req *QueryPoolBatchSwapMsgsRequest) (*QueryPoolBatchSwapMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchSwapMsgs not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchSwapMsg(ctx context.Context, req *QueryPoolBatchSwapMsgRequest) (*QueryPoolBatchSwapMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchSwapMsg not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchDepositMsgs(ctx context.Context, req *QueryPoolBatchDepositMsgsRequest) (*QueryPoolBatchDepositMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchDepositMsgs not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchDepositMsg(ctx context.Context, req *QueryPoolBatchDepositMsgRequest) (*QueryPoolBatchDepositMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchDepositMsg not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchWithdrawMsgs(ctx context.Context, req *QueryPoolBatchWithdrawMsgsRequest) (*QueryPoolBatchWithdrawMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchWithdrawMsgs not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchWithdrawMsg(ctx context.Context, req *QueryPoolBatchWithdrawMsgRequest) (*QueryPoolBatchWithdrawMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchWithdrawMsg not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolPositions(ctx context.Context, req *QueryLiquidityPoolPositionsRequest) (*QueryLiquidityPoolPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolPositions not implemented")
+}
+func (*UnimplementedQueryServer) SimulateSwap(ctx context.Context, req *QuerySimulateSwapRequest) (*QuerySimulateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSwap not implemented")
+}
+func (*UnimplementedQueryServer) EstimateDeposit(ctx context.Context, req *QueryEstimateDepositRequest) (*QueryEstimateDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateDeposit not implemented")
+}
+func (*UnimplementedQueryServer) EstimateWithdraw(ctx context.Context, req *QueryEstimateWithdrawRequest) (*QueryEstimateWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateWithdraw not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchResults(ctx context.Context, req *QueryPoolBatchResultsRequest) (*QueryPoolBatchResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchResults not implemented")
+}
+func (*UnimplementedQueryServer) TimeWeightedAveragePrice(ctx context.Context, req *QueryTimeWeightedAveragePriceRequest) (*QueryTimeWeightedAveragePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeWeightedAveragePrice not implemented")
+}
+func (*UnimplementedQueryServer) SwapMsgsByRequester(ctx context.Context, req *QuerySwapMsgsByRequesterRequest) (*QuerySwapMsgsByRequesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapMsgsByRequester not implemented")
+}
+func (*UnimplementedQueryServer) DepositMsgsByDepositor(ctx context.Context, req *QueryDepositMsgsByDepositorRequest) (*QueryDepositMsgsByDepositorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositMsgsByDepositor not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawMsgsByWithdrawer(ctx context.Context, req *QueryWithdrawMsgsByWithdrawerRequest) (*QueryWithdrawMsgsByWithdrawerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawMsgsByWithdrawer not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPositions(ctx context.Context, req *QueryLiquidityPositionsRequest) (*QueryLiquidityPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPositions not implemented")
+}
+func (*UnimplementedQueryServer) RewardPlans(ctx context.Context, req *QueryRewardPlansRequest) (*QueryRewardPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPlans not implemented")
+}
+func (*UnimplementedQueryServer) RewardPlan(ctx context.Context, req *QueryRewardPlanRequest) (*QueryRewardPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPlan not implemented")
+}
+func (*UnimplementedQueryServer) RewardAccumulator(ctx context.Context, req *QueryRewardAccumulatorRequest) (*QueryRewardAccumulatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardAccumulator not implemented")
+}
+func (*UnimplementedQueryServer) Stakes(ctx context.Context, req *QueryStakesRequest) (*QueryStakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stakes not implemented")
+}
+func (*UnimplementedQueryServer) Bonds(ctx context.Context, req *QueryBondsRequest) (*QueryBondsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bonds not implemented")
+}
+func (*UnimplementedQueryServer) BondedPoolCoins(ctx context.Context, req *QueryBondedPoolCoinsRequest) (*QueryBondedPoolCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondedPoolCoins not implemented")
+}
+func (*UnimplementedQueryServer) CollectedProtocolFees(ctx context.Context, req *QueryCollectedProtocolFeesRequest) (*QueryCollectedProtocolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectedProtocolFees not implemented")
+}
+func (*UnimplementedQueryServer) PoolFeeRate(ctx context.Context, req *QueryPoolFeeRateRequest) (*QueryPoolFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolFeeRate not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakers(ctx context.Context, req *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakers not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_LiquidityPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPools(ctx, req.(*QueryLiquidityPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPool(ctx, req.(*QueryLiquidityPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityPoolByPoolCoinDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolByPoolCoinDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolByPoolCoinDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByPoolCoinDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolByPoolCoinDenom(ctx, req.(*QueryLiquidityPoolByPoolCoinDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityPoolByReserveAcc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolByReserveAccRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolByReserveAcc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByReserveAcc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolByReserveAcc(ctx, req.(*QueryLiquidityPoolByReserveAccRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityPoolByDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolByDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolByDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolByDenoms(ctx, req.(*QueryLiquidityPoolByDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityPoolsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolsByDenom(ctx, req.(*QueryLiquidityPoolsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityPoolBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolBatch(ctx, req.(*QueryLiquidityPoolBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolBatchSwapMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchSwapMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchSwapMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchSwapMsgs(ctx, req.(*QueryPoolBatchSwapMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolBatchSwapMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchSwapMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchSwapMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchSwapMsg(ctx, req.(*QueryPoolBatchSwapMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolBatchDepositMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchDepositMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchDepositMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchDepositMsgs(ctx, req.(*QueryPoolBatchDepositMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolBatchDepositMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchDepositMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchDepositMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchDepositMsg(ctx, req.(*QueryPoolBatchDepositMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolBatchWithdrawMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchWithdrawMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchWithdrawMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchWithdrawMsgs(ctx, req.(*QueryPoolBatchWithdrawMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolBatchWithdrawMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchWithdrawMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchWithdrawMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchWithdrawMsg(ctx, req.(*QueryPoolBatchWithdrawMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityPoolPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolPositions(ctx, req.(*QueryLiquidityPoolPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/SimulateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSwap(ctx, req.(*QuerySimulateSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/EstimateDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateDeposit(ctx, req.(*QueryEstimateDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/EstimateWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateWithdraw(ctx, req.(*QueryEstimateWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolBatchResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchResults(ctx, req.(*QueryPoolBatchResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimeWeightedAveragePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeWeightedAveragePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimeWeightedAveragePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/TimeWeightedAveragePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeWeightedAveragePrice(ctx, req.(*QueryTimeWeightedAveragePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapMsgsByRequester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapMsgsByRequesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapMsgsByRequester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/SwapMsgsByRequester",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapMsgsByRequester(ctx, req.(*QuerySwapMsgsByRequesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositMsgsByDepositor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositMsgsByDepositorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositMsgsByDepositor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/DepositMsgsByDepositor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositMsgsByDepositor(ctx, req.(*QueryDepositMsgsByDepositorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawMsgsByWithdrawer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawMsgsByWithdrawerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawMsgsByWithdrawer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/WithdrawMsgsByWithdrawer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawMsgsByWithdrawer(ctx, req.(*QueryWithdrawMsgsByWithdrawerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPositions(ctx, req.(*QueryLiquidityPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/RewardPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPlans(ctx, req.(*QueryRewardPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/RewardPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPlan(ctx, req.(*QueryRewardPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardAccumulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardAccumulatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardAccumulator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/RewardAccumulator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardAccumulator(ctx, req.(*QueryRewardAccumulatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/Stakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stakes(ctx, req.(*QueryStakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Bonds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBondsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bonds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/Bonds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bonds(ctx, req.(*QueryBondsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BondedPoolCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBondedPoolCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BondedPoolCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/BondedPoolCoins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BondedPoolCoins(ctx, req.(*QueryBondedPoolCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollectedProtocolFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectedProtocolFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectedProtocolFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/CollectedProtocolFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectedProtocolFees(ctx, req.(*QueryCollectedProtocolFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolFeeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolFeeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolFeeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolFeeRate(ctx, req.(*QueryPoolFeeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/CircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakers(ctx, req.(*QueryCircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.liquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LiquidityPools",
+			Handler:    _Query_LiquidityPools_Handler,
+		},
+		{
+			MethodName: "LiquidityPool",
+			Handler:    _Query_LiquidityPool_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolByPoolCoinDenom",
+			Handler:    _Query_LiquidityPoolByPoolCoinDenom_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolByReserveAcc",
+			Handler:    _Query_LiquidityPoolByReserveAcc_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolByDenoms",
+			Handler:    _Query_LiquidityPoolByDenoms_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolsByDenom",
+			Handler:    _Query_LiquidityPoolsByDenom_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolBatch",
+			Handler:    _Query_LiquidityPoolBatch_Handler,
+		},
+		{
+			MethodName: "PoolBatchSwapMsgs",
+			Handler:    _Query_PoolBatchSwapMsgs_Handler,
+		},
+		{
+			MethodName: "PoolBatchSwapMsg",
+			Handler:    _Query_PoolBatchSwapMsg_Handler,
+		},
+		{
+			MethodName: "PoolBatchDepositMsgs",
+			Handler:    _Query_PoolBatchDepositMsgs_Handler,
+		},
+		{
+			MethodName: "PoolBatchDepositMsg",
+			Handler:    _Query_PoolBatchDepositMsg_Handler,
+		},
+		{
+			MethodName: "PoolBatchWithdrawMsgs",
+			Handler:    _Query_PoolBatchWithdrawMsgs_Handler,
+		},
+		{
+			MethodName: "PoolBatchWithdrawMsg",
+			Handler:    _Query_PoolBatchWithdrawMsg_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolPositions",
+			Handler:    _Query_LiquidityPoolPositions_Handler,
+		},
+		{
+			MethodName: "SimulateSwap",
+			Handler:    _Query_SimulateSwap_Handler,
+		},
+		{
+			MethodName: "EstimateDeposit",
+			Handler:    _Query_EstimateDeposit_Handler,
+		},
+		{
+			MethodName: "EstimateWithdraw",
+			Handler:    _Query_EstimateWithdraw_Handler,
+		},
+		{
+			MethodName: "PoolBatchResults",
+			Handler:    _Query_PoolBatchResults_Handler,
+		},
+		{
+			MethodName: "TimeWeightedAveragePrice",
+			Handler:    _Query_TimeWeightedAveragePrice_Handler,
+		},
+		{
+			MethodName: "SwapMsgsByRequester",
+			Handler:    _Query_SwapMsgsByRequester_Handler,
+		},
+		{
+			MethodName: "DepositMsgsByDepositor",
+			Handler:    _Query_DepositMsgsByDepositor_Handler,
+		},
+		{
+			MethodName: "WithdrawMsgsByWithdrawer",
+			Handler:    _Query_WithdrawMsgsByWithdrawer_Handler,
+		},
+		{
+			MethodName: "LiquidityPositions",
+			Handler:    _Query_LiquidityPositions_Handler,
+		},
+		{
+			MethodName: "RewardPlans",
+			Handler:    _Query_RewardPlans_Handler,
+		},
+		{
+			MethodName: "RewardPlan",
+			Handler:    _Query_RewardPlan_Handler,
+		},
+		{
+			MethodName: "RewardAccumulator",
+			Handler:    _Query_RewardAccumulator_Handler,
+		},
+		{
+			MethodName: "Stakes",
+			Handler:    _Query_Stakes_Handler,
+		},
+		{
+			MethodName: "Bonds",
+			Handler:    _Query_Bonds_Handler,
+		},
+		{
+			MethodName: "BondedPoolCoins",
+			Handler:    _Query_BondedPoolCoins_Handler,
+		},
+		{
+			MethodName: "CollectedProtocolFees",
+			Handler:    _Query_CollectedProtocolFees_Handler,
+		},
+		{
+			MethodName: "PoolFeeRate",
+			Handler:    _Query_PoolFeeRate_Handler,
+		},
+		{
+			MethodName: "CircuitBreakers",
+			Handler:    _Query_CircuitBreakers_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/liquidity/v1beta1/query.proto",
+}
+
+func (m *QueryLiquidityPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolByPoolCoinDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolByPoolCoinDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolCoinDenom) > 0 {
+		i -= len(m.PoolCoinDenom)
+		copy(dAtA[i:], m.PoolCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolByReserveAccRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolByReserveAccRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolByReserveAccRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReserveAcc) > 0 {
+		i -= len(m.ReserveAcc)
+		copy(dAtA[i:], m.ReserveAcc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReserveAcc)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolByDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolByDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolByDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TypeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TypeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DenomB) > 0 {
+		i -= len(m.DenomB)
+		copy(dAtA[i:], m.DenomB)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomA) > 0 {
+		i -= len(m.DenomA)
+		copy(dAtA[i:], m.DenomA)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TypeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TypeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TypeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TypeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchSwapMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchSwapMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchSwapMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchSwapMsgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchSwapMsgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchSwapMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchSwapMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchSwapMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchSwapMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Swaps) > 0 {
+		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchSwapMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchSwapMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchSwapMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchDepositMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchDepositMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchDepositMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchDepositMsgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchDepositMsgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchDepositMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchDepositMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchDepositMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchDepositMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchDepositMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchDepositMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchDepositMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchWithdrawMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchWithdrawMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchWithdrawMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdraws) > 0 {
+		for iNdEx := len(m.Withdraws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdraws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchWithdrawMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdraw.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DemandCoinAmount) > 0 {
+		i -= len(m.DemandCoinAmount)
+		copy(dAtA[i:], m.DemandCoinAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DemandCoinAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OrderPrice) > 0 {
+		i -= len(m.OrderPrice)
+		copy(dAtA[i:], m.OrderPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DemandCoinDenom) > 0 {
+		i -= len(m.DemandCoinDenom)
+		copy(dAtA[i:], m.DemandCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DemandCoinDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OfferCoin) > 0 {
+		i -= len(m.OfferCoin)
+		copy(dAtA[i:], m.OfferCoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OfferCoin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SwapTypeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SwapTypeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExchangedCoinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.ExchangedDemandCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.OfferCoinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TransactedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Matched {
+		i--
+		if m.Matched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PriceDirection) > 0 {
+		i -= len(m.PriceDirection)
+		copy(dAtA[i:], m.PriceDirection)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDirection)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.SwapPrice.Size()
+		i -= size
+		if _, err := m.SwapPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositCoins) > 0 {
+		i -= len(m.DepositCoins)
+		copy(dAtA[i:], m.DepositCoins)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositCoins)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RefundedCoins) > 0 {
+		for iNdEx := len(m.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AcceptedCoins) > 0 {
+		for iNdEx := len(m.AcceptedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawCoinDenom) > 0 {
+		i -= len(m.WithdrawCoinDenom)
+		copy(dAtA[i:], m.WithdrawCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawCoinDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PoolCoinAmount) > 0 {
+		i -= len(m.PoolCoinAmount)
+		copy(dAtA[i:], m.PoolCoinAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolCoinAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawFeeCoins) > 0 {
+		for iNdEx := len(m.WithdrawFeeCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawFeeCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.WithdrawCoins) > 0 {
+		for iNdEx := len(m.WithdrawCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BatchResults) > 0 {
+		for iNdEx := len(m.BatchResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeWeightedAveragePriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeWeightedAveragePriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeWeightedAveragePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DenomY) > 0 {
+		i -= len(m.DenomY)
+		copy(dAtA[i:], m.DenomY)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomY)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomX) > 0 {
+		i -= len(m.DenomX)
+		copy(dAtA[i:], m.DenomX)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomX)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeWeightedAveragePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeWeightedAveragePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeWeightedAveragePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DenomY) > 0 {
+		i -= len(m.DenomY)
+		copy(dAtA[i:], m.DenomY)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomY)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomX) > 0 {
+		i -= len(m.DenomX)
+		copy(dAtA[i:], m.DenomX)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomX)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapMsgsByRequesterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapMsgsByRequesterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapMsgsByRequesterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SwapRequesterAddress) > 0 {
+		i -= len(m.SwapRequesterAddress)
+		copy(dAtA[i:], m.SwapRequesterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SwapRequesterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapMsgsByRequesterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapMsgsByRequesterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapMsgsByRequesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Swaps) > 0 {
+		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositMsgsByDepositorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositMsgsByDepositorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositMsgsByDepositorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DepositorAddress) > 0 {
+		i -= len(m.DepositorAddress)
+		copy(dAtA[i:], m.DepositorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositMsgsByDepositorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositMsgsByDepositorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositMsgsByDepositorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawMsgsByWithdrawerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawMsgsByWithdrawerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawMsgsByWithdrawerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawMsgsByWithdrawerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawMsgsByWithdrawerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawMsgsByWithdrawerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdraws) > 0 {
+		for iNdEx := len(m.Withdraws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdraws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawFeeCoins) > 0 {
+		for iNdEx := len(m.WithdrawFeeCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawFeeCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.WithdrawCoins) > 0 {
+		for iNdEx := len(m.WithdrawCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PoolCoinTotalSupply.Size()
+		i -= size
+		if _, err := m.PoolCoinTotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.EscrowedPoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPlansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPlansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPlansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPlansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardAccumulatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardAccumulatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardAccumulatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardAccumulatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardAccumulatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardAccumulatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Accumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bonds) > 0 {
+		for iNdEx := len(m.Bonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondedPoolCoinsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondedPoolCoinsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondedPoolCoinsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n42, err42 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinDuration):])
+	if err42 != nil {
+		return 0, err42
+	}
+	i -= n42
+	i = encodeVarintQuery(dAtA, i, uint64(n42))
+	i--
+	dAtA[i] = 0x12
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondedPoolCoinsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondedPoolCoinsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondedPoolCoinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolCoins) > 0 {
+		for iNdEx := len(m.PoolCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedProtocolFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedProtocolFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedProtocolFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedProtocolFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedProtocolFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedProtocolFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CollectedProtocolFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolFeeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolFeeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolFeeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolFeeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DynamicSwapFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PoolFeeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeWithRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeWithRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeWithRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryLiquidityPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolByReserveAccRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReserveAcc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolByDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomA)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomB)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TypeId != 0 {
+		n += 1 + sovQuery(uint64(m.TypeId))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TypeId != 0 {
+		n += 1 + sovQuery(uint64(m.TypeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Batch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TypeId != 0 {
+		n += 1 + sovQuery(uint64(m.TypeId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolBatchSwapMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchSwapMsgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchSwapMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Swaps) > 0 {
+		for _, e := range m.Swaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchSwapMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Swap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolBatchDepositMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchDepositMsgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchDepositMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchDepositMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolBatchWithdrawMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchWithdrawMsgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchWithdrawMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdraws) > 0 {
+		for _, e := range m.Withdraws {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchWithdrawMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdraw.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityPoolPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.SwapTypeId != 0 {
+		n += 1 + sovQuery(uint64(m.SwapTypeId))
+	}
+	l = len(m.OfferCoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DemandCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DemandCoinAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PriceDirection)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Matched {
+		n += 2
+	}
+	l = m.TransactedCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OfferCoinFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExchangedDemandCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExchangedCoinFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.DepositCoins)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AcceptedCoins) > 0 {
+		for _, e := range m.AcceptedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RefundedCoins) > 0 {
+		for _, e := range m.RefundedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.PoolCoinAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WithdrawCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.WithdrawCoins) > 0 {
+		for _, e := range m.WithdrawCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawFeeCoins) > 0 {
+		for _, e := range m.WithdrawFeeCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolBatchResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BatchResults) > 0 {
+		for _, e := range m.BatchResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimeWeightedAveragePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.DenomX)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomY)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimeWeightedAveragePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomX)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomY)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapMsgsByRequesterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapRequesterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapMsgsByRequesterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Swaps) > 0 {
+		for _, e := range m.Swaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositMsgsByDepositorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositMsgsByDepositorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawMsgsByWithdrawerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawMsgsByWithdrawerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdraws) > 0 {
+		for _, e := range m.Withdraws {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidityPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EscrowedPoolCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PoolCoinTotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Share.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.WithdrawCoins) > 0 {
+		for _, e := range m.WithdrawCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawFeeCoins) > 0 {
+		for _, e := range m.WithdrawFeeCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *QueryRewardPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardAccumulatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryRewardAccumulatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Accumulator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBondsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBondsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bonds) > 0 {
+		for _, e := range m.Bonds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBondedPoolCoinsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBondedPoolCoinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolCoins) > 0 {
+		for _, e := range m.PoolCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCollectedProtocolFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryCollectedProtocolFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollectedProtocolFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolFeeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolFeeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolFeeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DynamicSwapFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCircuitBreakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StakeWithRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolByPoolCoinDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolByPoolCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolByReserveAccRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolByReserveAccRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolByReserveAccRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAcc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAcc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolByDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolByDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolByDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeId", wireType)
+			}
+			m.TypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeId", wireType)
+			}
+			m.TypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeId", wireType)
+			}
+			m.TypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchSwapMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swaps = append(m.Swaps, SwapMsgState{})
+			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchSwapMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, DepositMsgState{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchDepositMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchWithdrawMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchWithdrawMsgRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchWithdrawMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraws", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdraws = append(m.Withdraws, WithdrawMsgState{})
+			if err := m.Withdraws[len(m.Withdraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchWithdrawMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdraw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateSwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapTypeId", wireType)
+			}
+			m.SwapTypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapTypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferCoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandCoinAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDirection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDirection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Matched = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransactedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedDemandCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedDemandCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedCoinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCoins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedCoins = append(m.AcceptedCoins, types.Coin{})
+			if err := m.AcceptedCoins[len(m.AcceptedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedCoins = append(m.RefundedCoins, types.Coin{})
+			if err := m.RefundedCoins[len(m.RefundedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCoinAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawCoins = append(m.WithdrawCoins, types.Coin{})
+			if err := m.WithdrawCoins[len(m.WithdrawCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawFeeCoins = append(m.WithdrawFeeCoins, types.Coin{})
+			if err := m.WithdrawFeeCoins[len(m.WithdrawFeeCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchResults = append(m.BatchResults, PoolBatchResult{})
+			if err := m.BatchResults[len(m.BatchResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeWeightedAveragePriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeWeightedAveragePriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeWeightedAveragePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomX", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomX = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomY = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeWeightedAveragePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeWeightedAveragePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeWeightedAveragePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomX", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomX = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomY = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapMsgsByRequesterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapMsgsByRequesterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapMsgsByRequesterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRequesterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRequesterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapMsgsByRequesterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapMsgsByRequesterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapMsgsByRequesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swaps = append(m.Swaps, SwapMsgState{})
+			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositMsgsByDepositorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositMsgsByDepositorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositMsgsByDepositorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositMsgsByDepositorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositMsgsByDepositorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositMsgsByDepositorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, DepositMsgState{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawMsgsByWithdrawerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawMsgsByWithdrawerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawMsgsByWithdrawerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryPoolBatchWithdrawMsgRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.MsgIndex != 0 {
-		n += 1 + sovQuery(uint64(m.MsgIndex))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryPoolBatchWithdrawMsgsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Withdraws) > 0 {
-		for _, e := range m.Withdraws {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryWithdrawMsgsByWithdrawerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawMsgsByWithdrawerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawMsgsByWithdrawerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraws", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdraws = append(m.Withdraws, WithdrawMsgState{})
+			if err := m.Withdraws[len(m.Withdraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryPoolBatchWithdrawMsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Withdraw.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryLiquidityPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidityPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2905,17 +13947,17 @@ func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidityPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidityPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2925,11 +13967,26 @@ func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, LiquidityPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2951,7 +14008,7 @@ func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *LiquidityPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2974,15 +14031,34 @@ func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3009,7 +14085,176 @@ func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedPoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoinTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawCoins = append(m.WithdrawCoins, types.Coin{})
+			if err := m.WithdrawCoins[len(m.WithdrawCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawFeeCoins = append(m.WithdrawFeeCoins, types.Coin{})
+			if err := m.WithdrawFeeCoins[len(m.WithdrawFeeCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3034,7 +14279,7 @@ func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPlansRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3057,17 +14302,36 @@ func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolByPoolCoinDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPlansRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolByPoolCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3077,23 +14341,27 @@ func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3116,7 +14384,7 @@ func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolByReserveAccRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPlansResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3139,17 +14407,51 @@ func (m *QueryLiquidityPoolByReserveAccRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolByReserveAccRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPlansResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolByReserveAccRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAcc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, RewardPlan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3159,23 +14461,27 @@ func (m *QueryLiquidityPoolByReserveAccRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveAcc = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3198,7 +14504,7 @@ func (m *QueryLiquidityPoolByReserveAccRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolBatchRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3221,17 +14527,17 @@ func (m *QueryLiquidityPoolBatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolBatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPlanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			m.PoolId = 0
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3241,7 +14547,7 @@ func (m *QueryLiquidityPoolBatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3267,7 +14573,7 @@ func (m *QueryLiquidityPoolBatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3290,15 +14596,15 @@ func (m *QueryLiquidityPoolBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3325,7 +14631,7 @@ func (m *QueryLiquidityPoolBatchResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3350,7 +14656,7 @@ func (m *QueryLiquidityPoolBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardAccumulatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3373,17 +14679,17 @@ func (m *QueryLiquidityPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardAccumulatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardAccumulatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3393,28 +14699,11 @@ func (m *QueryLiquidityPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3436,7 +14725,7 @@ func (m *QueryLiquidityPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardAccumulatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3459,49 +14748,15 @@ func (m *QueryLiquidityPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardAccumulatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardAccumulatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pools = append(m.Pools, Pool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3528,10 +14783,7 @@ func (m *QueryLiquidityPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3556,7 +14808,7 @@ func (m *QueryLiquidityPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3579,65 +14831,47 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3664,7 +14898,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3689,7 +14926,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3712,17 +14949,17 @@ func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3732,11 +14969,26 @@ func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakes = append(m.Stakes, StakeWithRewards{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -3767,7 +15019,7 @@ func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3794,7 +15046,7 @@ func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBondsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3817,17 +15069,17 @@ func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBondsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBondsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3837,16 +15089,29 @@ func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.MsgIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3856,11 +15121,28 @@ func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3882,7 +15164,7 @@ func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchSwapMsgsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBondsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3905,15 +15187,15 @@ func (m *QueryPoolBatchSwapMsgsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBondsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBondsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3940,8 +15222,8 @@ func (m *QueryPoolBatchSwapMsgsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Swaps = append(m.Swaps, SwapMsgState{})
-			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bonds = append(m.Bonds, Bond{})
+			if err := m.Bonds[len(m.Bonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4002,7 +15284,7 @@ func (m *QueryPoolBatchSwapMsgsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchSwapMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBondedPoolCoinsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4025,15 +15307,47 @@ func (m *QueryPoolBatchSwapMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBondedPoolCoinsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBondedPoolCoinsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4060,7 +15374,7 @@ func (m *QueryPoolBatchSwapMsgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4085,7 +15399,7 @@ func (m *QueryPoolBatchSwapMsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBondedPoolCoinsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4108,34 +15422,15 @@ func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBondedPoolCoinsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBondedPoolCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4162,10 +15457,8 @@ func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolCoins = append(m.PoolCoins, types.Coin{})
+			if err := m.PoolCoins[len(m.PoolCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4190,7 +15483,7 @@ func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCollectedProtocolFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4213,10 +15506,10 @@ func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollectedProtocolFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollectedProtocolFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4238,25 +15531,6 @@ func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
-			}
-			m.MsgIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4278,7 +15552,7 @@ func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCollectedProtocolFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4301,49 +15575,15 @@ func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollectedProtocolFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollectedProtocolFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Deposits = append(m.Deposits, DepositMsgState{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedProtocolFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4370,10 +15610,7 @@ func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollectedProtocolFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4398,7 +15635,7 @@ func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchDepositMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolFeeRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4421,17 +15658,17 @@ func (m *QueryPoolBatchDepositMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolFeeRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolFeeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4441,25 +15678,11 @@ func (m *QueryPoolBatchDepositMsgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4481,7 +15704,7 @@ func (m *QueryPoolBatchDepositMsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchWithdrawMsgsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolFeeRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4504,17 +15727,17 @@ func (m *QueryPoolBatchWithdrawMsgsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolFeeRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolFeeRate", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4524,14 +15747,28 @@ func (m *QueryPoolBatchWithdrawMsgsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4558,10 +15795,7 @@ func (m *QueryPoolBatchWithdrawMsgsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DynamicSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4586,7 +15820,7 @@ func (m *QueryPoolBatchWithdrawMsgsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchWithdrawMsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4609,10 +15843,10 @@ func (m *QueryPoolBatchWithdrawMsgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4635,10 +15869,10 @@ func (m *QueryPoolBatchWithdrawMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.MsgIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4648,11 +15882,28 @@ func (m *QueryPoolBatchWithdrawMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4674,7 +15925,7 @@ func (m *QueryPoolBatchWithdrawMsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchWithdrawMsgsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4697,15 +15948,15 @@ func (m *QueryPoolBatchWithdrawMsgsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdraws", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4732,8 +15983,8 @@ func (m *QueryPoolBatchWithdrawMsgsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdraws = append(m.Withdraws, WithdrawMsgState{})
-			if err := m.Withdraws[len(m.Withdraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4794,7 +16045,7 @@ func (m *QueryPoolBatchWithdrawMsgsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchWithdrawMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *StakeWithRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4817,15 +16068,15 @@ func (m *QueryPoolBatchWithdrawMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakeWithRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakeWithRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4852,7 +16103,41 @@ func (m *QueryPoolBatchWithdrawMsgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Withdraw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
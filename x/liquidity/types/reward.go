@@ -0,0 +1,168 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// RewardsEscrowAcc is the account escrowing the reward coins of the reward plans.
+var RewardsEscrowAcc = sdk.AccAddress(address.Module(ModuleName, []byte("RewardsEscrowAcc")))
+
+// StakesEscrowAcc is the account escrowing the staked pool coins, apart from the reward coins which may be of the same
+// denom.
+var StakesEscrowAcc = sdk.AccAddress(address.Module(ModuleName, []byte("StakesEscrowAcc")))
+
+// NewRewardAccumulator returns the reward accumulator of the pool with no staked pool coin.
+func NewRewardAccumulator(poolID uint64) RewardAccumulator {
+	return RewardAccumulator{
+		PoolId:      poolID,
+		TotalStaked: sdk.ZeroInt(),
+	}
+}
+
+// NewStake returns the stake of the staker in the pool with no staked pool coin, accruing rewards from the current
+// reward per share of the pool.
+func NewStake(accumulator RewardAccumulator, staker sdk.AccAddress) Stake {
+	return Stake{
+		PoolId:         accumulator.PoolId,
+		StakerAddress:  staker.String(),
+		Amount:         sdk.ZeroInt(),
+		RewardPerShare: accumulator.RewardPerShare,
+	}
+}
+
+// Validate validates RewardPlan.
+func (plan RewardPlan) Validate() error {
+	if plan.Id == 0 {
+		return ErrRewardPlanNotExists
+	}
+	if _, err := sdk.AccAddressFromBech32(plan.FunderAddress); err != nil {
+		return ErrInvalidFunderAddr
+	}
+	if err := plan.RewardCoins.Validate(); err != nil {
+		return err
+	}
+	if err := plan.DistributedCoins.Validate(); err != nil {
+		return err
+	}
+	if err := plan.TruncatedRewards.Validate(); err != nil {
+		return err
+	}
+	if plan.RewardCoins.Empty() || plan.StartHeight <= 0 || plan.EndHeight <= plan.StartHeight ||
+		!plan.RewardCoins.IsAllGTE(plan.DistributedCoins) {
+		return ErrBadRewardPlan
+	}
+	return nil
+}
+
+// GetFunder returns the funder of the reward plan.
+func (plan RewardPlan) GetFunder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(plan.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// RemainingCoins returns the reward coins of the plan not distributed yet.
+func (plan RewardPlan) RemainingCoins() sdk.Coins {
+	return plan.RewardCoins.Sub(plan.DistributedCoins)
+}
+
+// EmissionAt returns the reward coins emitted by the plan at the height, streaming the reward coins evenly over the
+// blocks from the start height to the end height exclusively. The amount A of each reward coin emitted at the height h
+// is ⌊A(h-start+1)/duration⌋ - ⌊A(h-start)/duration⌋, so that the emissions of all blocks sum up to A.
+func (plan RewardPlan) EmissionAt(height int64) sdk.Coins {
+	if height < plan.StartHeight || height >= plan.EndHeight {
+		return sdk.Coins{}
+	}
+	duration := sdk.NewInt(plan.EndHeight - plan.StartHeight)
+	elapsed := sdk.NewInt(height - plan.StartHeight)
+	emission := sdk.Coins{}
+	for _, coin := range plan.RewardCoins {
+		amount := coin.Amount.Mul(elapsed.AddRaw(1)).Quo(duration).Sub(coin.Amount.Mul(elapsed).Quo(duration))
+		emission = emission.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return emission
+}
+
+// Validate validates RewardAccumulator.
+func (accumulator RewardAccumulator) Validate() error {
+	if accumulator.PoolId == 0 || accumulator.TotalStaked.IsNil() || accumulator.TotalStaked.IsNegative() {
+		return ErrBadRewardAccumulator
+	}
+	if err := accumulator.RewardPerShare.Validate(); err != nil {
+		return err
+	}
+	if err := accumulator.TruncatedRewards.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Distribute returns the accumulator with the rewards distributed to the staked pool coins in proportion to their
+// amount, truncated to the precision of the reward per share, and the rewards truncated from the distribution.
+func (accumulator RewardAccumulator) Distribute(rewards sdk.Coins) (RewardAccumulator, sdk.DecCoins) {
+	rewardPerShare := sdk.NewDecCoinsFromCoins(rewards...).QuoDecTruncate(accumulator.TotalStaked.ToDec())
+	accumulator.RewardPerShare = accumulator.RewardPerShare.Add(rewardPerShare...)
+	truncated := sdk.NewDecCoinsFromCoins(rewards...).Sub(rewardPerShare.MulDecTruncate(accumulator.TotalStaked.ToDec()))
+	return accumulator, truncated
+}
+
+// Settle returns the accumulator and the stake with the rewards pending for the stake paid out, and the rewards. The
+// stake accrues rewards from the current reward per share, and the decimal truncated from the rewards is added to the
+// truncated rewards.
+func (accumulator RewardAccumulator) Settle(stake Stake) (RewardAccumulator, Stake, sdk.Coins) {
+	rewards, truncated := stake.accruedRewards(accumulator).TruncateDecimal()
+	accumulator.TruncatedRewards = accumulator.TruncatedRewards.Add(truncated...)
+	stake.RewardPerShare = accumulator.RewardPerShare
+	return accumulator, stake, rewards
+}
+
+// TakeTruncatedRewards returns the accumulator with the decimals of the truncated rewards of the ended plan left over,
+// and the whole truncated rewards of the plan to be refunded to its funder. The rewards truncated from the
+// distributions of the other plans of the pool are not taken.
+func (accumulator RewardAccumulator) TakeTruncatedRewards(plan RewardPlan) (RewardAccumulator, sdk.Coins) {
+	taken, change := plan.TruncatedRewards.TruncateDecimal()
+	accumulator.TruncatedRewards = accumulator.TruncatedRewards.Add(change...)
+	return accumulator, taken
+}
+
+// Validate validates Stake.
+func (stake Stake) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(stake.StakerAddress); err != nil {
+		return ErrInvalidStakerAddr
+	}
+	if stake.Amount.IsNil() || !stake.Amount.IsPositive() {
+		return ErrInsufficientStake
+	}
+	if err := stake.RewardPerShare.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetStaker returns the staker of the stake.
+func (stake Stake) GetStaker() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(stake.StakerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// PendingRewards returns the rewards accrued by the stake since its reward per share was last settled, which is the
+// staked amount times the growth of the reward per share of the accumulator, truncated.
+func (stake Stake) PendingRewards(accumulator RewardAccumulator) sdk.Coins {
+	rewards, _ := stake.accruedRewards(accumulator).TruncateDecimal()
+	return rewards
+}
+
+// accruedRewards returns the decimal rewards accrued by the stake since its reward per share was last settled.
+func (stake Stake) accruedRewards(accumulator RewardAccumulator) sdk.DecCoins {
+	growth, hasNeg := accumulator.RewardPerShare.SafeSub(stake.RewardPerShare)
+	if hasNeg {
+		panic("reward per share of the stake exceeds the reward per share of the accumulator")
+	}
+	return growth.MulDecTruncate(stake.Amount.ToDec())
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestRewardPlan_EmissionAt(t *testing.T) {
+	plan := types.RewardPlan{
+		Id:            1,
+		PoolId:        1,
+		FunderAddress: sdk.AccAddress(crypto.AddressHash([]byte("funder"))).String(),
+		RewardCoins:   sdk.NewCoins(sdk.NewInt64Coin("denomX", 1000), sdk.NewInt64Coin("denomY", 7)),
+		StartHeight:   10,
+		EndHeight:     13,
+	}
+	require.NoError(t, plan.Validate())
+
+	require.True(t, plan.EmissionAt(9).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomX", 333), sdk.NewInt64Coin("denomY", 2)), plan.EmissionAt(10))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomX", 333), sdk.NewInt64Coin("denomY", 2)), plan.EmissionAt(11))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomX", 334), sdk.NewInt64Coin("denomY", 3)), plan.EmissionAt(12))
+	require.True(t, plan.EmissionAt(13).IsZero())
+
+	// the emissions of all blocks sum up to the reward coins
+	total := sdk.NewCoins()
+	for height := plan.StartHeight; height < plan.EndHeight; height++ {
+		total = total.Add(plan.EmissionAt(height)...)
+	}
+	require.Equal(t, plan.RewardCoins, total)
+
+	plan.DistributedCoins = plan.EmissionAt(10)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomX", 667), sdk.NewInt64Coin("denomY", 5)), plan.RemainingCoins())
+}
+
+func TestStake_PendingRewards(t *testing.T) {
+	staker := sdk.AccAddress(crypto.AddressHash([]byte("staker")))
+	accumulator := types.NewRewardAccumulator(1)
+	accumulator.TotalStaked = sdk.NewInt(300)
+	stake := types.NewStake(accumulator, staker)
+	stake.Amount = sdk.NewInt(100)
+	require.True(t, stake.PendingRewards(accumulator).IsZero())
+
+	// 1000 denomX distributed to 300 staked pool coins, of which the stake takes 1/3 truncated
+	accumulator, truncated := accumulator.Distribute(sdk.NewCoins(sdk.NewInt64Coin("denomX", 1000)))
+	require.Equal(t, sdk.MustNewDecFromStr("3.333333333333333333"), accumulator.RewardPerShare.AmountOf("denomX"))
+	require.Equal(t, sdk.MustNewDecFromStr("0.0000000000000001"), truncated.AmountOf("denomX"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomX", 333)), stake.PendingRewards(accumulator))
+
+	// the stake settled at the current reward per share accrues only the later rewards
+	stake.RewardPerShare = accumulator.RewardPerShare
+	accumulator, truncated = accumulator.Distribute(sdk.NewCoins(sdk.NewInt64Coin("denomY", 600)))
+	require.True(t, truncated.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denomY", 200)), stake.PendingRewards(accumulator))
+
+	stake.RewardPerShare = accumulator.RewardPerShare.Add(sdk.NewInt64DecCoin("denomY", 1))
+	require.Panics(t, func() { stake.PendingRewards(accumulator) })
+}
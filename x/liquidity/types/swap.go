@@ -24,6 +24,20 @@ const (
 	Staying
 )
 
+// String returns the name of the price direction.
+func (direction PriceDirection) String() string {
+	switch direction {
+	case Increasing:
+		return "increasing"
+	case Decreasing:
+		return "decreasing"
+	case Staying:
+		return "staying"
+	default:
+		return ""
+	}
+}
+
 // Direction of order
 type OrderDirection int
 
@@ -40,6 +54,13 @@ type Order struct {
 	SwapMsgStates []*SwapMsgState
 }
 
+// SwapRouteHop is the next hop of a swap route queued by the transacted hop, offering the demand coin exchanged by the
+// hop which is held in escrow.
+type SwapRouteHop struct {
+	SwapMsgState  *SwapMsgState
+	ExchangedCoin sdk.Coin
+}
+
 // OrderBook is a list of orders
 type OrderBook []Order
 
@@ -144,14 +165,31 @@ type MatchResult struct {
 }
 
 // The price and coins of swap messages in orderbook are calculated
-// to derive match result with the price direction.
-func (orderBook OrderBook) Match(x, y sdk.Dec) (BatchResult, bool) {
-	currentPrice := x.Quo(y)
+// to derive match result with the price direction. The swap price is kept within the price band of the max price
+// deviation around the current price, unless the max price deviation is zero.
+func (orderBook OrderBook) Match(curve SwapCurve, x, y, maxPriceDeviation sdk.Dec) (BatchResult, bool) {
+	currentPrice := curve.Price(x, y)
 	priceDirection := orderBook.PriceDirection(currentPrice)
 	if priceDirection == Staying {
 		return orderBook.CalculateMatchStay(currentPrice), true
 	}
-	return orderBook.CalculateMatch(priceDirection, x, y)
+	return orderBook.CalculateMatch(curve, priceDirection, x, y, maxPriceDeviation)
+}
+
+// PriceBand returns the lower and the upper bound of the prices deviating from the price by up to the max price
+// deviation.
+func PriceBand(price, maxPriceDeviation sdk.Dec) (lower, upper sdk.Dec) {
+	return price.Mul(sdk.OneDec().Sub(maxPriceDeviation)), price.Mul(sdk.OneDec().Add(maxPriceDeviation))
+}
+
+// IsWithinPriceBand returns whether the order price is within the price band of the max price deviation around the
+// pool price. Any order price is within the price band if the max price deviation is zero.
+func IsWithinPriceBand(orderPrice, poolPrice, maxPriceDeviation sdk.Dec) bool {
+	if maxPriceDeviation.IsNil() || !maxPriceDeviation.IsPositive() {
+		return true
+	}
+	lower, upper := PriceBand(poolPrice, maxPriceDeviation)
+	return orderPrice.GTE(lower) && orderPrice.LTE(upper)
 }
 
 // Check orderbook validity naively
@@ -203,11 +241,19 @@ func (orderBook OrderBook) CalculateMatchStay(currentPrice sdk.Dec) (r BatchResu
 	return
 }
 
-// Calculates the batch results with the logic for each direction
-func (orderBook OrderBook) CalculateMatch(direction PriceDirection, x, y sdk.Dec) (maxScenario BatchResult, found bool) {
-	currentPrice := x.Quo(y)
+// Calculates the batch results with the logic for each direction. The order prices beyond the price band of the max
+// price deviation around the current price are clamped to the band, so the swap price stays within the band. The price
+// levels are evaluated in the price direction until the first exact match, which is the batch result, so the swap
+// curve is not evaluated at the price levels beyond it.
+func (orderBook OrderBook) CalculateMatch(curve SwapCurve, direction PriceDirection, x, y, maxPriceDeviation sdk.Dec) (maxScenario BatchResult, found bool) {
+	currentPrice := curve.Price(x, y)
 	lastOrderPrice := currentPrice
-	var matchScenarios []BatchResult
+	banded := !maxPriceDeviation.IsNil() && maxPriceDeviation.IsPositive()
+	var lowerPrice, upperPrice sdk.Dec
+	if banded {
+		lowerPrice, upperPrice = PriceBand(currentPrice, maxPriceDeviation)
+	}
+	maxScenario = NewBatchResult()
 	start, end, delta := 0, len(orderBook)-1, 1
 	if direction == Decreasing {
 		start, end, delta = end, start, -1
@@ -217,21 +263,37 @@ func (orderBook OrderBook) CalculateMatch(direction PriceDirection, x, y sdk.Dec
 		if (direction == Increasing && order.Price.LT(currentPrice)) ||
 			(direction == Decreasing && order.Price.GT(currentPrice)) {
 			continue
-		} else {
-			orderPrice := order.Price
-			r := orderBook.CalculateSwap(direction, x, y, orderPrice, lastOrderPrice)
-			// Check to see if it exceeds a value that can be a decimal error
-			if (direction == Increasing && r.PoolY.Sub(r.EX.Quo(r.SwapPrice)).GTE(sdk.OneDec())) ||
-				(direction == Decreasing && r.PoolX.Sub(r.EY.Mul(r.SwapPrice)).GTE(sdk.OneDec())) {
-				continue
+		}
+		orderPrice := order.Price
+		if banded {
+			if direction == Increasing && orderPrice.GT(upperPrice) {
+				orderPrice = upperPrice
+			} else if direction == Decreasing && orderPrice.LT(lowerPrice) {
+				orderPrice = lowerPrice
+			}
+			// the order prices beyond the band are all clamped to the same price at the edge of the band
+			if orderPrice.Equal(lastOrderPrice) && !orderPrice.Equal(order.Price) {
+				break
 			}
-			matchScenarios = append(matchScenarios, r)
-			lastOrderPrice = orderPrice
 		}
-	}
-	maxScenario = NewBatchResult()
-	for _, s := range matchScenarios {
+		s := orderBook.CalculateSwap(curve, direction, x, y, orderPrice, lastOrderPrice)
+		// Check to see if it exceeds a value that can be a decimal error
+		if (direction == Increasing && s.PoolY.Sub(s.EX.Quo(s.SwapPrice)).GTE(sdk.OneDec())) ||
+			(direction == Decreasing && s.PoolX.Sub(s.EY.Mul(s.SwapPrice)).GTE(sdk.OneDec())) {
+			continue
+		}
+		lastOrderPrice = orderPrice
+
 		MEX, MEY := orderBook.MustExecutableAmt(s.SwapPrice)
+		if banded {
+			// the orders beyond the band are regarded as the orders at the edge of the band, so they can be
+			// executed partially at the edge
+			if direction == Increasing && s.SwapPrice.Equal(upperPrice) {
+				MEX = sdk.ZeroInt()
+			} else if direction == Decreasing && s.SwapPrice.Equal(lowerPrice) {
+				MEY = sdk.ZeroInt()
+			}
+		}
 		if s.EX.GTE(MEX.ToDec()) && s.EY.GTE(MEY.ToDec()) {
 			if s.MatchType == ExactMatch && s.TransactAmt.IsPositive() {
 				maxScenario = s
@@ -248,16 +310,16 @@ func (orderBook OrderBook) CalculateMatch(direction PriceDirection, x, y sdk.Dec
 }
 
 // CalculateSwap calculates the batch result.
-func (orderBook OrderBook) CalculateSwap(direction PriceDirection, x, y, orderPrice, lastOrderPrice sdk.Dec) BatchResult {
+func (orderBook OrderBook) CalculateSwap(curve SwapCurve, direction PriceDirection, x, y, orderPrice, lastOrderPrice sdk.Dec) BatchResult {
 	r := NewBatchResult()
 	r.OriginalEX, r.OriginalEY = orderBook.ExecutableAmt(lastOrderPrice.Add(orderPrice).Quo(sdk.NewDec(2)))
 	r.EX = r.OriginalEX.ToDec()
 	r.EY = r.OriginalEY.ToDec()
 
-	r.SwapPrice = x.Add(r.EX.MulInt64(2)).Quo(y.Add(r.EY.MulInt64(2))) // P_s = (X + 2EX) / (Y + 2EY)
+	r.SwapPrice = curve.SwapPrice(direction, x, y, r.EX, r.EY)
 
 	if direction == Increasing {
-		r.PoolY = r.SwapPrice.Mul(y).Sub(x).Quo(r.SwapPrice.MulInt64(2)) // (P_s * Y - X / 2P_s)
+		r.PoolY = curve.PoolY(x, y, r.SwapPrice)
 		if lastOrderPrice.LT(r.SwapPrice) && r.SwapPrice.LT(orderPrice) && !r.PoolY.IsNegative() {
 			if r.EX.IsZero() && r.EY.IsZero() {
 				r.MatchType = NoMatch
@@ -266,7 +328,7 @@ func (orderBook OrderBook) CalculateSwap(direction PriceDirection, x, y, orderPr
 			}
 		}
 	} else if direction == Decreasing {
-		r.PoolX = x.Sub(r.SwapPrice.Mul(y)).QuoInt64(2) // (X - P_s * Y) / 2
+		r.PoolX = curve.PoolX(x, y, r.SwapPrice)
 		if orderPrice.LT(r.SwapPrice) && r.SwapPrice.LT(lastOrderPrice) && !r.PoolX.IsNegative() {
 			if r.EX.IsZero() && r.EY.IsZero() {
 				r.MatchType = NoMatch
@@ -283,11 +345,11 @@ func (orderBook OrderBook) CalculateSwap(direction PriceDirection, x, y, orderPr
 		r.SwapPrice = orderPrice
 		// When calculating the Pool value, conservatively Truncated decimal, so Ceil it to reduce the decimal error
 		if direction == Increasing {
-			r.PoolY = r.SwapPrice.Mul(y).Sub(x).Quo(r.SwapPrice.MulInt64(2)) // (P_s * Y - X) / 2P_s
+			r.PoolY = curve.PoolY(x, y, r.SwapPrice)
 			r.EX = sdk.MinDec(r.EX, r.EY.Add(r.PoolY).Mul(r.SwapPrice)).Ceil()
 			r.EY = sdk.MaxDec(sdk.MinDec(r.EY, r.EX.Quo(r.SwapPrice).Sub(r.PoolY)), sdk.ZeroDec()).Ceil()
 		} else if direction == Decreasing {
-			r.PoolX = x.Sub(r.SwapPrice.Mul(y)).QuoInt64(2) // (X - P_s * Y) / 2
+			r.PoolX = curve.PoolX(x, y, r.SwapPrice)
 			r.EY = sdk.MinDec(r.EY, r.EX.Add(r.PoolX).Quo(r.SwapPrice)).Ceil()
 			r.EX = sdk.MaxDec(sdk.MinDec(r.EX, r.EY.Mul(r.SwapPrice).Sub(r.PoolX)), sdk.ZeroDec()).Ceil()
 		}
@@ -295,13 +357,13 @@ func (orderBook OrderBook) CalculateSwap(direction PriceDirection, x, y, orderPr
 	}
 
 	if direction == Increasing {
-		if r.SwapPrice.LT(x.Quo(y)) || r.PoolY.IsNegative() {
+		if r.SwapPrice.LT(curve.Price(x, y)) || r.PoolY.IsNegative() {
 			r.TransactAmt = sdk.ZeroDec()
 		} else {
 			r.TransactAmt = sdk.MinDec(r.EX, r.EY.Add(r.PoolY).Mul(r.SwapPrice))
 		}
 	} else if direction == Decreasing {
-		if r.SwapPrice.GT(x.Quo(y)) || r.PoolX.IsNegative() {
+		if r.SwapPrice.GT(curve.Price(x, y)) || r.PoolX.IsNegative() {
 			r.TransactAmt = sdk.ZeroDec()
 		} else {
 			r.TransactAmt = sdk.MinDec(r.EY, r.EX.Add(r.PoolX).Quo(r.SwapPrice))
@@ -615,3 +677,133 @@ func UpdateSwapMsgStates(x, y sdk.Dec, xToY, yToX []*SwapMsgState, matchResultXt
 
 	return xToY, yToX, x, y, poolXDelta, poolYDelta
 }
+
+// MinDemandOrderPrice returns the order price of the swap order limited so that the order receives at least its min
+// demand coin amount when its whole offer coin is transacted. The demand coin received for the offer coin without the
+// offer coin fee is at least the min demand coin amount at any swap price within the limit, so the order is matched
+// only at the swap prices meeting the minimum and never moves the swap price of the batch by being refunded after the
+// match. A partially transacted order receives the min demand coin amount in proportion to its transacted offer coin.
+// The exact-output order is limited by its demand coin amount instead.
+func MinDemandOrderPrice(msg MsgSwapWithinBatch) sdk.Dec {
+	if msg.SwapTypeId == ExactOutputSwapTypeID || msg.MinDemandCoinAmount.IsNil() || !msg.MinDemandCoinAmount.IsPositive() {
+		return msg.OrderPrice
+	}
+	offerAmt := msg.OfferCoin.Amount.Sub(msg.OfferCoinFee.Amount).ToDec()
+	if !offerAmt.IsPositive() {
+		return msg.OrderPrice
+	}
+	denomX, _ := AlphabeticalDenomPair(msg.OfferCoin.Denom, msg.DemandCoinDenom)
+	if msg.OfferCoin.Denom == denomX {
+		return sdk.MinDec(msg.OrderPrice, offerAmt.QuoTruncate(msg.MinDemandCoinAmount.ToDec()))
+	}
+	return sdk.MaxDec(msg.OrderPrice, msg.MinDemandCoinAmount.ToDec().QuoRoundUp(offerAmt))
+}
+
+// ChargedOfferCoinFee returns the offer coin fee of the remaining offer coin of the swap order at the swap fee rate,
+// bounded by the offer coin fee reserved by the order. The offer coin fee reserved at submission is the highest swap
+// fee the order pays, so the order is charged the lower swap fee rate when the rate falls and keeps its reserved offer
+// coin fee when the rate rises.
+func ChargedOfferCoinFee(sms *SwapMsgState, swapFeeRate sdk.Dec) sdk.Coin {
+	offerCoinFee := GetOfferCoinFee(sms.RemainingOfferCoin, swapFeeRate)
+	if sms.ReservedOfferCoinFee.IsLT(offerCoinFee) {
+		return sms.ReservedOfferCoinFee
+	}
+	return offerCoinFee
+}
+
+// MaxExactOutputSizingRounds is the max number of the rounds resizing the exact-output swap orders to the swap price
+// of the batch. The exact-output orders are only rejected after the last round, until the match is stable.
+const MaxExactOutputSizingRounds = 10
+
+// ExactOutputOfferAmount returns the offer coin amount and the offer coin fee amount of the exact-output swap order
+// needed for the demand coin amount after the swap fee at the swap price, with the offer coin fee in the same ratio to
+// the offer coin as the one reserved by the order.
+func ExactOutputOfferAmount(sms *SwapMsgState, swapPrice sdk.Dec) (sdk.Int, sdk.Int) {
+	feeRatio := sms.Msg.OfferCoinFee.Amount.ToDec().Quo(sms.Msg.OfferCoin.Amount.ToDec())
+	denomX, _ := AlphabeticalDenomPair(sms.Msg.OfferCoin.Denom, sms.Msg.DemandCoinDenom)
+	var offerAmt sdk.Dec
+	if sms.Msg.OfferCoin.Denom == denomX {
+		offerAmt = sms.Msg.DemandCoinAmount.ToDec().Mul(swapPrice).Quo(sdk.OneDec().Sub(feeRatio)).Ceil()
+	} else {
+		offerAmt = sms.Msg.DemandCoinAmount.ToDec().Quo(swapPrice).Quo(sdk.OneDec().Sub(feeRatio)).Ceil()
+	}
+	return offerAmt.TruncateInt(), offerAmt.Mul(feeRatio).TruncateInt()
+}
+
+// SizeExactOutputOrders sizes the remaining offer coins and the reserved offer coin fees of the exact-output swap
+// orders to the ones needed for their demand coin amounts at the swap price of the batch, before the orders are
+// matched. The orders are matched again after each round, as the sized orders move the swap price, and the
+// exact-output orders not fully matched or not able to receive their demand coin amounts at the swap price are
+// rejected. It returns the swap orders to be matched and the rejected exact-output orders.
+func SizeExactOutputOrders(swapMsgStates []*SwapMsgState, denomX, denomY string, curve SwapCurve, X, Y, maxPriceDeviation sdk.Dec, height int64) (orders, rejected []*SwapMsgState) {
+	orders = swapMsgStates
+	for round := 0; ; round++ {
+		orderMap, xToY, yToX := MakeOrderMap(orders, denomX, denomY, false)
+		result, found := orderMap.SortOrderBook().Match(curve, X, Y, maxPriceDeviation)
+		if !found {
+			return orders, rejected
+		}
+		matchResultMap := make(map[uint64]MatchResult)
+		if result.MatchType != NoMatch {
+			matchResultXtoY, _, _ := FindOrderMatch(DirectionXtoY, xToY, result.EX, result.SwapPrice, height)
+			matchResultYtoX, _, _ := FindOrderMatch(DirectionYtoX, yToX, result.EY, result.SwapPrice, height)
+			for _, match := range append(matchResultXtoY, matchResultYtoX...) {
+				matchResultMap[match.SwapMsgState.MsgIndex] = match
+			}
+		}
+
+		changed := false
+		var next []*SwapMsgState
+		for _, sms := range orders {
+			if sms.Msg.SwapTypeId != ExactOutputSwapTypeID {
+				next = append(next, sms)
+				continue
+			}
+			match, matched := matchResultMap[sms.MsgIndex]
+			if !matched {
+				rejected = append(rejected, sms)
+				changed = true
+				continue
+			}
+			offerAmt, offerCoinFeeAmt := ExactOutputOfferAmount(sms, result.SwapPrice)
+			switch {
+			case offerAmt.GT(sms.RemainingOfferCoin.Amount):
+				rejected = append(rejected, sms)
+				changed = true
+				continue
+			case offerAmt.LT(sms.RemainingOfferCoin.Amount) && round < MaxExactOutputSizingRounds:
+				sms.RemainingOfferCoin.Amount = offerAmt
+				sms.ReservedOfferCoinFee.Amount = sdk.MinInt(offerCoinFeeAmt, sms.ReservedOfferCoinFee.Amount)
+				changed = true
+			case match.TransactedCoinAmt.LT(sms.RemainingOfferCoin.Amount.ToDec()):
+				rejected = append(rejected, sms)
+				changed = true
+				continue
+			}
+			next = append(next, sms)
+		}
+		orders = next
+		if !changed {
+			return orders, rejected
+		}
+	}
+}
+
+// WeightedReserves returns the virtual reserves of a pair of weighted reserve coins, whose pool price x'/y' equals
+// the weighted pool price (x/wX)/(y/wY). The ESPM pool amounts derived from the virtual reserves keep the post-trade
+// weighted pool price equal to the swap price, as the pool amounts derived from x and y do for an unweighted pair.
+func WeightedReserves(x, y, weightX, weightY sdk.Dec) (sdk.Dec, sdk.Dec) {
+	if weightX.Equal(weightY) {
+		return x, y
+	}
+	totalWeight := weightX.Add(weightY)
+	return x.Mul(weightY).MulInt64(2).Quo(totalWeight), y.Mul(weightX).MulInt64(2).Quo(totalWeight)
+}
+
+// WeightedPoolPrice returns the pool price (x/wX)/(y/wY) of a pair of weighted reserve coins.
+func WeightedPoolPrice(x, y, weightX, weightY sdk.Dec) sdk.Dec {
+	if weightX.Equal(weightY) {
+		return x.Quo(y)
+	}
+	return x.Mul(weightY).Quo(y.Mul(weightX))
+}
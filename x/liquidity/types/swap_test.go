@@ -86,7 +86,7 @@ func TestSwapScenario(t *testing.T) {
 
 	// The price and coins of swap messages in orderbook are calculated
 	// to derive match result with the price direction.
-	result, found := orderBook.Match(X.ToDec(), Y.ToDec())
+	result, found := orderBook.Match(types.ConstantProductCurve{}, X.ToDec(), Y.ToDec(), sdk.ZeroDec())
 	require.True(t, found)
 	require.NotEqual(t, types.NoMatch, result.MatchType)
 
@@ -439,8 +439,8 @@ func TestComputePriceDirection(t *testing.T) {
 
 	poolPrice := X.Quo(Y)
 	direction := orderBook.PriceDirection(poolPrice)
-	result, found := orderBook.Match(X, Y)
-	result2, found2 := orderBook.CalculateMatch(direction, X, Y)
+	result, found := orderBook.Match(types.ConstantProductCurve{}, X, Y, sdk.ZeroDec())
+	result2, found2 := orderBook.CalculateMatch(types.ConstantProductCurve{}, direction, X, Y, sdk.ZeroDec())
 	require.Equal(t, found2, found)
 	require.Equal(t, result2, result)
 
@@ -472,8 +472,8 @@ func TestComputePriceDirection(t *testing.T) {
 
 	poolPrice = X.Quo(Y)
 	direction = orderBook.PriceDirection(poolPrice)
-	result, found = orderBook.Match(X, Y)
-	result2, found2 = orderBook.CalculateMatch(direction, X, Y)
+	result, found = orderBook.Match(types.ConstantProductCurve{}, X, Y, sdk.ZeroDec())
+	result2, found2 = orderBook.CalculateMatch(types.ConstantProductCurve{}, direction, X, Y, sdk.ZeroDec())
 	require.Equal(t, found2, found)
 	require.Equal(t, result2, result)
 
@@ -492,11 +492,56 @@ func TestComputePriceDirection(t *testing.T) {
 	Y = orderMap[a.String()].SellOfferAmt.ToDec()
 	poolPrice = X.Quo(Y)
 
-	result, _ = orderBook.Match(X, Y)
+	result, _ = orderBook.Match(types.ConstantProductCurve{}, X, Y, sdk.ZeroDec())
 	result2 = orderBook.CalculateMatchStay(poolPrice)
 	require.Equal(t, result2, result)
 }
 
+func TestPriceBand(t *testing.T) {
+	lower, upper := types.PriceBand(sdk.NewDec(2), sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, sdk.NewDecWithPrec(18, 1), lower)
+	require.Equal(t, sdk.NewDecWithPrec(22, 1), upper)
+
+	require.True(t, types.IsWithinPriceBand(sdk.NewDec(100), sdk.NewDec(2), sdk.ZeroDec()))
+	require.True(t, types.IsWithinPriceBand(sdk.NewDecWithPrec(18, 1), sdk.NewDec(2), sdk.NewDecWithPrec(1, 1)))
+	require.True(t, types.IsWithinPriceBand(sdk.NewDecWithPrec(22, 1), sdk.NewDec(2), sdk.NewDecWithPrec(1, 1)))
+	require.False(t, types.IsWithinPriceBand(sdk.NewDecWithPrec(179, 2), sdk.NewDec(2), sdk.NewDecWithPrec(1, 1)))
+	require.False(t, types.IsWithinPriceBand(sdk.NewDecWithPrec(221, 2), sdk.NewDec(2), sdk.NewDecWithPrec(1, 1)))
+}
+
+func TestCalculateMatchPriceBand(t *testing.T) {
+	X, Y := sdk.NewDec(1000000), sdk.NewDec(1000000)
+	maxPriceDeviation := sdk.NewDecWithPrec(1, 1)
+	lower, upper := types.PriceBand(X.Quo(Y), maxPriceDeviation)
+
+	for _, tc := range []struct {
+		order     types.Order
+		direction types.PriceDirection
+	}{
+		{types.Order{Price: sdk.NewDec(10), BuyOfferAmt: sdk.NewInt(100000), SellOfferAmt: sdk.ZeroInt()}, types.Increasing},
+		{types.Order{Price: sdk.NewDecWithPrec(1, 1), BuyOfferAmt: sdk.ZeroInt(), SellOfferAmt: sdk.NewInt(100000)}, types.Decreasing},
+	} {
+		orderBook := types.OrderBook{tc.order}
+		require.Equal(t, tc.direction, orderBook.PriceDirection(X.Quo(Y)))
+
+		// the absurd order price moves the swap price beyond the price band without the max price deviation
+		result, found := orderBook.Match(types.ConstantProductCurve{}, X, Y, sdk.ZeroDec())
+		require.True(t, found)
+		require.False(t, types.IsWithinPriceBand(result.SwapPrice, X.Quo(Y), maxPriceDeviation))
+
+		// the swap price is clamped to the edge of the price band
+		result, found = orderBook.Match(types.ConstantProductCurve{}, X, Y, maxPriceDeviation)
+		require.True(t, found)
+		require.Equal(t, tc.direction, result.PriceDirection)
+		if tc.direction == types.Increasing {
+			require.Equal(t, upper, result.SwapPrice)
+		} else {
+			require.Equal(t, lower, result.SwapPrice)
+		}
+		require.True(t, result.TransactAmt.IsPositive())
+	}
+}
+
 func TestCalculateMatchStay(t *testing.T) {
 	currentPrice := sdk.MustNewDecFromStr("1.0")
 	orderBook := types.OrderBook{
@@ -679,3 +724,93 @@ func TestCountFractionalMatchedMsgs(t *testing.T) {
 		require.Equal(t, tc.cnt, types.CountFractionalMatchedMsgs(tc.msgs))
 	}
 }
+
+func TestSizeExactOutputOrders(t *testing.T) {
+	curve := types.ConstantProductCurve{}
+	X, Y := sdk.NewDec(1000000), sdk.NewDec(1000000)
+	newOrder := func(msgIndex uint64, swapTypeID uint32, demandCoinAmt int64) *types.SwapMsgState {
+		msg := &types.MsgSwapWithinBatch{
+			SwapTypeId:       swapTypeID,
+			OfferCoin:        sdk.NewInt64Coin(DenomX, 10000),
+			OfferCoinFee:     sdk.NewInt64Coin(DenomX, 15),
+			DemandCoinDenom:  DenomY,
+			OrderPrice:       sdk.MustNewDecFromStr("1.1"),
+			DemandCoinAmount: sdk.NewInt(demandCoinAmt),
+		}
+		return &types.SwapMsgState{
+			MsgIndex:             msgIndex,
+			OrderExpiryHeight:    1,
+			ExchangedOfferCoin:   sdk.NewInt64Coin(DenomX, 0),
+			RemainingOfferCoin:   msg.OfferCoin,
+			ReservedOfferCoinFee: msg.OfferCoinFee,
+			Msg:                  msg,
+		}
+	}
+	orders := []*types.SwapMsgState{
+		newOrder(1, types.DefaultSwapTypeID, 0),
+		newOrder(2, types.ExactOutputSwapTypeID, 5000),
+		newOrder(3, types.ExactOutputSwapTypeID, 9990),
+	}
+
+	// the exact-output order not able to receive its demand coin amount is rejected, and the other one is sized
+	sized, rejected := types.SizeExactOutputOrders(orders, DenomX, DenomY, curve, X, Y, sdk.ZeroDec(), 1)
+	require.Len(t, sized, 2)
+	require.Len(t, rejected, 1)
+	require.Equal(t, uint64(3), rejected[0].MsgIndex)
+	require.Equal(t, sdk.NewInt(10000), sized[0].RemainingOfferCoin.Amount)
+	require.True(t, sized[1].RemainingOfferCoin.Amount.LT(sdk.NewInt(10000)))
+	require.True(t, sized[1].ReservedOfferCoinFee.Amount.LT(sdk.NewInt(15)))
+
+	// the sized order is fully matched at the swap price of the sized orders, receiving its demand coin amount
+	orderMap, xToY, _ := types.MakeOrderMap(sized, DenomX, DenomY, false)
+	result, found := orderMap.SortOrderBook().Match(curve, X, Y, sdk.ZeroDec())
+	require.True(t, found)
+	matchResults, _, _ := types.FindOrderMatch(types.DirectionXtoY, xToY, result.EX, result.SwapPrice, 1)
+	require.Len(t, matchResults, 2)
+	for _, match := range matchResults {
+		require.Equal(t, match.SwapMsgState.RemainingOfferCoin.Amount.ToDec(), match.TransactedCoinAmt)
+		if match.SwapMsgState.MsgIndex == 2 {
+			require.True(t, match.ExchangedDemandCoinAmt.Sub(match.ExchangedCoinFeeAmt).GTE(sdk.NewDec(5000)))
+			offerAmt, _ := types.ExactOutputOfferAmount(match.SwapMsgState, result.SwapPrice)
+			require.Equal(t, offerAmt, match.SwapMsgState.RemainingOfferCoin.Amount)
+		}
+	}
+}
+
+func TestMinDemandOrderPrice(t *testing.T) {
+	offerCoin := sdk.NewInt64Coin(DenomX, 10000)
+	msg := types.MsgSwapWithinBatch{
+		SwapTypeId:          types.DefaultSwapTypeID,
+		OfferCoin:           offerCoin,
+		OfferCoinFee:        sdk.NewInt64Coin(DenomX, 25),
+		DemandCoinDenom:     DenomY,
+		OrderPrice:          sdk.MustNewDecFromStr("1.1"),
+		MinDemandCoinAmount: sdk.NewInt(9900),
+	}
+
+	// the order price of the X to Y order is lowered so that the offer coin without the fee buys the minimum
+	orderPrice := types.MinDemandOrderPrice(msg)
+	require.True(t, orderPrice.LT(msg.OrderPrice))
+	require.True(t, sdk.NewDec(9975).Quo(orderPrice).TruncateInt().GTE(msg.MinDemandCoinAmount))
+
+	// the order price already meeting the minimum is not changed
+	msg.MinDemandCoinAmount = sdk.NewInt(9000)
+	require.Equal(t, msg.OrderPrice, types.MinDemandOrderPrice(msg))
+	msg.MinDemandCoinAmount = sdk.Int{}
+	require.Equal(t, msg.OrderPrice, types.MinDemandOrderPrice(msg))
+
+	// the order price of the Y to X order is raised so that the offer coin without the fee buys the minimum
+	msg.OfferCoin = sdk.NewInt64Coin(DenomY, 10000)
+	msg.OfferCoinFee = sdk.NewInt64Coin(DenomY, 25)
+	msg.DemandCoinDenom = DenomX
+	msg.OrderPrice = sdk.MustNewDecFromStr("0.9")
+	msg.MinDemandCoinAmount = sdk.NewInt(9900)
+	orderPrice = types.MinDemandOrderPrice(msg)
+	require.True(t, orderPrice.GT(msg.OrderPrice))
+	require.True(t, sdk.NewDec(9975).Mul(orderPrice).TruncateInt().GTE(msg.MinDemandCoinAmount))
+
+	// the exact-output order is limited by its demand coin amount instead
+	msg.SwapTypeId = types.ExactOutputSwapTypeID
+	msg.DemandCoinAmount = sdk.NewInt(5000)
+	require.Equal(t, msg.OrderPrice, types.MinDemandOrderPrice(msg))
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPairAccumulator returns the accumulator of the pair of reserve coins sorted alphabetically.
+func (accumulator PriceAccumulator) GetPairAccumulator(denomX, denomY string) (PairPriceAccumulator, bool) {
+	for _, pair := range accumulator.PairAccumulators {
+		if pair.DenomX == denomX && pair.DenomY == denomY {
+			return pair, true
+		}
+	}
+	return PairPriceAccumulator{}, false
+}
+
+// CumulativePriceAt returns the cumulative price of the pair at the given time not before the update of the
+// accumulator, accumulating the last price for the seconds elapsed since the update.
+func (pair PairPriceAccumulator) CumulativePriceAt(updateTime, t time.Time) sdk.Dec {
+	return pair.CumulativePrice.Add(pair.LastPrice.Mul(ElapsedSeconds(updateTime, t)))
+}
+
+// ElapsedSeconds returns the seconds elapsed from the start time to the end time with the precision of nanoseconds,
+// zero if the end time is not after the start time.
+func ElapsedSeconds(startTime, endTime time.Time) sdk.Dec {
+	if !endTime.After(startTime) {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecWithPrec(endTime.Sub(startTime).Nanoseconds(), 9)
+}
@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+func TestElapsedSeconds(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, sdk.NewDec(10), types.ElapsedSeconds(startTime, startTime.Add(10*time.Second)))
+	require.Equal(t, sdk.MustNewDecFromStr("0.000000001"), types.ElapsedSeconds(startTime, startTime.Add(time.Nanosecond)))
+	require.Equal(t, sdk.ZeroDec(), types.ElapsedSeconds(startTime, startTime))
+	require.Equal(t, sdk.ZeroDec(), types.ElapsedSeconds(startTime, startTime.Add(-time.Second)))
+}
+
+func TestPriceAccumulator(t *testing.T) {
+	updateTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	accumulator := types.PriceAccumulator{
+		PoolId:     1,
+		BatchIndex: 1,
+		Time:       updateTime,
+		PairAccumulators: []types.PairPriceAccumulator{
+			{DenomX: "denomA", DenomY: "denomB", LastPrice: sdk.MustNewDecFromStr("1.5"), CumulativePrice: sdk.NewDec(100)},
+			{DenomX: "denomA", DenomY: "denomC", LastPrice: sdk.NewDec(2), CumulativePrice: sdk.NewDec(200)},
+		},
+	}
+
+	pair, found := accumulator.GetPairAccumulator("denomA", "denomC")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), pair.LastPrice)
+	_, found = accumulator.GetPairAccumulator("denomB", "denomA")
+	require.False(t, found)
+
+	pair, _ = accumulator.GetPairAccumulator("denomA", "denomB")
+	require.Equal(t, sdk.NewDec(100), pair.CumulativePriceAt(updateTime, updateTime))
+	require.Equal(t, sdk.NewDec(115), pair.CumulativePriceAt(updateTime, updateTime.Add(10*time.Second)))
+}
@@ -11,19 +11,24 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,10 +41,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
 type MsgCreatePool struct {
 	PoolCreatorAddress string `protobuf:"bytes,1,opt,name=pool_creator_address,json=poolCreatorAddress,proto3" json:"pool_creator_address,omitempty" yaml:"pool_creator_address"`
-	// id of the target pool type, must match the value in the pool. Only pool-type-id 1 is supported.
+	// id of the target pool type, must match the value in the pool. Supported pool-type-ids are listed in the PoolTypes param.
 	PoolTypeId uint32 `protobuf:"varint,2,opt,name=pool_type_id,json=poolTypeId,proto3" json:"pool_type_id,omitempty" yaml:"pool_type_id"`
 	// reserve coin pair of the pool to deposit.
 	DepositCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit_coins,json=depositCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit_coins" yaml:"deposit_coins"`
+	// weights of the reserve coins in the same order as deposit_coins, only for the weighted pool type. The weights must sum up to 100.
+	ReserveCoinWeights []uint32 `protobuf:"varint,5,rep,packed,name=reserve_coin_weights,json=reserveCoinWeights,proto3" json:"reserve_coin_weights,omitempty" yaml:"reserve_coin_weights"`
+	// amplification coefficient of the StableSwap curve, only for the stable pool type. The StableSwapAmplification param
+	// is used if zero.
+	Amplification uint32 `protobuf:"varint,6,opt,name=amplification,proto3" json:"amplification,omitempty" yaml:"amplification"`
 }
 
 func (m *MsgCreatePool) Reset()         { *m = MsgCreatePool{} }
@@ -211,6 +221,9 @@ type MsgWithdrawWithinBatch struct {
 	// id of the target pool
 	PoolId   uint64     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
 	PoolCoin types.Coin `protobuf:"bytes,3,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin" yaml:"pool_coin"`
+	// denom of the reserve coin in which the whole pool coin is withdrawn, only for the weighted pool type. Every reserve
+	// coin is withdrawn in proportion if empty.
+	WithdrawCoinDenom string `protobuf:"bytes,4,opt,name=withdraw_coin_denom,json=withdrawCoinDenom,proto3" json:"withdraw_coin_denom,omitempty" yaml:"withdraw_coin_denom"`
 }
 
 func (m *MsgWithdrawWithinBatch) Reset()         { *m = MsgWithdrawWithinBatch{} }
@@ -297,9 +310,9 @@ var xxx_messageInfo_MsgWithdrawWithinBatchResponse proto.InternalMessageInfo
 type MsgSwapWithinBatch struct {
 	// address of swap requester
 	SwapRequesterAddress string `protobuf:"bytes,1,opt,name=swap_requester_address,json=swapRequesterAddress,proto3" json:"swap_requester_address,omitempty" yaml:"swap_requester_address"`
-	// id of swap type, must match the value in the pool. Only `swap_type_id` 1 is supported.
+	// id of the liquidity pool
 	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
-	// id of swap type. Must match the value in the pool.
+	// id of swap type, 1 for the exact-input swap and 2 for the exact-output swap.
 	SwapTypeId uint32 `protobuf:"varint,3,opt,name=swap_type_id,json=swapTypeId,proto3" json:"swap_type_id,omitempty" yaml:"swap_type_id"`
 	// offer sdk.coin for the swap request, must match the denom in the pool.
 	OfferCoin types.Coin `protobuf:"bytes,4,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
@@ -311,6 +324,15 @@ type MsgSwapWithinBatch struct {
 	// where X is the amount of the first coin and Y is the amount
 	// of the second coin when their denoms are sorted alphabetically.
 	OrderPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=order_price,json=orderPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"order_price" yaml:"order_price"`
+	// lifespan of the order in blocks, the order is carried forward to the following batches until the end of the
+	// batch at or after the lifespan. 0 expires the order at the end of the current batch.
+	OrderLifespan uint32 `protobuf:"varint,8,opt,name=order_lifespan,json=orderLifespan,proto3" json:"order_lifespan,omitempty" yaml:"order_lifespan"`
+	// minimum amount of demand coin to be received after the swap fee when the whole offer coin is transacted, limiting
+	// the order price so that the order is matched only at the swap prices meeting it. 0 does not limit the amount.
+	MinDemandCoinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_demand_coin_amount,json=minDemandCoinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_demand_coin_amount" yaml:"min_demand_coin_amount"`
+	// exact amount of demand coin to be received after the swap fee by the exact-output swap of `swap_type_id` 2, for
+	// which the offer coin is the maximum amount to be offered.
+	DemandCoinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=demand_coin_amount,json=demandCoinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"demand_coin_amount" yaml:"demand_coin_amount"`
 }
 
 func (m *MsgSwapWithinBatch) Reset()         { *m = MsgSwapWithinBatch{} }
@@ -383,750 +405,5864 @@ func (m *MsgSwapWithinBatchResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapWithinBatchResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreatePool)(nil), "tendermint.liquidity.v1beta1.MsgCreatePool")
-	proto.RegisterType((*MsgCreatePoolResponse)(nil), "tendermint.liquidity.v1beta1.MsgCreatePoolResponse")
-	proto.RegisterType((*MsgDepositWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgDepositWithinBatch")
-	proto.RegisterType((*MsgDepositWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgDepositWithinBatchResponse")
-	proto.RegisterType((*MsgWithdrawWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgWithdrawWithinBatch")
-	proto.RegisterType((*MsgWithdrawWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgWithdrawWithinBatchResponse")
-	proto.RegisterType((*MsgSwapWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgSwapWithinBatch")
-	proto.RegisterType((*MsgSwapWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgSwapWithinBatchResponse")
+// `MsgDepositToRange` defines an sdk.Msg type that supports submitting a deposit of the reserve coins to a price range
+// of the concentrated liquidity pool. The deposit is processed immediately, creating a position with the liquidity
+// the deposit coins provide within the price range at the current pool price. The rest of the deposit coins is refunded.
+//
+// See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgDepositToRange struct {
+	DepositorAddress string `protobuf:"bytes,1,opt,name=depositor_address,json=depositorAddress,proto3" json:"depositor_address,omitempty" yaml:"depositor_address"`
+	// id of the target pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// lower bound of the price range, the price is the exchange ratio of X/Y
+	LowerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=lower_price,json=lowerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_price" yaml:"lower_price"`
+	// upper bound of the price range, the price is the exchange ratio of X/Y
+	UpperPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=upper_price,json=upperPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_price" yaml:"upper_price"`
+	// reserve coins to deposit, at most the amounts are accepted
+	DepositCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=deposit_coins,json=depositCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit_coins" yaml:"deposit_coins"`
 }
 
-func init() {
-	proto.RegisterFile("tendermint/liquidity/v1beta1/tx.proto", fileDescriptor_deae1e5d4eb3529c)
+func (m *MsgDepositToRange) Reset()         { *m = MsgDepositToRange{} }
+func (m *MsgDepositToRange) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToRange) ProtoMessage()    {}
+func (*MsgDepositToRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{8}
 }
-
-var fileDescriptor_deae1e5d4eb3529c = []byte{
-	// 1152 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x97, 0xcf, 0x6f, 0xdb, 0x54,
-	0x1c, 0xc0, 0xe3, 0x26, 0xeb, 0x8f, 0xb7, 0xb6, 0xac, 0x5e, 0xb7, 0x65, 0x61, 0x8b, 0xad, 0x27,
-	0x0d, 0x05, 0xb1, 0x3a, 0x89, 0x9d, 0xb4, 0x4d, 0xe1, 0xe2, 0x24, 0x2d, 0x22, 0xa8, 0xd2, 0x30,
-	0x43, 0x0c, 0x10, 0x8a, 0x5c, 0xfb, 0x35, 0x35, 0x4b, 0xde, 0x73, 0xfd, 0x9c, 0xb6, 0x01, 0xed,
-	0x8a, 0x40, 0xbb, 0x8c, 0x4c, 0xdc, 0x90, 0x98, 0x7a, 0x44, 0xe2, 0x5f, 0x40, 0x42, 0x42, 0x68,
-	0x07, 0x0e, 0x3b, 0x22, 0x0e, 0x01, 0xb5, 0x17, 0xc4, 0x81, 0x43, 0x0f, 0x88, 0x13, 0x42, 0xcf,
-	0x76, 0x52, 0x37, 0x09, 0xcb, 0x36, 0x4d, 0xea, 0x81, 0x5c, 0xf2, 0xde, 0xf7, 0xf7, 0xf7, 0xbd,
-	0x8f, 0xdf, 0xb3, 0xc1, 0x35, 0x17, 0x61, 0x13, 0x39, 0x0d, 0x0b, 0xbb, 0xe9, 0xba, 0xb5, 0xdd,
-	0xb4, 0x4c, 0xcb, 0x6d, 0xa5, 0x77, 0xb2, 0x1b, 0xc8, 0xd5, 0xb3, 0x69, 0x77, 0x4f, 0xb2, 0x1d,
-	0xe2, 0x12, 0xfe, 0xca, 0xb1, 0x99, 0xd4, 0x33, 0x93, 0x02, 0xb3, 0xc4, 0x7c, 0x8d, 0xd4, 0x88,
-	0x67, 0x98, 0x66, 0x23, 0xdf, 0x27, 0x71, 0xc9, 0x20, 0xb4, 0x41, 0x68, 0xd5, 0x57, 0x18, 0xc4,
-	0xc2, 0x81, 0xc2, 0xff, 0x33, 0x16, 0x6a, 0x08, 0x2f, 0x10, 0x1b, 0x61, 0xdd, 0xb6, 0x76, 0xe4,
-	0x34, 0xb1, 0x5d, 0x8b, 0x60, 0x9a, 0xd6, 0x31, 0x26, 0xae, 0xee, 0x8d, 0x7d, 0x43, 0xf8, 0x75,
-	0x0c, 0xcc, 0xac, 0xd3, 0x5a, 0xc9, 0x41, 0xba, 0x8b, 0x6e, 0x10, 0x52, 0xe7, 0x7f, 0xe4, 0xc0,
-	0xbc, 0x4d, 0x48, 0xbd, 0x6a, 0x30, 0x19, 0x71, 0xaa, 0xba, 0x69, 0x3a, 0x88, 0xd2, 0x38, 0x27,
-	0x72, 0xa9, 0xa9, 0xe2, 0x7d, 0xae, 0xad, 0x6e, 0xcb, 0x0b, 0xba, 0x61, 0x90, 0x26, 0x76, 0xc5,
-	0x40, 0x29, 0x92, 0x4d, 0xd1, 0xdd, 0x42, 0x22, 0x71, 0xac, 0x9a, 0x85, 0xfd, 0x99, 0x45, 0xc5,
-	0x06, 0xa2, 0x54, 0xaf, 0xa1, 0x4a, 0x1a, 0xfa, 0xf5, 0x66, 0x91, 0x92, 0x6f, 0x2d, 0x16, 0x9c,
-	0x2d, 0xc7, 0x5d, 0x6a, 0xe5, 0x5a, 0x06, 0xca, 0xd7, 0xf3, 0xcd, 0x25, 0x85, 0x7e, 0x84, 0xf7,
-	0x9a, 0x99, 0xba, 0xa2, 0xec, 0xee, 0x7c, 0x8c, 0x5b, 0x4d, 0x0c, 0xf7, 0xc7, 0x66, 0xa9, 0x79,
-	0x5b, 0x52, 0x0d, 0x43, 0xf5, 0xe3, 0x1f, 0x75, 0x84, 0x17, 0x5b, 0x7a, 0xa3, 0xbe, 0x02, 0x87,
-	0x95, 0x06, 0x35, 0x9e, 0x89, 0x4b, 0xbe, 0x34, 0x70, 0xe1, 0x2b, 0x60, 0xda, 0x33, 0x76, 0x5b,
-	0x36, 0xaa, 0x5a, 0x66, 0x7c, 0x4c, 0xe4, 0x52, 0x33, 0xc5, 0x54, 0x5b, 0x9d, 0xad, 0x44, 0x61,
-	0x16, 0xee, 0x8f, 0x8d, 0x37, 0x2d, 0xec, 0x2a, 0xf2, 0x51, 0x47, 0x38, 0x1f, 0x8a, 0x1d, 0x98,
-	0x43, 0x0d, 0xb0, 0xe9, 0xcd, 0x96, 0x8d, 0xde, 0x30, 0xf9, 0x3f, 0x39, 0x30, 0x63, 0x22, 0x9b,
-	0x50, 0xcb, 0xad, 0xb2, 0xd5, 0xa6, 0xf1, 0x98, 0x18, 0x4d, 0x9d, 0x95, 0x2f, 0x4b, 0x7e, 0x63,
-	0xd2, 0x86, 0x4e, 0x51, 0x77, 0xcf, 0xa4, 0x12, 0xb1, 0x70, 0xf1, 0x5b, 0xae, 0xad, 0x6e, 0x54,
-	0x6e, 0x7e, 0xf0, 0x09, 0x34, 0x11, 0x26, 0x0d, 0xb8, 0x22, 0xfa, 0x83, 0x5b, 0xf0, 0xba, 0x08,
-	0xf5, 0x06, 0x5b, 0x3d, 0x26, 0xcb, 0x66, 0xbc, 0x1f, 0xbc, 0x73, 0x5d, 0xec, 0xb7, 0x7c, 0xef,
-	0xa4, 0xa5, 0xdc, 0xb5, 0xfc, 0x70, 0x7f, 0x6c, 0x8a, 0x2d, 0x0f, 0x4b, 0x43, 0x1f, 0x76, 0x84,
-	0xc8, 0x51, 0x47, 0x98, 0xf7, 0x3b, 0x38, 0x51, 0x23, 0xfc, 0xe6, 0x57, 0x21, 0x55, 0xb3, 0xdc,
-	0xad, 0xe6, 0x86, 0x64, 0x90, 0x46, 0xda, 0x2f, 0x35, 0xf8, 0x5b, 0xa0, 0xe6, 0xed, 0x34, 0xeb,
-	0x95, 0xfa, 0x71, 0xb4, 0xe9, 0xc0, 0xd7, 0x9b, 0xad, 0x4c, 0x7e, 0xf6, 0x40, 0x88, 0xfc, 0xfe,
-	0x40, 0x88, 0xc0, 0x4b, 0xe0, 0xc2, 0x09, 0x40, 0x34, 0x44, 0x6d, 0x82, 0x29, 0x82, 0x5f, 0xc5,
-	0x3c, 0x4d, 0xd9, 0x77, 0x7b, 0xd7, 0x72, 0xb7, 0x2c, 0x5c, 0xd4, 0x5d, 0x63, 0x8b, 0xff, 0x8e,
-	0x03, 0x73, 0x41, 0xb4, 0x01, 0x7e, 0xee, 0x9d, 0x16, 0x3f, 0xf1, 0x13, 0x2b, 0x14, 0x86, 0xe7,
-	0x5c, 0x4f, 0xd6, 0x45, 0xe7, 0x75, 0x30, 0xe1, 0xb1, 0x10, 0x50, 0x13, 0x2b, 0x4a, 0x7d, 0xd4,
-	0x2c, 0xe6, 0xfe, 0xe8, 0x08, 0x5d, 0x9b, 0xa3, 0x8e, 0x30, 0x1b, 0x02, 0x88, 0xb1, 0x33, 0xce,
-	0x46, 0x43, 0xb9, 0x89, 0xfe, 0x5f, 0xb8, 0x11, 0xc0, 0xd5, 0xa1, 0x74, 0xf4, 0xf8, 0xf9, 0x2b,
-	0x0a, 0x2e, 0xae, 0xd3, 0x1a, 0x53, 0x99, 0x8e, 0xbe, 0x1b, 0x06, 0xe8, 0x7b, 0x0e, 0xf0, 0xbb,
-	0x81, 0x1c, 0xf5, 0x13, 0xf4, 0xc5, 0x69, 0x11, 0x74, 0xd9, 0x5f, 0xab, 0xc1, 0xc2, 0xa0, 0x36,
-	0x77, 0x2c, 0x7c, 0xee, 0x0c, 0xfd, 0xc0, 0x81, 0x29, 0xff, 0xd4, 0x23, 0x16, 0x8e, 0x47, 0x45,
-	0xee, 0xf1, 0xfc, 0xdc, 0xe5, 0xda, 0xaa, 0x5d, 0x31, 0x42, 0x50, 0x30, 0xe7, 0xb2, 0x92, 0x57,
-	0x33, 0xa5, 0x52, 0x76, 0x71, 0x75, 0x35, 0x5f, 0x58, 0x5e, 0x2b, 0x64, 0x8a, 0x99, 0x5c, 0xae,
-	0xb4, 0x2a, 0x17, 0x16, 0xd5, 0x5c, 0x26, 0x5f, 0x54, 0x0b, 0x25, 0x65, 0x39, 0xbb, 0xaa, 0x2c,
-	0x2f, 0x2b, 0x4b, 0xf9, 0x42, 0xa1, 0x5c, 0x58, 0x5c, 0x93, 0xd7, 0x96, 0x32, 0x25, 0x79, 0x2d,
-	0x23, 0xab, 0xb2, 0xa2, 0xe6, 0x06, 0xe1, 0x83, 0x77, 0xf6, 0xc7, 0x26, 0xbb, 0x38, 0x05, 0x34,
-	0x9d, 0x0b, 0x9f, 0xd1, 0xc4, 0xc2, 0x50, 0x9b, 0xf4, 0x0e, 0x66, 0x62, 0xe1, 0x10, 0x19, 0x22,
-	0x48, 0x0e, 0xdf, 0xf7, 0x1e, 0x1a, 0x7f, 0x8f, 0x03, 0x7e, 0x9d, 0xd6, 0xde, 0xde, 0xd5, 0xed,
-	0x30, 0x16, 0x3f, 0x71, 0xe0, 0x22, 0xdd, 0xd5, 0xed, 0xaa, 0x83, 0xb6, 0x9b, 0x88, 0xba, 0x03,
-	0x68, 0x7c, 0x79, 0x5a, 0x68, 0x5c, 0xf5, 0x1b, 0x1f, 0x5e, 0x1c, 0xd4, 0xe6, 0x99, 0x42, 0xeb,
-	0xca, 0x9f, 0x3b, 0x21, 0x15, 0x30, 0xed, 0x65, 0xee, 0xde, 0x74, 0xd1, 0x91, 0x37, 0x5d, 0xd8,
-	0x1c, 0x6a, 0x80, 0x4d, 0x83, 0x9b, 0xee, 0x2e, 0x07, 0x00, 0xd9, 0xdc, 0x44, 0x8e, 0x8f, 0x5b,
-	0x6c, 0x14, 0x6e, 0x6f, 0xb5, 0xd5, 0x7c, 0x25, 0xf5, 0xa4, 0x87, 0xd5, 0x20, 0x32, 0x73, 0x7e,
-	0x41, 0xc7, 0x29, 0xa1, 0x36, 0xe5, 0x4d, 0x98, 0x0d, 0xff, 0x0e, 0xbb, 0x48, 0x1a, 0x3a, 0x36,
-	0x3d, 0x55, 0xd5, 0x8b, 0x1d, 0x3f, 0xe3, 0xed, 0xf5, 0xcb, 0x6d, 0x15, 0x54, 0x26, 0xfd, 0x74,
-	0x45, 0x18, 0x3e, 0xe0, 0xfb, 0xec, 0xa1, 0xf6, 0x82, 0x2f, 0x63, 0x11, 0xcb, 0x4c, 0xc2, 0xdf,
-	0xe7, 0xc0, 0xec, 0x71, 0xc6, 0xea, 0x26, 0x42, 0xf1, 0xf1, 0x51, 0x8d, 0x6a, 0x6d, 0x55, 0xae,
-	0x5c, 0x1b, 0xd1, 0x68, 0xfe, 0x3f, 0xba, 0xbc, 0xd0, 0xdf, 0x25, 0xcb, 0x09, 0xb5, 0xe9, 0x5e,
-	0xa7, 0x6b, 0x08, 0xf1, 0x2d, 0x70, 0x96, 0x38, 0x26, 0x72, 0xaa, 0xb6, 0x63, 0x19, 0x28, 0x3e,
-	0xe1, 0xb5, 0x79, 0xab, 0xad, 0xce, 0x55, 0xce, 0xc0, 0xac, 0xc4, 0xf6, 0x71, 0x82, 0x85, 0x2d,
-	0x23, 0x83, 0x45, 0xfd, 0xa5, 0x23, 0xbc, 0xf4, 0x04, 0x87, 0x74, 0x19, 0x19, 0x47, 0x1d, 0x81,
-	0x0f, 0xf2, 0x1f, 0x87, 0x87, 0x1a, 0xf0, 0x66, 0x37, 0xd8, 0x24, 0xf4, 0x70, 0x5e, 0x01, 0x89,
-	0xc1, 0x27, 0xaf, 0xfb, 0x60, 0xca, 0xff, 0x44, 0x41, 0x74, 0x9d, 0xd6, 0x78, 0x0c, 0x40, 0xe8,
-	0x95, 0xf1, 0x15, 0xe9, 0x71, 0xaf, 0xb0, 0xd2, 0x89, 0xd7, 0x87, 0x84, 0xf2, 0x14, 0xc6, 0xdd,
-	0xbc, 0xfc, 0xa7, 0x1c, 0xe0, 0x87, 0xbc, 0x68, 0x8c, 0x8e, 0x35, 0xe8, 0x94, 0x78, 0xf5, 0x19,
-	0x9c, 0x7a, 0x85, 0x7c, 0xce, 0x81, 0xf3, 0xc3, 0x6e, 0xac, 0xdc, 0xc8, 0xa0, 0x43, 0xbc, 0x12,
-	0xaf, 0x3d, 0x8b, 0x57, 0xaf, 0x16, 0x07, 0xc4, 0xd8, 0x3e, 0xf1, 0x99, 0x91, 0x51, 0xfa, 0xb6,
-	0x33, 0xb1, 0xfc, 0xb4, 0x1e, 0xdd, 0x9c, 0xc5, 0x37, 0x1f, 0x1e, 0x24, 0xb9, 0x47, 0x07, 0x49,
-	0xee, 0xb7, 0x83, 0x24, 0x77, 0xef, 0x30, 0x19, 0x79, 0x74, 0x98, 0x8c, 0xfc, 0x7c, 0x98, 0x8c,
-	0xbc, 0x9f, 0x0d, 0xc1, 0x38, 0xf4, 0xc3, 0x67, 0x2f, 0x34, 0xf6, 0xd8, 0xdc, 0x18, 0xf7, 0xbe,
-	0x41, 0x94, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x7d, 0x7f, 0x83, 0x29, 0x0d, 0x00, 0x00,
+func (m *MsgDepositToRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositToRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositToRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToRange.Merge(m, src)
+}
+func (m *MsgDepositToRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositToRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToRange.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgDepositToRange proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Submit a create liquidity pool message.
-	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
-	// Submit a deposit to the liquidity pool batch.
-	DepositWithinBatch(ctx context.Context, in *MsgDepositWithinBatch, opts ...grpc.CallOption) (*MsgDepositWithinBatchResponse, error)
-	// Submit a withdraw from the liquidity pool batch.
-	WithdrawWithinBatch(ctx context.Context, in *MsgWithdrawWithinBatch, opts ...grpc.CallOption) (*MsgWithdrawWithinBatchResponse, error)
-	// Submit a swap to the liquidity pool batch.
-	Swap(ctx context.Context, in *MsgSwapWithinBatch, opts ...grpc.CallOption) (*MsgSwapWithinBatchResponse, error)
+// MsgDepositToRangeResponse defines the Msg/DepositToRange response type.
+type MsgDepositToRangeResponse struct {
+	// id of the created position
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgDepositToRangeResponse) Reset()         { *m = MsgDepositToRangeResponse{} }
+func (m *MsgDepositToRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToRangeResponse) ProtoMessage()    {}
+func (*MsgDepositToRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{9}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgDepositToRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
-	out := new(MsgCreatePoolResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/CreatePool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositToRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDepositToRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToRangeResponse.Merge(m, src)
+}
+func (m *MsgDepositToRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositToRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToRangeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DepositWithinBatch(ctx context.Context, in *MsgDepositWithinBatch, opts ...grpc.CallOption) (*MsgDepositWithinBatchResponse, error) {
-	out := new(MsgDepositWithinBatchResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/DepositWithinBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositToRangeResponse proto.InternalMessageInfo
+
+func (m *MsgDepositToRangeResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) WithdrawWithinBatch(ctx context.Context, in *MsgWithdrawWithinBatch, opts ...grpc.CallOption) (*MsgWithdrawWithinBatchResponse, error) {
-	out := new(MsgWithdrawWithinBatchResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/WithdrawWithinBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// `MsgWithdrawFromRange` defines an sdk.Msg type that supports submitting a withdraw of a position from the concentrated
+// liquidity pool. The withdraw is processed immediately, paying out the reserve coins of the position at the current
+// pool price and its share of the swap fees accrued in the pool, and deleting the position.
+//
+// See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgWithdrawFromRange struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	// id of the target position
+	PositionId uint64 `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 }
 
-func (c *msgClient) Swap(ctx context.Context, in *MsgSwapWithinBatch, opts ...grpc.CallOption) (*MsgSwapWithinBatchResponse, error) {
-	out := new(MsgSwapWithinBatchResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/Swap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawFromRange) Reset()         { *m = MsgWithdrawFromRange{} }
+func (m *MsgWithdrawFromRange) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromRange) ProtoMessage()    {}
+func (*MsgWithdrawFromRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{10}
+}
+func (m *MsgWithdrawFromRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Submit a create liquidity pool message.
-	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
-	// Submit a deposit to the liquidity pool batch.
-	DepositWithinBatch(context.Context, *MsgDepositWithinBatch) (*MsgDepositWithinBatchResponse, error)
-	// Submit a withdraw from the liquidity pool batch.
-	WithdrawWithinBatch(context.Context, *MsgWithdrawWithinBatch) (*MsgWithdrawWithinBatchResponse, error)
-	// Submit a swap to the liquidity pool batch.
-	Swap(context.Context, *MsgSwapWithinBatch) (*MsgSwapWithinBatchResponse, error)
+func (m *MsgWithdrawFromRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromRange.Merge(m, src)
+}
+func (m *MsgWithdrawFromRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromRange.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgWithdrawFromRange proto.InternalMessageInfo
+
+// MsgWithdrawFromRangeResponse defines the Msg/WithdrawFromRange response type.
+type MsgWithdrawFromRangeResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+func (m *MsgWithdrawFromRangeResponse) Reset()         { *m = MsgWithdrawFromRangeResponse{} }
+func (m *MsgWithdrawFromRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromRangeResponse) ProtoMessage()    {}
+func (*MsgWithdrawFromRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{11}
 }
-func (*UnimplementedMsgServer) DepositWithinBatch(ctx context.Context, req *MsgDepositWithinBatch) (*MsgDepositWithinBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositWithinBatch not implemented")
+func (m *MsgWithdrawFromRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WithdrawWithinBatch(ctx context.Context, req *MsgWithdrawWithinBatch) (*MsgWithdrawWithinBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawWithinBatch not implemented")
+func (m *MsgWithdrawFromRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwapWithinBatch) (*MsgSwapWithinBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+func (m *MsgWithdrawFromRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromRangeResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFromRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromRangeResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgWithdrawFromRangeResponse proto.InternalMessageInfo
+
+// `MsgCancelSwap` defines an sdk.Msg type that supports submitting a cancellation of a pending swap order.
+//
+// The swap order is marked to be deleted and is not matched anymore. The remaining offer coin and the unused reserved
+// offer coin fee of the order are refunded at the end of the batch.
+//
+// See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgCancelSwap struct {
+	SwapRequesterAddress string `protobuf:"bytes,1,opt,name=swap_requester_address,json=swapRequesterAddress,proto3" json:"swap_requester_address,omitempty" yaml:"swap_requester_address"`
+	// id of the target pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// index of the swap message of the order in the pool batch
+	MsgIndex uint64 `protobuf:"varint,3,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty" yaml:"msg_index"`
 }
 
-func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Msg/CreatePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+func (m *MsgCancelSwap) Reset()         { *m = MsgCancelSwap{} }
+func (m *MsgCancelSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSwap) ProtoMessage()    {}
+func (*MsgCancelSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{12}
+}
+func (m *MsgCancelSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSwap.Merge(m, src)
+}
+func (m *MsgCancelSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSwap.DiscardUnknown(m)
 }
 
-func _Msg_DepositWithinBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositWithinBatch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositWithinBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Msg/DepositWithinBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositWithinBatch(ctx, req.(*MsgDepositWithinBatch))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelSwap proto.InternalMessageInfo
+
+// MsgCancelSwapResponse defines the Msg/CancelSwap response type.
+type MsgCancelSwapResponse struct {
 }
 
-func _Msg_WithdrawWithinBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawWithinBatch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawWithinBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Msg/WithdrawWithinBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawWithinBatch(ctx, req.(*MsgWithdrawWithinBatch))
+func (m *MsgCancelSwapResponse) Reset()         { *m = MsgCancelSwapResponse{} }
+func (m *MsgCancelSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSwapResponse) ProtoMessage()    {}
+func (*MsgCancelSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{13}
+}
+func (m *MsgCancelSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSwapResponse.Merge(m, src)
+}
+func (m *MsgCancelSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSwapResponse.DiscardUnknown(m)
 }
 
-func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSwapWithinBatch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Swap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Msg/Swap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Swap(ctx, req.(*MsgSwapWithinBatch))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelSwapResponse proto.InternalMessageInfo
+
+// `MsgSwapRoute` defines an sdk.Msg type that supports submitting a swap routed through an ordered list of pools.
+//
+// The offer coin is swapped in the batch of the first pool, and the exchanged coin of each hop is swapped in the batch
+// of the next pool, within the same end blocker or in the following batch. The last hop is not matched below the
+// minimum demand coin amount. The coins of a hop that fails are refunded to the swap requester.
+//
+// See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgSwapRoute struct {
+	SwapRequesterAddress string `protobuf:"bytes,1,opt,name=swap_requester_address,json=swapRequesterAddress,proto3" json:"swap_requester_address,omitempty" yaml:"swap_requester_address"`
+	// ids of the pools to swap through in order
+	PoolIds []uint64 `protobuf:"varint,2,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+	// offer sdk.coin for the swap request, must match a reserve coin denom of the first pool.
+	OfferCoin types.Coin `protobuf:"bytes,3,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
+	// denom of demand coin to be exchanged on the last pool, must match a reserve coin denom of the last pool.
+	DemandCoinDenom string `protobuf:"bytes,4,opt,name=demand_coin_denom,json=demandCoinDenom,proto3" json:"demand_coin_denom,omitempty" yaml:"demand_coin_denom"`
+	// half of offer coin amount * params.swap_fee_rate and ceil for reservation to pay fees of the first hop.
+	OfferCoinFee types.Coin `protobuf:"bytes,5,opt,name=offer_coin_fee,json=offerCoinFee,proto3" json:"offer_coin_fee" yaml:"offer_coin_fee"`
+	// minimum amount of demand coin to be received from the last pool, limiting the order price of the last hop which is
+	// refunded in its offer coin instead of the offer coin of the route when not matched
+	MinDemandCoinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_demand_coin_amount,json=minDemandCoinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_demand_coin_amount" yaml:"min_demand_coin_amount"`
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tendermint.liquidity.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreatePool",
-			Handler:    _Msg_CreatePool_Handler,
-		},
-		{
-			MethodName: "DepositWithinBatch",
-			Handler:    _Msg_DepositWithinBatch_Handler,
-		},
-		{
-			MethodName: "WithdrawWithinBatch",
-			Handler:    _Msg_WithdrawWithinBatch_Handler,
-		},
-		{
-			MethodName: "Swap",
-			Handler:    _Msg_Swap_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tendermint/liquidity/v1beta1/tx.proto",
+func (m *MsgSwapRoute) Reset()         { *m = MsgSwapRoute{} }
+func (m *MsgSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRoute) ProtoMessage()    {}
+func (*MsgSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{14}
 }
-
-func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRoute.Merge(m, src)
+}
+func (m *MsgSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRoute.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSwapRoute proto.InternalMessageInfo
+
+// MsgSwapRouteResponse defines the Msg/SwapRoute response type.
+type MsgSwapRouteResponse struct {
 }
 
-func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DepositCoins) > 0 {
-		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *MsgSwapRouteResponse) Reset()         { *m = MsgSwapRouteResponse{} }
+func (m *MsgSwapRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRouteResponse) ProtoMessage()    {}
+func (*MsgSwapRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{15}
+}
+func (m *MsgSwapRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PoolTypeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolTypeId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.PoolCreatorAddress) > 0 {
-		i -= len(m.PoolCreatorAddress)
-		copy(dAtA[i:], m.PoolCreatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolCreatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSwapRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRouteResponse.Merge(m, src)
 }
-
-func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSwapRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRouteResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSwapRouteResponse proto.InternalMessageInfo
+
+// `MsgCreateRewardPlan` defines an sdk.Msg type that supports submitting a reward plan for the pool coin stakers of
+// the liquidity pool.
+//
+// The reward coins are escrowed from the funder and streamed in equal parts at every block of the duration to the
+// staked pool coin of the pool. The reward coins of the blocks without staked pool coin are refunded to the funder at
+// the end of the reward plan.
+//
+// See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgCreateRewardPlan struct {
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty" yaml:"funder_address"`
+	// id of the target pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// reward coins to distribute over the duration
+	RewardCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=reward_coins,json=rewardCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_coins" yaml:"reward_coins"`
+	// number of blocks distributing the reward coins, starting from the next block
+	Duration uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty" yaml:"duration"`
 }
 
-func (m *MsgDepositWithinBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateRewardPlan) Reset()         { *m = MsgCreateRewardPlan{} }
+func (m *MsgCreateRewardPlan) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRewardPlan) ProtoMessage()    {}
+func (*MsgCreateRewardPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{16}
+}
+func (m *MsgCreateRewardPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateRewardPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateRewardPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCreateRewardPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRewardPlan.Merge(m, src)
+}
+func (m *MsgCreateRewardPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateRewardPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRewardPlan.DiscardUnknown(m)
 }
 
-func (m *MsgDepositWithinBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCreateRewardPlan proto.InternalMessageInfo
+
+// MsgCreateRewardPlanResponse defines the Msg/CreateRewardPlan response type.
+type MsgCreateRewardPlanResponse struct {
+	// id of the created reward plan
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
 }
 
-func (m *MsgDepositWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DepositCoins) > 0 {
-		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgCreateRewardPlanResponse) Reset()         { *m = MsgCreateRewardPlanResponse{} }
+func (m *MsgCreateRewardPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRewardPlanResponse) ProtoMessage()    {}
+func (*MsgCreateRewardPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{17}
+}
+func (m *MsgCreateRewardPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateRewardPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateRewardPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.DepositorAddress) > 0 {
-		i -= len(m.DepositorAddress)
-		copy(dAtA[i:], m.DepositorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DepositorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreateRewardPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRewardPlanResponse.Merge(m, src)
+}
+func (m *MsgCreateRewardPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateRewardPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRewardPlanResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDepositWithinBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateRewardPlanResponse proto.InternalMessageInfo
+
+func (m *MsgCreateRewardPlanResponse) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDepositWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// `MsgStake` defines an sdk.Msg type that supports submitting a stake of the pool coin of a liquidity pool.
+//
+// The pool coin is escrowed and earns the rewards of the reward plans of the pool in proportion to the staked amount
+// from the next block. The pending rewards of the existing stake of the staker are claimed.
+//
+// See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgStake struct {
+	StakerAddress string `protobuf:"bytes,1,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty" yaml:"staker_address"`
+	// pool coin to stake
+	PoolCoin types.Coin `protobuf:"bytes,2,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin" yaml:"pool_coin"`
 }
 
-func (m *MsgDepositWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgStake) Reset()         { *m = MsgStake{} }
+func (m *MsgStake) String() string { return proto.CompactTextString(m) }
+func (*MsgStake) ProtoMessage()    {}
+func (*MsgStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{18}
 }
-
-func (m *MsgWithdrawWithinBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStake.Merge(m, src)
+}
+func (m *MsgStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStake.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawWithinBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgStake proto.InternalMessageInfo
+
+// MsgStakeResponse defines the Msg/Stake response type.
+type MsgStakeResponse struct {
 }
 
-func (m *MsgWithdrawWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgStakeResponse) Reset()         { *m = MsgStakeResponse{} }
+func (m *MsgStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStakeResponse) ProtoMessage()    {}
+func (*MsgStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{19}
+}
+func (m *MsgStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.WithdrawerAddress) > 0 {
-		i -= len(m.WithdrawerAddress)
-		copy(dAtA[i:], m.WithdrawerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgWithdrawWithinBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStakeResponse.Merge(m, src)
 }
-
-func (m *MsgWithdrawWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStakeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgStakeResponse proto.InternalMessageInfo
+
+// `MsgUnstake` defines an sdk.Msg type that supports submitting an unstake of the staked pool coin.
+//
+// The pending rewards of the stake are claimed and the unstaked pool coin is returned to the staker.
+//
+// See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgUnstake struct {
+	StakerAddress string `protobuf:"bytes,1,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty" yaml:"staker_address"`
+	// staked pool coin to unstake
+	PoolCoin types.Coin `protobuf:"bytes,2,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin" yaml:"pool_coin"`
 }
 
-func (m *MsgSwapWithinBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnstake) Reset()         { *m = MsgUnstake{} }
+func (m *MsgUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgUnstake) ProtoMessage()    {}
+func (*MsgUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{20}
+}
+func (m *MsgUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnstake.Merge(m, src)
+}
+func (m *MsgUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnstake.DiscardUnknown(m)
 }
 
-func (m *MsgSwapWithinBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnstake proto.InternalMessageInfo
+
+// MsgUnstakeResponse defines the Msg/Unstake response type.
+type MsgUnstakeResponse struct {
 }
 
-func (m *MsgSwapWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.OrderPrice.Size()
-		i -= size
-		if _, err := m.OrderPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgUnstakeResponse) Reset()         { *m = MsgUnstakeResponse{} }
+func (m *MsgUnstakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnstakeResponse) ProtoMessage()    {}
+func (*MsgUnstakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{21}
+}
+func (m *MsgUnstakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnstakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnstakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.OfferCoinFee.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgUnstakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnstakeResponse.Merge(m, src)
+}
+func (m *MsgUnstakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnstakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnstakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnstakeResponse proto.InternalMessageInfo
+
+// `MsgClaimRewards` defines an sdk.Msg type that supports submitting a claim of the pending rewards of the staked pool
+// coin of a liquidity pool.
+//
+// See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgClaimRewards struct {
+	StakerAddress string `protobuf:"bytes,1,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty" yaml:"staker_address"`
+	// id of the target pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+}
+
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{22}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.DemandCoinDenom) > 0 {
-		i -= len(m.DemandCoinDenom)
-		copy(dAtA[i:], m.DemandCoinDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DemandCoinDenom)))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	{
-		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+// MsgClaimRewardsResponse defines the Msg/ClaimRewards response type.
+type MsgClaimRewardsResponse struct {
+	// claimed reward coins
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{23}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.SwapTypeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SwapTypeId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SwapRequesterAddress) > 0 {
-		i -= len(m.SwapRequesterAddress)
-		copy(dAtA[i:], m.SwapRequesterAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapRequesterAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSwapWithinBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSwapWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// `MsgBond` defines an sdk.Msg type that supports submitting a bond locking the pool coin of a liquidity pool for a
+// bond duration.
+//
+// The pool coin is escrowed until the bond duration has passed since the unbonding of the bond begins.
+//
+// See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgBond struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	// pool coin to bond
+	PoolCoin types.Coin `protobuf:"bytes,2,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin" yaml:"pool_coin"`
+	// bond duration, one of the bond durations of the params
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
 }
 
-func (m *MsgSwapWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBond) Reset()         { *m = MsgBond{} }
+func (m *MsgBond) String() string { return proto.CompactTextString(m) }
+func (*MsgBond) ProtoMessage()    {}
+func (*MsgBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{24}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgCreatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PoolCreatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolTypeId != 0 {
-		n += 1 + sovTx(uint64(m.PoolTypeId))
-	}
-	if len(m.DepositCoins) > 0 {
-		for _, e := range m.DepositCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBond.Merge(m, src)
+}
+func (m *MsgBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBond.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgBond proto.InternalMessageInfo
+
+// MsgBondResponse defines the Msg/Bond response type.
+type MsgBondResponse struct {
+	// id of the created bond
+	BondId uint64 `protobuf:"varint,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty" yaml:"bond_id"`
+}
+
+func (m *MsgBondResponse) Reset()         { *m = MsgBondResponse{} }
+func (m *MsgBondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBondResponse) ProtoMessage()    {}
+func (*MsgBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{25}
+}
+func (m *MsgBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondResponse.Merge(m, src)
+}
+func (m *MsgBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDepositWithinBatch) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgBondResponse proto.InternalMessageInfo
+
+func (m *MsgBondResponse) GetBondId() uint64 {
+	if m != nil {
+		return m.BondId
 	}
-	var l int
-	_ = l
-	l = len(m.DepositorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// `MsgBeginUnbond` defines an sdk.Msg type that supports submitting a beginning of the unbonding of a bond.
+//
+// The pool coin of the bond is returned to the owner when the bond duration has passed.
+//
+// See: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgBeginUnbond struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	// id of the bond to unbond
+	BondId uint64 `protobuf:"varint,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty" yaml:"bond_id"`
+}
+
+func (m *MsgBeginUnbond) Reset()         { *m = MsgBeginUnbond{} }
+func (m *MsgBeginUnbond) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginUnbond) ProtoMessage()    {}
+func (*MsgBeginUnbond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{26}
+}
+func (m *MsgBeginUnbond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginUnbond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginUnbond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+}
+func (m *MsgBeginUnbond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginUnbond.Merge(m, src)
+}
+func (m *MsgBeginUnbond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginUnbond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginUnbond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginUnbond proto.InternalMessageInfo
+
+// MsgBeginUnbondResponse defines the Msg/BeginUnbond response type.
+type MsgBeginUnbondResponse struct {
+	// time when the pool coin of the bond is returned to the owner
+	UnbondTime time.Time `protobuf:"bytes,1,opt,name=unbond_time,json=unbondTime,proto3,stdtime" json:"unbond_time" yaml:"unbond_time"`
+}
+
+func (m *MsgBeginUnbondResponse) Reset()         { *m = MsgBeginUnbondResponse{} }
+func (m *MsgBeginUnbondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginUnbondResponse) ProtoMessage()    {}
+func (*MsgBeginUnbondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{27}
+}
+func (m *MsgBeginUnbondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginUnbondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginUnbondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.DepositCoins) > 0 {
-		for _, e := range m.DepositCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgBeginUnbondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginUnbondResponse.Merge(m, src)
+}
+func (m *MsgBeginUnbondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginUnbondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginUnbondResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginUnbondResponse proto.InternalMessageInfo
+
+func (m *MsgBeginUnbondResponse) GetUnbondTime() time.Time {
+	if m != nil {
+		return m.UnbondTime
+	}
+	return time.Time{}
+}
+
+// MsgSetCircuitBreaker defines an sdk.Msg type that supports enabling or disabling a circuit breaker by one of the
+// emergency admins of the circuit_breaker_admins param.
+type MsgSetCircuitBreaker struct {
+	AdminAddress string `protobuf:"bytes,1,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty" yaml:"admin_address"`
+	// id of the pool, all pools if zero
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// type of the messages, all types if empty
+	MsgType string `protobuf:"bytes,3,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty" yaml:"msg_type"`
+	// whether to enable or disable the circuit breaker
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+}
+
+func (m *MsgSetCircuitBreaker) Reset()         { *m = MsgSetCircuitBreaker{} }
+func (m *MsgSetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCircuitBreaker) ProtoMessage()    {}
+func (*MsgSetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{28}
+}
+func (m *MsgSetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgSetCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCircuitBreaker.DiscardUnknown(m)
 }
 
-func (m *MsgDepositWithinBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetCircuitBreaker proto.InternalMessageInfo
+
+// MsgSetCircuitBreakerResponse defines the Msg/SetCircuitBreaker response type.
+type MsgSetCircuitBreakerResponse struct {
+}
+
+func (m *MsgSetCircuitBreakerResponse) Reset()         { *m = MsgSetCircuitBreakerResponse{} }
+func (m *MsgSetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgSetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{29}
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCircuitBreakerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawWithinBatch) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetCircuitBreakerResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreatePool)(nil), "tendermint.liquidity.v1beta1.MsgCreatePool")
+	proto.RegisterType((*MsgCreatePoolResponse)(nil), "tendermint.liquidity.v1beta1.MsgCreatePoolResponse")
+	proto.RegisterType((*MsgDepositWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgDepositWithinBatch")
+	proto.RegisterType((*MsgDepositWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgDepositWithinBatchResponse")
+	proto.RegisterType((*MsgWithdrawWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgWithdrawWithinBatch")
+	proto.RegisterType((*MsgWithdrawWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgWithdrawWithinBatchResponse")
+	proto.RegisterType((*MsgSwapWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgSwapWithinBatch")
+	proto.RegisterType((*MsgSwapWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgSwapWithinBatchResponse")
+	proto.RegisterType((*MsgDepositToRange)(nil), "tendermint.liquidity.v1beta1.MsgDepositToRange")
+	proto.RegisterType((*MsgDepositToRangeResponse)(nil), "tendermint.liquidity.v1beta1.MsgDepositToRangeResponse")
+	proto.RegisterType((*MsgWithdrawFromRange)(nil), "tendermint.liquidity.v1beta1.MsgWithdrawFromRange")
+	proto.RegisterType((*MsgWithdrawFromRangeResponse)(nil), "tendermint.liquidity.v1beta1.MsgWithdrawFromRangeResponse")
+	proto.RegisterType((*MsgCancelSwap)(nil), "tendermint.liquidity.v1beta1.MsgCancelSwap")
+	proto.RegisterType((*MsgCancelSwapResponse)(nil), "tendermint.liquidity.v1beta1.MsgCancelSwapResponse")
+	proto.RegisterType((*MsgSwapRoute)(nil), "tendermint.liquidity.v1beta1.MsgSwapRoute")
+	proto.RegisterType((*MsgSwapRouteResponse)(nil), "tendermint.liquidity.v1beta1.MsgSwapRouteResponse")
+	proto.RegisterType((*MsgCreateRewardPlan)(nil), "tendermint.liquidity.v1beta1.MsgCreateRewardPlan")
+	proto.RegisterType((*MsgCreateRewardPlanResponse)(nil), "tendermint.liquidity.v1beta1.MsgCreateRewardPlanResponse")
+	proto.RegisterType((*MsgStake)(nil), "tendermint.liquidity.v1beta1.MsgStake")
+	proto.RegisterType((*MsgStakeResponse)(nil), "tendermint.liquidity.v1beta1.MsgStakeResponse")
+	proto.RegisterType((*MsgUnstake)(nil), "tendermint.liquidity.v1beta1.MsgUnstake")
+	proto.RegisterType((*MsgUnstakeResponse)(nil), "tendermint.liquidity.v1beta1.MsgUnstakeResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "tendermint.liquidity.v1beta1.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "tendermint.liquidity.v1beta1.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgBond)(nil), "tendermint.liquidity.v1beta1.MsgBond")
+	proto.RegisterType((*MsgBondResponse)(nil), "tendermint.liquidity.v1beta1.MsgBondResponse")
+	proto.RegisterType((*MsgBeginUnbond)(nil), "tendermint.liquidity.v1beta1.MsgBeginUnbond")
+	proto.RegisterType((*MsgBeginUnbondResponse)(nil), "tendermint.liquidity.v1beta1.MsgBeginUnbondResponse")
+	proto.RegisterType((*MsgSetCircuitBreaker)(nil), "tendermint.liquidity.v1beta1.MsgSetCircuitBreaker")
+	proto.RegisterType((*MsgSetCircuitBreakerResponse)(nil), "tendermint.liquidity.v1beta1.MsgSetCircuitBreakerResponse")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/liquidity/v1beta1/tx.proto", fileDescriptor_deae1e5d4eb3529c)
+}
+
+var fileDescriptor_deae1e5d4eb3529c = []byte{
+	// 2467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x14, 0x45, 0x8d, 0x44, 0xfd, 0xac, 0x64, 0x99, 0xa6, 0x1d, 0x92, 0x98, 0xd6,
+	0xa9, 0xd2, 0x48, 0xfc, 0x15, 0x25, 0xd1, 0x0d, 0x02, 0x2c, 0x25, 0xab, 0x15, 0x5b, 0x21, 0xee,
+	0x46, 0x46, 0xd2, 0xb8, 0x2e, 0xb1, 0xe4, 0x8e, 0xa8, 0xad, 0xc9, 0x5d, 0x7a, 0x77, 0x69, 0x99,
+	0x29, 0x02, 0x24, 0x28, 0x50, 0xb4, 0x48, 0x53, 0x38, 0x34, 0x02, 0x14, 0x68, 0x81, 0x06, 0x42,
+	0x0f, 0x45, 0x81, 0x5e, 0x0a, 0xf4, 0x5a, 0xb4, 0x40, 0xd1, 0xe6, 0xd0, 0x43, 0x7a, 0x2b, 0x5a,
+	0x40, 0x29, 0xec, 0x4b, 0xd1, 0x43, 0x0f, 0x02, 0x72, 0x6e, 0x31, 0x33, 0xfb, 0xcb, 0xa5, 0x4d,
+	0x32, 0x16, 0x22, 0xdb, 0xb0, 0x2e, 0xda, 0x99, 0x79, 0xef, 0xcd, 0x9b, 0xf7, 0xbe, 0xf7, 0xe6,
+	0xcd, 0x0c, 0xc1, 0x45, 0x1d, 0xc9, 0x22, 0x52, 0x1b, 0x92, 0xac, 0xa7, 0xea, 0xd2, 0xcd, 0x96,
+	0x24, 0x4a, 0x7a, 0x3b, 0x75, 0x2b, 0x53, 0x41, 0xba, 0x90, 0x49, 0xe9, 0xb7, 0x93, 0x4d, 0x55,
+	0xd1, 0x15, 0xf6, 0x82, 0x4d, 0x96, 0xb4, 0xc8, 0x92, 0x06, 0x59, 0x74, 0xbe, 0xa6, 0xd4, 0x14,
+	0x42, 0x98, 0xc2, 0x5f, 0x94, 0x27, 0x7a, 0xb6, 0xaa, 0x68, 0x0d, 0x45, 0x2b, 0xd3, 0x81, 0xaa,
+	0x22, 0xc9, 0xc6, 0x40, 0xac, 0xa6, 0x28, 0xb5, 0x3a, 0x4a, 0x91, 0x56, 0xa5, 0xb5, 0x97, 0x12,
+	0x5b, 0xaa, 0xa0, 0x4b, 0x8a, 0x39, 0x1e, 0xef, 0x1e, 0xd7, 0xa5, 0x06, 0xd2, 0x74, 0xa1, 0xd1,
+	0x34, 0x08, 0xe8, 0xbf, 0xea, 0x72, 0x0d, 0xc9, 0xcb, 0x4a, 0x13, 0xc9, 0x42, 0x53, 0xba, 0x95,
+	0x4d, 0x29, 0x4d, 0x2c, 0x43, 0x4b, 0x09, 0xb2, 0xac, 0xe8, 0x44, 0x9e, 0x46, 0x09, 0xe1, 0x5f,
+	0x46, 0x41, 0x78, 0x47, 0xab, 0x6d, 0xa8, 0x48, 0xd0, 0xd1, 0x15, 0x45, 0xa9, 0xb3, 0x7f, 0x66,
+	0xc0, 0x7c, 0x53, 0x51, 0xea, 0xe5, 0x2a, 0xee, 0x53, 0xd4, 0xb2, 0x20, 0x8a, 0x2a, 0xd2, 0xb4,
+	0x08, 0x93, 0x60, 0x16, 0xc7, 0x8b, 0x77, 0x99, 0x0e, 0x77, 0x33, 0xbb, 0x2c, 0x54, 0xab, 0x4a,
+	0x4b, 0xd6, 0x13, 0xc6, 0x60, 0x42, 0xd9, 0x4b, 0xe8, 0xfb, 0x28, 0xa1, 0xa8, 0x52, 0x4d, 0x92,
+	0x69, 0x4b, 0xd2, 0x12, 0x0d, 0xa4, 0x69, 0x42, 0x0d, 0x95, 0x52, 0x90, 0x2e, 0x38, 0x83, 0x72,
+	0xf9, 0xf6, 0x6a, 0x41, 0xdd, 0x57, 0xf5, 0xb5, 0xf6, 0x4a, 0xbb, 0x8a, 0xf2, 0xf5, 0x7c, 0x6b,
+	0x2d, 0xa7, 0x7d, 0x57, 0xbe, 0xdd, 0x4a, 0xd7, 0x73, 0xb9, 0x83, 0x5b, 0x6f, 0xca, 0xed, 0x96,
+	0x0c, 0x0f, 0x7d, 0x53, 0x9a, 0x78, 0x23, 0xc9, 0x55, 0xab, 0x1c, 0x95, 0x7f, 0x7c, 0x14, 0x3f,
+	0xdf, 0x16, 0x1a, 0xf5, 0x4b, 0xb0, 0x97, 0x6a, 0x90, 0x67, 0x71, 0xf7, 0x06, 0xed, 0x35, 0x58,
+	0xd8, 0x12, 0x98, 0x24, 0xc4, 0x7a, 0xbb, 0x89, 0xca, 0x92, 0x18, 0xf1, 0x25, 0x98, 0xc5, 0x70,
+	0x71, 0xb1, 0xc3, 0x4d, 0x95, 0xfc, 0x30, 0x03, 0x0f, 0x7d, 0xc1, 0x96, 0x24, 0xeb, 0xb9, 0xec,
+	0xf1, 0x51, 0x7c, 0xce, 0x21, 0xdb, 0x20, 0x87, 0x3c, 0xc0, 0xcd, 0xdd, 0x76, 0x13, 0x6d, 0x8b,
+	0xec, 0x7f, 0x19, 0x10, 0x16, 0x51, 0x53, 0xd1, 0x24, 0xbd, 0x8c, 0xdd, 0xa5, 0x45, 0x02, 0x09,
+	0xff, 0xe2, 0x44, 0xf6, 0x5c, 0x92, 0x2e, 0x2c, 0x59, 0x11, 0x34, 0x64, 0x3a, 0x3d, 0xb9, 0xa1,
+	0x48, 0x72, 0xf1, 0x37, 0x4c, 0x87, 0xab, 0x94, 0x76, 0xaf, 0x7d, 0x0f, 0x8a, 0x48, 0x56, 0x1a,
+	0xf0, 0x52, 0x82, 0x7e, 0xbc, 0x0e, 0x97, 0x12, 0x50, 0x68, 0x60, 0xeb, 0xe1, 0xbe, 0x4c, 0x9a,
+	0xfc, 0xc1, 0xb7, 0x96, 0x12, 0xdd, 0x94, 0xdf, 0x72, 0x53, 0x66, 0x4d, 0xca, 0xeb, 0x87, 0xbe,
+	0x71, 0x6c, 0x1e, 0x3c, 0x8d, 0xf6, 0xd1, 0x51, 0x7c, 0xe4, 0xf8, 0x28, 0x3e, 0x4f, 0x57, 0xe0,
+	0xd2, 0x11, 0xfe, 0xfa, 0x93, 0xf8, 0x62, 0x4d, 0xd2, 0xf7, 0x5b, 0x95, 0x64, 0x55, 0x69, 0xa4,
+	0xa8, 0xaa, 0xc6, 0xbf, 0x65, 0x4d, 0xbc, 0x91, 0xc2, 0x6b, 0xd5, 0xa8, 0x1c, 0x7e, 0xd2, 0xe0,
+	0x25, 0x2d, 0xf6, 0x3b, 0x60, 0x5e, 0x45, 0x1a, 0x52, 0x6f, 0x21, 0x22, 0xab, 0x7c, 0x80, 0xa4,
+	0xda, 0xbe, 0xae, 0x45, 0x46, 0x13, 0xfe, 0xc5, 0x70, 0x71, 0xa9, 0xc3, 0x81, 0x52, 0xe8, 0xda,
+	0x7a, 0x7a, 0x29, 0x91, 0x4d, 0x5f, 0xb7, 0x9d, 0xd3, 0x8b, 0x05, 0xf2, 0xac, 0xd1, 0x8d, 0x25,
+	0xbf, 0x46, 0x3b, 0xd9, 0x2d, 0x10, 0x16, 0x1a, 0xcd, 0xba, 0xb4, 0x27, 0x55, 0x09, 0x1e, 0x23,
+	0x41, 0xe2, 0x9d, 0x44, 0x87, 0x1b, 0x2d, 0xf9, 0x33, 0xe9, 0xb4, 0xbd, 0x24, 0x17, 0x19, 0xe4,
+	0xdd, 0x6c, 0x97, 0x42, 0x3f, 0xfc, 0x30, 0x3e, 0xf2, 0xef, 0x0f, 0xe3, 0x23, 0xf0, 0x2c, 0x38,
+	0xe3, 0x02, 0x32, 0x8f, 0xb4, 0xa6, 0x22, 0x6b, 0x08, 0xfe, 0x3c, 0x40, 0x46, 0x36, 0xe9, 0xf2,
+	0x5e, 0x93, 0xf4, 0x7d, 0x49, 0x2e, 0x0a, 0x7a, 0x75, 0x9f, 0xfd, 0x3d, 0x03, 0x66, 0x8d, 0x55,
+	0x7b, 0x70, 0x7e, 0xe7, 0xb4, 0x70, 0x1e, 0x71, 0x79, 0xd2, 0x09, 0xf2, 0x19, 0xab, 0xcf, 0x84,
+	0xf8, 0x57, 0xc1, 0x18, 0xc1, 0xac, 0x81, 0xee, 0x40, 0x31, 0xd9, 0x85, 0xee, 0xd5, 0x95, 0xff,
+	0x1c, 0xc5, 0x4d, 0x9a, 0xe3, 0xa3, 0xf8, 0x94, 0x03, 0xe8, 0x18, 0xe3, 0x41, 0xfc, 0xd5, 0x13,
+	0xdf, 0xfe, 0xa7, 0x1a, 0xdf, 0x0e, 0xdc, 0xc4, 0xc1, 0x73, 0x3d, 0xd1, 0x61, 0xe1, 0xe7, 0x9f,
+	0x01, 0xb0, 0xb0, 0xa3, 0xd5, 0xf0, 0x90, 0xa8, 0x0a, 0x07, 0x4e, 0x00, 0xfd, 0x91, 0x01, 0xec,
+	0x81, 0xd1, 0x8f, 0xba, 0x11, 0xf4, 0xfe, 0x69, 0x21, 0xe8, 0x1c, 0xb5, 0x95, 0x57, 0x31, 0xc8,
+	0xcf, 0xda, 0x9d, 0x27, 0x8e, 0xa1, 0x3f, 0x31, 0x60, 0x9c, 0x74, 0x62, 0xe7, 0x44, 0xfc, 0x09,
+	0xe6, 0xe1, 0xf8, 0x79, 0x97, 0xe9, 0x70, 0xcd, 0x52, 0xd5, 0x01, 0x0a, 0xcc, 0xbc, 0x99, 0xcb,
+	0x73, 0xe9, 0x8d, 0x8d, 0xcc, 0xea, 0xe5, 0xcb, 0xf9, 0xc2, 0xfa, 0x56, 0x21, 0x5d, 0x4c, 0xaf,
+	0xac, 0x6c, 0x5c, 0xce, 0x16, 0x56, 0xb9, 0x95, 0x74, 0xbe, 0xc8, 0x15, 0x36, 0x72, 0xeb, 0x99,
+	0xcb, 0xb9, 0xf5, 0xf5, 0xdc, 0x5a, 0xbe, 0x50, 0xd8, 0x2c, 0xac, 0x6e, 0x65, 0xb7, 0xd6, 0xd2,
+	0x1b, 0xd9, 0xad, 0x74, 0x96, 0xcb, 0xe6, 0xb8, 0x15, 0x2f, 0xf8, 0xe0, 0x5b, 0x87, 0xbe, 0x90,
+	0x09, 0x27, 0x03, 0x4d, 0x33, 0xce, 0xbd, 0x44, 0x91, 0x64, 0xc8, 0x87, 0xf0, 0x37, 0xa6, 0x60,
+	0xab, 0x60, 0xce, 0x34, 0x12, 0x19, 0x2b, 0x13, 0xfd, 0x22, 0x01, 0xe2, 0xd3, 0x5c, 0x87, 0x63,
+	0x4b, 0x63, 0xb0, 0x25, 0xe8, 0x4a, 0x03, 0xdb, 0x47, 0xd3, 0x55, 0x49, 0xae, 0x1d, 0x1f, 0xc5,
+	0xa3, 0x6e, 0x9b, 0x3b, 0x38, 0x1d, 0x46, 0xc7, 0xf2, 0x37, 0x71, 0x9f, 0x03, 0x7e, 0x09, 0x10,
+	0xeb, 0x0d, 0x2e, 0x0b, 0x7f, 0xff, 0x1b, 0x07, 0xec, 0x8e, 0x56, 0x7b, 0xf5, 0x40, 0x68, 0x3a,
+	0xb1, 0xf7, 0x57, 0x06, 0x2c, 0x68, 0x07, 0x42, 0xb3, 0xac, 0xa2, 0x9b, 0x2d, 0xa4, 0xe9, 0x1e,
+	0xfc, 0x7d, 0x70, 0x5a, 0xf8, 0x7b, 0x8e, 0xda, 0xa2, 0xb7, 0x72, 0x90, 0x9f, 0xc7, 0x03, 0xbc,
+	0xd9, 0x7f, 0xe2, 0x30, 0x2c, 0x81, 0x49, 0x32, 0xb3, 0xb9, 0xed, 0xfb, 0xfb, 0x6e, 0xfb, 0x4e,
+	0x72, 0xc8, 0x03, 0xdc, 0x34, 0xb6, 0xfd, 0x77, 0x19, 0x00, 0x94, 0xbd, 0x3d, 0xa4, 0x52, 0x4c,
+	0x07, 0xfa, 0x61, 0xfa, 0x9b, 0x1d, 0x2e, 0x5f, 0x5a, 0x1c, 0x34, 0x23, 0x7a, 0x71, 0x39, 0x4b,
+	0x15, 0xb2, 0xa7, 0x84, 0xfc, 0x38, 0x69, 0x10, 0x64, 0x5e, 0xc5, 0xbb, 0x55, 0x43, 0x90, 0x45,
+	0x27, 0x2e, 0x47, 0x89, 0xaf, 0x5f, 0x20, 0x1b, 0x32, 0x9d, 0xae, 0x08, 0x9d, 0xbb, 0x48, 0x17,
+	0x3d, 0xe4, 0xa7, 0x69, 0x9f, 0x85, 0x45, 0xf6, 0x2e, 0x03, 0xa6, 0xec, 0x19, 0xcb, 0x7b, 0x08,
+	0x45, 0x82, 0xfd, 0x16, 0xca, 0x77, 0xb8, 0x6c, 0xe9, 0x62, 0x9f, 0x85, 0xe6, 0x1f, 0xb0, 0xca,
+	0x33, 0xdd, 0xab, 0xc4, 0x73, 0x42, 0x7e, 0xd2, 0x5a, 0xe9, 0x16, 0x42, 0x6c, 0x1b, 0x4c, 0x28,
+	0xaa, 0x88, 0xd4, 0x72, 0x53, 0x95, 0xaa, 0x28, 0x32, 0x46, 0x96, 0xf9, 0x7a, 0x87, 0x9b, 0x2d,
+	0x8d, 0xc2, 0x4c, 0x12, 0xfb, 0x71, 0x0c, 0x8b, 0xdd, 0x44, 0x55, 0x2c, 0xf5, 0x1f, 0x47, 0xf1,
+	0xe7, 0x07, 0xd8, 0x09, 0x36, 0x51, 0xf5, 0xf8, 0x28, 0xce, 0x1a, 0xf3, 0xdb, 0xe2, 0x21, 0x0f,
+	0x48, 0xeb, 0x0a, 0x6e, 0xb0, 0xaf, 0x82, 0x29, 0x3a, 0x56, 0x97, 0xf6, 0x90, 0xd6, 0x14, 0xe4,
+	0x48, 0x88, 0x60, 0x68, 0xa9, 0xc3, 0xcd, 0xe0, 0xd9, 0xd3, 0x69, 0x17, 0x8a, 0xce, 0x38, 0xc5,
+	0x99, 0x2c, 0x90, 0x0f, 0x93, 0x8e, 0x6f, 0x18, 0x6d, 0xf6, 0x67, 0x0c, 0x58, 0x68, 0x10, 0x2f,
+	0xd8, 0x1e, 0xa1, 0xa6, 0x8a, 0x8c, 0x93, 0xb5, 0xed, 0x75, 0xb8, 0xf9, 0x52, 0x08, 0x16, 0xf2,
+	0x04, 0x1c, 0xc6, 0xf2, 0xb6, 0x65, 0x7d, 0x88, 0xe5, 0x6d, 0xcb, 0xba, 0x1d, 0x7e, 0xbd, 0x27,
+	0x83, 0xfc, 0x5c, 0x03, 0x3b, 0xde, 0x84, 0x00, 0x47, 0x7a, 0xd9, 0xf7, 0x19, 0xc0, 0xf6, 0xd0,
+	0x0c, 0x10, 0xcd, 0xaa, 0x1d, 0xee, 0x4c, 0x69, 0xdc, 0xc2, 0xed, 0x23, 0xa8, 0x76, 0xce, 0x8b,
+	0x4a, 0x53, 0xad, 0x19, 0xb1, 0x4b, 0x27, 0x47, 0x8e, 0xbc, 0x00, 0xa2, 0xde, 0x04, 0x68, 0xe5,
+	0xc7, 0x9f, 0x04, 0xc1, 0xac, 0xbd, 0x83, 0xef, 0x2a, 0xbc, 0x20, 0xd7, 0xd0, 0xb3, 0xda, 0xce,
+	0x4a, 0x88, 0x6d, 0x30, 0x51, 0x57, 0x0e, 0xac, 0x48, 0xf2, 0x3b, 0x23, 0x29, 0x9d, 0x2c, 0x9c,
+	0x40, 0x24, 0x39, 0xc4, 0x43, 0x1e, 0x90, 0x16, 0x8d, 0xa4, 0x36, 0x98, 0x68, 0x35, 0x9b, 0xd6,
+	0xd4, 0x81, 0x93, 0x0f, 0x62, 0x87, 0x78, 0xc8, 0x03, 0xd2, 0xa2, 0x53, 0x7b, 0x2b, 0xda, 0xd1,
+	0xcf, 0xbd, 0xa2, 0xcd, 0x9c, 0x4e, 0x45, 0xbb, 0x0b, 0xce, 0x79, 0xe2, 0xc1, 0x8c, 0x16, 0x76,
+	0x0d, 0x4c, 0x90, 0x7e, 0x49, 0x91, 0x31, 0xb4, 0x18, 0x02, 0xad, 0x05, 0xdb, 0xa0, 0x8e, 0x41,
+	0x72, 0x04, 0xa6, 0xad, 0x6d, 0x11, 0xfe, 0xc2, 0x07, 0xe6, 0x1d, 0x95, 0xca, 0x96, 0xaa, 0x34,
+	0x68, 0xa4, 0xfd, 0x96, 0x01, 0x61, 0xe5, 0x40, 0xf6, 0xd4, 0x1f, 0x3f, 0x3e, 0xad, 0x28, 0x33,
+	0x2c, 0xeb, 0xd2, 0x09, 0x6f, 0x2f, 0xb8, 0x6d, 0x46, 0xd7, 0xd7, 0xdc, 0x66, 0xa0, 0x11, 0xf6,
+	0x25, 0x4f, 0x84, 0xf5, 0xb7, 0x8b, 0xc3, 0xee, 0x31, 0x70, 0xa1, 0x97, 0x81, 0xac, 0x44, 0xf5,
+	0xa9, 0x8f, 0xde, 0xb5, 0x08, 0x72, 0x15, 0xd5, 0x71, 0x36, 0x7b, 0x56, 0xc3, 0x3d, 0x20, 0x65,
+	0x15, 0xc1, 0x78, 0x43, 0xab, 0x95, 0x25, 0x59, 0x44, 0xb7, 0x49, 0xc2, 0x0a, 0x14, 0x2f, 0xf6,
+	0xf2, 0x8d, 0x51, 0xc7, 0x5b, 0xb4, 0x90, 0x0f, 0x35, 0xb4, 0xda, 0x36, 0xfe, 0xf4, 0xde, 0x0c,
+	0x58, 0x66, 0xb7, 0x1c, 0xf2, 0xb7, 0x20, 0x98, 0x34, 0x36, 0x16, 0x5e, 0x69, 0xe9, 0xe8, 0x69,
+	0xf3, 0xc7, 0x2b, 0x20, 0x64, 0x98, 0x56, 0x8b, 0xf8, 0x12, 0xfe, 0xc5, 0x40, 0x71, 0xa5, 0xc3,
+	0xc5, 0x4a, 0xe0, 0x1a, 0xcc, 0xe0, 0x44, 0x95, 0x85, 0xd7, 0x0f, 0x7d, 0xd3, 0x82, 0xaa, 0x0a,
+	0x6d, 0xac, 0xb3, 0x65, 0xd5, 0x69, 0x97, 0x57, 0x34, 0xc8, 0x8f, 0x51, 0xb7, 0x68, 0xdd, 0xf5,
+	0xb0, 0xff, 0x31, 0xac, 0x87, 0x03, 0x9e, 0x7a, 0xf8, 0x8d, 0x47, 0xaf, 0x87, 0x47, 0x4f, 0xa4,
+	0x1e, 0xce, 0xe4, 0x1f, 0xa1, 0x1e, 0x7e, 0x48, 0xfd, 0x18, 0x3c, 0xf5, 0xfa, 0xd1, 0x11, 0x6c,
+	0x0b, 0x60, 0xde, 0x19, 0x52, 0x56, 0xac, 0xfd, 0x2a, 0x00, 0xe6, 0xac, 0xfb, 0x39, 0x1e, 0x1d,
+	0x08, 0xaa, 0x78, 0xa5, 0x2e, 0xc8, 0xec, 0xef, 0x18, 0x30, 0xb5, 0xd7, 0x92, 0x45, 0x1b, 0xcd,
+	0x46, 0xa8, 0xbd, 0x77, 0x5a, 0xa1, 0x66, 0xb8, 0xc3, 0xad, 0x14, 0xe4, 0xc3, 0xb4, 0xe3, 0xc4,
+	0x73, 0xdd, 0x1f, 0x18, 0x30, 0xa9, 0x12, 0x7b, 0x0c, 0x7a, 0xf3, 0xf6, 0x0e, 0xd3, 0xe1, 0xd6,
+	0x4a, 0x2f, 0x38, 0xeb, 0x14, 0x7a, 0x21, 0x31, 0x78, 0xf1, 0x31, 0x67, 0xde, 0xd7, 0xda, 0xf3,
+	0x0e, 0x57, 0x7b, 0x4c, 0x50, 0x56, 0xd2, 0x60, 0x37, 0x40, 0xc8, 0x7c, 0xa7, 0x88, 0x04, 0xcc,
+	0x9d, 0x74, 0xb6, 0x14, 0x24, 0x3a, 0xb8, 0x12, 0xb6, 0x91, 0x5a, 0x4c, 0x6a, 0xc8, 0x5b, 0x8c,
+	0x0e, 0x08, 0x95, 0xc0, 0xf9, 0x1e, 0x48, 0xb1, 0x2a, 0x98, 0x17, 0xc1, 0x58, 0xb3, 0x2e, 0x38,
+	0xaa, 0x17, 0xd6, 0x61, 0x5d, 0x3a, 0x80, 0xad, 0x5b, 0x17, 0x70, 0xd5, 0x72, 0xd7, 0x0f, 0x42,
+	0x18, 0x8f, 0xba, 0x70, 0x03, 0x11, 0xac, 0x69, 0xf8, 0xeb, 0x71, 0xc3, 0x9a, 0x5b, 0x29, 0xc8,
+	0x87, 0x69, 0x87, 0x89, 0x35, 0xf7, 0xcd, 0x9a, 0xef, 0x09, 0xbd, 0x59, 0x73, 0x78, 0x98, 0x05,
+	0x33, 0xa6, 0x53, 0xac, 0x04, 0xf1, 0x81, 0x1f, 0x80, 0x1d, 0xad, 0x76, 0x55, 0xd6, 0x9e, 0xf9,
+	0xea, 0x71, 0xf2, 0xd5, 0x3c, 0x60, 0x6d, 0xb7, 0xd8, 0x8f, 0x2a, 0x3e, 0x30, 0x8d, 0x83, 0xb4,
+	0x2e, 0x48, 0x0d, 0x1a, 0xa3, 0xda, 0x13, 0xeb, 0xb2, 0x93, 0x4a, 0xe5, 0x0e, 0xa3, 0xbd, 0xcd,
+	0x80, 0xb3, 0x5d, 0xe6, 0xb1, 0xf2, 0x17, 0x02, 0x63, 0x34, 0x79, 0x62, 0xf3, 0xf4, 0x49, 0xf5,
+	0x69, 0xec, 0xaa, 0xa1, 0x12, 0xb3, 0x29, 0x1b, 0xbe, 0x13, 0x00, 0x63, 0x3b, 0x5a, 0xad, 0xa8,
+	0xc8, 0xe2, 0x93, 0x79, 0x44, 0x7b, 0x3a, 0x22, 0x89, 0x6d, 0x3a, 0x36, 0x47, 0xb3, 0x60, 0xa6,
+	0xaf, 0xf8, 0x49, 0xf3, 0x15, 0x3f, 0xb9, 0x69, 0x10, 0x14, 0x0b, 0x1d, 0xee, 0x7c, 0x69, 0x1c,
+	0xae, 0xa6, 0x57, 0xd6, 0xd3, 0x69, 0x0d, 0x1e, 0xfa, 0xc2, 0xba, 0xd4, 0x40, 0xd6, 0xb8, 0x31,
+	0x5d, 0xf7, 0x2e, 0xfa, 0xd3, 0x4f, 0xe2, 0x4c, 0xcf, 0x9d, 0xf4, 0x65, 0x30, 0x6d, 0x40, 0xc0,
+	0xb9, 0x7b, 0x56, 0x14, 0x59, 0xec, 0xb9, 0x7b, 0x1a, 0x03, 0x90, 0x0f, 0xe2, 0xaf, 0x6d, 0x11,
+	0xbe, 0xe7, 0x03, 0x53, 0x58, 0x00, 0xaa, 0x49, 0xf2, 0x55, 0xb9, 0xf2, 0xc4, 0x42, 0xe9, 0x25,
+	0x7b, 0xd1, 0x34, 0xc2, 0xbf, 0xd0, 0xeb, 0x34, 0xf9, 0x00, 0x2b, 0x38, 0xec, 0xd9, 0x02, 0x0b,
+	0x6e, 0x73, 0x58, 0x66, 0xbd, 0x06, 0x26, 0x5a, 0xa4, 0xa7, 0x8c, 0x1d, 0x46, 0x6c, 0x32, 0x91,
+	0x8d, 0x7a, 0x1c, 0xbd, 0x6b, 0xfe, 0x5c, 0xa3, 0x18, 0x33, 0x7c, 0x69, 0xde, 0x63, 0xd9, 0xcc,
+	0xf0, 0x0e, 0x76, 0x27, 0xa0, 0x3d, 0x98, 0x01, 0xfe, 0xd2, 0x4f, 0x8b, 0x6a, 0xa4, 0x6f, 0x48,
+	0x6a, 0xb5, 0x25, 0xe9, 0x45, 0x15, 0xe1, 0x04, 0x46, 0x9c, 0x21, 0x88, 0xb8, 0x52, 0x7f, 0xbc,
+	0x9c, 0xe1, 0xd2, 0x09, 0xf2, 0x93, 0xa4, 0xed, 0x70, 0x86, 0x3b, 0xdd, 0x3e, 0xcc, 0x19, 0x9e,
+	0x72, 0xf9, 0x15, 0x80, 0x8f, 0xf8, 0xe4, 0xb9, 0xc6, 0xb8, 0xca, 0x24, 0x67, 0xda, 0x39, 0x7a,
+	0x34, 0x3e, 0x20, 0xf7, 0xc3, 0xe5, 0x0a, 0xbe, 0x20, 0x76, 0xbd, 0xcf, 0x4d, 0xdb, 0x37, 0x05,
+	0x98, 0x15, 0xf2, 0x63, 0x0d, 0xad, 0x86, 0x5f, 0x79, 0xd8, 0x97, 0xc1, 0x18, 0x92, 0x85, 0x4a,
+	0x1d, 0x89, 0xa4, 0x76, 0x0d, 0x15, 0xbf, 0xd8, 0xe1, 0xa6, 0x4b, 0x41, 0xa8, 0xab, 0x2d, 0x04,
+	0x0f, 0x7d, 0x81, 0x8a, 0xa2, 0xd4, 0x6d, 0x7d, 0x0c, 0x52, 0xc8, 0x9b, 0x4c, 0x9e, 0xfb, 0x1f,
+	0x8f, 0x97, 0x4c, 0x8c, 0x64, 0x3f, 0x0d, 0x03, 0xff, 0x8e, 0x56, 0x63, 0x65, 0x00, 0x1c, 0xbf,
+	0xb7, 0x79, 0x31, 0xf9, 0xb0, 0x1f, 0x10, 0x25, 0x5d, 0xbf, 0x69, 0x88, 0xe6, 0x86, 0x20, 0xb6,
+	0xb0, 0xf9, 0x03, 0x06, 0xb0, 0x3d, 0x7e, 0xfd, 0xd0, 0x5f, 0x96, 0x97, 0x29, 0xfa, 0x95, 0xcf,
+	0xc0, 0x64, 0x29, 0xf2, 0x23, 0x06, 0xcc, 0xf5, 0x7a, 0x46, 0x5f, 0xe9, 0x2b, 0xb4, 0x07, 0x57,
+	0xf4, 0xa5, 0xcf, 0xc2, 0x65, 0xe9, 0xa2, 0x82, 0x00, 0xb9, 0x82, 0x4b, 0xf7, 0x95, 0xd2, 0xf5,
+	0xee, 0x10, 0x5d, 0x1f, 0x96, 0xc3, 0x9a, 0xf3, 0x4d, 0x30, 0xd5, 0xf5, 0x4a, 0x91, 0x1a, 0xd4,
+	0x9c, 0x06, 0x43, 0x74, 0x6d, 0x48, 0x06, 0x6b, 0xee, 0xef, 0x33, 0x60, 0xd6, 0x7b, 0x77, 0x9b,
+	0x1d, 0xd8, 0x86, 0x16, 0x4f, 0xf4, 0xd2, 0xf0, 0x3c, 0x96, 0x16, 0x18, 0xfa, 0xf6, 0xf5, 0xe7,
+	0x00, 0xd0, 0xb7, 0x88, 0xa3, 0xb9, 0x21, 0x88, 0xad, 0xf9, 0x6e, 0x80, 0x71, 0xfb, 0x76, 0xef,
+	0xcb, 0x03, 0x39, 0x8e, 0xd0, 0x46, 0xb3, 0x83, 0xd3, 0x5a, 0x93, 0xbd, 0xcd, 0x80, 0x19, 0xcf,
+	0xfd, 0x46, 0x66, 0xc0, 0x88, 0xb5, 0x59, 0xa2, 0x85, 0xa1, 0x59, 0x2c, 0x15, 0xca, 0x60, 0x94,
+	0x1e, 0x75, 0x9f, 0xef, 0xaf, 0x3f, 0xa6, 0x8b, 0x26, 0x07, 0xa3, 0x73, 0x16, 0xaf, 0xe6, 0x09,
+	0x6d, 0xb1, 0x2f, 0xab, 0x41, 0x19, 0x4d, 0x0f, 0x4a, 0x69, 0x4d, 0xa3, 0x83, 0x49, 0xd7, 0xd1,
+	0x62, 0xb9, 0xbf, 0x49, 0x1c, 0xe4, 0xd1, 0xfc, 0x50, 0xe4, 0xd6, 0xac, 0xdf, 0x06, 0x01, 0x52,
+	0x2e, 0x5f, 0xec, 0xcb, 0x8e, 0xc9, 0xa2, 0xcb, 0x03, 0x91, 0x59, 0xd2, 0x6f, 0x82, 0x09, 0x67,
+	0x21, 0xb5, 0xd4, 0x9f, 0xdb, 0xa6, 0x8e, 0xae, 0x0c, 0x43, 0xed, 0x0a, 0x7a, 0x6f, 0xd5, 0x30,
+	0x00, 0xb6, 0xbb, 0x79, 0xa2, 0x97, 0x86, 0xe7, 0x31, 0xb5, 0x28, 0x7e, 0xfd, 0xa3, 0x7b, 0x31,
+	0xe6, 0xe3, 0x7b, 0x31, 0xe6, 0x5f, 0xf7, 0x62, 0xcc, 0x9d, 0xfb, 0xb1, 0x91, 0x8f, 0xef, 0xc7,
+	0x46, 0xfe, 0x7e, 0x3f, 0x36, 0xf2, 0x46, 0xc6, 0x71, 0xac, 0xe9, 0xf9, 0x6b, 0xdb, 0xdb, 0x8e,
+	0x6f, 0x72, 0xca, 0xa9, 0x04, 0x49, 0x2d, 0x95, 0xfb, 0xff, 0x00, 0x7f, 0xe4, 0xe2, 0x75, 0x9e,
+	0x2b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Submit a create liquidity pool message.
+	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
+	// Submit a deposit to the liquidity pool batch.
+	DepositWithinBatch(ctx context.Context, in *MsgDepositWithinBatch, opts ...grpc.CallOption) (*MsgDepositWithinBatchResponse, error)
+	// Submit a withdraw from the liquidity pool batch.
+	WithdrawWithinBatch(ctx context.Context, in *MsgWithdrawWithinBatch, opts ...grpc.CallOption) (*MsgWithdrawWithinBatchResponse, error)
+	// Submit a swap to the liquidity pool batch.
+	Swap(ctx context.Context, in *MsgSwapWithinBatch, opts ...grpc.CallOption) (*MsgSwapWithinBatchResponse, error)
+	// Submit a deposit to a price range of the concentrated liquidity pool.
+	DepositToRange(ctx context.Context, in *MsgDepositToRange, opts ...grpc.CallOption) (*MsgDepositToRangeResponse, error)
+	// Submit a withdraw of a position from the concentrated liquidity pool.
+	WithdrawFromRange(ctx context.Context, in *MsgWithdrawFromRange, opts ...grpc.CallOption) (*MsgWithdrawFromRangeResponse, error)
+	// Submit a cancellation of a pending swap order of the liquidity pool batch.
+	CancelSwap(ctx context.Context, in *MsgCancelSwap, opts ...grpc.CallOption) (*MsgCancelSwapResponse, error)
+	// Submit a swap routed through the liquidity pool batches of an ordered list of pools.
+	SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error)
+	// Submit a reward plan funding the pool coin stakers of the liquidity pool.
+	CreateRewardPlan(ctx context.Context, in *MsgCreateRewardPlan, opts ...grpc.CallOption) (*MsgCreateRewardPlanResponse, error)
+	// Submit a stake of pool coin for the rewards of the reward plans of the liquidity pool.
+	Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error)
+	// Submit an unstake of staked pool coin.
+	Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error)
+	// Submit a claim of the rewards of the staked pool coin.
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	// Submit a bond locking pool coin for a bond duration.
+	Bond(ctx context.Context, in *MsgBond, opts ...grpc.CallOption) (*MsgBondResponse, error)
+	// Submit a beginning of the unbonding of a bond.
+	BeginUnbond(ctx context.Context, in *MsgBeginUnbond, opts ...grpc.CallOption) (*MsgBeginUnbondResponse, error)
+	// Submit a circuit breaker setting by an emergency admin.
+	SetCircuitBreaker(ctx context.Context, in *MsgSetCircuitBreaker, opts ...grpc.CallOption) (*MsgSetCircuitBreakerResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
+	out := new(MsgCreatePoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/CreatePool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.WithdrawerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DepositWithinBatch(ctx context.Context, in *MsgDepositWithinBatch, opts ...grpc.CallOption) (*MsgDepositWithinBatchResponse, error) {
+	out := new(MsgDepositWithinBatchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/DepositWithinBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	return out, nil
+}
+
+func (c *msgClient) WithdrawWithinBatch(ctx context.Context, in *MsgWithdrawWithinBatch, opts ...grpc.CallOption) (*MsgWithdrawWithinBatchResponse, error) {
+	out := new(MsgWithdrawWithinBatchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/WithdrawWithinBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.PoolCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdrawWithinBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) Swap(ctx context.Context, in *MsgSwapWithinBatch, opts ...grpc.CallOption) (*MsgSwapWithinBatchResponse, error) {
+	out := new(MsgSwapWithinBatchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSwapWithinBatch) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DepositToRange(ctx context.Context, in *MsgDepositToRange, opts ...grpc.CallOption) (*MsgDepositToRangeResponse, error) {
+	out := new(MsgDepositToRangeResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/DepositToRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SwapRequesterAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFromRange(ctx context.Context, in *MsgWithdrawFromRange, opts ...grpc.CallOption) (*MsgWithdrawFromRangeResponse, error) {
+	out := new(MsgWithdrawFromRangeResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/WithdrawFromRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	return out, nil
+}
+
+func (c *msgClient) CancelSwap(ctx context.Context, in *MsgCancelSwap, opts ...grpc.CallOption) (*MsgCancelSwapResponse, error) {
+	out := new(MsgCancelSwapResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/CancelSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.SwapTypeId != 0 {
-		n += 1 + sovTx(uint64(m.SwapTypeId))
+	return out, nil
+}
+
+func (c *msgClient) SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error) {
+	out := new(MsgSwapRouteResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/SwapRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.OfferCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.DemandCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateRewardPlan(ctx context.Context, in *MsgCreateRewardPlan, opts ...grpc.CallOption) (*MsgCreateRewardPlanResponse, error) {
+	out := new(MsgCreateRewardPlanResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/CreateRewardPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error) {
+	out := new(MsgStakeResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/Stake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error) {
+	out := new(MsgUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/Unstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Bond(ctx context.Context, in *MsgBond, opts ...grpc.CallOption) (*MsgBondResponse, error) {
+	out := new(MsgBondResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/Bond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BeginUnbond(ctx context.Context, in *MsgBeginUnbond, opts ...grpc.CallOption) (*MsgBeginUnbondResponse, error) {
+	out := new(MsgBeginUnbondResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/BeginUnbond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCircuitBreaker(ctx context.Context, in *MsgSetCircuitBreaker, opts ...grpc.CallOption) (*MsgSetCircuitBreakerResponse, error) {
+	out := new(MsgSetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/SetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Submit a create liquidity pool message.
+	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
+	// Submit a deposit to the liquidity pool batch.
+	DepositWithinBatch(context.Context, *MsgDepositWithinBatch) (*MsgDepositWithinBatchResponse, error)
+	// Submit a withdraw from the liquidity pool batch.
+	WithdrawWithinBatch(context.Context, *MsgWithdrawWithinBatch) (*MsgWithdrawWithinBatchResponse, error)
+	// Submit a swap to the liquidity pool batch.
+	Swap(context.Context, *MsgSwapWithinBatch) (*MsgSwapWithinBatchResponse, error)
+	// Submit a deposit to a price range of the concentrated liquidity pool.
+	DepositToRange(context.Context, *MsgDepositToRange) (*MsgDepositToRangeResponse, error)
+	// Submit a withdraw of a position from the concentrated liquidity pool.
+	WithdrawFromRange(context.Context, *MsgWithdrawFromRange) (*MsgWithdrawFromRangeResponse, error)
+	// Submit a cancellation of a pending swap order of the liquidity pool batch.
+	CancelSwap(context.Context, *MsgCancelSwap) (*MsgCancelSwapResponse, error)
+	// Submit a swap routed through the liquidity pool batches of an ordered list of pools.
+	SwapRoute(context.Context, *MsgSwapRoute) (*MsgSwapRouteResponse, error)
+	// Submit a reward plan funding the pool coin stakers of the liquidity pool.
+	CreateRewardPlan(context.Context, *MsgCreateRewardPlan) (*MsgCreateRewardPlanResponse, error)
+	// Submit a stake of pool coin for the rewards of the reward plans of the liquidity pool.
+	Stake(context.Context, *MsgStake) (*MsgStakeResponse, error)
+	// Submit an unstake of staked pool coin.
+	Unstake(context.Context, *MsgUnstake) (*MsgUnstakeResponse, error)
+	// Submit a claim of the rewards of the staked pool coin.
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	// Submit a bond locking pool coin for a bond duration.
+	Bond(context.Context, *MsgBond) (*MsgBondResponse, error)
+	// Submit a beginning of the unbonding of a bond.
+	BeginUnbond(context.Context, *MsgBeginUnbond) (*MsgBeginUnbondResponse, error)
+	// Submit a circuit breaker setting by an emergency admin.
+	SetCircuitBreaker(context.Context, *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+}
+func (*UnimplementedMsgServer) DepositWithinBatch(ctx context.Context, req *MsgDepositWithinBatch) (*MsgDepositWithinBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositWithinBatch not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawWithinBatch(ctx context.Context, req *MsgWithdrawWithinBatch) (*MsgWithdrawWithinBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawWithinBatch not implemented")
+}
+func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwapWithinBatch) (*MsgSwapWithinBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (*UnimplementedMsgServer) DepositToRange(ctx context.Context, req *MsgDepositToRange) (*MsgDepositToRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToRange not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFromRange(ctx context.Context, req *MsgWithdrawFromRange) (*MsgWithdrawFromRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromRange not implemented")
+}
+func (*UnimplementedMsgServer) CancelSwap(ctx context.Context, req *MsgCancelSwap) (*MsgCancelSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSwap not implemented")
+}
+func (*UnimplementedMsgServer) SwapRoute(ctx context.Context, req *MsgSwapRoute) (*MsgSwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRoute not implemented")
+}
+func (*UnimplementedMsgServer) CreateRewardPlan(ctx context.Context, req *MsgCreateRewardPlan) (*MsgCreateRewardPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRewardPlan not implemented")
+}
+func (*UnimplementedMsgServer) Stake(ctx context.Context, req *MsgStake) (*MsgStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stake not implemented")
+}
+func (*UnimplementedMsgServer) Unstake(ctx context.Context, req *MsgUnstake) (*MsgUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unstake not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) Bond(ctx context.Context, req *MsgBond) (*MsgBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bond not implemented")
+}
+func (*UnimplementedMsgServer) BeginUnbond(ctx context.Context, req *MsgBeginUnbond) (*MsgBeginUnbondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginUnbond not implemented")
+}
+func (*UnimplementedMsgServer) SetCircuitBreaker(ctx context.Context, req *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCircuitBreaker not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/CreatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositWithinBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositWithinBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositWithinBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/DepositWithinBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositWithinBatch(ctx, req.(*MsgDepositWithinBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawWithinBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawWithinBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawWithinBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/WithdrawWithinBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawWithinBatch(ctx, req.(*MsgWithdrawWithinBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapWithinBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/Swap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Swap(ctx, req.(*MsgSwapWithinBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositToRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/DepositToRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositToRange(ctx, req.(*MsgDepositToRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFromRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/WithdrawFromRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromRange(ctx, req.(*MsgWithdrawFromRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/CancelSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSwap(ctx, req.(*MsgCancelSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/SwapRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapRoute(ctx, req.(*MsgSwapRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateRewardPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRewardPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRewardPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/CreateRewardPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRewardPlan(ctx, req.(*MsgCreateRewardPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Stake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Stake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/Stake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Stake(ctx, req.(*MsgStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/Unstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unstake(ctx, req.(*MsgUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Bond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Bond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/Bond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Bond(ctx, req.(*MsgBond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginUnbond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginUnbond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginUnbond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/BeginUnbond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginUnbond(ctx, req.(*MsgBeginUnbond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/SetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCircuitBreaker(ctx, req.(*MsgSetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.liquidity.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePool",
+			Handler:    _Msg_CreatePool_Handler,
+		},
+		{
+			MethodName: "DepositWithinBatch",
+			Handler:    _Msg_DepositWithinBatch_Handler,
+		},
+		{
+			MethodName: "WithdrawWithinBatch",
+			Handler:    _Msg_WithdrawWithinBatch_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Msg_Swap_Handler,
+		},
+		{
+			MethodName: "DepositToRange",
+			Handler:    _Msg_DepositToRange_Handler,
+		},
+		{
+			MethodName: "WithdrawFromRange",
+			Handler:    _Msg_WithdrawFromRange_Handler,
+		},
+		{
+			MethodName: "CancelSwap",
+			Handler:    _Msg_CancelSwap_Handler,
+		},
+		{
+			MethodName: "SwapRoute",
+			Handler:    _Msg_SwapRoute_Handler,
+		},
+		{
+			MethodName: "CreateRewardPlan",
+			Handler:    _Msg_CreateRewardPlan_Handler,
+		},
+		{
+			MethodName: "Stake",
+			Handler:    _Msg_Stake_Handler,
+		},
+		{
+			MethodName: "Unstake",
+			Handler:    _Msg_Unstake_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "Bond",
+			Handler:    _Msg_Bond_Handler,
+		},
+		{
+			MethodName: "BeginUnbond",
+			Handler:    _Msg_BeginUnbond_Handler,
+		},
+		{
+			MethodName: "SetCircuitBreaker",
+			Handler:    _Msg_SetCircuitBreaker_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/liquidity/v1beta1/tx.proto",
+}
+
+func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ReserveCoinWeights) > 0 {
+		dAtA2 := make([]byte, len(m.ReserveCoinWeights)*10)
+		var j1 int
+		for _, num := range m.ReserveCoinWeights {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DepositCoins) > 0 {
+		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolTypeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolTypeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolCreatorAddress) > 0 {
+		i -= len(m.PoolCreatorAddress)
+		copy(dAtA[i:], m.PoolCreatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolCreatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositWithinBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositWithinBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositCoins) > 0 {
+		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DepositorAddress) > 0 {
+		i -= len(m.DepositorAddress)
+		copy(dAtA[i:], m.DepositorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DepositorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositWithinBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawWithinBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawWithinBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawCoinDenom) > 0 {
+		i -= len(m.WithdrawCoinDenom)
+		copy(dAtA[i:], m.WithdrawCoinDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawCoinDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawWithinBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapWithinBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapWithinBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DemandCoinAmount.Size()
+		i -= size
+		if _, err := m.DemandCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinDemandCoinAmount.Size()
+		i -= size
+		if _, err := m.MinDemandCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.OrderLifespan != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderLifespan))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.OrderPrice.Size()
+		i -= size
+		if _, err := m.OrderPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.OfferCoinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DemandCoinDenom) > 0 {
+		i -= len(m.DemandCoinDenom)
+		copy(dAtA[i:], m.DemandCoinDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DemandCoinDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SwapTypeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SwapTypeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SwapRequesterAddress) > 0 {
+		i -= len(m.SwapRequesterAddress)
+		copy(dAtA[i:], m.SwapRequesterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapRequesterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapWithinBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositCoins) > 0 {
+		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.UpperPrice.Size()
+		i -= size
+		if _, err := m.UpperPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LowerPrice.Size()
+		i -= size
+		if _, err := m.LowerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DepositorAddress) > 0 {
+		i -= len(m.DepositorAddress)
+		copy(dAtA[i:], m.DepositorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DepositorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SwapRequesterAddress) > 0 {
+		i -= len(m.SwapRequesterAddress)
+		copy(dAtA[i:], m.SwapRequesterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapRequesterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinDemandCoinAmount.Size()
+		i -= size
+		if _, err := m.MinDemandCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.OfferCoinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DemandCoinDenom) > 0 {
+		i -= len(m.DemandCoinDenom)
+		copy(dAtA[i:], m.DemandCoinDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DemandCoinDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PoolIds) > 0 {
+		dAtA9 := make([]byte, len(m.PoolIds)*10)
+		var j8 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTx(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SwapRequesterAddress) > 0 {
+		i -= len(m.SwapRequesterAddress)
+		copy(dAtA[i:], m.SwapRequesterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapRequesterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRewardPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRewardPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRewardPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RewardCoins) > 0 {
+		for iNdEx := len(m.RewardCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRewardPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRewardPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRewardPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BondId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BondId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginUnbond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginUnbond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginUnbond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BondId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BondId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginUnbondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginUnbondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginUnbondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTx(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AdminAddress) > 0 {
+		i -= len(m.AdminAddress)
+		copy(dAtA[i:], m.AdminAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AdminAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolCreatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolTypeId != 0 {
+		n += 1 + sovTx(uint64(m.PoolTypeId))
+	}
+	if len(m.DepositCoins) > 0 {
+		for _, e := range m.DepositCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ReserveCoinWeights) > 0 {
+		l = 0
+		for _, e := range m.ReserveCoinWeights {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovTx(uint64(m.Amplification))
+	}
+	return n
+}
+
+func (m *MsgCreatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositWithinBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.DepositCoins) > 0 {
+		for _, e := range m.DepositCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositWithinBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawWithinBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.WithdrawCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawWithinBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapWithinBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapRequesterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.SwapTypeId != 0 {
+		n += 1 + sovTx(uint64(m.SwapTypeId))
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DemandCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OfferCoinFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.OrderPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderLifespan != 0 {
+		n += 1 + sovTx(uint64(m.OrderLifespan))
+	}
+	l = m.MinDemandCoinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.DemandCoinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapWithinBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositToRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.LowerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.UpperPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DepositCoins) > 0 {
+		for _, e := range m.DepositCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositToRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapRequesterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovTx(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *MsgCancelSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapRequesterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DemandCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OfferCoinFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinDemandCoinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRewardPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.RewardCoins) > 0 {
+		for _, e := range m.RewardCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgCreateRewardPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *MsgStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BondId != 0 {
+		n += 1 + sovTx(uint64(m.BondId))
+	}
+	return n
+}
+
+func (m *MsgBeginUnbond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BondId != 0 {
+		n += 1 + sovTx(uint64(m.BondId))
+	}
+	return n
+}
+
+func (m *MsgBeginUnbondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypeId", wireType)
+			}
+			m.PoolTypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolTypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCoins = append(m.DepositCoins, types.Coin{})
+			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReserveCoinWeights = append(m.ReserveCoinWeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReserveCoinWeights) == 0 {
+					m.ReserveCoinWeights = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReserveCoinWeights = append(m.ReserveCoinWeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinWeights", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositWithinBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCoins = append(m.DepositCoins, types.Coin{})
+			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositWithinBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositWithinBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawWithinBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawWithinBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawWithinBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapWithinBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRequesterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRequesterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapTypeId", wireType)
+			}
+			m.SwapTypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapTypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderLifespan", wireType)
+			}
+			m.OrderLifespan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderLifespan |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDemandCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDemandCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DemandCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapWithinBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapWithinBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositToRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositToRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositToRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCoins = append(m.DepositCoins, types.Coin{})
+			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositToRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositToRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositToRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRequesterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRequesterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRequesterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRequesterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDemandCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDemandCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRewardPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRewardPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRewardPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardCoins = append(m.RewardCoins, types.Coin{})
+			if err := m.RewardCoins[len(m.RewardCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRewardPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRewardPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRewardPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.OfferCoinFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.OrderPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgSwapWithinBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1149,15 +6285,15 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1185,13 +6321,13 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreatorAddress = string(dAtA[iNdEx:postIndex])
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
 			}
-			m.PoolTypeId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1201,16 +6337,130 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolTypeId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1220,26 +6470,43 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositCoins = append(m.DepositCoins, types.Coin{})
-			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1261,7 +6528,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1280,16 +6547,50 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1311,7 +6612,7 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgBond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1334,15 +6635,15 @@ func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositWithinBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1370,13 +6671,13 @@ func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositorAddress = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1386,14 +6687,28 @@ func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1420,8 +6735,7 @@ func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositCoins = append(m.DepositCoins, types.Coin{})
-			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1446,7 +6760,7 @@ func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositWithinBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBondResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1469,12 +6783,31 @@ func (m *MsgDepositWithinBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositWithinBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBondResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+			}
+			m.BondId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1496,7 +6829,7 @@ func (m *MsgDepositWithinBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginUnbond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1519,15 +6852,15 @@ func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawWithinBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginUnbond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginUnbond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1555,32 +6888,13 @@ func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
 			}
-			var msglen int
+			m.BondId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1590,25 +6904,11 @@ func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BondId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1630,7 +6930,7 @@ func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawWithinBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginUnbondResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,12 +6953,45 @@ func (m *MsgWithdrawWithinBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawWithinBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginUnbondResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginUnbondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1680,7 +7013,7 @@ func (m *MsgWithdrawWithinBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1703,15 +7036,15 @@ func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapWithinBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapRequesterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1739,7 +7072,7 @@ func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwapRequesterAddress = string(dAtA[iNdEx:postIndex])
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -1761,60 +7094,8 @@ func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapTypeId", wireType)
-			}
-			m.SwapTypeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SwapTypeId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1842,46 +7123,13 @@ func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DemandCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoinFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1891,26 +7139,12 @@ func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1932,7 +7166,7 @@ func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapWithinBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1955,10 +7189,10 @@ func (m *MsgSwapWithinBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapWithinBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -91,6 +91,19 @@ func GetOfferCoinFee(offerCoin sdk.Coin, swapFeeRate sdk.Dec) sdk.Coin {
 	return sdk.NewCoin(offerCoin.Denom, offerCoin.Amount.ToDec().Mul(swapFeeRate.QuoInt64(2)).Ceil().TruncateInt()) // Ceil(offerCoin.Amount * (swapFeeRate/2))
 }
 
+// GetOfferCoinAndFee splits the coin into the offer coin and the offer coin fee reserved for it by GetOfferCoinFee,
+// so that their sum does not exceed the coin.
+func GetOfferCoinAndFee(coin sdk.Coin, swapFeeRate sdk.Dec) (offerCoin, offerCoinFee sdk.Coin) {
+	offerCoin = sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Quo(sdk.OneDec().Add(swapFeeRate.QuoInt64(2))).TruncateInt())
+	offerCoinFee = GetOfferCoinFee(offerCoin, swapFeeRate)
+	// the ceiling of the offer coin fee can exceed the coin by the truncated decimal
+	for offerCoin.IsPositive() && offerCoin.Amount.Add(offerCoinFee.Amount).GT(coin.Amount) {
+		offerCoin.Amount = offerCoin.Amount.SubRaw(1)
+		offerCoinFee = GetOfferCoinFee(offerCoin, swapFeeRate)
+	}
+	return offerCoin, offerCoinFee
+}
+
 func MustParseCoinsNormalized(coinStr string) sdk.Coins {
 	coins, err := sdk.ParseCoinsNormalized(coinStr)
 	if err != nil {
@@ -122,3 +135,17 @@ func CheckOverflowWithDec(a, b sdk.Dec) (err error) {
 	b.Quo(a)
 	return nil
 }
+
+// WeightedPower returns base^(num/den) for a positive base, taking the root of the reduced exponent first.
+func WeightedPower(base sdk.Dec, num, den uint32) (sdk.Dec, error) {
+	gcd := num
+	for b := den; b != 0; {
+		gcd, b = b, gcd%b
+	}
+	num, den = num/gcd, den/gcd
+	root, err := base.ApproxRoot(uint64(den))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return root.Power(uint64(num)), nil
+}
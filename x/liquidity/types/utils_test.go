@@ -281,6 +281,30 @@ func TestGetOfferCoinFee(t *testing.T) {
 	}
 }
 
+func TestGetOfferCoinAndFee(t *testing.T) {
+	testDenom := "test"
+	testCases := []struct {
+		coin               sdk.Coin
+		swapFeeRate        sdk.Dec
+		expectOfferCoin    sdk.Coin
+		expectOfferCoinFee sdk.Coin
+	}{
+		{sdk.NewInt64Coin(testDenom, 10015), types.DefaultSwapFeeRate, sdk.NewInt64Coin(testDenom, 10000), sdk.NewInt64Coin(testDenom, 15)},
+		{sdk.NewInt64Coin(testDenom, 10016), types.DefaultSwapFeeRate, sdk.NewInt64Coin(testDenom, 10000), sdk.NewInt64Coin(testDenom, 15)},
+		{sdk.NewInt64Coin(testDenom, 1000), types.DefaultSwapFeeRate, sdk.NewInt64Coin(testDenom, 998), sdk.NewInt64Coin(testDenom, 2)},
+		{sdk.NewInt64Coin(testDenom, 10), types.DefaultSwapFeeRate, sdk.NewInt64Coin(testDenom, 9), sdk.NewInt64Coin(testDenom, 1)},
+		{sdk.NewInt64Coin(testDenom, 1), types.DefaultSwapFeeRate, sdk.NewInt64Coin(testDenom, 0), sdk.NewInt64Coin(testDenom, 0)},
+		{sdk.NewInt64Coin(testDenom, 10000), sdk.ZeroDec(), sdk.NewInt64Coin(testDenom, 10000), sdk.NewInt64Coin(testDenom, 0)},
+	}
+
+	for _, tc := range testCases {
+		offerCoin, offerCoinFee := types.GetOfferCoinAndFee(tc.coin, tc.swapFeeRate)
+		require.True(t, tc.expectOfferCoin.IsEqual(offerCoin))
+		require.True(t, tc.expectOfferCoinFee.IsEqual(offerCoinFee))
+		require.True(t, types.GetOfferCoinFee(offerCoin, tc.swapFeeRate).IsEqual(offerCoinFee))
+	}
+}
+
 func TestCheckOverflow(t *testing.T) {
 	testCases := []struct {
 		name      string